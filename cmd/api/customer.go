@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/app"
+	customerController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+var customerCommands = map[string]command{
+	"get":    runCustomerGet,
+	"add":    runCustomerAdd,
+	"update": runCustomerUpdate,
+	"delete": runCustomerDelete,
+}
+
+const customerUsage = `Usage: main customer <get|add|update|delete> [flags]
+
+Commands:
+  get      Print a customer found by CPF
+  add      Register a customer
+  update   Change the name or email of a customer
+  delete   Erase a customer and all of their personal data
+`
+
+// runCustomer dispatches to the customer subcommands, which go through the same use cases, and
+// so the same validation, events and audit log, as the API.
+func runCustomer(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, customerUsage)
+		return errors.New("missing customer command")
+	}
+	run, ok := customerCommands[args[0]]
+	if !ok {
+		fmt.Fprint(os.Stderr, customerUsage)
+		return fmt.Errorf("unknown customer command %q", args[0])
+	}
+	return run(args[1:])
+}
+
+func runCustomerGet(args []string) error {
+	flags := flag.NewFlagSet("customer get", flag.ContinueOnError)
+	cpf := flags.String("cpf", "", "CPF of the customer")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *cpf == "" {
+		return errors.New("-cpf is required")
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+	customer, err := controller.GetByCpf(*cpf, audit.System())
+	if err != nil {
+		return err
+	}
+	return printJSON(os.Stdout, customer)
+}
+
+// runCustomerAdd prints the new customer, read back by CPF since registering returns nothing.
+func runCustomerAdd(args []string) error {
+	flags := flag.NewFlagSet("customer add", flag.ContinueOnError)
+	request := &dto.AddCustomerRequestDto{}
+	flags.StringVar(&request.CPF, "cpf", "", "CPF of the customer")
+	flags.StringVar(&request.Name, "name", "", "name of the customer")
+	flags.StringVar(&request.Email, "email", "", "email of the customer")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if request.CPF == "" {
+		return errors.New("-cpf is required")
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+	if err := controller.Add(request, audit.System()); err != nil {
+		return err
+	}
+	customer, err := controller.GetByCpf(request.CPF, audit.System())
+	if err != nil {
+		return err
+	}
+	return printJSON(os.Stdout, customer)
+}
+
+func runCustomerUpdate(args []string) error {
+	flags := flag.NewFlagSet("customer update", flag.ContinueOnError)
+	id := flags.String("id", "", "ID of the customer")
+	request := &dto.UpdateCustomerRequestDto{}
+	flags.StringVar(&request.Name, "name", "", "new name, unchanged when omitted")
+	flags.StringVar(&request.Email, "email", "", "new email, unchanged when omitted")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("-id is required")
+	}
+	if request.Name == "" && request.Email == "" {
+		return errors.New("nothing to update, pass -name or -email")
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+	customer, err := controller.Update(*id, request, audit.System())
+	if err != nil {
+		return err
+	}
+	return printJSON(os.Stdout, customer)
+}
+
+// runCustomerDelete asks for -yes, as an erasure cannot be undone.
+func runCustomerDelete(args []string) error {
+	flags := flag.NewFlagSet("customer delete", flag.ContinueOnError)
+	id := flags.String("id", "", "ID of the customer")
+	confirmed := flags.Bool("yes", false, "confirm the erasure, which cannot be undone")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *id == "" {
+		return errors.New("-id is required")
+	}
+	if !*confirmed {
+		return errors.New("erasing a customer cannot be undone, pass -yes to confirm")
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+	if err := controller.Erase(*id, audit.System()); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "customer %s erased\n", *id)
+	return nil
+}
+
+// newCustomerController builds the controller and everything below it, without the server.
+func newCustomerController() (customerController.CustomerController, error) {
+	var controller customerController.CustomerController
+	if err := app.InitializeCLI(&controller).Err(); err != nil {
+		return nil, err
+	}
+	return controller, nil
+}
+
+func printJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
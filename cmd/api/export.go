@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/exporter"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+// runExport streams the customer base to a file or standard output as the system actor. A failed
+// export removes the file it was writing, so a truncated dump is never mistaken for a full one.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	outputPath := flags.String("output", "-", "file to write the export to, - for standard output")
+	format := flags.String("format", "", "csv, ndjson or parquet, taken from the file extension when omitted, csv otherwise")
+	maskPII := flags.Bool("mask-pii", false, "mask CPF, name, nickname and email")
+	segments := flags.Int("segments", 0, "parallel scan segments, 4 when omitted and at most 16")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *format == "" {
+		*format = exportFormatFromExtension(*outputPath)
+	}
+	if exporter.ContentType(*format) == "" {
+		return exporter.ErrUnsupportedFormat
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+
+	output := io.Writer(os.Stdout)
+	if *outputPath != "-" {
+		created, err := os.Create(*outputPath)
+		if err != nil {
+			return err
+		}
+		defer created.Close()
+		output = created
+	}
+	writer, err := exporter.NewWriter(*format, output)
+	if err != nil {
+		return err
+	}
+
+	count, err := controller.Export(writer, *segments, *maskPII, audit.System())
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if *outputPath != "-" {
+			os.Remove(*outputPath)
+		}
+		return fmt.Errorf("export failed after %d customers: %w", count, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "exported %d customers\n", count)
+	return nil
+}
+
+func exportFormatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return exporter.FormatNDJSON
+	case ".parquet":
+		return exporter.FormatParquet
+	}
+	return exporter.FormatCSV
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/importer"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+// runImport reads the file, imports it as the system actor and writes the per-row report as JSON.
+// It fails when any row could not be stored, so scripts notice the rows to import again.
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	file := flags.String("file", "", "CSV or NDJSON file to import, - for standard input")
+	format := flags.String("format", "", "csv or ndjson, taken from the file extension when omitted")
+	reportPath := flags.String("report", "", "file to write the JSON report to, standard output when omitted")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-file is required")
+	}
+	if *format == "" {
+		*format = formatFromExtension(*file)
+	}
+
+	input := io.Reader(os.Stdin)
+	if *file != "-" {
+		opened, err := os.Open(*file)
+		if err != nil {
+			return err
+		}
+		defer opened.Close()
+		input = opened
+	}
+	rows, err := importer.Parse(*format, input)
+	if err != nil {
+		return err
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+	report, err := controller.Import(rows, audit.System())
+	if err != nil {
+		return err
+	}
+
+	output := io.Writer(os.Stdout)
+	if *reportPath != "" {
+		created, err := os.Create(*reportPath)
+		if err != nil {
+			return err
+		}
+		defer created.Close()
+		output = created
+	}
+	if err := printJSON(output, report); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "created %d, duplicates %d, invalid %d, failed %d\n", report.Created, report.Duplicates, report.Invalid, report.Failed)
+	if report.Failed > 0 {
+		return fmt.Errorf("%d rows could not be stored", report.Failed)
+	}
+	return nil
+}
+
+func formatFromExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return importer.FormatCSV
+	case ".ndjson", ".jsonl":
+		return importer.FormatNDJSON
+	}
+	return ""
+}
@@ -1,47 +1,79 @@
 package main
 
 import (
-	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
 	"os"
-	"os/signal"
-	"syscall"
 
 	_ "github.com/viniciuscluna/tc-fiap-customer/docs"
-
-	"github.com/viniciuscluna/tc-fiap-customer/internal/app"
 )
 
+// command runs a subcommand with the arguments that follow its name.
+type command func(args []string) error
+
+var commands = map[string]command{
+	"serve":    runServe,
+	"migrate":  runMigrate,
+	"customer": runCustomer,
+	"import":   runImport,
+	"export":   runExport,
+	"seed":     runSeed,
+	"reindex":  runReindex,
+}
+
+const usage = `Usage: main [command] [flags]
+
+Commands:
+  serve      Start the HTTP API and the background workers (default)
+  migrate    Create the DynamoDB tables that do not exist yet and wait until they are active
+  customer   Get, add, update or delete a customer
+  import     Import customers in bulk from a CSV or NDJSON file
+  export     Export every customer as CSV, NDJSON or Parquet
+  seed       Generate demo customers, optionally with orders and consents
+  reindex    Encrypt plaintext customers and move every customer to the current key
+
+Run "main <command> -h" for the flags of a command. Every command but serve and migrate
+is recorded in the audit log as the system actor.
+`
+
 // @title           Tc-Fiap-Customer
 // @version         1.0
 // @description     Api to manage a fast food restaurant
 // @host            localhost:8080
 // @BasePath        /
+// @securityDefinitions.apikey BearerAuth
+// @in          header
+// @name        Authorization
+// @description Bearer JWT, e.g. "Bearer eyJ..."
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in          header
+// @name        X-API-Key
+// @description API key issued to internal services
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Capture system signals for graceful shutdown
-	go func() {
-		c := make(chan os.Signal, 1)
-		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-		<-c
-		cancel()
-	}()
-
-	// Initialize the application using Uber FX
-	app := app.InitializeApp()
-
-	// Start the Uber FX lifecycle
-	if err := app.Start(ctx); err != nil {
-		log.Fatalf("Error while starting app: %v", err)
+	// Without a command the binary serves, as the container entrypoint runs it without arguments.
+	name, args := "serve", []string(nil)
+	if len(os.Args) > 1 {
+		name, args = os.Args[1], os.Args[2:]
+	}
+	if name == "help" || name == "-h" || name == "--help" {
+		fmt.Fprint(os.Stdout, usage)
+		return
 	}
 
-	// Wait until the context is canceled
-	<-ctx.Done()
+	run, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", name, usage)
+		os.Exit(2)
+	}
 
-	// Stop the Uber FX lifecycle
-	if err := app.Stop(ctx); err != nil {
-		log.Fatalf("Error while stopping app: %v", err)
+	err := run(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// runMigrate creates the missing tables and waits until they are active without starting
+// anything else, so a deployment can provision them before the pods roll out. With
+// -copy-customers-from, it then copies the customers of the legacy table keyed by the numeric
+// CPF, to be moved to the blind index by the reindex command.
+func runMigrate(args []string) error {
+	flags := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	legacyTable := flags.String("copy-customers-from", "", "legacy customer table, keyed by the numeric CPF, to copy the customers from")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if err := dynamodb.Migrate(); err != nil {
+		return err
+	}
+	if *legacyTable == "" {
+		return nil
+	}
+
+	report, err := dynamodb.CopyLegacyCustomers(*legacyTable)
+	if report != nil {
+		fmt.Fprintf(os.Stderr, "copied %d, skipped %d already there\n", report.Copied, report.Skipped)
+	}
+	return err
+}
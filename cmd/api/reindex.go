@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+// runReindex encrypts the customers still stored in plaintext and moves the others to the current
+// master key, printing what it did as JSON. It is safe to run again, for instance after a failure
+// or once a key was rotated.
+func runReindex(args []string) error {
+	flags := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	segments := flags.Int("segments", 0, "parallel scan segments, 4 when omitted and at most 16")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	controller, err := newCustomerController()
+	if err != nil {
+		return err
+	}
+
+	report, reindexErr := controller.Reindex(*segments, audit.System())
+	if report != nil {
+		if err := printJSON(os.Stdout, report); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "scanned %d, encrypted %d, reencrypted %d, conflicts %d\n", report.Scanned, report.Encrypted, report.Reencrypted, len(report.Conflicts))
+	}
+	return reindexErr
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/app"
+	consentController "github.com/viniciuscluna/tc-fiap-customer/internal/consent/controller"
+	consentDto "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	customerController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	loyaltyCommands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+	orderHistoryEntities "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	orderHistoryCommands "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/recordorder"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/seed"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+// seeder loads generated data through the same use cases as the API and the event consumers.
+type seeder struct {
+	customers   customerController.CustomerController
+	consents    consentController.ConsentController
+	recordOrder recordorder.RecordOrderUseCase
+	earnPoints  earnpoints.EarnPointsUseCase
+}
+
+type seedReport struct {
+	added, existing, orders, consents int
+}
+
+// runSeed registers generated customers and, when asked, their orders, which earn loyalty points,
+// and their consents. Customers already there are skipped and orders already recorded are not
+// recorded or credited again, so running it again with the same seed changes nothing. It refuses
+// to seed the tables of AWS, where demonstration customers would mix with real ones, unless
+// -allow-remote is passed.
+func runSeed(args []string) error {
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	count := flags.Int("count", 20, "number of customers to generate")
+	randomSeed := flags.Uint64("seed", seed.DefaultSeed, "random seed, the same seed gives the same customers")
+	loyalty := flags.Bool("loyalty", false, "also record orders for the customers, which earn loyalty points")
+	consents := flags.Bool("consents", false, "also record the consents of the customers")
+	allowRemote := flags.Bool("allow-remote", false, "seed the tables of AWS instead of a local endpoint")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *count <= 0 {
+		return errors.New("-count must be positive")
+	}
+	if os.Getenv("DYNAMODB_ENDPOINT") == "" && !*allowRemote {
+		return errors.New("DYNAMODB_ENDPOINT is not set, so the customers would be added to the tables of AWS; pass -allow-remote to seed them anyway")
+	}
+
+	var s seeder
+	if err := app.InitializeCLI(&s.customers, &s.consents, &s.recordOrder, &s.earnPoints).Err(); err != nil {
+		return err
+	}
+
+	customers := seed.Generate(seed.Options{
+		Count:    *count,
+		Seed:     *randomSeed,
+		Now:      time.Now().UTC(),
+		Orders:   *loyalty,
+		Consents: *consents,
+	})
+	report := &seedReport{}
+	for i, customer := range customers {
+		if err := s.load(customer, report); err != nil {
+			return fmt.Errorf("failed to seed customer %d: %w", i, err)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "added %d customers, %d already there, %d orders, %d consents\n",
+		report.added, report.existing, report.orders, report.consents)
+	return nil
+}
+
+func (s *seeder) load(customer *seed.Customer, report *seedReport) error {
+	request := &dto.AddCustomerRequestDto{Name: customer.Name, Email: customer.Email, CPF: customer.CPF}
+	err := s.customers.Add(request, audit.System())
+	added := err == nil
+	switch {
+	case added:
+		report.added++
+	case errors.Is(err, repositories.ErrCustomerAlreadyExists):
+		report.existing++
+	default:
+		return err
+	}
+	if len(customer.Orders) == 0 && len(customer.Consents) == 0 {
+		return nil
+	}
+
+	registered, err := s.customers.GetByCpf(customer.CPF, audit.System())
+	if err != nil {
+		return err
+	}
+
+	for _, order := range customer.Orders {
+		items := make([]orderHistoryEntities.OrderItem, 0, len(order.Items))
+		for _, item := range order.Items {
+			items = append(items, orderHistoryEntities.OrderItem{Name: item.Name, Quantity: item.Quantity})
+		}
+		if err := s.recordOrder.Execute(orderHistoryCommands.NewRecordOrderCommand(registered.ID, order.ID, order.CompletedAt, order.Total, items)); err != nil {
+			return err
+		}
+		if err := s.earnPoints.Execute(loyaltyCommands.NewEarnPointsCommand(registered.ID, order.PaymentID, order.ID, order.Total, order.CompletedAt)); err != nil {
+			return err
+		}
+		report.orders++
+	}
+
+	// Consents are records rather than state, so they are only given to customers added now, not
+	// repeated on every run.
+	if !added {
+		return nil
+	}
+	for _, consent := range customer.Consents {
+		request := &consentDto.ConsentRequestDto{Source: string(consent.Source), PolicyVersion: consent.PolicyVersion}
+		record := s.consents.Revoke
+		if consent.Granted {
+			record = s.consents.Grant
+		}
+		if _, err := record(registered.ID, string(consent.Purpose), request, audit.System()); err != nil {
+			return err
+		}
+		report.consents++
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/app"
+)
+
+// runServe starts the API and the background workers and stops them on SIGINT or SIGTERM.
+func runServe(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Capture system signals for graceful shutdown
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+		<-c
+		cancel()
+	}()
+
+	// Initialize the application using Uber FX
+	app := app.InitializeApp()
+
+	// Start the Uber FX lifecycle
+	if err := app.Start(ctx); err != nil {
+		return fmt.Errorf("error while starting app: %w", err)
+	}
+
+	// Wait until the context is canceled
+	<-ctx.Done()
+
+	// Stop the Uber FX lifecycle
+	if err := app.Stop(ctx); err != nil {
+		return fmt.Errorf("error while stopping app: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package controller
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+
+type APIKeyController interface {
+	Create(request *dto.CreateAPIKeyRequestDto) (*dto.APIKeySecretResponseDto, error)
+	Rotate(id string) (*dto.APIKeySecretResponseDto, error)
+	Revoke(id string) (*dto.APIKeyResponseDto, error)
+}
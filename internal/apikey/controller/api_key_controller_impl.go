@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+	apiKeyPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/createapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/revokeapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/rotateapikey"
+)
+
+var (
+	_ APIKeyController = (*APIKeyControllerImpl)(nil)
+)
+
+type APIKeyControllerImpl struct {
+	presenter           apiKeyPresenter.APIKeyPresenter
+	createAPIKeyUseCase createapikey.CreateAPIKeyUseCase
+	rotateAPIKeyUseCase rotateapikey.RotateAPIKeyUseCase
+	revokeAPIKeyUseCase revokeapikey.RevokeAPIKeyUseCase
+}
+
+func NewAPIKeyControllerImpl(
+	presenter apiKeyPresenter.APIKeyPresenter,
+	createAPIKeyUseCase createapikey.CreateAPIKeyUseCase,
+	rotateAPIKeyUseCase rotateapikey.RotateAPIKeyUseCase,
+	revokeAPIKeyUseCase revokeapikey.RevokeAPIKeyUseCase) *APIKeyControllerImpl {
+	return &APIKeyControllerImpl{
+		presenter:           presenter,
+		createAPIKeyUseCase: createAPIKeyUseCase,
+		rotateAPIKeyUseCase: rotateAPIKeyUseCase,
+		revokeAPIKeyUseCase: revokeAPIKeyUseCase,
+	}
+}
+
+func (c *APIKeyControllerImpl) Create(request *dto.CreateAPIKeyRequestDto) (*dto.APIKeySecretResponseDto, error) {
+	apiKey, key, err := c.createAPIKeyUseCase.Execute(commands.NewCreateAPIKeyCommand(request.Name, request.Scopes))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentSecret(apiKey, key), nil
+}
+
+func (c *APIKeyControllerImpl) Rotate(id string) (*dto.APIKeySecretResponseDto, error) {
+	apiKey, key, err := c.rotateAPIKeyUseCase.Execute(commands.NewRotateAPIKeyCommand(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentSecret(apiKey, key), nil
+}
+
+func (c *APIKeyControllerImpl) Revoke(id string) (*dto.APIKeyResponseDto, error) {
+	apiKey, err := c.revokeAPIKeyUseCase.Execute(commands.NewRevokeAPIKeyCommand(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(apiKey), nil
+}
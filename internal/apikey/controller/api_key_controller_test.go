@@ -0,0 +1,112 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/presenter"
+	mockCreateAPIKey "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/usecase/createapikey"
+	mockRevokeAPIKey "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/usecase/revokeapikey"
+	mockRotateAPIKey "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/usecase/rotateapikey"
+)
+
+type APIKeyControllerTestSuite struct {
+	suite.Suite
+	mockPresenter     *mockPresenter.MockAPIKeyPresenter
+	mockCreateUseCase *mockCreateAPIKey.MockCreateAPIKeyUseCase
+	mockRotateUseCase *mockRotateAPIKey.MockRotateAPIKeyUseCase
+	mockRevokeUseCase *mockRevokeAPIKey.MockRevokeAPIKeyUseCase
+	controller        controller.APIKeyController
+}
+
+func (suite *APIKeyControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockAPIKeyPresenter(suite.T())
+	suite.mockCreateUseCase = mockCreateAPIKey.NewMockCreateAPIKeyUseCase(suite.T())
+	suite.mockRotateUseCase = mockRotateAPIKey.NewMockRotateAPIKeyUseCase(suite.T())
+	suite.mockRevokeUseCase = mockRevokeAPIKey.NewMockRevokeAPIKeyUseCase(suite.T())
+
+	suite.controller = controller.NewAPIKeyControllerImpl(
+		suite.mockPresenter,
+		suite.mockCreateUseCase,
+		suite.mockRotateUseCase,
+		suite.mockRevokeUseCase,
+	)
+}
+
+func TestAPIKeyControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyControllerTestSuite))
+}
+
+// Feature: API Key Controller
+// Scenario: Orchestrate key management use cases
+
+func (suite *APIKeyControllerTestSuite) Test_APIKeyCreation_ShouldPresentKeyWithSecret() {
+	// GIVEN a creation request
+	request := &dto.CreateAPIKeyRequestDto{Name: "order-service", Scopes: []string{"customers:read"}}
+	apiKey := &entities.APIKey{ID: "key-1"}
+	expected := &dto.APIKeySecretResponseDto{Key: "secret"}
+
+	suite.mockCreateUseCase.EXPECT().
+		Execute(commands.NewCreateAPIKeyCommand("order-service", []string{"customers:read"})).
+		Return(apiKey, "secret", nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentSecret(apiKey, "secret").Return(expected).Once()
+
+	// WHEN the controller creates the key
+	result, err := suite.controller.Create(request)
+
+	// THEN the presented key should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, result)
+}
+
+func (suite *APIKeyControllerTestSuite) Test_APIKeyRotation_ShouldPresentKeyWithNewSecret() {
+	// GIVEN an existing key
+	apiKey := &entities.APIKey{ID: "key-1"}
+	expected := &dto.APIKeySecretResponseDto{Key: "new-secret"}
+
+	suite.mockRotateUseCase.EXPECT().Execute(commands.NewRotateAPIKeyCommand("key-1")).Return(apiKey, "new-secret", nil).Once()
+	suite.mockPresenter.EXPECT().PresentSecret(apiKey, "new-secret").Return(expected).Once()
+
+	// WHEN the controller rotates the key
+	result, err := suite.controller.Rotate("key-1")
+
+	// THEN the presented key should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, result)
+}
+
+func (suite *APIKeyControllerTestSuite) Test_APIKeyRevocation_ShouldPresentKey() {
+	// GIVEN an existing key
+	apiKey := &entities.APIKey{ID: "key-1"}
+	expected := &dto.APIKeyResponseDto{ID: "key-1"}
+
+	suite.mockRevokeUseCase.EXPECT().Execute(commands.NewRevokeAPIKeyCommand("key-1")).Return(apiKey, nil).Once()
+	suite.mockPresenter.EXPECT().Present(apiKey).Return(expected).Once()
+
+	// WHEN the controller revokes the key
+	result, err := suite.controller.Revoke("key-1")
+
+	// THEN the presented key should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expected, result)
+}
+
+func (suite *APIKeyControllerTestSuite) Test_APIKeyRevocation_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the use case fails
+	expectedError := errors.New("database error")
+	suite.mockRevokeUseCase.EXPECT().Execute(commands.NewRevokeAPIKeyCommand("key-1")).Return(nil, expectedError).Once()
+
+	// WHEN the controller revokes the key
+	result, err := suite.controller.Revoke("key-1")
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
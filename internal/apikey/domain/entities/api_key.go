@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// APIKey grants scoped access to service-to-service callers. Only the hash of the key is stored.
+type APIKey struct {
+	ID        string     `json:"id" dynamodbav:"id"`
+	Name      string     `json:"name" dynamodbav:"name"`
+	KeyHash   string     `json:"-" dynamodbav:"key_hash"`
+	Scopes    []string   `json:"scopes" dynamodbav:"scopes"`
+	CreatedAt time.Time  `json:"created_at" dynamodbav:"created_at"`
+	RotatedAt *time.Time `json:"rotated_at,omitempty" dynamodbav:"rotated_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" dynamodbav:"revoked_at,omitempty"`
+}
+
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrAPIKeyRevoked  = errors.New("api key is revoked")
+)
+
+type APIKeyRepository interface {
+	GetByID(id string) (*entities.APIKey, error)
+	Add(apiKey *entities.APIKey) error
+	// Rotate replaces the hash of the key, failing with ErrAPIKeyRevoked once it was revoked.
+	Rotate(id string, keyHash string, rotatedAt time.Time) (*entities.APIKey, error)
+	// Revoke revokes the key, keeping the original revocation time when it already was.
+	Revoke(id string, revokedAt time.Time) (*entities.APIKey, error)
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	apiKeyController "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/createapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/rotateapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	manageAPIKeysRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleAdmin},
+	}
+)
+
+type apiKeyApiController struct {
+	controller apiKeyController.APIKeyController
+}
+
+func NewAPIKeyController(controller apiKeyController.APIKeyController) *apiKeyApiController {
+	return &apiKeyApiController{
+		controller: controller,
+	}
+}
+
+func (c *apiKeyApiController) RegisterRoutes(r chi.Router) {
+	prefix := "/v1/admin/api-keys"
+	r.With(auth.Authorize(manageAPIKeysRule)).Post(prefix, c.Create)
+	r.With(auth.Authorize(manageAPIKeysRule)).Post(prefix+"/{id}/rotate", c.Rotate)
+	r.With(auth.Authorize(manageAPIKeysRule)).Delete(prefix+"/{id}", c.Revoke)
+}
+
+// @Summary     Create API key
+// @Description Create a scoped API key for a service caller. The key is returned only once.
+// @Tags        API Keys
+// @Accept      json
+// @Produce     json
+// @Param       body body dto.CreateAPIKeyRequestDto true "Body"
+// @Success     201  {object} dto.APIKeySecretResponseDto
+// @Failure     400  {object} map[string]string
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/admin/api-keys [post]
+func (h *apiKeyApiController) Create(w http.ResponseWriter, r *http.Request) {
+	var createRequest dto.CreateAPIKeyRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	secret, err := h.controller.Create(&createRequest)
+
+	if err != nil {
+		switch {
+		case errors.Is(err, createapikey.ErrInvalidName):
+			http.Error(w, `{"error":"Invalid name"}`, http.StatusBadRequest)
+		case errors.Is(err, createapikey.ErrInvalidScope):
+			http.Error(w, `{"error":"Invalid scopes"}`, http.StatusBadRequest)
+		default:
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(secret)
+}
+
+// @Summary     Rotate API key
+// @Description Replace the secret of an API key, keeping its ID and scopes. The previous secret stops working.
+// @Tags        API Keys
+// @Produce     json
+// @Param       id path string true "API key ID"
+// @Success     200  {object} dto.APIKeySecretResponseDto
+// @Failure     404  {object} map[string]string
+// @Failure     409  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/admin/api-keys/{id}/rotate [post]
+func (h *apiKeyApiController) Rotate(w http.ResponseWriter, r *http.Request) {
+	secret, err := h.controller.Rotate(chi.URLParam(r, "id"))
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrAPIKeyNotFound):
+			http.Error(w, `{"error":"API key not found"}`, http.StatusNotFound)
+		case errors.Is(err, rotateapikey.ErrAPIKeyRevoked):
+			http.Error(w, `{"error":"API key is revoked"}`, http.StatusConflict)
+		default:
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(secret)
+}
+
+// @Summary     Revoke API key
+// @Description Revoke an API key. Revoking an already revoked key has no effect.
+// @Tags        API Keys
+// @Produce     json
+// @Param       id path string true "API key ID"
+// @Success     200  {object} dto.APIKeyResponseDto
+// @Failure     404  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/admin/api-keys/{id} [delete]
+func (h *apiKeyApiController) Revoke(w http.ResponseWriter, r *http.Request) {
+	apiKey, err := h.controller.Revoke(chi.URLParam(r, "id"))
+
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			http.Error(w, `{"error":"API key not found"}`, http.StatusNotFound)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(apiKey)
+}
@@ -0,0 +1,173 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/createapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/rotateapikey"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type APIKeyApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockAPIKeyController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *APIKeyApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockAPIKeyController(suite.T())
+	suite.principal = &auth.Principal{Subject: "admin-1", Roles: []auth.Role{auth.RoleAdmin}}
+	apiCtrl := apiController.NewAPIKeyController(suite.mockController)
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiCtrl.RegisterRoutes(suite.router)
+}
+
+func TestAPIKeyApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyApiControllerTestSuite))
+}
+
+// Feature: API Key Admin REST API
+// Scenario: Admin manages service API keys
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyCreation_ViaPostEndpoint_ShouldReturnKeyOnce() {
+	// GIVEN a valid creation request
+	requestDto := &dto.CreateAPIKeyRequestDto{Name: "order-service", Scopes: []string{"customers:read"}}
+	suite.mockController.EXPECT().
+		Create(requestDto).
+		Return(&dto.APIKeySecretResponseDto{APIKey: dto.APIKeyResponseDto{ID: "key-1"}, Key: "tcfc_key-1_secret"}, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/admin/api-keys
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	// AND the key should not be cached
+	assert.Equal(suite.T(), "no-store", w.Header().Get("Cache-Control"))
+	var response dto.APIKeySecretResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(suite.T(), "tcfc_key-1_secret", response.Key)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyCreation_ViaPostEndpoint_WithInvalidScope_ShouldReturnBadRequest() {
+	// GIVEN a request with an unknown scope
+	requestDto := &dto.CreateAPIKeyRequestDto{Name: "order-service", Scopes: []string{"admin"}}
+	suite.mockController.EXPECT().Create(requestDto).Return(nil, createapikey.ErrInvalidScope).Once()
+
+	// WHEN a POST request is made to /v1/admin/api-keys
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyCreation_ViaPostEndpoint_AsStaff_ShouldReturnForbidden() {
+	// GIVEN a staff member
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+
+	// WHEN a POST request is made to /v1/admin/api-keys
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys", bytes.NewBufferString(`{"name":"x","scopes":["customers:read"]}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyCreation_ViaPostEndpoint_WithAPIKey_ShouldReturnForbidden() {
+	// GIVEN a service authenticated with an API key holding every scope
+	suite.principal = &auth.Principal{
+		Subject: "apikey:key-1",
+		Roles:   []auth.Role{auth.RoleService},
+		Scopes:  []string{auth.ScopeCustomersRead, auth.ScopeCustomersWrite},
+	}
+
+	// WHEN a POST request is made to /v1/admin/api-keys
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys", bytes.NewBufferString(`{"name":"x","scopes":["customers:read"]}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN keys should not be able to mint other keys
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyRotation_ViaPostEndpoint_WithRevokedKey_ShouldReturnConflict() {
+	// GIVEN a revoked key
+	suite.mockController.EXPECT().Rotate("key-1").Return(nil, rotateapikey.ErrAPIKeyRevoked).Once()
+
+	// WHEN a POST request is made to /v1/admin/api-keys/key-1/rotate
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys/key-1/rotate", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyRotation_ViaPostEndpoint_ShouldReturnNewKey() {
+	// GIVEN an active key
+	suite.mockController.EXPECT().
+		Rotate("key-1").
+		Return(&dto.APIKeySecretResponseDto{Key: "tcfc_key-1_new"}, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/admin/api-keys/key-1/rotate
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/api-keys/key-1/rotate", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyRevocation_ViaDeleteEndpoint_ShouldReturnRevokedKey() {
+	// GIVEN an active key
+	suite.mockController.EXPECT().Revoke("key-1").Return(&dto.APIKeyResponseDto{ID: "key-1"}, nil).Once()
+
+	// WHEN a DELETE request is made to /v1/admin/api-keys/key-1
+	req := httptest.NewRequest(http.MethodDelete, "/v1/admin/api-keys/key-1", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *APIKeyApiControllerTestSuite) Test_APIKeyRevocation_ViaDeleteEndpoint_WithUnknownKey_ShouldReturnNotFound() {
+	// GIVEN an unknown key
+	suite.mockController.EXPECT().Revoke("missing").Return(nil, repositories.ErrAPIKeyNotFound).Once()
+
+	// WHEN a DELETE request is made to /v1/admin/api-keys/missing
+	req := httptest.NewRequest(http.MethodDelete, "/v1/admin/api-keys/missing", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 404 Not Found
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
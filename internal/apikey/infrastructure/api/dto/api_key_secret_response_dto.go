@@ -0,0 +1,7 @@
+package dto
+
+// APIKeySecretResponseDto carries the plaintext key, returned only when it is created or rotated.
+type APIKeySecretResponseDto struct {
+	APIKey APIKeyResponseDto `json:"api_key"`
+	Key    string            `json:"key"`
+}
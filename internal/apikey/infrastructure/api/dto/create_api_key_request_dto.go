@@ -0,0 +1,6 @@
+package dto
+
+type CreateAPIKeyRequestDto struct {
+	Name   string   `json:"name" example:"order-service"`
+	Scopes []string `json:"scopes" example:"customers:read,customers:write"`
+}
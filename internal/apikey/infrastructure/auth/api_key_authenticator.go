@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/verifyapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+var (
+	_ auth.Authenticator = (*APIKeyAuthenticator)(nil)
+)
+
+// APIKeyAuthenticator authenticates service callers presenting an API key in the X-API-Key header.
+// It is chained with the JWT authenticators, so a request may use either mechanism.
+type APIKeyAuthenticator struct {
+	verifyAPIKeyUseCase verifyapikey.VerifyAPIKeyUseCase
+}
+
+func NewAPIKeyAuthenticator(verifyAPIKeyUseCase verifyapikey.VerifyAPIKeyUseCase) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{verifyAPIKeyUseCase: verifyAPIKeyUseCase}
+}
+
+func (a *APIKeyAuthenticator) Authenticate(r *http.Request) (*auth.Principal, error) {
+	key := r.Header.Get(auth.APIKeyHeader)
+	if key == "" {
+		return nil, auth.ErrMissingCredentials
+	}
+
+	apiKey, err := a.verifyAPIKeyUseCase.Execute(commands.NewVerifyAPIKeyCommand(key))
+	if errors.Is(err, verifyapikey.ErrInvalidAPIKey) {
+		return nil, auth.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify api key: %w", err)
+	}
+
+	return &auth.Principal{
+		Subject: "apikey:" + apiKey.ID,
+		Roles:   []auth.Role{auth.RoleService},
+		Scopes:  apiKey.Scopes,
+		Claims: map[string]any{
+			"api_key_id":   apiKey.ID,
+			"api_key_name": apiKey.Name,
+		},
+	}, nil
+}
@@ -0,0 +1,110 @@
+package auth_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	apiKeyAuth "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/verifyapikey"
+	mockVerifyAPIKey "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/usecase/verifyapikey"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type APIKeyAuthenticatorTestSuite struct {
+	suite.Suite
+	mockVerifyUseCase *mockVerifyAPIKey.MockVerifyAPIKeyUseCase
+	authenticator     *apiKeyAuth.APIKeyAuthenticator
+}
+
+func (suite *APIKeyAuthenticatorTestSuite) SetupTest() {
+	suite.mockVerifyUseCase = mockVerifyAPIKey.NewMockVerifyAPIKeyUseCase(suite.T())
+	suite.authenticator = apiKeyAuth.NewAPIKeyAuthenticator(suite.mockVerifyUseCase)
+}
+
+func TestAPIKeyAuthenticatorTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyAuthenticatorTestSuite))
+}
+
+func requestWithKey(key string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	if key != "" {
+		req.Header.Set(auth.APIKeyHeader, key)
+	}
+	return req
+}
+
+// Feature: API Key Authentication
+// Scenario: Service callers authenticate with X-API-Key
+
+func (suite *APIKeyAuthenticatorTestSuite) Test_Authentication_WithValidKey_ShouldReturnServicePrincipal() {
+	// GIVEN a valid key with read scope
+	suite.mockVerifyUseCase.EXPECT().
+		Execute(commands.NewVerifyAPIKeyCommand("tcfc_key-1_secret")).
+		Return(&entities.APIKey{ID: "key-1", Name: "order-service", Scopes: []string{auth.ScopeCustomersRead}}, nil).
+		Once()
+
+	// WHEN the request is authenticated
+	principal, err := suite.authenticator.Authenticate(requestWithKey("tcfc_key-1_secret"))
+
+	// THEN a service principal with the key scopes should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "apikey:key-1", principal.Subject)
+	assert.True(suite.T(), principal.HasRole(auth.RoleService))
+	assert.True(suite.T(), principal.HasScope(auth.ScopeCustomersRead))
+	assert.False(suite.T(), principal.HasScope(auth.ScopeCustomersWrite))
+}
+
+func (suite *APIKeyAuthenticatorTestSuite) Test_Authentication_WithoutHeader_ShouldReportMissingCredentials() {
+	// WHEN a request without key is authenticated
+	_, err := suite.authenticator.Authenticate(requestWithKey(""))
+
+	// THEN the next authenticator should get a chance
+	assert.ErrorIs(suite.T(), err, auth.ErrMissingCredentials)
+}
+
+func (suite *APIKeyAuthenticatorTestSuite) Test_Authentication_WithInvalidKey_ShouldReportInvalidCredentials() {
+	// GIVEN a key that does not verify
+	suite.mockVerifyUseCase.EXPECT().
+		Execute(commands.NewVerifyAPIKeyCommand("tcfc_key-1_wrong")).
+		Return(nil, verifyapikey.ErrInvalidAPIKey).
+		Once()
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(requestWithKey("tcfc_key-1_wrong"))
+
+	// THEN the credentials should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func (suite *APIKeyAuthenticatorTestSuite) Test_Authentication_WithRepositoryFailure_ShouldReturnError() {
+	// GIVEN the key store is unavailable
+	suite.mockVerifyUseCase.EXPECT().
+		Execute(commands.NewVerifyAPIKeyCommand("tcfc_key-1_secret")).
+		Return(nil, errors.New("database error")).
+		Once()
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(requestWithKey("tcfc_key-1_secret"))
+
+	// THEN the request should not be authenticated
+	assert.Error(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, auth.ErrMissingCredentials)
+}
+
+func (suite *APIKeyAuthenticatorTestSuite) Test_Authentication_ChainedWithJWT_ShouldAcceptEitherCredential() {
+	// GIVEN the API key authenticator chained before a JWT authenticator
+	jwtAuthenticator, _ := auth.NewJWTAuthenticator(auth.JWTConfig{HMACSecret: []byte("secret")})
+	chain := auth.Chain(suite.authenticator, jwtAuthenticator)
+
+	// WHEN a request carries neither credential
+	_, err := chain.Authenticate(requestWithKey(""))
+
+	// THEN the request should be treated as anonymous
+	assert.ErrorIs(suite.T(), err, auth.ErrMissingCredentials)
+}
@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.APIKeyRepository = (*APIKeyRepositoryImpl)(nil)
+)
+
+type APIKeyRepositoryImpl struct {
+	db dynamodbpkg.Client
+}
+
+func NewAPIKeyRepositoryImpl(db dynamodbpkg.Client) *APIKeyRepositoryImpl {
+	return &APIKeyRepositoryImpl{db: db}
+}
+
+func (r *APIKeyRepositoryImpl) GetByID(id string) (*entities.APIKey, error) {
+	result, err := r.db.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String(dynamodbpkg.APIKeyTableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: id},
+		},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	if result.Item == nil {
+		return nil, repositories.ErrAPIKeyNotFound
+	}
+
+	return unmarshalAPIKey(result.Item)
+}
+
+func (r *APIKeyRepositoryImpl) Add(apiKey *entities.APIKey) error {
+	av, err := attributevalue.MarshalMap(apiKey)
+	if err != nil {
+		return fmt.Errorf("failed to marshal api key: %w", err)
+	}
+
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName:           aws.String(dynamodbpkg.APIKeyTableName),
+		Item:                av,
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to add api key: %w", err)
+	}
+
+	return nil
+}
+
+// Rotate and Revoke only set the attributes they change, each conditioned on the key not being
+// revoked, so a rotation racing a revocation can never write the key back as active.
+func (r *APIKeyRepositoryImpl) Rotate(id string, keyHash string, rotatedAt time.Time) (*entities.APIKey, error) {
+	update := expression.Set(expression.Name("key_hash"), expression.Value(keyHash)).
+		Set(expression.Name("rotated_at"), expression.Value(rotatedAt))
+	apiKey, current, err := r.update(id, update, "rotate")
+	if current != nil {
+		return nil, repositories.ErrAPIKeyRevoked
+	}
+	return apiKey, err
+}
+
+func (r *APIKeyRepositoryImpl) Revoke(id string, revokedAt time.Time) (*entities.APIKey, error) {
+	apiKey, current, err := r.update(id, expression.Set(expression.Name("revoked_at"), expression.Value(revokedAt)), "revoke")
+	if current != nil {
+		return current, nil
+	}
+	return apiKey, err
+}
+
+// update applies update to an active key and returns it updated. When the key was already revoked,
+// it returns the key as stored instead.
+func (r *APIKeyRepositoryImpl) update(id string, update expression.UpdateBuilder, operation string) (*entities.APIKey, *entities.APIKey, error) {
+	active := expression.AttributeExists(expression.Name("id")).And(expression.AttributeNotExists(expression.Name("revoked_at")))
+	expr, err := expression.NewBuilder().WithCondition(active).WithUpdate(update).Build()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build %s of api key: %w", operation, err)
+	}
+
+	result, err := r.db.UpdateItem(context.Background(), &dynamodb.UpdateItemInput{
+		TableName: aws.String(dynamodbpkg.APIKeyTableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: id},
+		},
+		ConditionExpression:                 expr.Condition(),
+		UpdateExpression:                    expr.Update(),
+		ExpressionAttributeNames:            expr.Names(),
+		ExpressionAttributeValues:           expr.Values(),
+		ReturnValues:                        types.ReturnValueAllNew,
+		ReturnValuesOnConditionCheckFailure: types.ReturnValuesOnConditionCheckFailureAllOld,
+	})
+
+	var conditionFailed *types.ConditionalCheckFailedException
+	switch {
+	case errors.As(err, &conditionFailed) && conditionFailed.Item == nil:
+		return nil, nil, repositories.ErrAPIKeyNotFound
+	case errors.As(err, &conditionFailed):
+		current, err := unmarshalAPIKey(conditionFailed.Item)
+		return nil, current, err
+	case err != nil:
+		return nil, nil, fmt.Errorf("failed to %s api key: %w", operation, err)
+	}
+
+	apiKey, err := unmarshalAPIKey(result.Attributes)
+	return apiKey, nil, err
+}
+
+func unmarshalAPIKey(item map[string]types.AttributeValue) (*entities.APIKey, error) {
+	apiKey := &entities.APIKey{}
+	if err := attributevalue.UnmarshalMap(item, apiKey); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal api key: %w", err)
+	}
+	return apiKey, nil
+}
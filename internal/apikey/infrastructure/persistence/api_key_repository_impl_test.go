@@ -0,0 +1,195 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/persistence"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// Mock DynamoDB Client
+type MockDynamoDBClient struct {
+	mock.Mock
+	dynamodbpkg.Client
+}
+
+func (m *MockDynamoDBClient) GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.GetItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) UpdateItem(ctx context.Context, input *dynamodb.UpdateItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.UpdateItemOutput), args.Error(1)
+}
+
+type APIKeyRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     *MockDynamoDBClient
+	repository *persistence.APIKeyRepositoryImpl
+}
+
+func (suite *APIKeyRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	suite.repository = persistence.NewAPIKeyRepositoryImpl(suite.mockDB)
+}
+
+func TestAPIKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyRepositoryTestSuite))
+}
+
+// Feature: API Key Repository - Persistence Layer
+// Scenario: Store and retrieve hashed API keys
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRetrieval_WithExistingID_ShouldReturnKey() {
+	// GIVEN a key stored in DynamoDB
+	stored := &entities.APIKey{ID: "key-1", Name: "order-service", KeyHash: "hash", Scopes: []string{"customers:read"}}
+	item, _ := attributevalue.MarshalMap(stored)
+
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["id"]) == "key-1"
+	})).Return(&dynamodb.GetItemOutput{Item: item}, nil).Once()
+
+	// WHEN retrieving the key
+	result, err := suite.repository.GetByID("key-1")
+
+	// THEN the stored key should be returned with its hash and scopes
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "hash", result.KeyHash)
+	assert.Equal(suite.T(), []string{"customers:read"}, result.Scopes)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRetrieval_WithUnknownID_ShouldReturnNotFound() {
+	// GIVEN no key with the ID
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, nil).Once()
+
+	// WHEN retrieving the key
+	result, err := suite.repository.GetByID("missing")
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrAPIKeyNotFound)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyPersistence_ShouldNotOverwriteExistingKey() {
+	// GIVEN a new key
+	apiKey := &entities.APIKey{ID: "key-1", KeyHash: "hash", Scopes: []string{"customers:read"}, CreatedAt: time.Now()}
+
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == "attribute_not_exists(id)" &&
+			dynamodbpkg.StringValue(input.Item["key_hash"]) == "hash"
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN adding the key
+	err := suite.repository.Add(apiKey)
+
+	// THEN it should be stored only if the ID is free
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+// Scenario: Rotations and revocations never undo each other
+
+// activeKeyCondition is the condition of every rotation and revocation.
+const activeKeyCondition = "(attribute_exists (#0)) AND (attribute_not_exists (#1))"
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRotation_ShouldOnlySetTheHashOfAnActiveKey() {
+	// GIVEN an active key
+	rotatedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	stored, _ := attributevalue.MarshalMap(&entities.APIKey{ID: "key-1", KeyHash: "new-hash", RotatedAt: &rotatedAt})
+	suite.mockDB.On("UpdateItem", mock.MatchedBy(func(input *dynamodb.UpdateItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == activeKeyCondition &&
+			input.ExpressionAttributeNames["#1"] == "revoked_at" &&
+			aws.ToString(input.UpdateExpression) == "SET #2 = :0, #3 = :1\n" &&
+			input.ExpressionAttributeNames["#2"] == "key_hash" &&
+			input.ExpressionAttributeNames["#3"] == "rotated_at"
+	})).Return(&dynamodb.UpdateItemOutput{Attributes: stored}, nil).Once()
+
+	// WHEN rotating the key
+	apiKey, err := suite.repository.Rotate("key-1", "new-hash", rotatedAt)
+
+	// THEN the key should be returned as stored
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "new-hash", apiKey.KeyHash)
+	assert.Equal(suite.T(), rotatedAt, *apiKey.RotatedAt)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRotation_WithKeyRevokedMeanwhile_ShouldReturnRevoked() {
+	// GIVEN a key revoked after it was read
+	revokedAt := time.Now()
+	stored, _ := attributevalue.MarshalMap(&entities.APIKey{ID: "key-1", RevokedAt: &revokedAt})
+	suite.mockDB.On("UpdateItem", mock.Anything).Return(nil, &types.ConditionalCheckFailedException{Item: stored}).Once()
+
+	// WHEN rotating the key
+	apiKey, err := suite.repository.Rotate("key-1", "new-hash", time.Now())
+
+	// THEN the rotation should be refused, leaving the key revoked
+	assert.ErrorIs(suite.T(), err, repositories.ErrAPIKeyRevoked)
+	assert.Nil(suite.T(), apiKey)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRevocation_WithRevokedKey_ShouldKeepOriginalRevocation() {
+	// GIVEN a key revoked yesterday
+	revokedAt := time.Now().Add(-24 * time.Hour).UTC()
+	stored, _ := attributevalue.MarshalMap(&entities.APIKey{ID: "key-1", RevokedAt: &revokedAt})
+	suite.mockDB.On("UpdateItem", mock.MatchedBy(func(input *dynamodb.UpdateItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == activeKeyCondition &&
+			input.ReturnValuesOnConditionCheckFailure == types.ReturnValuesOnConditionCheckFailureAllOld
+	})).Return(nil, &types.ConditionalCheckFailedException{Item: stored}).Once()
+
+	// WHEN revoking it again
+	apiKey, err := suite.repository.Revoke("key-1", time.Now())
+
+	// THEN the key should be returned with its original revocation time
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), revokedAt, *apiKey.RevokedAt)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRevocation_WithUnknownID_ShouldReturnNotFound() {
+	// GIVEN the key does not exist
+	suite.mockDB.On("UpdateItem", mock.Anything).Return(nil, &types.ConditionalCheckFailedException{}).Once()
+
+	// WHEN revoking the key
+	_, err := suite.repository.Revoke("missing", time.Now())
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrAPIKeyNotFound)
+}
+
+func (suite *APIKeyRepositoryTestSuite) Test_APIKeyRotation_WithDatabaseError_ShouldReturnError() {
+	// GIVEN DynamoDB fails
+	suite.mockDB.On("UpdateItem", mock.Anything).Return(nil, errors.New("database error")).Once()
+
+	// WHEN rotating the key
+	_, err := suite.repository.Rotate("key-1", "new-hash", time.Now())
+
+	// THEN the error should be wrapped
+	assert.ErrorContains(suite.T(), err, "failed to rotate api key")
+	assert.NotErrorIs(suite.T(), err, repositories.ErrAPIKeyNotFound)
+}
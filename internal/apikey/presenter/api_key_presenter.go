@@ -0,0 +1,11 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+)
+
+type APIKeyPresenter interface {
+	Present(apiKey *entities.APIKey) *dto.APIKeyResponseDto
+	PresentSecret(apiKey *entities.APIKey, key string) *dto.APIKeySecretResponseDto
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+)
+
+var (
+	_ APIKeyPresenter = (*APIKeyPresenterImpl)(nil)
+)
+
+type APIKeyPresenterImpl struct {
+}
+
+func NewAPIKeyPresenterImpl() *APIKeyPresenterImpl {
+	return &APIKeyPresenterImpl{}
+}
+
+func (p *APIKeyPresenterImpl) Present(apiKey *entities.APIKey) *dto.APIKeyResponseDto {
+	return &dto.APIKeyResponseDto{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		Scopes:    apiKey.Scopes,
+		CreatedAt: apiKey.CreatedAt,
+		RotatedAt: apiKey.RotatedAt,
+		RevokedAt: apiKey.RevokedAt,
+	}
+}
+
+func (p *APIKeyPresenterImpl) PresentSecret(apiKey *entities.APIKey, key string) *dto.APIKeySecretResponseDto {
+	return &dto.APIKeySecretResponseDto{
+		APIKey: *p.Present(apiKey),
+		Key:    key,
+	}
+}
@@ -0,0 +1,63 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/presenter"
+)
+
+type APIKeyPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.APIKeyPresenter
+}
+
+func (suite *APIKeyPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewAPIKeyPresenterImpl()
+}
+
+func TestAPIKeyPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyPresenterTestSuite))
+}
+
+// Feature: API Key Presentation
+// Scenario: Transform API key entity to response DTO without leaking the hash
+
+func (suite *APIKeyPresenterTestSuite) Test_APIKeyPresentation_ShouldExposeMetadataOnly() {
+	// GIVEN a revoked key
+	now := time.Now()
+	apiKey := &entities.APIKey{
+		ID:        "key-1",
+		Name:      "order-service",
+		KeyHash:   "hash",
+		Scopes:    []string{"customers:read"},
+		CreatedAt: now,
+		RevokedAt: &now,
+	}
+
+	// WHEN the presenter transforms the key
+	result := suite.presenter.Present(apiKey)
+
+	// THEN the metadata should be mapped
+	assert.Equal(suite.T(), "key-1", result.ID)
+	assert.Equal(suite.T(), "order-service", result.Name)
+	assert.Equal(suite.T(), []string{"customers:read"}, result.Scopes)
+	assert.Equal(suite.T(), now, result.CreatedAt)
+	assert.Equal(suite.T(), &now, result.RevokedAt)
+	assert.Nil(suite.T(), result.RotatedAt)
+}
+
+func (suite *APIKeyPresenterTestSuite) Test_APIKeySecretPresentation_ShouldIncludePlaintextKey() {
+	// GIVEN a new key and its plaintext value
+	apiKey := &entities.APIKey{ID: "key-1", Name: "order-service"}
+
+	// WHEN the presenter transforms it
+	result := suite.presenter.PresentSecret(apiKey, "tcfc_key-1_secret")
+
+	// THEN the key should be returned with its metadata
+	assert.Equal(suite.T(), "tcfc_key-1_secret", result.Key)
+	assert.Equal(suite.T(), "key-1", result.APIKey.ID)
+}
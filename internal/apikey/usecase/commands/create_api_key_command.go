@@ -0,0 +1,13 @@
+package commands
+
+type CreateAPIKeyCommand struct {
+	Name   string
+	Scopes []string
+}
+
+func NewCreateAPIKeyCommand(name string, scopes []string) *CreateAPIKeyCommand {
+	return &CreateAPIKeyCommand{
+		Name:   name,
+		Scopes: scopes,
+	}
+}
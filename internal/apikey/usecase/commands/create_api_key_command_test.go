@@ -0,0 +1,22 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+func TestNewCreateAPIKeyCommand(t *testing.T) {
+	// GIVEN a key name and scopes
+	name := "order-service"
+	scopes := []string{"customers:read"}
+
+	// WHEN creating a new CreateAPIKeyCommand
+	command := commands.NewCreateAPIKeyCommand(name, scopes)
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, name, command.Name)
+	assert.Equal(t, scopes, command.Scopes)
+}
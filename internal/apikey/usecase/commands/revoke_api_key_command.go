@@ -0,0 +1,11 @@
+package commands
+
+type RevokeAPIKeyCommand struct {
+	ID string
+}
+
+func NewRevokeAPIKeyCommand(id string) *RevokeAPIKeyCommand {
+	return &RevokeAPIKeyCommand{
+		ID: id,
+	}
+}
@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+func TestNewRevokeAPIKeyCommand(t *testing.T) {
+	// GIVEN a key ID
+	id := "key-1"
+
+	// WHEN creating a new RevokeAPIKeyCommand
+	command := commands.NewRevokeAPIKeyCommand(id)
+
+	// THEN the command should be created with the correct ID
+	assert.NotNil(t, command)
+	assert.Equal(t, id, command.ID)
+}
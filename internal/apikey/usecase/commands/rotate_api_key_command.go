@@ -0,0 +1,11 @@
+package commands
+
+type RotateAPIKeyCommand struct {
+	ID string
+}
+
+func NewRotateAPIKeyCommand(id string) *RotateAPIKeyCommand {
+	return &RotateAPIKeyCommand{
+		ID: id,
+	}
+}
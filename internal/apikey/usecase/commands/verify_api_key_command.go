@@ -0,0 +1,11 @@
+package commands
+
+type VerifyAPIKeyCommand struct {
+	Key string
+}
+
+func NewVerifyAPIKeyCommand(key string) *VerifyAPIKeyCommand {
+	return &VerifyAPIKeyCommand{
+		Key: key,
+	}
+}
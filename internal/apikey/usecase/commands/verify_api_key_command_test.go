@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+func TestNewVerifyAPIKeyCommand(t *testing.T) {
+	// GIVEN a presented API key
+	key := "tcfc_key-1_secret"
+
+	// WHEN creating a new VerifyAPIKeyCommand
+	command := commands.NewVerifyAPIKeyCommand(key)
+
+	// THEN the command should be created with the correct key
+	assert.NotNil(t, command)
+	assert.Equal(t, key, command.Key)
+}
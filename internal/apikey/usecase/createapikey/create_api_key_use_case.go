@@ -0,0 +1,18 @@
+package createapikey
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+var (
+	ErrInvalidName  = errors.New("api key name is required")
+	ErrInvalidScope = errors.New("invalid api key scope")
+)
+
+type CreateAPIKeyUseCase interface {
+	// Execute returns the stored key and its plaintext value, which is never available again.
+	Execute(command *commands.CreateAPIKeyCommand) (*entities.APIKey, string, error)
+}
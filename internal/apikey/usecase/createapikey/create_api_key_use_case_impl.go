@@ -0,0 +1,58 @@
+package createapikey
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+var (
+	_ CreateAPIKeyUseCase = (*CreateAPIKeyUseCaseImpl)(nil)
+)
+
+type CreateAPIKeyUseCaseImpl struct {
+	apiKeyRepository repositories.APIKeyRepository
+}
+
+func NewCreateAPIKeyUseCaseImpl(apiKeyRepository repositories.APIKeyRepository) *CreateAPIKeyUseCaseImpl {
+	return &CreateAPIKeyUseCaseImpl{apiKeyRepository: apiKeyRepository}
+}
+
+func (u *CreateAPIKeyUseCaseImpl) Execute(command *commands.CreateAPIKeyCommand) (*entities.APIKey, string, error) {
+	if command.Name == "" {
+		return nil, "", ErrInvalidName
+	}
+	if len(command.Scopes) == 0 {
+		return nil, "", ErrInvalidScope
+	}
+	for _, scope := range command.Scopes {
+		if !auth.IsAPIKeyScope(scope) {
+			return nil, "", ErrInvalidScope
+		}
+	}
+
+	id, err := auth.NewAPIKeyID()
+	if err != nil {
+		return nil, "", err
+	}
+	key, err := auth.GenerateAPIKey(id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := &entities.APIKey{
+		ID:        id,
+		Name:      command.Name,
+		KeyHash:   auth.HashAPIKey(key),
+		Scopes:    command.Scopes,
+		CreatedAt: time.Now(),
+	}
+	if err := u.apiKeyRepository.Add(apiKey); err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
@@ -0,0 +1,102 @@
+package createapikey_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/createapikey"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type CreateAPIKeyUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAPIKeyRepository
+	useCase        createapikey.CreateAPIKeyUseCase
+}
+
+func (suite *CreateAPIKeyUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockAPIKeyRepository(suite.T())
+	suite.useCase = createapikey.NewCreateAPIKeyUseCaseImpl(suite.mockRepository)
+}
+
+func TestCreateAPIKeyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateAPIKeyUseCaseTestSuite))
+}
+
+// Feature: Create API Key Use Case
+// Scenario: Admin issues a key for an internal service
+
+func (suite *CreateAPIKeyUseCaseTestSuite) Test_APIKeyCreation_WithValidScopes_ShouldStoreOnlyTheHash() {
+	// GIVEN a service that needs to read customers
+	command := commands.NewCreateAPIKeyCommand("order-service", []string{auth.ScopeCustomersRead})
+
+	var stored *entities.APIKey
+	suite.mockRepository.EXPECT().
+		Add(mock.AnythingOfType("*entities.APIKey")).
+		Run(func(apiKey *entities.APIKey) { stored = apiKey }).
+		Return(nil).
+		Once()
+
+	// WHEN the key is created
+	apiKey, key, err := suite.useCase.Execute(command)
+
+	// THEN the plaintext key should be returned once
+	assert.NoError(suite.T(), err)
+	id, ok := auth.ParseAPIKeyID(key)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), apiKey.ID, id)
+	// AND only its hash should be stored
+	assert.Equal(suite.T(), auth.HashAPIKey(key), stored.KeyHash)
+	assert.NotContains(suite.T(), stored.KeyHash, key)
+	assert.Equal(suite.T(), []string{auth.ScopeCustomersRead}, stored.Scopes)
+	assert.False(suite.T(), stored.CreatedAt.IsZero())
+}
+
+func (suite *CreateAPIKeyUseCaseTestSuite) Test_APIKeyCreation_WithUnknownScope_ShouldReturnInvalidScope() {
+	// GIVEN a scope that cannot be granted to keys
+	command := commands.NewCreateAPIKeyCommand("order-service", []string{"admin"})
+
+	// WHEN the key is created
+	apiKey, key, err := suite.useCase.Execute(command)
+
+	// THEN the request should be refused
+	assert.ErrorIs(suite.T(), err, createapikey.ErrInvalidScope)
+	assert.Nil(suite.T(), apiKey)
+	assert.Empty(suite.T(), key)
+}
+
+func (suite *CreateAPIKeyUseCaseTestSuite) Test_APIKeyCreation_WithoutScopes_ShouldReturnInvalidScope() {
+	// WHEN a key without scopes is created
+	_, _, err := suite.useCase.Execute(commands.NewCreateAPIKeyCommand("order-service", nil))
+
+	// THEN the request should be refused
+	assert.ErrorIs(suite.T(), err, createapikey.ErrInvalidScope)
+}
+
+func (suite *CreateAPIKeyUseCaseTestSuite) Test_APIKeyCreation_WithoutName_ShouldReturnInvalidName() {
+	// WHEN a key without name is created
+	_, _, err := suite.useCase.Execute(commands.NewCreateAPIKeyCommand("", []string{auth.ScopeCustomersRead}))
+
+	// THEN the request should be refused
+	assert.ErrorIs(suite.T(), err, createapikey.ErrInvalidName)
+}
+
+func (suite *CreateAPIKeyUseCaseTestSuite) Test_APIKeyCreation_WithRepositoryFailure_ShouldReturnError() {
+	// GIVEN the repository fails
+	expectedError := errors.New("database error")
+	suite.mockRepository.EXPECT().Add(mock.Anything).Return(expectedError).Once()
+
+	// WHEN the key is created
+	apiKey, key, err := suite.useCase.Execute(commands.NewCreateAPIKeyCommand("order-service", []string{auth.ScopeCustomersWrite}))
+
+	// THEN the error should be returned without a key
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), apiKey)
+	assert.Empty(suite.T(), key)
+}
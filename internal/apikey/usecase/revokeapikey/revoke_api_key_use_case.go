@@ -0,0 +1,10 @@
+package revokeapikey
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+type RevokeAPIKeyUseCase interface {
+	Execute(command *commands.RevokeAPIKeyCommand) (*entities.APIKey, error)
+}
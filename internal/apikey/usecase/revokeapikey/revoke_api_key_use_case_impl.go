@@ -0,0 +1,26 @@
+package revokeapikey
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+var (
+	_ RevokeAPIKeyUseCase = (*RevokeAPIKeyUseCaseImpl)(nil)
+)
+
+type RevokeAPIKeyUseCaseImpl struct {
+	apiKeyRepository repositories.APIKeyRepository
+}
+
+func NewRevokeAPIKeyUseCaseImpl(apiKeyRepository repositories.APIKeyRepository) *RevokeAPIKeyUseCaseImpl {
+	return &RevokeAPIKeyUseCaseImpl{apiKeyRepository: apiKeyRepository}
+}
+
+// Execute is idempotent: revoking a revoked key keeps the original revocation time.
+func (u *RevokeAPIKeyUseCaseImpl) Execute(command *commands.RevokeAPIKeyCommand) (*entities.APIKey, error) {
+	return u.apiKeyRepository.Revoke(command.ID, time.Now())
+}
@@ -0,0 +1,75 @@
+package revokeapikey_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/revokeapikey"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/domain/repositories"
+)
+
+type RevokeAPIKeyUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAPIKeyRepository
+	useCase        revokeapikey.RevokeAPIKeyUseCase
+}
+
+func (suite *RevokeAPIKeyUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockAPIKeyRepository(suite.T())
+	suite.useCase = revokeapikey.NewRevokeAPIKeyUseCaseImpl(suite.mockRepository)
+}
+
+func TestRevokeAPIKeyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RevokeAPIKeyUseCaseTestSuite))
+}
+
+// Feature: Revoke API Key Use Case
+// Scenario: Admin disables a key
+
+func (suite *RevokeAPIKeyUseCaseTestSuite) Test_APIKeyRevocation_WithActiveKey_ShouldSetRevocationTime() {
+	// GIVEN an active key
+	suite.mockRepository.EXPECT().
+		Revoke("key-1", mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(id string, revokedAt time.Time) (*entities.APIKey, error) {
+			return &entities.APIKey{ID: id, RevokedAt: &revokedAt}, nil
+		}).
+		Once()
+
+	// WHEN the key is revoked
+	apiKey, err := suite.useCase.Execute(commands.NewRevokeAPIKeyCommand("key-1"))
+
+	// THEN the key should be marked as revoked
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), apiKey.Revoked())
+}
+
+func (suite *RevokeAPIKeyUseCaseTestSuite) Test_APIKeyRevocation_WithRevokedKey_ShouldKeepOriginalRevocation() {
+	// GIVEN a key revoked yesterday, which the repository keeps as it was
+	revokedAt := time.Now().Add(-24 * time.Hour)
+	suite.mockRepository.EXPECT().Revoke("key-1", mock.Anything).Return(&entities.APIKey{ID: "key-1", RevokedAt: &revokedAt}, nil).Once()
+
+	// WHEN the key is revoked again
+	apiKey, err := suite.useCase.Execute(commands.NewRevokeAPIKeyCommand("key-1"))
+
+	// THEN the original revocation time should be kept
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), revokedAt, *apiKey.RevokedAt)
+}
+
+func (suite *RevokeAPIKeyUseCaseTestSuite) Test_APIKeyRevocation_WithUnknownKey_ShouldReturnNotFound() {
+	// GIVEN an unknown key
+	suite.mockRepository.EXPECT().Revoke("missing", mock.Anything).Return(nil, repositories.ErrAPIKeyNotFound).Once()
+
+	// WHEN the key is revoked
+	apiKey, err := suite.useCase.Execute(commands.NewRevokeAPIKeyCommand("missing"))
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrAPIKeyNotFound)
+	assert.Nil(suite.T(), apiKey)
+}
@@ -0,0 +1,16 @@
+package rotateapikey
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+var (
+	ErrAPIKeyRevoked = repositories.ErrAPIKeyRevoked
+)
+
+type RotateAPIKeyUseCase interface {
+	// Execute replaces the secret of a key and returns its new plaintext value.
+	Execute(command *commands.RotateAPIKeyCommand) (*entities.APIKey, string, error)
+}
@@ -0,0 +1,38 @@
+package rotateapikey
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+var (
+	_ RotateAPIKeyUseCase = (*RotateAPIKeyUseCaseImpl)(nil)
+)
+
+type RotateAPIKeyUseCaseImpl struct {
+	apiKeyRepository repositories.APIKeyRepository
+}
+
+func NewRotateAPIKeyUseCaseImpl(apiKeyRepository repositories.APIKeyRepository) *RotateAPIKeyUseCaseImpl {
+	return &RotateAPIKeyUseCaseImpl{apiKeyRepository: apiKeyRepository}
+}
+
+// Execute keeps the key ID and scopes so callers only need to swap the secret.
+// The previous secret stops working immediately.
+func (u *RotateAPIKeyUseCaseImpl) Execute(command *commands.RotateAPIKeyCommand) (*entities.APIKey, string, error) {
+	key, err := auth.GenerateAPIKey(command.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey, err := u.apiKeyRepository.Rotate(command.ID, auth.HashAPIKey(key), time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
@@ -0,0 +1,79 @@
+package rotateapikey_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/rotateapikey"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type RotateAPIKeyUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAPIKeyRepository
+	useCase        rotateapikey.RotateAPIKeyUseCase
+}
+
+func (suite *RotateAPIKeyUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockAPIKeyRepository(suite.T())
+	suite.useCase = rotateapikey.NewRotateAPIKeyUseCaseImpl(suite.mockRepository)
+}
+
+func TestRotateAPIKeyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RotateAPIKeyUseCaseTestSuite))
+}
+
+// Feature: Rotate API Key Use Case
+// Scenario: Admin replaces a leaked or expiring secret
+
+func (suite *RotateAPIKeyUseCaseTestSuite) Test_APIKeyRotation_WithActiveKey_ShouldReplaceHashKeepingID() {
+	// GIVEN an active key
+	suite.mockRepository.EXPECT().
+		Rotate("key-1", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		RunAndReturn(func(id string, keyHash string, rotatedAt time.Time) (*entities.APIKey, error) {
+			return &entities.APIKey{ID: id, Name: "order-service", KeyHash: keyHash, Scopes: []string{auth.ScopeCustomersRead}, RotatedAt: &rotatedAt}, nil
+		}).
+		Once()
+
+	// WHEN the key is rotated
+	apiKey, key, err := suite.useCase.Execute(commands.NewRotateAPIKeyCommand("key-1"))
+
+	// THEN a new secret for the same key ID should be returned
+	assert.NoError(suite.T(), err)
+	id, _ := auth.ParseAPIKeyID(key)
+	assert.Equal(suite.T(), "key-1", id)
+	assert.True(suite.T(), auth.VerifyAPIKeyHash(key, apiKey.KeyHash))
+	// AND the scopes should be kept
+	assert.Equal(suite.T(), []string{auth.ScopeCustomersRead}, apiKey.Scopes)
+}
+
+func (suite *RotateAPIKeyUseCaseTestSuite) Test_APIKeyRotation_WithRevokedKey_ShouldReturnRevoked() {
+	// GIVEN a revoked key, even revoked while rotating
+	suite.mockRepository.EXPECT().Rotate("key-1", mock.Anything, mock.Anything).Return(nil, repositories.ErrAPIKeyRevoked).Once()
+
+	// WHEN the key is rotated
+	apiKey, key, err := suite.useCase.Execute(commands.NewRotateAPIKeyCommand("key-1"))
+
+	// THEN the rotation should be refused
+	assert.ErrorIs(suite.T(), err, rotateapikey.ErrAPIKeyRevoked)
+	assert.Nil(suite.T(), apiKey)
+	assert.Empty(suite.T(), key)
+}
+
+func (suite *RotateAPIKeyUseCaseTestSuite) Test_APIKeyRotation_WithUnknownKey_ShouldReturnNotFound() {
+	// GIVEN an unknown key
+	suite.mockRepository.EXPECT().Rotate("missing", mock.Anything, mock.Anything).Return(nil, repositories.ErrAPIKeyNotFound).Once()
+
+	// WHEN the key is rotated
+	_, _, err := suite.useCase.Execute(commands.NewRotateAPIKeyCommand("missing"))
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrAPIKeyNotFound)
+}
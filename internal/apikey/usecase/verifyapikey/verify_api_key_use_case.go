@@ -0,0 +1,16 @@
+package verifyapikey
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+)
+
+var (
+	ErrInvalidAPIKey = errors.New("invalid api key")
+)
+
+type VerifyAPIKeyUseCase interface {
+	Execute(command *commands.VerifyAPIKeyCommand) (*entities.APIKey, error)
+}
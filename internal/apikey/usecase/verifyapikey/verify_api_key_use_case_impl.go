@@ -0,0 +1,45 @@
+package verifyapikey
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+var (
+	_ VerifyAPIKeyUseCase = (*VerifyAPIKeyUseCaseImpl)(nil)
+)
+
+type VerifyAPIKeyUseCaseImpl struct {
+	apiKeyRepository repositories.APIKeyRepository
+}
+
+func NewVerifyAPIKeyUseCaseImpl(apiKeyRepository repositories.APIKeyRepository) *VerifyAPIKeyUseCaseImpl {
+	return &VerifyAPIKeyUseCaseImpl{apiKeyRepository: apiKeyRepository}
+}
+
+// Execute resolves a presented key. Malformed, unknown, mismatching and revoked keys
+// all report ErrInvalidAPIKey so callers cannot tell them apart.
+func (u *VerifyAPIKeyUseCaseImpl) Execute(command *commands.VerifyAPIKeyCommand) (*entities.APIKey, error) {
+	id, ok := auth.ParseAPIKeyID(command.Key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey, err := u.apiKeyRepository.GetByID(id)
+	if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.VerifyAPIKeyHash(command.Key, apiKey.KeyHash) || apiKey.Revoked() {
+		return nil, ErrInvalidAPIKey
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,107 @@
+package verifyapikey_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/verifyapikey"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/apikey/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type VerifyAPIKeyUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAPIKeyRepository
+	useCase        verifyapikey.VerifyAPIKeyUseCase
+	key            string
+	stored         *entities.APIKey
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockAPIKeyRepository(suite.T())
+	suite.useCase = verifyapikey.NewVerifyAPIKeyUseCaseImpl(suite.mockRepository)
+	suite.key, _ = auth.GenerateAPIKey("key-1")
+	suite.stored = &entities.APIKey{ID: "key-1", KeyHash: auth.HashAPIKey(suite.key), Scopes: []string{auth.ScopeCustomersRead}}
+}
+
+func TestVerifyAPIKeyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyAPIKeyUseCaseTestSuite))
+}
+
+// Feature: Verify API Key Use Case
+// Scenario: Service caller presents an API key
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithValidKey_ShouldReturnKey() {
+	// GIVEN a stored key
+	suite.mockRepository.EXPECT().GetByID("key-1").Return(suite.stored, nil).Once()
+
+	// WHEN the key is verified
+	apiKey, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand(suite.key))
+
+	// THEN the stored key should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.stored, apiKey)
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithWrongSecret_ShouldReturnInvalid() {
+	// GIVEN a key with the right ID but another secret
+	other, _ := auth.GenerateAPIKey("key-1")
+	suite.mockRepository.EXPECT().GetByID("key-1").Return(suite.stored, nil).Once()
+
+	// WHEN the key is verified
+	apiKey, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand(other))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, verifyapikey.ErrInvalidAPIKey)
+	assert.Nil(suite.T(), apiKey)
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithRevokedKey_ShouldReturnInvalid() {
+	// GIVEN a revoked key
+	revokedAt := time.Now()
+	suite.stored.RevokedAt = &revokedAt
+	suite.mockRepository.EXPECT().GetByID("key-1").Return(suite.stored, nil).Once()
+
+	// WHEN the key is verified
+	_, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand(suite.key))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, verifyapikey.ErrInvalidAPIKey)
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithUnknownKey_ShouldReturnInvalid() {
+	// GIVEN a key that was never issued
+	suite.mockRepository.EXPECT().GetByID("key-1").Return(nil, repositories.ErrAPIKeyNotFound).Once()
+
+	// WHEN the key is verified
+	_, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand(suite.key))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, verifyapikey.ErrInvalidAPIKey)
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithMalformedKey_ShouldNotQueryRepository() {
+	// WHEN a malformed key is verified
+	_, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand("not-a-key"))
+
+	// THEN it should be rejected without a lookup
+	assert.ErrorIs(suite.T(), err, verifyapikey.ErrInvalidAPIKey)
+}
+
+func (suite *VerifyAPIKeyUseCaseTestSuite) Test_APIKeyVerification_WithRepositoryFailure_ShouldReturnError() {
+	// GIVEN the repository fails
+	expectedError := errors.New("database error")
+	suite.mockRepository.EXPECT().GetByID("key-1").Return(nil, expectedError).Once()
+
+	// WHEN the key is verified
+	_, err := suite.useCase.Execute(commands.NewVerifyAPIKeyCommand(suite.key))
+
+	// THEN the failure should not be reported as an invalid key
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -4,47 +4,368 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"go.uber.org/fx"
 
+	apiKeyController "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/controller"
+	apiKeyRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/repositories"
+	apiKeyApiController "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/controller"
+	apiKeyAuth "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/auth"
+	apiKeyPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/persistence"
+	apiKeyPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/presenter"
+	apiKeyUseCasesCreate "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/createapikey"
+	apiKeyUseCasesRevoke "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/revokeapikey"
+	apiKeyUseCasesRotate "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/rotateapikey"
+	apiKeyUseCasesVerify "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/verifyapikey"
+	auditController "github.com/viniciuscluna/tc-fiap-customer/internal/audit/controller"
+	auditRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	auditApiController "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/controller"
+	auditDataExport "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/dataexport"
+	auditPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/persistence"
+	auditPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/audit/presenter"
+	auditUseCasesList "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/listauditentries"
+	auditUseCasesRecord "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	consentController "github.com/viniciuscluna/tc-fiap-customer/internal/consent/controller"
+	consentRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	consentApiController "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/controller"
+	consentAudit "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/audit"
+	consentDataExport "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/dataexport"
+	consentPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/persistence"
+	consentPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/consent/presenter"
+	consentUseCasesGet "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/getconsents"
+	consentUseCasesHistory "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listconsenthistory"
+	consentUseCasesOptedIn "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listoptedin"
+	consentUseCasesRecord "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
 	customerController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/controller"
 	customerRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	customerApiController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/controller"
+	customerAudit "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	customerCache "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/cache"
+	customerDataExport "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/dataexport"
+	customerLoyalty "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/loyalty"
 	customerPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/persistence"
 	customerPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/customer/presenter"
 	customerUseCasesAdd "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addCustomer"
+	customerUseCasesAddGuest "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addguest"
+	customerUseCasesClaimGuest "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	customerUseCasesErase "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/erasecustomer"
+	customerUseCasesExport "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
 	customerUseCasesGetByCpf "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getbycpf"
+	customerUseCasesAsOf "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+	customerUseCasesIdentify "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/identify"
+	customerUseCasesImport "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+	customerUseCasesHistory "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/listcustomerhistory"
+	customerUseCasesReindex "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/reindexcustomers"
+	customerUseCasesUpdate "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/updatecustomer"
+	dataExportController "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/controller"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	dataExportApiController "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/controller"
+	dataExportAudit "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/audit"
+	dataExportRegistry "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/registry"
+	dataExportPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/presenter"
+	dataExportUseCasesExport "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/exportcustomerdata"
+	loyaltyController "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/controller"
+	loyaltyRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	loyaltyApiController "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/controller"
+	loyaltyConfig "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/config"
+	loyaltyDataExport "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/dataexport"
+	loyaltyJobs "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/jobs"
+	loyaltyMessaging "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/messaging"
+	loyaltyPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/persistence"
+	loyaltyPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/presenter"
+	loyaltyUseCasesAdjust "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/adjustpoints"
+	loyaltyUseCasesEarn "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+	loyaltyUseCasesExpire "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/expirepoints"
+	loyaltyUseCasesList "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listentries"
+	loyaltyUseCasesListRewards "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listrewards"
+	loyaltyUseCasesRecalculateTier "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/recalculatetier"
+	loyaltyUseCasesRedeem "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeempoints"
+	loyaltyUseCasesRedeemReward "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeemreward"
+	orderHistoryController "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/controller"
+	orderHistoryRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	orderHistoryApiController "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/controller"
+	orderHistoryDataExport "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/dataexport"
+	orderHistoryMessaging "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/messaging"
+	orderHistoryPersistence "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/persistence"
+	orderHistoryPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/presenter"
+	orderHistoryUseCasesList "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/listorders"
+	orderHistoryUseCasesRecord "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/recordorder"
 
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/cache"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/metrics"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/ratelimit"
 	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
 	"github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
 )
 
 func InitializeApp() *fx.App {
 	return fx.New(
+		modules(),
+		fx.Invoke(provisionTables),
+		fx.Invoke(registerRoutes),
+		fx.Invoke(startHTTPServer),
+		fx.Invoke(startOutboxRelay),
+		fx.Invoke(startOrderEventConsumer),
+		fx.Invoke(startPaymentEventConsumer),
+		fx.Invoke(startLoyaltyRulesWatcher),
+		fx.Invoke(startTierRecalculationJob),
+	)
+}
+
+// InitializeCLI builds the same graph as the API without starting the server or any background
+// work, filling targets, which are pointers to the types a command needs. Only what the targets
+// depend on is constructed.
+func InitializeCLI(targets ...any) *fx.App {
+	return fx.New(
+		modules(),
+		fx.NopLogger,
+		fx.Populate(targets...),
+	)
+}
+
+// modules provides every component of the service, shared by the API and the CLI.
+func modules() fx.Option {
+	return fx.Options(
 		fx.Provide(
+			dynamodb.LoadConfig,
 			dynamodb.NewDynamoDBClient,
+			dynamodb.NewProvisionerFromEnv,
+			encryption.NewKeyProviderFromEnv,
+			encryption.NewEncryptor,
+			encryption.NewBlindIndex,
+			auth.NewJWTAuthenticatorFromEnv,
+			fx.Annotate(auth.NewRSATokenIssuerFromEnv, fx.As(fx.Self()), fx.As(new(auth.TokenIssuer))),
+			newAuthenticator,
 			fx.Annotate(customerPersistence.NewCustomerRepositoryImpl, fx.As(new(customerRepositories.CustomerRepository))),
+			fx.Annotate(customerPersistence.NewCustomerHistoryRepositoryImpl, fx.As(new(customerRepositories.CustomerHistoryRepository))),
+			fx.Annotate(customerLoyalty.NewCustomerTierRepositoryImpl, fx.As(new(customerRepositories.CustomerTierRepository))),
 			fx.Annotate(customerUseCasesAdd.NewAddCustomerUseCaseImpl, fx.As(new(customerUseCasesAdd.AddCustomerUseCase))),
 			fx.Annotate(customerUseCasesGetByCpf.NewGetByCpfUseCaseImpl, fx.As(new(customerUseCasesGetByCpf.GetByCpfUseCase))),
+			fx.Annotate(customerUseCasesIdentify.NewIdentifyCustomerUseCaseImpl, fx.As(new(customerUseCasesIdentify.IdentifyCustomerUseCase))),
+			fx.Annotate(customerUseCasesAddGuest.NewAddGuestUseCaseImpl, fx.As(new(customerUseCasesAddGuest.AddGuestUseCase))),
+			fx.Annotate(customerUseCasesClaimGuest.NewClaimGuestUseCaseImpl, fx.As(new(customerUseCasesClaimGuest.ClaimGuestUseCase))),
+			fx.Annotate(customerUseCasesUpdate.NewUpdateCustomerUseCaseImpl, fx.As(new(customerUseCasesUpdate.UpdateCustomerUseCase))),
+			fx.Annotate(customerUseCasesErase.NewEraseCustomerUseCaseImpl, fx.As(new(customerUseCasesErase.EraseCustomerUseCase))),
+			fx.Annotate(customerUseCasesHistory.NewListCustomerHistoryUseCaseImpl, fx.As(new(customerUseCasesHistory.ListCustomerHistoryUseCase))),
+			fx.Annotate(customerUseCasesAsOf.NewGetCustomerAsOfUseCaseImpl, fx.As(new(customerUseCasesAsOf.GetCustomerAsOfUseCase))),
+			fx.Annotate(customerUseCasesImport.NewImportCustomersUseCaseImpl, fx.As(new(customerUseCasesImport.ImportCustomersUseCase))),
+			fx.Annotate(customerUseCasesExport.NewExportCustomersUseCaseImpl, fx.As(new(customerUseCasesExport.ExportCustomersUseCase))),
+			fx.Annotate(customerUseCasesReindex.NewReindexCustomersUseCaseImpl, fx.As(new(customerUseCasesReindex.ReindexCustomersUseCase))),
 			fx.Annotate(customerController.NewCustomerControllerImpl, fx.As(new(customerController.CustomerController))),
 			fx.Annotate(customerPresenter.NewCustomerPresenterImpl, fx.As(new(customerPresenter.CustomerPresenter))),
+			fx.Annotate(apiKeyPersistence.NewAPIKeyRepositoryImpl, fx.As(new(apiKeyRepositories.APIKeyRepository))),
+			fx.Annotate(apiKeyUseCasesCreate.NewCreateAPIKeyUseCaseImpl, fx.As(new(apiKeyUseCasesCreate.CreateAPIKeyUseCase))),
+			fx.Annotate(apiKeyUseCasesRotate.NewRotateAPIKeyUseCaseImpl, fx.As(new(apiKeyUseCasesRotate.RotateAPIKeyUseCase))),
+			fx.Annotate(apiKeyUseCasesRevoke.NewRevokeAPIKeyUseCaseImpl, fx.As(new(apiKeyUseCasesRevoke.RevokeAPIKeyUseCase))),
+			fx.Annotate(apiKeyUseCasesVerify.NewVerifyAPIKeyUseCaseImpl, fx.As(new(apiKeyUseCasesVerify.VerifyAPIKeyUseCase))),
+			fx.Annotate(apiKeyController.NewAPIKeyControllerImpl, fx.As(new(apiKeyController.APIKeyController))),
+			fx.Annotate(apiKeyPresenter.NewAPIKeyPresenterImpl, fx.As(new(apiKeyPresenter.APIKeyPresenter))),
+			apiKeyAuth.NewAPIKeyAuthenticator,
+			fx.Annotate(orderHistoryPersistence.NewOrderHistoryRepositoryImpl, fx.As(new(orderHistoryRepositories.OrderHistoryRepository))),
+			fx.Annotate(orderHistoryUseCasesRecord.NewRecordOrderUseCaseImpl, fx.As(new(orderHistoryUseCasesRecord.RecordOrderUseCase))),
+			fx.Annotate(orderHistoryUseCasesList.NewListOrdersUseCaseImpl, fx.As(new(orderHistoryUseCasesList.ListOrdersUseCase))),
+			fx.Annotate(orderHistoryController.NewOrderHistoryControllerImpl, fx.As(new(orderHistoryController.OrderHistoryController))),
+			fx.Annotate(orderHistoryPresenter.NewOrderHistoryPresenterImpl, fx.As(new(orderHistoryPresenter.OrderHistoryPresenter))),
+			orderHistoryMessaging.NewOrderEventHandler,
+			fx.Annotate(loyaltyPersistence.NewLoyaltyRepositoryImpl, fx.As(new(loyaltyRepositories.LoyaltyRepository))),
+			loyaltyUseCasesEarn.EarningRulesFromEnv,
+			fx.Annotate(loyaltyUseCasesEarn.NewEarnPointsUseCaseImpl, fx.As(new(loyaltyUseCasesEarn.EarnPointsUseCase))),
+			fx.Annotate(loyaltyUseCasesRedeem.NewRedeemPointsUseCaseImpl, fx.As(new(loyaltyUseCasesRedeem.RedeemPointsUseCase))),
+			fx.Annotate(loyaltyUseCasesAdjust.NewAdjustPointsUseCaseImpl, fx.As(new(loyaltyUseCasesAdjust.AdjustPointsUseCase))),
+			fx.Annotate(loyaltyUseCasesExpire.NewExpirePointsUseCaseImpl, fx.As(new(loyaltyUseCasesExpire.ExpirePointsUseCase))),
+			fx.Annotate(loyaltyUseCasesList.NewListEntriesUseCaseImpl, fx.As(new(loyaltyUseCasesList.ListEntriesUseCase))),
+			fx.Annotate(loyaltyConfig.NewFileRulesRepositoryFromEnv, fx.As(fx.Self()), fx.As(new(loyaltyRepositories.LoyaltyRulesRepository))),
+			fx.Annotate(loyaltyUseCasesRecalculateTier.NewRecalculateTierUseCaseImpl, fx.As(new(loyaltyUseCasesRecalculateTier.RecalculateTierUseCase))),
+			fx.Annotate(loyaltyUseCasesListRewards.NewListRewardsUseCaseImpl, fx.As(new(loyaltyUseCasesListRewards.ListRewardsUseCase))),
+			fx.Annotate(loyaltyUseCasesRedeemReward.NewRedeemRewardUseCaseImpl, fx.As(new(loyaltyUseCasesRedeemReward.RedeemRewardUseCase))),
+			loyaltyJobs.NewTierRecalculationJobFromEnv,
+			fx.Annotate(loyaltyController.NewLoyaltyControllerImpl, fx.As(new(loyaltyController.LoyaltyController))),
+			fx.Annotate(loyaltyPresenter.NewLoyaltyPresenterImpl, fx.As(new(loyaltyPresenter.LoyaltyPresenter))),
+			loyaltyMessaging.NewPaymentEventHandler,
+			fx.Annotate(consentPersistence.NewConsentRepositoryImpl, fx.As(new(consentRepositories.ConsentRepository))),
+			fx.Annotate(consentUseCasesRecord.NewRecordConsentUseCaseImpl, fx.As(new(consentUseCasesRecord.RecordConsentUseCase))),
+			fx.Annotate(consentUseCasesGet.NewGetConsentsUseCaseImpl, fx.As(new(consentUseCasesGet.GetConsentsUseCase))),
+			fx.Annotate(consentUseCasesHistory.NewListConsentHistoryUseCaseImpl, fx.As(new(consentUseCasesHistory.ListConsentHistoryUseCase))),
+			fx.Annotate(consentUseCasesOptedIn.NewListOptedInUseCaseImpl, fx.As(new(consentUseCasesOptedIn.ListOptedInUseCase))),
+			fx.Annotate(consentController.NewConsentControllerImpl, fx.As(new(consentController.ConsentController))),
+			fx.Annotate(consentPresenter.NewConsentPresenterImpl, fx.As(new(consentPresenter.ConsentPresenter))),
+			fx.Annotate(auditPersistence.NewAuditRepositoryImpl, fx.As(new(auditRepositories.AuditRepository))),
+			fx.Annotate(auditUseCasesRecord.NewRecordAuditEntryUseCaseImpl, fx.As(new(auditUseCasesRecord.RecordAuditEntryUseCase))),
+			fx.Annotate(auditUseCasesList.NewListAuditEntriesUseCaseImpl, fx.As(new(auditUseCasesList.ListAuditEntriesUseCase))),
+			fx.Annotate(auditController.NewAuditControllerImpl, fx.As(new(auditController.AuditController))),
+			fx.Annotate(auditPresenter.NewAuditPresenterImpl, fx.As(new(auditPresenter.AuditPresenter))),
+			newDataExportRegistry,
+			fx.Annotate(dataExportUseCasesExport.NewExportCustomerDataUseCaseImpl, fx.As(new(dataExportUseCasesExport.ExportCustomerDataUseCase))),
+			fx.Annotate(dataExportController.NewDataExportControllerImpl, fx.As(new(dataExportController.DataExportController))),
+			fx.Annotate(dataExportPresenter.NewDataExportPresenterImpl, fx.As(new(dataExportPresenter.DataExportPresenter))),
+			messaging.ConfigFromEnv,
+			messaging.NewMemoryBrokerFromConfig,
+			messaging.NewPublisher,
+			newOutboxRelay,
 			chi.NewRouter,
-			func(customerController customerController.CustomerController) []rest.Controller {
+			fx.Annotate(ratelimit.NewMemoryStore, fx.As(new(ratelimit.Store))),
+			newLookupLimiter,
+			func(customerController customerController.CustomerController, apiKeyController apiKeyController.APIKeyController, orderHistoryController orderHistoryController.OrderHistoryController, loyaltyController loyaltyController.LoyaltyController, consentController consentController.ConsentController, dataExportController dataExportController.DataExportController, auditController auditController.AuditController, lookupLimiter *ratelimit.Limiter) []rest.Controller {
 				return []rest.Controller{
-					customerApiController.NewCustomerController(customerController),
+					customerApiController.NewCustomerController(customerController, lookupLimiter),
+					apiKeyApiController.NewAPIKeyController(apiKeyController),
+					orderHistoryApiController.NewOrderHistoryController(orderHistoryController),
+					loyaltyApiController.NewLoyaltyController(loyaltyController),
+					consentApiController.NewConsentController(consentController),
+					dataExportApiController.NewDataExportController(dataExportController),
+					auditApiController.NewAuditController(auditController),
 				}
 			},
 		),
-		fx.Invoke(registerRoutes),
-		fx.Invoke(startHTTPServer),
+		// Every customer use case, the data export and the consent records are wrapped to record who
+		// invoked them in the audit log
+		fx.Decorate(
+			newCachedCustomerRepository,
+			fx.Annotate(customerAudit.NewAuditedGetByCpfUseCase, fx.As(new(customerUseCasesGetByCpf.GetByCpfUseCase))),
+			fx.Annotate(customerAudit.NewAuditedAddCustomerUseCase, fx.As(new(customerUseCasesAdd.AddCustomerUseCase))),
+			fx.Annotate(customerAudit.NewAuditedIdentifyCustomerUseCase, fx.As(new(customerUseCasesIdentify.IdentifyCustomerUseCase))),
+			fx.Annotate(customerAudit.NewAuditedAddGuestUseCase, fx.As(new(customerUseCasesAddGuest.AddGuestUseCase))),
+			fx.Annotate(customerAudit.NewAuditedClaimGuestUseCase, fx.As(new(customerUseCasesClaimGuest.ClaimGuestUseCase))),
+			fx.Annotate(customerAudit.NewAuditedUpdateCustomerUseCase, fx.As(new(customerUseCasesUpdate.UpdateCustomerUseCase))),
+			fx.Annotate(customerAudit.NewAuditedEraseCustomerUseCase, fx.As(new(customerUseCasesErase.EraseCustomerUseCase))),
+			fx.Annotate(customerAudit.NewAuditedListCustomerHistoryUseCase, fx.As(new(customerUseCasesHistory.ListCustomerHistoryUseCase))),
+			fx.Annotate(customerAudit.NewAuditedGetCustomerAsOfUseCase, fx.As(new(customerUseCasesAsOf.GetCustomerAsOfUseCase))),
+			fx.Annotate(customerAudit.NewAuditedImportCustomersUseCase, fx.As(new(customerUseCasesImport.ImportCustomersUseCase))),
+			fx.Annotate(customerAudit.NewAuditedExportCustomersUseCase, fx.As(new(customerUseCasesExport.ExportCustomersUseCase))),
+			fx.Annotate(customerAudit.NewAuditedReindexCustomersUseCase, fx.As(new(customerUseCasesReindex.ReindexCustomersUseCase))),
+			fx.Annotate(dataExportAudit.NewAuditedExportCustomerDataUseCase, fx.As(new(dataExportUseCasesExport.ExportCustomerDataUseCase))),
+			fx.Annotate(consentAudit.NewAuditedRecordConsentUseCase, fx.As(new(consentUseCasesRecord.RecordConsentUseCase))),
+		),
 	)
 }
 
-func registerRoutes(r *chi.Mux, controllers []rest.Controller) {
+// newAuthenticator accepts API keys from internal services, tokens from the identity provider
+// and the sessions issued by this service.
+func newAuthenticator(apiKeyAuthenticator *apiKeyAuth.APIKeyAuthenticator, jwtAuthenticator *auth.JWTAuthenticator, issuer *auth.RSATokenIssuer) (auth.Authenticator, error) {
+	sessionAuthenticator, err := issuer.Authenticator()
+	if err != nil {
+		return nil, err
+	}
+	return auth.Chain(apiKeyAuthenticator, jwtAuthenticator, sessionAuthenticator), nil
+}
+
+// newLookupLimiter throttles the CPF lookup per client. Every client may do 60 lookups per
+// minute, but only 10 of them may miss, which keeps CPF enumeration impractically slow.
+func newLookupLimiter(store ratelimit.Store) (*ratelimit.Limiter, error) {
+	limit, err := ratelimit.LimitFromEnv("RATE_LIMIT_LOOKUP", ratelimit.Limit{Requests: 60, Period: time.Minute})
+	if err != nil {
+		return nil, err
+	}
+	notFoundLimit, err := ratelimit.LimitFromEnv("RATE_LIMIT_LOOKUP_NOT_FOUND", ratelimit.Limit{Requests: 10, Period: time.Minute})
+	if err != nil {
+		return nil, err
+	}
+	return ratelimit.NewLimiter(store, ratelimit.Policy{
+		Name:          "customer-lookup",
+		Limit:         limit,
+		NotFoundLimit: notFoundLimit,
+	}), nil
+}
+
+// newCachedCustomerRepository puts the read-through cache configured by CUSTOMER_CACHE_SIZE,
+// CUSTOMER_CACHE_TTL and CUSTOMER_CACHE_NEGATIVE_TTL in front of the repository and publishes its
+// metrics as customer_cache. With CUSTOMER_CACHE_REDIS_URL the replicas also share a Redis cache
+// and broadcast their writes through it; otherwise the TTL bounds how long other replicas serve a
+// customer after a write.
+func newCachedCustomerRepository(lc fx.Lifecycle, repository customerRepositories.CustomerRepository, encryptor *encryption.Encryptor, blindIndex *encryption.BlindIndex) (customerRepositories.CustomerRepository, error) {
+	config, err := cache.ConfigFromEnv("CUSTOMER_CACHE", cache.Config{Size: 10000, TTL: 30 * time.Second, NegativeTTL: 5 * time.Second, RedisTimeout: 100 * time.Millisecond, RedisTombstoneTTL: 10 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	if !config.Enabled() {
+		return repository, nil
+	}
+
+	var shared *customerCache.SharedCustomerCache
+	if config.Shared() {
+		store, err := cache.NewRedisStore(config.RedisURL, config.RedisTimeout)
+		if err != nil {
+			return nil, err
+		}
+		shared = customerCache.NewSharedCustomerCache(store, encryptor, blindIndex, config.RedisTombstoneTTL)
+		lc.Append(fx.Hook{
+			OnStop: func(ctx context.Context) error {
+				return store.Close()
+			},
+		})
+	}
+
+	cached := customerCache.NewCachedCustomerRepository(repository, config, shared)
+	if shared != nil {
+		lc.Append(fx.Hook{
+			OnStart: func(ctx context.Context) error {
+				log.Println("Starting customer cache invalidation listener")
+				return cached.Start()
+			},
+			OnStop: func(ctx context.Context) error {
+				log.Println("Stopping customer cache invalidation listener")
+				cached.Stop()
+				return nil
+			},
+		})
+	}
+	metrics.Publish("customer_cache", func() any { return cached.Metrics() })
+	return cached, nil
+}
+
+// newDataExportRegistry lists the modules holding personal data, in the order their sections
+// appear in data exports.
+func newDataExportRegistry(
+	customerRepository customerRepositories.CustomerRepository,
+	customerHistoryRepository customerRepositories.CustomerHistoryRepository,
+	consentRepository consentRepositories.ConsentRepository,
+	loyaltyRepository loyaltyRepositories.LoyaltyRepository,
+	orderHistoryRepository orderHistoryRepositories.OrderHistoryRepository,
+	auditRepository auditRepositories.AuditRepository) dataExportRepositories.ContributorRegistry {
+	return dataExportRegistry.NewContributorRegistryImpl(
+		customerDataExport.NewProfileDataContributor(customerRepository),
+		customerDataExport.NewProfileHistoryDataContributor(customerHistoryRepository),
+		consentDataExport.NewConsentDataContributor(consentRepository),
+		loyaltyDataExport.NewLoyaltyDataContributor(loyaltyRepository),
+		orderHistoryDataExport.NewOrderHistoryDataContributor(orderHistoryRepository),
+		auditDataExport.NewAuditDataContributor(auditRepository),
+	)
+}
+
+// newOutboxRelay publishes the domain events the repositories store in the outbox table.
+func newOutboxRelay(db dynamodb.Client, publisher messaging.Publisher) (*outbox.Relay, error) {
+	config, err := outbox.RelayConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	store := outbox.NewDynamoDBStore(db, dynamodb.OutboxTableName)
+	return outbox.NewRelay(store, publisher, config), nil
+}
+
+// readMetricsRule restricts the metrics to the staff, as they expose memory statistics, the command
+// line and the health of the dependencies.
+var readMetricsRule = auth.Rule{
+	Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+}
+
+func registerRoutes(r *chi.Mux, controllers []rest.Controller, authenticator auth.Authenticator, issuer *auth.RSATokenIssuer) {
+	r.Use(middleware.RequestID)
 	r.Use(middleware.Logger)
+	r.Use(auth.Middleware(authenticator))
+
+	// Public keys of the session tokens issued by this service
+	r.Get("/.well-known/jwks.json", issuer.JWKSHandler)
+
+	// Cache and runtime metrics published through expvar, which expose the state of the service
+	r.With(auth.Authorize(readMetricsRule)).Get("/debug/vars", metrics.Handler().ServeHTTP)
 
 	// Swagger UI
 	r.Get("/swagger/*", httpSwagger.Handler(
@@ -73,3 +394,100 @@ func startHTTPServer(lc fx.Lifecycle, r *chi.Mux) {
 		},
 	})
 }
+
+// provisionTables checks the tables as DYNAMODB_PROVISIONING says before anything else starts,
+// failing the startup when one of them is missing or does not become active.
+func provisionTables(lc fx.Lifecycle, provisioner *dynamodb.Provisioner) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Provisioning DynamoDB tables")
+			return provisioner.Provision(ctx)
+		},
+	})
+}
+
+func startOutboxRelay(lc fx.Lifecycle, relay *outbox.Relay) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting outbox relay")
+			relay.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping outbox relay")
+			relay.Stop()
+			return nil
+		},
+	})
+}
+
+// startOrderEventConsumer feeds the order history from the queue subscribed to the order
+// events, configured by ORDER_EVENTS_QUEUE_URL and ORDER_EVENTS_DLQ_URL.
+func startOrderEventConsumer(lc fx.Lifecycle, config messaging.Config, broker *messaging.MemoryBroker, handler *orderHistoryMessaging.OrderEventHandler) error {
+	return startConsumer(lc, config, broker, "order-events", "ORDER_EVENTS", handler.Handle)
+}
+
+// startPaymentEventConsumer credits loyalty points from the queue subscribed to the payment
+// events, configured by PAYMENT_EVENTS_QUEUE_URL and PAYMENT_EVENTS_DLQ_URL.
+func startPaymentEventConsumer(lc fx.Lifecycle, config messaging.Config, broker *messaging.MemoryBroker, handler *loyaltyMessaging.PaymentEventHandler) error {
+	return startConsumer(lc, config, broker, "payment-events", "PAYMENT_EVENTS", handler.Handle)
+}
+
+// startLoyaltyRulesWatcher reloads the loyalty rules when LOYALTY_RULES_FILE changes.
+func startLoyaltyRulesWatcher(lc fx.Lifecycle, rules *loyaltyConfig.FileRulesRepository) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting loyalty rules watcher")
+			rules.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping loyalty rules watcher")
+			rules.Stop()
+			return nil
+		},
+	})
+}
+
+func startTierRecalculationJob(lc fx.Lifecycle, job *loyaltyJobs.TierRecalculationJob) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting tier recalculation job")
+			job.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping tier recalculation job")
+			job.Stop()
+			return nil
+		},
+	})
+}
+
+// startConsumer runs a worker for the named queue, whose URLs are read from <prefix>_QUEUE_URL
+// and <prefix>_DLQ_URL, for as long as the application runs.
+func startConsumer(lc fx.Lifecycle, config messaging.Config, broker *messaging.MemoryBroker, name string, prefix string, handler messaging.Handler) error {
+	queue, err := messaging.QueueConfigFromEnv(config, name, prefix)
+	if err != nil {
+		return err
+	}
+	consumer, err := messaging.NewConsumer(config, broker, queue)
+	if err != nil {
+		return err
+	}
+	worker := messaging.NewWorker(consumer, handler)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Printf("Starting %s consumer\n", name)
+			worker.Start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Printf("Stopping %s consumer\n", name)
+			worker.Stop()
+			return nil
+		},
+	})
+	return nil
+}
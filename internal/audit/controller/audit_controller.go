@@ -0,0 +1,7 @@
+package controller
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+
+type AuditController interface {
+	List(customerID string, actor string, limit int, cursor string) (*dto.AuditEntriesResponseDto, error)
+}
@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+	auditPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/audit/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/listauditentries"
+)
+
+var (
+	_ AuditController = (*AuditControllerImpl)(nil)
+)
+
+type AuditControllerImpl struct {
+	presenter               auditPresenter.AuditPresenter
+	listAuditEntriesUseCase listauditentries.ListAuditEntriesUseCase
+}
+
+func NewAuditControllerImpl(presenter auditPresenter.AuditPresenter, listAuditEntriesUseCase listauditentries.ListAuditEntriesUseCase) *AuditControllerImpl {
+	return &AuditControllerImpl{
+		presenter:               presenter,
+		listAuditEntriesUseCase: listAuditEntriesUseCase,
+	}
+}
+
+func (c *AuditControllerImpl) List(customerID string, actor string, limit int, cursor string) (*dto.AuditEntriesResponseDto, error) {
+	page, err := c.listAuditEntriesUseCase.Execute(commands.NewListAuditEntriesCommand(customerID, actor, limit, cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(page), nil
+}
@@ -0,0 +1,69 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/presenter"
+	mockListAuditEntries "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/listauditentries"
+)
+
+type AuditControllerTestSuite struct {
+	suite.Suite
+	mockPresenter               *mockPresenter.MockAuditPresenter
+	mockListAuditEntriesUseCase *mockListAuditEntries.MockListAuditEntriesUseCase
+	controller                  controller.AuditController
+}
+
+func (suite *AuditControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockAuditPresenter(suite.T())
+	suite.mockListAuditEntriesUseCase = mockListAuditEntries.NewMockListAuditEntriesUseCase(suite.T())
+	suite.controller = controller.NewAuditControllerImpl(suite.mockPresenter, suite.mockListAuditEntriesUseCase)
+}
+
+func TestAuditControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditControllerTestSuite))
+}
+
+// Feature: Audit Controller
+// Scenario: Audit entries are listed and presented
+
+func (suite *AuditControllerTestSuite) Test_List_ShouldPresentPage() {
+	// GIVEN a page of entries about a customer
+	page := &entities.AuditPage{Entries: []*entities.AuditEntry{{ID: "entry-1"}}}
+	expectedDto := &dto.AuditEntriesResponseDto{Entries: []dto.AuditEntryResponseDto{{ID: "entry-1"}}}
+	suite.mockListAuditEntriesUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListAuditEntriesCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Actor == "" && cmd.Limit == 10 && cmd.Cursor == "cursor"
+		})).
+		Return(page, nil).
+		Once()
+	suite.mockPresenter.EXPECT().Present(page).Return(expectedDto).Once()
+
+	// WHEN listing them
+	result, err := suite.controller.List("customer-1", "", 10, "cursor")
+
+	// THEN the presented page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *AuditControllerTestSuite) Test_List_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the use case fails
+	expectedError := errors.New("query failed")
+	suite.mockListAuditEntriesUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN listing
+	result, err := suite.controller.List("", "staff-1", 0, "")
+
+	// THEN the error should be returned without presenting anything
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,42 @@
+package entities
+
+import "time"
+
+// Outcome tells whether the audited invocation succeeded.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// FieldChange is the old and new value of a field changed by an invocation. Sensitive values
+// are stored as keyed hashes, so the log tells that a value changed without disclosing it.
+type FieldChange struct {
+	Field  string `json:"field" dynamodbav:"field"`
+	Old    string `json:"old,omitempty" dynamodbav:"old,omitempty"`
+	New    string `json:"new,omitempty" dynamodbav:"new,omitempty"`
+	Hashed bool   `json:"hashed" dynamodbav:"hashed"`
+}
+
+// AuditEntry records who invoked a use case on a customer record, and what it changed.
+// Entries are never changed once written.
+type AuditEntry struct {
+	ID string `json:"id" dynamodbav:"id"`
+	// Actor is the subject of the token behind the invocation, or "system" for jobs and consumers.
+	Actor      string   `json:"actor" dynamodbav:"actor"`
+	ActorRoles []string `json:"actor_roles,omitempty" dynamodbav:"actor_roles,omitempty"`
+	Action     string   `json:"action" dynamodbav:"action"`
+	// CustomerID is empty when the invocation failed before a customer was found.
+	CustomerID string         `json:"customer_id,omitempty" dynamodbav:"customer_id,omitempty"`
+	Changes    []*FieldChange `json:"changes,omitempty" dynamodbav:"changes,omitempty"`
+	Outcome    Outcome        `json:"outcome" dynamodbav:"outcome"`
+	RequestID  string         `json:"request_id,omitempty" dynamodbav:"request_id,omitempty"`
+	OccurredAt time.Time      `json:"occurred_at" dynamodbav:"occurred_at"`
+}
+
+// AuditPage is one page of audit entries, newest first.
+type AuditPage struct {
+	Entries    []*AuditEntry
+	NextCursor string
+}
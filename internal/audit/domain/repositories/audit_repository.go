@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+type AuditRepository interface {
+	// Append stores a new entry; entries are never overwritten.
+	Append(entry *entities.AuditEntry) error
+	// ListByCustomer returns up to limit entries about a customer, newest first, starting after cursor.
+	ListByCustomer(customerID string, limit int, cursor string) (*entities.AuditPage, error)
+	// ListByActor returns up to limit entries of an actor, newest first, starting after cursor.
+	ListByActor(actor string, limit int, cursor string) (*entities.AuditPage, error)
+}
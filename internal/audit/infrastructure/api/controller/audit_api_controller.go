@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	auditController "github.com/viniciuscluna/tc-fiap-customer/internal/audit/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/listauditentries"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	// The audit log tells who looked at whom, so it is only open to staff.
+	readAuditRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+)
+
+type auditApiController struct {
+	controller auditController.AuditController
+}
+
+func NewAuditController(controller auditController.AuditController) *auditApiController {
+	return &auditApiController{
+		controller: controller,
+	}
+}
+
+func (c *auditApiController) RegisterRoutes(r chi.Router) {
+	r.With(auth.Authorize(readAuditRule)).Get("/v1/audit", c.List)
+}
+
+// @Summary     List audit entries
+// @Description List who read or changed customer records, newest first, filtered by customer or by actor. Sensitive values are hashed
+// @Tags        Audit
+// @Produce     json
+// @Param       customer_id query string false "Customer ID (either customer_id or actor is required)"
+// @Param       actor       query string false "Token subject of the actor"
+// @Param       limit       query int    false "Page size (default 50, max 200)"
+// @Param       cursor      query string false "Cursor returned by the previous page"
+// @Success     200 {object} dto.AuditEntriesResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/audit [get]
+func (h *auditApiController) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, `{"error":"Invalid limit parameter"}`, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	entries, err := h.controller.List(query.Get("customer_id"), query.Get("actor"), limit, query.Get("cursor"))
+
+	if err != nil {
+		switch {
+		case errors.Is(err, listauditentries.ErrInvalidFilter):
+			http.Error(w, `{"error":"Either customer_id or actor is required"}`, http.StatusBadRequest)
+		case errors.Is(err, repositories.ErrInvalidCursor):
+			http.Error(w, `{"error":"Invalid cursor parameter"}`, http.StatusBadRequest)
+		default:
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
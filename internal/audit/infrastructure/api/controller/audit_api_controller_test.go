@@ -0,0 +1,119 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/listauditentries"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type AuditApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockAuditController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *AuditApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockAuditController(suite.T())
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiController.NewAuditController(suite.mockController).RegisterRoutes(suite.router)
+}
+
+func TestAuditApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditApiControllerTestSuite))
+}
+
+func (suite *AuditApiControllerTestSuite) request(path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+// Feature: Audit REST API
+// Scenario: Staff query the audit log by customer or by actor
+
+func (suite *AuditApiControllerTestSuite) Test_List_ByCustomer_ShouldReturnEntries() {
+	// GIVEN entries about a customer
+	suite.mockController.EXPECT().List("customer-1", "", 10, "cursor").
+		Return(&dto.AuditEntriesResponseDto{Entries: []dto.AuditEntryResponseDto{{ID: "entry-1", Action: "customer.read"}}}, nil).
+		Once()
+
+	// WHEN staff list them
+	w := suite.request("/v1/audit?customer_id=customer-1&limit=10&cursor=cursor")
+
+	// THEN the entries should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.AuditEntriesResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), "customer.read", response.Entries[0].Action)
+}
+
+func (suite *AuditApiControllerTestSuite) Test_List_ByActor_ShouldPassActor() {
+	// GIVEN entries of an actor
+	suite.mockController.EXPECT().List("", "kiosk-1", 0, "").Return(&dto.AuditEntriesResponseDto{}, nil).Once()
+
+	// WHEN staff list them
+	w := suite.request("/v1/audit?actor=kiosk-1")
+
+	// THEN the request should succeed
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *AuditApiControllerTestSuite) Test_List_WithErrors_ShouldMapStatusCodes() {
+	// GIVEN the errors the controller may return
+	cases := map[error]int{
+		listauditentries.ErrInvalidFilter: http.StatusBadRequest,
+		repositories.ErrInvalidCursor:     http.StatusBadRequest,
+		errors.New("query failed"):        http.StatusInternalServerError,
+	}
+	for err, status := range cases {
+		suite.mockController.EXPECT().List("", "", 0, "").Return(nil, err).Once()
+
+		// WHEN listing
+		w := suite.request("/v1/audit")
+
+		// THEN the matching status should be returned
+		assert.Equal(suite.T(), status, w.Code, err.Error())
+	}
+}
+
+func (suite *AuditApiControllerTestSuite) Test_List_WithInvalidLimit_ShouldReturnBadRequest() {
+	// GIVEN a limit that is not a positive number
+	// WHEN listing
+	w := suite.request("/v1/audit?customer_id=customer-1&limit=0")
+
+	// THEN the request should be rejected
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *AuditApiControllerTestSuite) Test_List_AsKiosk_ShouldReturnForbidden() {
+	// GIVEN a kiosk token
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+
+	// WHEN it lists the audit log
+	w := suite.request("/v1/audit?customer_id=customer-1")
+
+	// THEN access should be denied
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+type AuditEntriesResponseDto struct {
+	Entries    []AuditEntryResponseDto `json:"entries"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+type AuditEntryResponseDto struct {
+	ID         string                   `json:"id"`
+	Actor      string                   `json:"actor"`
+	ActorRoles []string                 `json:"actor_roles,omitempty"`
+	Action     string                   `json:"action"`
+	CustomerID string                   `json:"customer_id,omitempty"`
+	Changes    []FieldChangeResponseDto `json:"changes,omitempty"`
+	Outcome    string                   `json:"outcome"`
+	RequestID  string                   `json:"request_id,omitempty"`
+	OccurredAt time.Time                `json:"occurred_at"`
+}
+
+// FieldChangeResponseDto is a changed field; when hashed is true old and new are keyed hashes
+// that only tell whether two values are equal.
+type FieldChangeResponseDto struct {
+	Field  string `json:"field"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+	Hashed bool   `json:"hashed"`
+}
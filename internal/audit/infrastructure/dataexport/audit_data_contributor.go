@@ -0,0 +1,46 @@
+package dataexport
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+const entriesPageSize = 100
+
+var (
+	_ dataExportRepositories.DataContributor = (*AuditDataContributor)(nil)
+)
+
+// AuditDataContributor adds to data exports who read or changed the customer record, newest first.
+type AuditDataContributor struct {
+	auditRepository repositories.AuditRepository
+}
+
+func NewAuditDataContributor(auditRepository repositories.AuditRepository) *AuditDataContributor {
+	return &AuditDataContributor{auditRepository: auditRepository}
+}
+
+func (c *AuditDataContributor) Section() string {
+	return "access_log"
+}
+
+func (c *AuditDataContributor) Title() string {
+	return "Access log"
+}
+
+func (c *AuditDataContributor) Collect(customerID string) (any, error) {
+	entries := []*entities.AuditEntry{}
+	cursor := ""
+	for {
+		page, err := c.auditRepository.ListByCustomer(customerID, entriesPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, page.Entries...)
+		if page.NextCursor == "" {
+			return entries, nil
+		}
+		cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,41 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/dataexport"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/domain/repositories"
+)
+
+func TestAuditDataContributor_ShouldCollectEveryEntry(t *testing.T) {
+	repository := mockRepositories.NewMockAuditRepository(t)
+	repository.EXPECT().ListByCustomer("customer-1", 100, "").
+		Return(&entities.AuditPage{Entries: []*entities.AuditEntry{{ID: "entry-2"}}, NextCursor: "next"}, nil).
+		Once()
+	repository.EXPECT().ListByCustomer("customer-1", 100, "next").
+		Return(&entities.AuditPage{Entries: []*entities.AuditEntry{{ID: "entry-1"}}}, nil).
+		Once()
+
+	contributor := dataexport.NewAuditDataContributor(repository)
+	data, err := contributor.Collect("customer-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access_log", contributor.Section())
+	entries := data.([]*entities.AuditEntry)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "entry-1", entries[1].ID)
+}
+
+func TestAuditDataContributor_WithRepositoryError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockAuditRepository(t)
+	expectedError := errors.New("query failed")
+	repository.EXPECT().ListByCustomer("customer-1", 100, "").Return(nil, expectedError).Once()
+
+	data, err := dataexport.NewAuditDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,138 @@
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.AuditRepository = (*AuditRepositoryImpl)(nil)
+)
+
+// sortKeyLayout keeps a fixed width so the sort keys of both indexes order chronologically.
+const sortKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+// auditItem is an entry keyed by its ID. The sort key, shared by the customer and the actor
+// indexes, is the date followed by the ID; entries without a customer stay out of the customer index.
+type auditItem struct {
+	entities.AuditEntry
+	SortKey string `dynamodbav:"sk"`
+}
+
+type AuditRepositoryImpl struct {
+	db dynamodbpkg.Client
+}
+
+func NewAuditRepositoryImpl(db dynamodbpkg.Client) *AuditRepositoryImpl {
+	return &AuditRepositoryImpl{db: db}
+}
+
+// Append writes the entry only if no entry with its ID exists, so the log is never rewritten.
+func (r *AuditRepositoryImpl) Append(entry *entities.AuditEntry) error {
+	if entry.ID == "" {
+		id, err := newEntryID()
+		if err != nil {
+			return err
+		}
+		entry.ID = id
+	}
+	if entry.OccurredAt.IsZero() {
+		entry.OccurredAt = time.Now().UTC()
+	}
+
+	item, err := attributevalue.MarshalMap(auditItem{
+		AuditEntry: *entry,
+		SortKey:    entry.OccurredAt.UTC().Format(sortKeyLayout) + "#" + entry.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName:           aws.String(dynamodbpkg.AuditTableName),
+		Item:                item,
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to append audit entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuditRepositoryImpl) ListByCustomer(customerID string, limit int, cursor string) (*entities.AuditPage, error) {
+	return r.list(dynamodbpkg.AuditCustomerIndexName, "customer_id", customerID, limit, cursor)
+}
+
+func (r *AuditRepositoryImpl) ListByActor(actor string, limit int, cursor string) (*entities.AuditPage, error) {
+	return r.list(dynamodbpkg.AuditActorIndexName, "actor", actor, limit, cursor)
+}
+
+// list queries one of the indexes newest first. The cursor is the sort key of the last entry,
+// which also carries its ID, the rest of the key DynamoDB needs to resume the query.
+func (r *AuditRepositoryImpl) list(indexName string, attribute string, value string, limit int, cursor string) (*entities.AuditPage, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.AuditTableName),
+		IndexName:              aws.String(indexName),
+		KeyConditionExpression: aws.String(attribute + " = :value"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":value": &types.AttributeValueMemberS{Value: value},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		_, id, found := strings.Cut(string(decoded), "#")
+		if err != nil || !found || id == "" {
+			return nil, repositories.ErrInvalidCursor
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"id":      &types.AttributeValueMemberS{Value: id},
+			attribute: &types.AttributeValueMemberS{Value: value},
+			"sk":      &types.AttributeValueMemberS{Value: string(decoded)},
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+
+	var items []auditItem
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit entries: %w", err)
+	}
+
+	page := &entities.AuditPage{Entries: make([]*entities.AuditEntry, 0, len(items))}
+	for i := range items {
+		page.Entries = append(page.Entries, &items[i].AuditEntry)
+	}
+	if lastKey, ok := result.LastEvaluatedKey["sk"]; ok {
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(dynamodbpkg.StringValue(lastKey)))
+	}
+
+	return page, nil
+}
+
+func newEntryID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate audit entry id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,177 @@
+package persistence_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/persistence"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// Mock DynamoDB Client
+type MockDynamoDBClient struct {
+	mock.Mock
+	dynamodbpkg.Client
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+type AuditRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     *MockDynamoDBClient
+	repository *persistence.AuditRepositoryImpl
+}
+
+func (suite *AuditRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	suite.repository = persistence.NewAuditRepositoryImpl(suite.mockDB)
+}
+
+func TestAuditRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditRepositoryTestSuite))
+}
+
+// Feature: Audit Persistence
+// Scenario: Entries are appended and never overwritten
+
+func (suite *AuditRepositoryTestSuite) Test_Append_ShouldWriteConditionallyWithSortKey() {
+	// GIVEN an entry about a customer
+	occurredAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	entry := &entities.AuditEntry{ID: "entry-1", Actor: "staff-1", Action: "customer.update", CustomerID: "customer-1", Outcome: entities.OutcomeSuccess, OccurredAt: occurredAt}
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == "attribute_not_exists(id)" &&
+			dynamodbpkg.StringValue(input.Item["sk"]) == "2025-01-01T12:00:00.000000Z#entry-1" &&
+			dynamodbpkg.StringValue(input.Item["customer_id"]) == "customer-1" &&
+			dynamodbpkg.StringValue(input.Item["actor"]) == "staff-1"
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN appending it
+	err := suite.repository.Append(entry)
+
+	// THEN it should be written once
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *AuditRepositoryTestSuite) Test_Append_WithoutCustomer_ShouldStayOutOfCustomerIndex() {
+	// GIVEN a failed lookup that found no customer
+	entry := &entities.AuditEntry{Actor: "kiosk-1", Action: "customer.read", Outcome: entities.OutcomeFailure}
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		_, hasCustomer := input.Item["customer_id"]
+		return !hasCustomer
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN appending it
+	err := suite.repository.Append(entry)
+
+	// THEN the ID and date should be filled in
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), entry.ID)
+	assert.False(suite.T(), entry.OccurredAt.IsZero())
+}
+
+func (suite *AuditRepositoryTestSuite) Test_Append_WithDynamoDBError_ShouldReturnError() {
+	// GIVEN DynamoDB rejects the write
+	suite.mockDB.On("PutItem", mock.Anything).Return(nil, errors.New("dynamodb error")).Once()
+
+	// WHEN appending an entry
+	err := suite.repository.Append(&entities.AuditEntry{Actor: "staff-1", Action: "customer.read"})
+
+	// THEN the error should be returned
+	assert.ErrorContains(suite.T(), err, "failed to append audit entry")
+}
+
+// Feature: Audit Persistence
+// Scenario: Entries are listed by customer or by actor, newest first
+
+func (suite *AuditRepositoryTestSuite) Test_ListByCustomer_ShouldQueryCustomerIndexAndReturnCursor() {
+	// GIVEN one entry and more to come
+	lastKey := "2025-01-01T12:00:00.000000Z#entry-1"
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == "customer-index" &&
+			dynamodbpkg.StringValue(input.ExpressionAttributeValues[":value"]) == "customer-1" &&
+			!aws.ToBool(input.ScanIndexForward) &&
+			aws.ToInt32(input.Limit) == 1 &&
+			input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"id":          &types.AttributeValueMemberS{Value: "entry-1"},
+			"actor":       &types.AttributeValueMemberS{Value: "staff-1"},
+			"action":      &types.AttributeValueMemberS{Value: "customer.read"},
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"outcome":     &types.AttributeValueMemberS{Value: "success"},
+			"sk":          &types.AttributeValueMemberS{Value: lastKey},
+		}},
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"id":          &types.AttributeValueMemberS{Value: "entry-1"},
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: lastKey},
+		},
+	}, nil).Once()
+
+	// WHEN listing the first page
+	page, err := suite.repository.ListByCustomer("customer-1", 1, "")
+
+	// THEN the entry and the cursor should be returned
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Entries, 1)
+	assert.Equal(suite.T(), "staff-1", page.Entries[0].Actor)
+	assert.Equal(suite.T(), entities.OutcomeSuccess, page.Entries[0].Outcome)
+	assert.Equal(suite.T(), base64.RawURLEncoding.EncodeToString([]byte(lastKey)), page.NextCursor)
+}
+
+func (suite *AuditRepositoryTestSuite) Test_ListByActor_WithCursor_ShouldResumeAfterLastEntry() {
+	// GIVEN the cursor of a previous page
+	lastKey := "2025-01-01T12:00:00.000000Z#entry-1"
+	cursor := base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == "actor-index" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["id"]) == "entry-1" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["actor"]) == "staff-1" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["sk"]) == lastKey
+	})).Return(&dynamodb.QueryOutput{}, nil).Once()
+
+	// WHEN listing the next page
+	page, err := suite.repository.ListByActor("staff-1", 20, cursor)
+
+	// THEN the query should resume after the last entry and return no more cursor
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), page.Entries)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *AuditRepositoryTestSuite) Test_ListByActor_WithInvalidCursor_ShouldReturnErrInvalidCursor() {
+	// GIVEN a cursor that was not issued by the repository
+	// WHEN listing with it
+	page, err := suite.repository.ListByActor("staff-1", 20, "not-a-cursor")
+
+	// THEN ErrInvalidCursor should be returned
+	assert.Nil(suite.T(), page)
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+	suite.mockDB.AssertNotCalled(suite.T(), "Query", mock.Anything)
+}
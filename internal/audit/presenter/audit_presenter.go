@@ -0,0 +1,10 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+)
+
+type AuditPresenter interface {
+	Present(page *entities.AuditPage) *dto.AuditEntriesResponseDto
+}
@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+)
+
+var (
+	_ AuditPresenter = (*AuditPresenterImpl)(nil)
+)
+
+type AuditPresenterImpl struct {
+}
+
+func NewAuditPresenterImpl() *AuditPresenterImpl {
+	return &AuditPresenterImpl{}
+}
+
+func (p *AuditPresenterImpl) Present(page *entities.AuditPage) *dto.AuditEntriesResponseDto {
+	response := &dto.AuditEntriesResponseDto{
+		Entries:    make([]dto.AuditEntryResponseDto, 0, len(page.Entries)),
+		NextCursor: page.NextCursor,
+	}
+	for _, entry := range page.Entries {
+		item := dto.AuditEntryResponseDto{
+			ID:         entry.ID,
+			Actor:      entry.Actor,
+			ActorRoles: entry.ActorRoles,
+			Action:     entry.Action,
+			CustomerID: entry.CustomerID,
+			Outcome:    string(entry.Outcome),
+			RequestID:  entry.RequestID,
+			OccurredAt: entry.OccurredAt,
+		}
+		for _, change := range entry.Changes {
+			item.Changes = append(item.Changes, dto.FieldChangeResponseDto{
+				Field:  change.Field,
+				Old:    change.Old,
+				New:    change.New,
+				Hashed: change.Hashed,
+			})
+		}
+		response.Entries = append(response.Entries, item)
+	}
+	return response
+}
@@ -0,0 +1,71 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/presenter"
+)
+
+type AuditPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.AuditPresenter
+}
+
+func (suite *AuditPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewAuditPresenterImpl()
+}
+
+func TestAuditPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditPresenterTestSuite))
+}
+
+// Feature: Audit Presentation
+// Scenario: Transform audit entries to the response DTO
+
+func (suite *AuditPresenterTestSuite) Test_Present_ShouldMapEntriesChangesAndCursor() {
+	// GIVEN a page with an update
+	occurredAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	page := &entities.AuditPage{
+		Entries: []*entities.AuditEntry{{
+			ID:         "entry-1",
+			Actor:      "staff-1",
+			ActorRoles: []string{"staff"},
+			Action:     "customer.update",
+			CustomerID: "customer-1",
+			Changes:    []*entities.FieldChange{{Field: "email", Old: "hash-1", New: "hash-2", Hashed: true}},
+			Outcome:    entities.OutcomeSuccess,
+			RequestID:  "request-1",
+			OccurredAt: occurredAt,
+		}},
+		NextCursor: "next",
+	}
+
+	// WHEN presenting it
+	result := suite.presenter.Present(page)
+
+	// THEN the entry should be mapped
+	assert.Equal(suite.T(), "next", result.NextCursor)
+	entry := result.Entries[0]
+	assert.Equal(suite.T(), "staff-1", entry.Actor)
+	assert.Equal(suite.T(), []string{"staff"}, entry.ActorRoles)
+	assert.Equal(suite.T(), "customer.update", entry.Action)
+	assert.Equal(suite.T(), "success", entry.Outcome)
+	assert.Equal(suite.T(), "request-1", entry.RequestID)
+	assert.Equal(suite.T(), occurredAt, entry.OccurredAt)
+	assert.Equal(suite.T(), "email", entry.Changes[0].Field)
+	assert.True(suite.T(), entry.Changes[0].Hashed)
+}
+
+func (suite *AuditPresenterTestSuite) Test_Present_WithEmptyPage_ShouldReturnEmptyList() {
+	// GIVEN no entries
+	// WHEN presenting the page
+	result := suite.presenter.Present(&entities.AuditPage{})
+
+	// THEN an empty list should be returned instead of null
+	assert.NotNil(suite.T(), result.Entries)
+	assert.Empty(suite.T(), result.Entries)
+}
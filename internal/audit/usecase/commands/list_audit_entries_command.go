@@ -0,0 +1,19 @@
+package commands
+
+// ListAuditEntriesCommand filters the audit log by customer or by actor; exactly one of them
+// must be set.
+type ListAuditEntriesCommand struct {
+	CustomerID string
+	Actor      string
+	Limit      int
+	Cursor     string
+}
+
+func NewListAuditEntriesCommand(customerID string, actor string, limit int, cursor string) *ListAuditEntriesCommand {
+	return &ListAuditEntriesCommand{
+		CustomerID: customerID,
+		Actor:      actor,
+		Limit:      limit,
+		Cursor:     cursor,
+	}
+}
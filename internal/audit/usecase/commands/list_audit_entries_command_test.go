@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+)
+
+func TestNewListAuditEntriesCommand(t *testing.T) {
+	// GIVEN the second page of the entries about a customer
+	// WHEN creating a new ListAuditEntriesCommand
+	command := commands.NewListAuditEntriesCommand("customer-1", "", 20, "cursor")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Empty(t, command.Actor)
+	assert.Equal(t, 20, command.Limit)
+	assert.Equal(t, "cursor", command.Cursor)
+}
@@ -0,0 +1,30 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+// FieldChange is a changed field as seen by the use case; Sensitive values are hashed before
+// they are stored.
+type FieldChange struct {
+	Field     string
+	Old       string
+	New       string
+	Sensitive bool
+}
+
+type RecordAuditEntryCommand struct {
+	Actor      audit.Actor
+	Action     string
+	CustomerID string
+	Changes    []*FieldChange
+	Succeeded  bool
+}
+
+func NewRecordAuditEntryCommand(actor audit.Actor, action string, customerID string, changes []*FieldChange, succeeded bool) *RecordAuditEntryCommand {
+	return &RecordAuditEntryCommand{
+		Actor:      actor,
+		Action:     action,
+		CustomerID: customerID,
+		Changes:    changes,
+		Succeeded:  succeeded,
+	}
+}
@@ -0,0 +1,26 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+func TestNewRecordAuditEntryCommand(t *testing.T) {
+	// GIVEN a staff member changing the email of a customer
+	actor := audit.Actor{ID: "staff-1", Roles: []string{"staff"}, RequestID: "request-1"}
+	changes := []*commands.FieldChange{{Field: "email", Old: "old@example.com", New: "new@example.com", Sensitive: true}}
+
+	// WHEN creating a new RecordAuditEntryCommand
+	command := commands.NewRecordAuditEntryCommand(actor, "customer.update", "customer-1", changes, true)
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, actor, command.Actor)
+	assert.Equal(t, "customer.update", command.Action)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, changes, command.Changes)
+	assert.True(t, command.Succeeded)
+}
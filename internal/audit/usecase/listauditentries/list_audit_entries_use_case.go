@@ -0,0 +1,10 @@
+package listauditentries
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+)
+
+type ListAuditEntriesUseCase interface {
+	Execute(command *commands.ListAuditEntriesCommand) (*entities.AuditPage, error)
+}
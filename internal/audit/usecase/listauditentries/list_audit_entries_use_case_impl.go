@@ -0,0 +1,48 @@
+package listauditentries
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+)
+
+var (
+	_ ListAuditEntriesUseCase = (*ListAuditEntriesUseCaseImpl)(nil)
+
+	ErrInvalidFilter = errors.New("exactly one of customer ID and actor is required")
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 200
+)
+
+type ListAuditEntriesUseCaseImpl struct {
+	auditRepository repositories.AuditRepository
+}
+
+func NewListAuditEntriesUseCaseImpl(auditRepository repositories.AuditRepository) *ListAuditEntriesUseCaseImpl {
+	return &ListAuditEntriesUseCaseImpl{auditRepository: auditRepository}
+}
+
+// Execute lists the entries about a customer or the entries of an actor, newest first.
+func (u *ListAuditEntriesUseCaseImpl) Execute(command *commands.ListAuditEntriesCommand) (*entities.AuditPage, error) {
+	if (command.CustomerID == "") == (command.Actor == "") {
+		return nil, ErrInvalidFilter
+	}
+
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	if command.CustomerID != "" {
+		return u.auditRepository.ListByCustomer(command.CustomerID, limit, command.Cursor)
+	}
+	return u.auditRepository.ListByActor(command.Actor, limit, command.Cursor)
+}
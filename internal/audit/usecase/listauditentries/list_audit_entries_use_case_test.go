@@ -0,0 +1,71 @@
+package listauditentries_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/listauditentries"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/domain/repositories"
+)
+
+type ListAuditEntriesUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAuditRepository
+	useCase        listauditentries.ListAuditEntriesUseCase
+}
+
+func (suite *ListAuditEntriesUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockAuditRepository(suite.T())
+	suite.useCase = listauditentries.NewListAuditEntriesUseCaseImpl(suite.mockRepository)
+}
+
+func TestListAuditEntriesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListAuditEntriesUseCaseTestSuite))
+}
+
+// Feature: List Audit Entries Use Case
+// Scenario: Staff review the audit log of a customer or of an actor
+
+func (suite *ListAuditEntriesUseCaseTestSuite) Test_ListAuditEntries_ByCustomer_ShouldUseDefaultPageSize() {
+	// GIVEN entries about a customer
+	page := &entities.AuditPage{Entries: []*entities.AuditEntry{{ID: "entry-1", CustomerID: "customer-1"}}}
+	suite.mockRepository.EXPECT().ListByCustomer("customer-1", listauditentries.DefaultPageSize, "").Return(page, nil).Once()
+
+	// WHEN listing them without a limit
+	result, err := suite.useCase.Execute(commands.NewListAuditEntriesCommand("customer-1", "", 0, ""))
+
+	// THEN the page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
+
+func (suite *ListAuditEntriesUseCaseTestSuite) Test_ListAuditEntries_ByActor_ShouldBoundPageSize() {
+	// GIVEN entries of an actor
+	page := &entities.AuditPage{}
+	suite.mockRepository.EXPECT().ListByActor("staff-1", listauditentries.MaxPageSize, "cursor").Return(page, nil).Once()
+
+	// WHEN listing them with a limit above the maximum
+	result, err := suite.useCase.Execute(commands.NewListAuditEntriesCommand("", "staff-1", 5000, "cursor"))
+
+	// THEN the actor index should be queried with the maximum page size
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
+
+func (suite *ListAuditEntriesUseCaseTestSuite) Test_ListAuditEntries_WithoutOrWithBothFilters_ShouldReturnErrInvalidFilter() {
+	// GIVEN no filter, and both filters
+	for _, command := range []*commands.ListAuditEntriesCommand{
+		commands.NewListAuditEntriesCommand("", "", 0, ""),
+		commands.NewListAuditEntriesCommand("customer-1", "staff-1", 0, ""),
+	} {
+		// WHEN listing
+		result, err := suite.useCase.Execute(command)
+
+		// THEN the filter should be rejected
+		assert.Nil(suite.T(), result)
+		assert.ErrorIs(suite.T(), err, listauditentries.ErrInvalidFilter)
+	}
+}
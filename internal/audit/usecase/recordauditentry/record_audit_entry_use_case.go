@@ -0,0 +1,10 @@
+package recordauditentry
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+)
+
+type RecordAuditEntryUseCase interface {
+	Execute(command *commands.RecordAuditEntryCommand) (*entities.AuditEntry, error)
+}
@@ -0,0 +1,63 @@
+package recordauditentry
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+var (
+	_ RecordAuditEntryUseCase = (*RecordAuditEntryUseCaseImpl)(nil)
+)
+
+// hashDomainPrefix keeps the audit hashes apart from the blind indexes used for lookups, so an
+// audit entry cannot be joined with the customer table.
+const hashDomainPrefix = "audit."
+
+type RecordAuditEntryUseCaseImpl struct {
+	auditRepository repositories.AuditRepository
+	blindIndex      *encryption.BlindIndex
+}
+
+func NewRecordAuditEntryUseCaseImpl(auditRepository repositories.AuditRepository, blindIndex *encryption.BlindIndex) *RecordAuditEntryUseCaseImpl {
+	return &RecordAuditEntryUseCaseImpl{auditRepository: auditRepository, blindIndex: blindIndex}
+}
+
+// Execute appends an entry to the audit log, replacing sensitive values by keyed hashes.
+func (u *RecordAuditEntryUseCaseImpl) Execute(command *commands.RecordAuditEntryCommand) (*entities.AuditEntry, error) {
+	entry := &entities.AuditEntry{
+		Actor:      command.Actor.ID,
+		ActorRoles: command.Actor.Roles,
+		Action:     command.Action,
+		CustomerID: command.CustomerID,
+		Outcome:    entities.OutcomeFailure,
+		RequestID:  command.Actor.RequestID,
+	}
+	if command.Succeeded {
+		entry.Outcome = entities.OutcomeSuccess
+	}
+	for _, change := range command.Changes {
+		entry.Changes = append(entry.Changes, u.fieldChange(change))
+	}
+
+	if err := u.auditRepository.Append(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (u *RecordAuditEntryUseCaseImpl) fieldChange(change *commands.FieldChange) *entities.FieldChange {
+	if !change.Sensitive {
+		return &entities.FieldChange{Field: change.Field, Old: change.Old, New: change.New}
+	}
+
+	// Empty values stay empty so the log still tells a field being set from one being changed.
+	hash := func(value string) string {
+		if value == "" {
+			return ""
+		}
+		return u.blindIndex.Compute(hashDomainPrefix+change.Field, value)
+	}
+	return &entities.FieldChange{Field: change.Field, Old: hash(change.Old), New: hash(change.New), Hashed: true}
+}
@@ -0,0 +1,113 @@
+package recordauditentry_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+type RecordAuditEntryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockAuditRepository
+	blindIndex     *encryption.BlindIndex
+	useCase        recordauditentry.RecordAuditEntryUseCase
+	actor          audit.Actor
+}
+
+func (suite *RecordAuditEntryUseCaseTestSuite) SetupTest() {
+	provider, err := encryption.NewLocalKeyProvider("key-1",
+		map[string][]byte{"key-1": bytes.Repeat([]byte{1}, encryption.KeySize)},
+		bytes.Repeat([]byte{2}, encryption.KeySize))
+	require.NoError(suite.T(), err)
+
+	suite.mockRepository = mockRepositories.NewMockAuditRepository(suite.T())
+	suite.blindIndex = encryption.NewBlindIndex(provider)
+	suite.useCase = recordauditentry.NewRecordAuditEntryUseCaseImpl(suite.mockRepository, suite.blindIndex)
+	suite.actor = audit.Actor{ID: "staff-1", Roles: []string{"staff"}, RequestID: "request-1"}
+}
+
+func TestRecordAuditEntryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RecordAuditEntryUseCaseTestSuite))
+}
+
+// Feature: Record Audit Entry Use Case
+// Scenario: Every invocation on a customer record is appended to the audit log
+
+func (suite *RecordAuditEntryUseCaseTestSuite) Test_RecordAuditEntry_ShouldHashSensitiveValuesOnly() {
+	// GIVEN an update changing the email and the guest flag
+	changes := []*commands.FieldChange{
+		{Field: "email", Old: "old@example.com", New: "new@example.com", Sensitive: true},
+		{Field: "guest", Old: "true", New: "false"},
+	}
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil).Once()
+
+	// WHEN recording it
+	entry, err := suite.useCase.Execute(commands.NewRecordAuditEntryCommand(suite.actor, "customer.update", "customer-1", changes, true))
+
+	// THEN the actor and request should be kept and only the email hashed
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "staff-1", entry.Actor)
+	assert.Equal(suite.T(), []string{"staff"}, entry.ActorRoles)
+	assert.Equal(suite.T(), "request-1", entry.RequestID)
+	assert.Equal(suite.T(), entities.OutcomeSuccess, entry.Outcome)
+	assert.Equal(suite.T(), &entities.FieldChange{
+		Field:  "email",
+		Old:    suite.blindIndex.Compute("audit.email", "old@example.com"),
+		New:    suite.blindIndex.Compute("audit.email", "new@example.com"),
+		Hashed: true,
+	}, entry.Changes[0])
+	assert.NotContains(suite.T(), entry.Changes[0].New, "example.com")
+	assert.Equal(suite.T(), &entities.FieldChange{Field: "guest", Old: "true", New: "false"}, entry.Changes[1])
+}
+
+func (suite *RecordAuditEntryUseCaseTestSuite) Test_RecordAuditEntry_WithEmptySensitiveValue_ShouldKeepItEmpty() {
+	// GIVEN a registration, which has no old values
+	changes := []*commands.FieldChange{{Field: "cpf", New: "12345678900", Sensitive: true}}
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil).Once()
+
+	// WHEN recording it
+	entry, err := suite.useCase.Execute(commands.NewRecordAuditEntryCommand(suite.actor, "customer.create", "customer-1", changes, true))
+
+	// THEN the old value should stay empty instead of hashing an empty string
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), entry.Changes[0].Old)
+	assert.NotEmpty(suite.T(), entry.Changes[0].New)
+	assert.True(suite.T(), entry.Changes[0].Hashed)
+}
+
+func (suite *RecordAuditEntryUseCaseTestSuite) Test_RecordAuditEntry_WithFailedInvocation_ShouldRecordFailure() {
+	// GIVEN a lookup that found no customer
+	suite.mockRepository.EXPECT().Append(mock.MatchedBy(func(entry *entities.AuditEntry) bool {
+		return entry.Outcome == entities.OutcomeFailure && entry.CustomerID == "" && entry.Action == "customer.read"
+	})).Return(nil).Once()
+
+	// WHEN recording it
+	_, err := suite.useCase.Execute(commands.NewRecordAuditEntryCommand(suite.actor, "customer.read", "", nil, false))
+
+	// THEN the failure should be recorded
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RecordAuditEntryUseCaseTestSuite) Test_RecordAuditEntry_WithRepositoryError_ShouldReturnError() {
+	// GIVEN the audit table is unavailable
+	expectedError := errors.New("dynamodb error")
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(expectedError).Once()
+
+	// WHEN recording an entry
+	entry, err := suite.useCase.Execute(commands.NewRecordAuditEntryCommand(suite.actor, "customer.read", "customer-1", nil, true))
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), entry)
+	assert.Equal(suite.T(), expectedError, err)
+}
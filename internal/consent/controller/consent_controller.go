@@ -0,0 +1,14 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type ConsentController interface {
+	GetConsents(customerID string) (*dto.ConsentsResponseDto, error)
+	ListHistory(customerID string, limit int, cursor string) (*dto.ConsentHistoryResponseDto, error)
+	Grant(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error)
+	Revoke(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error)
+	ListOptedIn(purpose string, limit int, cursor string) (*dto.OptedInResponseDto, error)
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	consentPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/consent/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/getconsents"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listconsenthistory"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listoptedin"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+var (
+	_ ConsentController = (*ConsentControllerImpl)(nil)
+)
+
+type ConsentControllerImpl struct {
+	presenter                 consentPresenter.ConsentPresenter
+	getConsentsUseCase        getconsents.GetConsentsUseCase
+	listConsentHistoryUseCase listconsenthistory.ListConsentHistoryUseCase
+	recordConsentUseCase      recordconsent.RecordConsentUseCase
+	listOptedInUseCase        listoptedin.ListOptedInUseCase
+}
+
+func NewConsentControllerImpl(
+	presenter consentPresenter.ConsentPresenter,
+	getConsentsUseCase getconsents.GetConsentsUseCase,
+	listConsentHistoryUseCase listconsenthistory.ListConsentHistoryUseCase,
+	recordConsentUseCase recordconsent.RecordConsentUseCase,
+	listOptedInUseCase listoptedin.ListOptedInUseCase) *ConsentControllerImpl {
+	return &ConsentControllerImpl{
+		presenter:                 presenter,
+		getConsentsUseCase:        getConsentsUseCase,
+		listConsentHistoryUseCase: listConsentHistoryUseCase,
+		recordConsentUseCase:      recordConsentUseCase,
+		listOptedInUseCase:        listOptedInUseCase,
+	}
+}
+
+func (c *ConsentControllerImpl) GetConsents(customerID string) (*dto.ConsentsResponseDto, error) {
+	consents, err := c.getConsentsUseCase.Execute(commands.NewGetConsentsCommand(customerID))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentConsents(customerID, consents), nil
+}
+
+func (c *ConsentControllerImpl) ListHistory(customerID string, limit int, cursor string) (*dto.ConsentHistoryResponseDto, error) {
+	page, err := c.listConsentHistoryUseCase.Execute(commands.NewListConsentHistoryCommand(customerID, limit, cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentHistory(page), nil
+}
+
+func (c *ConsentControllerImpl) Grant(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error) {
+	return c.record(customerID, purpose, true, request, actor)
+}
+
+func (c *ConsentControllerImpl) Revoke(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error) {
+	return c.record(customerID, purpose, false, request, actor)
+}
+
+func (c *ConsentControllerImpl) ListOptedIn(purpose string, limit int, cursor string) (*dto.OptedInResponseDto, error) {
+	page, err := c.listOptedInUseCase.Execute(commands.NewListOptedInCommand(entities.Purpose(purpose), limit, cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentOptedIn(entities.Purpose(purpose), page), nil
+}
+
+func (c *ConsentControllerImpl) record(customerID string, purpose string, granted bool, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error) {
+	command := commands.NewRecordConsentCommand(
+		customerID,
+		entities.Purpose(purpose),
+		granted,
+		entities.Source(request.Source),
+		request.PolicyVersion,
+		actor.ID,
+	)
+	command.Actor = actor
+	record, err := c.recordConsentUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentRecord(record), nil
+}
@@ -0,0 +1,156 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/presenter"
+	mockGetConsents "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/usecase/getconsents"
+	mockListConsentHistory "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/usecase/listconsenthistory"
+	mockListOptedIn "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/usecase/listoptedin"
+	mockRecordConsent "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/usecase/recordconsent"
+)
+
+type ConsentControllerTestSuite struct {
+	suite.Suite
+	mockPresenter                 *mockPresenter.MockConsentPresenter
+	mockGetConsentsUseCase        *mockGetConsents.MockGetConsentsUseCase
+	mockListConsentHistoryUseCase *mockListConsentHistory.MockListConsentHistoryUseCase
+	mockRecordConsentUseCase      *mockRecordConsent.MockRecordConsentUseCase
+	mockListOptedInUseCase        *mockListOptedIn.MockListOptedInUseCase
+	controller                    controller.ConsentController
+}
+
+func (suite *ConsentControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockConsentPresenter(suite.T())
+	suite.mockGetConsentsUseCase = mockGetConsents.NewMockGetConsentsUseCase(suite.T())
+	suite.mockListConsentHistoryUseCase = mockListConsentHistory.NewMockListConsentHistoryUseCase(suite.T())
+	suite.mockRecordConsentUseCase = mockRecordConsent.NewMockRecordConsentUseCase(suite.T())
+	suite.mockListOptedInUseCase = mockListOptedIn.NewMockListOptedInUseCase(suite.T())
+	suite.controller = controller.NewConsentControllerImpl(
+		suite.mockPresenter,
+		suite.mockGetConsentsUseCase,
+		suite.mockListConsentHistoryUseCase,
+		suite.mockRecordConsentUseCase,
+		suite.mockListOptedInUseCase,
+	)
+}
+
+func TestConsentControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentControllerTestSuite))
+}
+
+// Feature: Consent Controller
+// Scenario: Consents are read, granted, revoked and exported
+
+func (suite *ConsentControllerTestSuite) Test_GetConsents_ShouldPresentConsents() {
+	// GIVEN the consents of a customer
+	consents := []*entities.ConsentRecord{{CustomerID: "customer-1", Purpose: entities.PurposeSMS}}
+	expectedDto := &dto.ConsentsResponseDto{CustomerID: "customer-1"}
+	suite.mockGetConsentsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.GetConsentsCommand) bool { return cmd.CustomerID == "customer-1" })).
+		Return(consents, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentConsents("customer-1", consents).Return(expectedDto).Once()
+
+	// WHEN reading the consents
+	result, err := suite.controller.GetConsents("customer-1")
+
+	// THEN the presented consents should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ConsentControllerTestSuite) Test_Grant_ShouldRecordGrantedConsent() {
+	// GIVEN a grant request
+	record := &entities.ConsentRecord{ID: "record-1", Granted: true}
+	expectedDto := &dto.ConsentRecordResponseDto{ID: "record-1", Granted: true}
+	suite.mockRecordConsentUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecordConsentCommand) bool {
+			return cmd.Granted && cmd.Purpose == entities.PurposeMarketingEmail && cmd.Source == entities.SourceApp &&
+				cmd.PolicyVersion == "2025-01" && cmd.RecordedBy == "customer-1" && cmd.Actor.ID == "customer-1"
+		})).
+		Return(record, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentRecord(record).Return(expectedDto).Once()
+
+	// WHEN granting
+	result, err := suite.controller.Grant("customer-1", "marketing_email", &dto.ConsentRequestDto{Source: "app", PolicyVersion: "2025-01"}, audit.Actor{ID: "customer-1"})
+
+	// THEN the grant should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ConsentControllerTestSuite) Test_Revoke_ShouldRecordRevokedConsent() {
+	// GIVEN a revocation request
+	record := &entities.ConsentRecord{ID: "record-2"}
+	suite.mockRecordConsentUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecordConsentCommand) bool { return !cmd.Granted && cmd.Purpose == entities.PurposeSMS })).
+		Return(record, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentRecord(record).Return(&dto.ConsentRecordResponseDto{ID: "record-2"}).Once()
+
+	// WHEN revoking
+	result, err := suite.controller.Revoke("customer-1", "sms", &dto.ConsentRequestDto{Source: "web"}, audit.Actor{ID: "customer-1"})
+
+	// THEN the revocation should be presented
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), result.Granted)
+}
+
+func (suite *ConsentControllerTestSuite) Test_Grant_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the use case fails
+	expectedError := errors.New("transaction failed")
+	suite.mockRecordConsentUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN granting
+	result, err := suite.controller.Grant("customer-1", "sms", &dto.ConsentRequestDto{Source: "web", PolicyVersion: "1"}, audit.Actor{ID: "customer-1"})
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *ConsentControllerTestSuite) Test_ListOptedIn_ShouldPresentPage() {
+	// GIVEN a page of opted-in customers
+	page := &entities.OptedInPage{NextCursor: "next"}
+	expectedDto := &dto.OptedInResponseDto{Purpose: "sms", NextCursor: "next"}
+	suite.mockListOptedInUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListOptedInCommand) bool {
+			return cmd.Purpose == entities.PurposeSMS && cmd.Limit == 100 && cmd.Cursor == "cursor"
+		})).
+		Return(page, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentOptedIn(entities.PurposeSMS, page).Return(expectedDto).Once()
+
+	// WHEN exporting
+	result, err := suite.controller.ListOptedIn("sms", 100, "cursor")
+
+	// THEN the presented page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *ConsentControllerTestSuite) Test_ListHistory_ShouldPresentPage() {
+	// GIVEN a page of history
+	page := &entities.ConsentHistoryPage{}
+	expectedDto := &dto.ConsentHistoryResponseDto{}
+	suite.mockListConsentHistoryUseCase.EXPECT().Execute(mock.Anything).Return(page, nil).Once()
+	suite.mockPresenter.EXPECT().PresentHistory(page).Return(expectedDto).Once()
+
+	// WHEN listing the history
+	result, err := suite.controller.ListHistory("customer-1", 0, "")
+
+	// THEN the presented page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
@@ -0,0 +1,68 @@
+package entities
+
+import "time"
+
+// Purpose is what a customer consents to be contacted or profiled for.
+type Purpose string
+
+const (
+	PurposeMarketingEmail     Purpose = "marketing_email"
+	PurposeSMS                Purpose = "sms"
+	PurposePersonalizedOffers Purpose = "personalized_offers"
+)
+
+// Purposes lists every purpose a customer can consent to, in the order they are presented.
+var Purposes = []Purpose{PurposeMarketingEmail, PurposeSMS, PurposePersonalizedOffers}
+
+func (p Purpose) Valid() bool {
+	for _, purpose := range Purposes {
+		if p == purpose {
+			return true
+		}
+	}
+	return false
+}
+
+// Source is the channel through which the customer gave or withdrew consent.
+type Source string
+
+const (
+	SourceKiosk Source = "kiosk"
+	SourceApp   Source = "app"
+	SourceWeb   Source = "web"
+	SourceStaff Source = "staff"
+)
+
+func (s Source) Valid() bool {
+	switch s {
+	case SourceKiosk, SourceApp, SourceWeb, SourceStaff:
+		return true
+	}
+	return false
+}
+
+// ConsentRecord is one grant or revocation of consent. Records are never changed once written:
+// the current consent for a purpose is the latest record.
+type ConsentRecord struct {
+	ID            string  `json:"id" dynamodbav:"id"`
+	CustomerID    string  `json:"customer_id" dynamodbav:"customer_id"`
+	Purpose       Purpose `json:"purpose" dynamodbav:"purpose"`
+	Granted       bool    `json:"granted" dynamodbav:"granted"`
+	Source        Source  `json:"source" dynamodbav:"source"`
+	PolicyVersion string  `json:"policy_version" dynamodbav:"policy_version"`
+	// RecordedBy is the subject of the token that recorded the change.
+	RecordedBy string    `json:"recorded_by" dynamodbav:"recorded_by"`
+	RecordedAt time.Time `json:"recorded_at" dynamodbav:"recorded_at"`
+}
+
+// ConsentHistoryPage is one page of the consent history of a customer, newest first.
+type ConsentHistoryPage struct {
+	Records    []*ConsentRecord
+	NextCursor string
+}
+
+// OptedInPage is one page of the customers currently consenting to a purpose.
+type OptedInPage struct {
+	Consents   []*ConsentRecord
+	NextCursor string
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+type ConsentRepository interface {
+	// Record appends the record to the history and makes it the current consent for its purpose.
+	Record(record *entities.ConsentRecord) error
+	// GetCurrent returns the latest record of each purpose the customer has ever answered.
+	GetCurrent(customerID string) ([]*entities.ConsentRecord, error)
+	// ListHistory returns up to limit records of a customer, newest first, starting after cursor.
+	ListHistory(customerID string, limit int, cursor string) (*entities.ConsentHistoryPage, error)
+	// ListOptedIn returns up to limit current grants for a purpose, starting after cursor.
+	ListOptedIn(purpose entities.Purpose, limit int, cursor string) (*entities.OptedInPage, error)
+}
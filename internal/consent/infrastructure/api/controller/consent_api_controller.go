@@ -0,0 +1,259 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	consentController "github.com/viniciuscluna/tc-fiap-customer/internal/consent/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listoptedin"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	// Customers and guests may only see their own consents; the handler checks the token subject.
+	readConsentRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin, auth.RoleCustomer, auth.RoleGuest},
+		Scopes: []string{auth.ScopeCustomersRead},
+	}
+	// Customers and guests may only change their own consents; the handler checks the token subject.
+	writeConsentRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin, auth.RoleCustomer, auth.RoleGuest},
+		Scopes: []string{auth.ScopeCustomersWrite},
+	}
+	// The opted-in export feeds the marketing tools, so it is closed to kiosks and customers.
+	exportConsentRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+		Scopes: []string{auth.ScopeCustomersRead},
+	}
+)
+
+type consentApiController struct {
+	controller consentController.ConsentController
+}
+
+func NewConsentController(controller consentController.ConsentController) *consentApiController {
+	return &consentApiController{
+		controller: controller,
+	}
+}
+
+func (c *consentApiController) RegisterRoutes(r chi.Router) {
+	r.With(auth.Authorize(readConsentRule)).Get("/v1/customer/{id}/consents", c.GetConsents)
+	r.With(auth.Authorize(readConsentRule)).Get("/v1/customer/{id}/consents/history", c.ListHistory)
+	r.With(auth.Authorize(writeConsentRule)).Post("/v1/customer/{id}/consents/{purpose}/grant", c.Grant)
+	r.With(auth.Authorize(writeConsentRule)).Post("/v1/customer/{id}/consents/{purpose}/revoke", c.Revoke)
+	r.With(auth.Authorize(exportConsentRule)).Get("/v1/consents/{purpose}/opted-in", c.ListOptedIn)
+}
+
+// @Summary     Get customer consents
+// @Description Get the current consent of a customer for every purpose. Purposes never answered are not granted
+// @Tags        Consent
+// @Produce     json
+// @Param       id  path string true "Customer ID"
+// @Success     200 {object} dto.ConsentsResponseDto
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/consents [get]
+func (h *consentApiController) GetConsents(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnConsent(principal, customerID, auth.ScopeCustomersRead) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	consents, err := h.controller.GetConsents(customerID)
+
+	if err != nil {
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(consents)
+}
+
+// @Summary     List consent history
+// @Description List every consent grant and revocation of a customer, newest first
+// @Tags        Consent
+// @Produce     json
+// @Param       id     path  string true  "Customer ID"
+// @Param       limit  query int    false "Page size (default 20, max 100)"
+// @Param       cursor query string false "Cursor returned by the previous page"
+// @Success     200 {object} dto.ConsentHistoryResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/consents/history [get]
+func (h *consentApiController) ListHistory(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnConsent(principal, customerID, auth.ScopeCustomersRead) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	limit, ok := parseLimit(w, r)
+	if !ok {
+		return
+	}
+
+	history, err := h.controller.ListHistory(customerID, limit, r.URL.Query().Get("cursor"))
+
+	if err != nil {
+		writeConsentError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+// @Summary     Grant consent
+// @Description Record that the customer consents to a purpose (marketing_email, sms or personalized_offers)
+// @Tags        Consent
+// @Accept      json
+// @Produce     json
+// @Param       id      path string true "Customer ID"
+// @Param       purpose path string true "Consent purpose"
+// @Param       body    body dto.ConsentRequestDto true "Body"
+// @Success     201 {object} dto.ConsentRecordResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/consents/{purpose}/grant [post]
+func (h *consentApiController) Grant(w http.ResponseWriter, r *http.Request) {
+	h.record(w, r, h.controller.Grant)
+}
+
+// @Summary     Revoke consent
+// @Description Record that the customer withdrew consent to a purpose (marketing_email, sms or personalized_offers)
+// @Tags        Consent
+// @Accept      json
+// @Produce     json
+// @Param       id      path string true "Customer ID"
+// @Param       purpose path string true "Consent purpose"
+// @Param       body    body dto.ConsentRequestDto true "Body"
+// @Success     201 {object} dto.ConsentRecordResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/consents/{purpose}/revoke [post]
+func (h *consentApiController) Revoke(w http.ResponseWriter, r *http.Request) {
+	h.record(w, r, h.controller.Revoke)
+}
+
+// @Summary     Export opted-in customers
+// @Description List the customers currently consenting to a purpose, with the source and policy version of their grant
+// @Tags        Consent
+// @Produce     json
+// @Param       purpose path  string true  "Consent purpose"
+// @Param       limit   query int    false "Page size (default 500, max 1000)"
+// @Param       cursor  query string false "Cursor returned by the previous page"
+// @Success     200 {object} dto.OptedInResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/consents/{purpose}/opted-in [get]
+func (h *consentApiController) ListOptedIn(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimit(w, r)
+	if !ok {
+		return
+	}
+
+	customers, err := h.controller.ListOptedIn(chi.URLParam(r, "purpose"), limit, r.URL.Query().Get("cursor"))
+
+	if err != nil {
+		writeConsentError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(customers)
+}
+
+type recordFunc func(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error)
+
+func (h *consentApiController) record(w http.ResponseWriter, r *http.Request, record recordFunc) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnConsent(principal, customerID, auth.ScopeCustomersWrite) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	var consentRequest dto.ConsentRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&consentRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	consent, err := record(customerID, chi.URLParam(r, "purpose"), &consentRequest, audit.ActorFromRequest(r))
+
+	if err != nil {
+		writeConsentError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(consent)
+}
+
+func parseLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return 0, true
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 {
+		http.Error(w, `{"error":"Invalid limit parameter"}`, http.StatusBadRequest)
+		return 0, false
+	}
+	return limit, true
+}
+
+func writeConsentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, recordconsent.ErrInvalidConsent):
+		http.Error(w, `{"error":"A known purpose and source are required, and grants need a policy version"}`, http.StatusBadRequest)
+	case errors.Is(err, listoptedin.ErrUnknownPurpose):
+		http.Error(w, `{"error":"Unknown consent purpose"}`, http.StatusBadRequest)
+	case errors.Is(err, repositories.ErrInvalidCursor):
+		http.Error(w, `{"error":"Invalid cursor parameter"}`, http.StatusBadRequest)
+	default:
+		rest.WriteError(w, err)
+	}
+}
+
+// actsOnOwnConsent lets staff-like roles and services with the scope act on any customer while
+// session tokens may only act on the customer they were issued to.
+func actsOnOwnConsent(principal *auth.Principal, customerID string, scope string) bool {
+	if principal.HasRole(auth.RoleKiosk) || principal.HasRole(auth.RoleStaff) || principal.HasRole(auth.RoleAdmin) || principal.HasScope(scope) {
+		return true
+	}
+	return principal.Subject == customerID
+}
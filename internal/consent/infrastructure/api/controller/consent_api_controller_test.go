@@ -0,0 +1,190 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listoptedin"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type ConsentApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockConsentController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *ConsentApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockConsentController(suite.T())
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiController.NewConsentController(suite.mockController).RegisterRoutes(suite.router)
+}
+
+func TestConsentApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentApiControllerTestSuite))
+}
+
+func (suite *ConsentApiControllerTestSuite) request(method string, path string, body any) *httptest.ResponseRecorder {
+	var payload []byte
+	if body != nil {
+		payload, _ = json.Marshal(body)
+	}
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+// Feature: Consent REST API
+// Scenario: Customers manage their consents and marketing exports the opted-in customers
+
+func (suite *ConsentApiControllerTestSuite) Test_GetConsents_WithOwnSessionToken_ShouldReturnConsents() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+	suite.mockController.EXPECT().GetConsents("customer-1").
+		Return(&dto.ConsentsResponseDto{CustomerID: "customer-1", Consents: []dto.ConsentResponseDto{{Purpose: "sms", Granted: true}}}, nil).
+		Once()
+
+	// WHEN the customer reads their consents
+	w := suite.request(http.MethodGet, "/v1/customer/customer-1/consents", nil)
+
+	// THEN the consents should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.ConsentsResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.True(suite.T(), response.Consents[0].Granted)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_GetConsents_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer reads someone else's consents
+	w := suite.request(http.MethodGet, "/v1/customer/customer-1/consents", nil)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_Grant_ShouldRecordWithPrincipalSubject() {
+	// GIVEN a kiosk recording a grant
+	suite.mockController.EXPECT().
+		Grant("customer-1", "marketing_email", mock.MatchedBy(func(request *dto.ConsentRequestDto) bool {
+			return request.Source == "kiosk" && request.PolicyVersion == "2025-01"
+		}), audit.Actor{ID: "kiosk-1", Roles: []string{"kiosk"}}).
+		Return(&dto.ConsentRecordResponseDto{ID: "record-1", Granted: true}, nil).
+		Once()
+
+	// WHEN granting
+	w := suite.request(http.MethodPost, "/v1/customer/customer-1/consents/marketing_email/grant", dto.ConsentRequestDto{Source: "kiosk", PolicyVersion: "2025-01"})
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_Grant_WithInvalidConsent_ShouldReturnBadRequest() {
+	// GIVEN a grant without a policy version
+	suite.mockController.EXPECT().Grant("customer-1", "sms", mock.Anything, audit.Actor{ID: "kiosk-1", Roles: []string{"kiosk"}}).Return(nil, recordconsent.ErrInvalidConsent).Once()
+
+	// WHEN granting
+	w := suite.request(http.MethodPost, "/v1/customer/customer-1/consents/sms/grant", dto.ConsentRequestDto{Source: "kiosk"})
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_Revoke_WithOwnSessionToken_ShouldReturnCreated() {
+	// GIVEN a customer withdrawing consent
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+	suite.mockController.EXPECT().Revoke("customer-1", "sms", mock.Anything, audit.Actor{ID: "customer-1", Roles: []string{"customer"}}).Return(&dto.ConsentRecordResponseDto{ID: "record-2"}, nil).Once()
+
+	// WHEN revoking
+	w := suite.request(http.MethodPost, "/v1/customer/customer-1/consents/sms/revoke", dto.ConsentRequestDto{Source: "app"})
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_Revoke_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer revokes someone else's consent
+	w := suite.request(http.MethodPost, "/v1/customer/customer-1/consents/sms/revoke", dto.ConsentRequestDto{Source: "app"})
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_ListHistory_WithInvalidCursor_ShouldReturnBadRequest() {
+	// GIVEN a cursor that is not valid
+	suite.mockController.EXPECT().ListHistory("customer-1", 0, "bad").Return(nil, repositories.ErrInvalidCursor).Once()
+
+	// WHEN listing the history
+	w := suite.request(http.MethodGet, "/v1/customer/customer-1/consents/history?cursor=bad", nil)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_ListOptedIn_WithStaffToken_ShouldReturnCustomers() {
+	// GIVEN a staff member exporting SMS consents
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	suite.mockController.EXPECT().ListOptedIn("sms", 200, "").
+		Return(&dto.OptedInResponseDto{Purpose: "sms", Customers: []dto.OptedInCustomerResponseDto{{CustomerID: "customer-1"}}}, nil).
+		Once()
+
+	// WHEN exporting
+	w := suite.request(http.MethodGet, "/v1/consents/sms/opted-in?limit=200", nil)
+
+	// THEN the customers should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.OptedInResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), "customer-1", response.Customers[0].CustomerID)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_ListOptedIn_WithUnknownPurpose_ShouldReturnBadRequest() {
+	// GIVEN a purpose that does not exist
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	suite.mockController.EXPECT().ListOptedIn("newsletter", 0, "").Return(nil, listoptedin.ErrUnknownPurpose).Once()
+
+	// WHEN exporting it
+	w := suite.request(http.MethodGet, "/v1/consents/newsletter/opted-in", nil)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *ConsentApiControllerTestSuite) Test_ListOptedIn_WithKioskToken_ShouldReturnForbidden() {
+	// GIVEN a kiosk
+	// WHEN it tries to export consents
+	w := suite.request(http.MethodGet, "/v1/consents/sms/opted-in", nil)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,8 @@
+package dto
+
+type ConsentRequestDto struct {
+	// Source is the channel the customer used: kiosk, app, web or staff.
+	Source string `json:"source"`
+	// PolicyVersion is the version of the privacy policy shown to the customer, required on grants.
+	PolicyVersion string `json:"policy_version,omitempty"`
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+type ConsentsResponseDto struct {
+	CustomerID string               `json:"customer_id"`
+	Consents   []ConsentResponseDto `json:"consents"`
+}
+
+// ConsentResponseDto is the current consent for a purpose. Purposes never answered are not
+// granted and carry no source, policy version or date.
+type ConsentResponseDto struct {
+	Purpose       string     `json:"purpose"`
+	Granted       bool       `json:"granted"`
+	Source        string     `json:"source,omitempty"`
+	PolicyVersion string     `json:"policy_version,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
+type ConsentHistoryResponseDto struct {
+	Records    []ConsentRecordResponseDto `json:"records"`
+	NextCursor string                     `json:"next_cursor,omitempty"`
+}
+
+type ConsentRecordResponseDto struct {
+	ID            string    `json:"id"`
+	Purpose       string    `json:"purpose"`
+	Granted       bool      `json:"granted"`
+	Source        string    `json:"source"`
+	PolicyVersion string    `json:"policy_version,omitempty"`
+	RecordedBy    string    `json:"recorded_by,omitempty"`
+	RecordedAt    time.Time `json:"recorded_at"`
+}
+
+type OptedInResponseDto struct {
+	Purpose    string                       `json:"purpose"`
+	Customers  []OptedInCustomerResponseDto `json:"customers"`
+	NextCursor string                       `json:"next_cursor,omitempty"`
+}
+
+type OptedInCustomerResponseDto struct {
+	CustomerID    string    `json:"customer_id"`
+	Source        string    `json:"source"`
+	PolicyVersion string    `json:"policy_version"`
+	GrantedAt     time.Time `json:"granted_at"`
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"log"
+
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
+)
+
+var (
+	_ recordconsent.RecordConsentUseCase = (*AuditedRecordConsentUseCase)(nil)
+)
+
+// Actions recorded in the audit log for the consents.
+const (
+	ActionGrantConsent  = "consent.grant"
+	ActionRevokeConsent = "consent.revoke"
+)
+
+// AuditedRecordConsentUseCase records every grant and revocation with the purpose as the changed
+// field. Failing to record an entry is logged instead of failing the invocation.
+type AuditedRecordConsentUseCase struct {
+	next                    recordconsent.RecordConsentUseCase
+	recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase
+}
+
+func NewAuditedRecordConsentUseCase(next recordconsent.RecordConsentUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedRecordConsentUseCase {
+	return &AuditedRecordConsentUseCase{next: next, recordAuditEntryUseCase: recordAuditEntryUseCase}
+}
+
+func (u *AuditedRecordConsentUseCase) Execute(command *commands.RecordConsentCommand) (*entities.ConsentRecord, error) {
+	record, err := u.next.Execute(command)
+
+	action, value := ActionRevokeConsent, "revoked"
+	if command.Granted {
+		action, value = ActionGrantConsent, "granted"
+	}
+	changes := []*auditCommands.FieldChange{{Field: "consent." + string(command.Purpose), New: value}}
+	entry := auditCommands.NewRecordAuditEntryCommand(command.Actor, action, command.CustomerID, changes, err == nil)
+	if _, recordErr := u.recordAuditEntryUseCase.Execute(entry); recordErr != nil {
+		log.Printf("Warning: failed to record audit entry %s of customer %q by %s: %v\n", action, command.CustomerID, command.Actor.ID, recordErr)
+	}
+
+	return record, err
+}
@@ -0,0 +1,89 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockRecordConsent "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/usecase/recordconsent"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedRecordConsentUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockRecordConsent.MockRecordConsentUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedRecordConsentUseCase
+}
+
+func (suite *AuditedRecordConsentUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockRecordConsent.NewMockRecordConsentUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedRecordConsentUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedRecordConsentUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedRecordConsentUseCaseTestSuite))
+}
+
+// Feature: Consent Audit
+// Scenario: Every grant and revocation is recorded with its actor and purpose
+
+func (suite *AuditedRecordConsentUseCaseTestSuite) Test_Grant_ShouldRecordGrant() {
+	// GIVEN a kiosk recording a grant
+	command := commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, true, entities.SourceKiosk, "2025-01", "kiosk-1")
+	command.Actor = auditpkg.Actor{ID: "kiosk-1", Roles: []string{"kiosk"}, RequestID: "request-1"}
+	record := &entities.ConsentRecord{ID: "record-1"}
+	suite.mockNext.EXPECT().Execute(command).Return(record, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Actor.ID == "kiosk-1" && cmd.Action == audit.ActionGrantConsent && cmd.CustomerID == "customer-1" && cmd.Succeeded &&
+			len(cmd.Changes) == 1 && cmd.Changes[0].Field == "consent.sms" && cmd.Changes[0].New == "granted"
+	})).Return(nil, nil).Once()
+
+	// WHEN recording the grant
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the record should be returned and the grant recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), record, result)
+}
+
+func (suite *AuditedRecordConsentUseCaseTestSuite) Test_Revoke_WithError_ShouldRecordFailedRevocation() {
+	// GIVEN the revocation fails
+	expectedError := errors.New("transaction failed")
+	command := commands.NewRecordConsentCommand("customer-1", entities.PurposeMarketingEmail, false, entities.SourceApp, "", "customer-1")
+	suite.mockNext.EXPECT().Execute(command).Return(nil, expectedError).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Action == audit.ActionRevokeConsent && !cmd.Succeeded &&
+			cmd.Changes[0].Field == "consent.marketing_email" && cmd.Changes[0].New == "revoked"
+	})).Return(nil, nil).Once()
+
+	// WHEN recording the revocation
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failed revocation recorded
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *AuditedRecordConsentUseCaseTestSuite) Test_Grant_WithAuditFailure_ShouldStillReturnRecord() {
+	// GIVEN the audit table is unavailable
+	command := commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, true, entities.SourceWeb, "2025-01", "customer-1")
+	record := &entities.ConsentRecord{ID: "record-1"}
+	suite.mockNext.EXPECT().Execute(command).Return(record, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Return(nil, errors.New("dynamodb error")).Once()
+
+	// WHEN recording the grant
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the grant should not fail because of the audit log
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), record, result)
+}
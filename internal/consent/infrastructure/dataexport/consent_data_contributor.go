@@ -0,0 +1,57 @@
+package dataexport
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+const historyPageSize = 100
+
+var (
+	_ dataExportRepositories.DataContributor = (*ConsentDataContributor)(nil)
+)
+
+// ConsentData is the consent section of a data export: the consents in force and every grant
+// and revocation ever recorded, newest first.
+type ConsentData struct {
+	Current []*entities.ConsentRecord `json:"current"`
+	History []*entities.ConsentRecord `json:"history"`
+}
+
+type ConsentDataContributor struct {
+	consentRepository repositories.ConsentRepository
+}
+
+func NewConsentDataContributor(consentRepository repositories.ConsentRepository) *ConsentDataContributor {
+	return &ConsentDataContributor{consentRepository: consentRepository}
+}
+
+func (c *ConsentDataContributor) Section() string {
+	return "consents"
+}
+
+func (c *ConsentDataContributor) Title() string {
+	return "Consents"
+}
+
+func (c *ConsentDataContributor) Collect(customerID string) (any, error) {
+	current, err := c.consentRepository.GetCurrent(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &ConsentData{Current: current, History: []*entities.ConsentRecord{}}
+	cursor := ""
+	for {
+		page, err := c.consentRepository.ListHistory(customerID, historyPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		data.History = append(data.History, page.Records...)
+		if page.NextCursor == "" {
+			return data, nil
+		}
+		cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,43 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/dataexport"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/domain/repositories"
+)
+
+func TestConsentDataContributor_ShouldCollectWholeHistory(t *testing.T) {
+	repository := mockRepositories.NewMockConsentRepository(t)
+	current := []*entities.ConsentRecord{{ID: "consent-2", Purpose: entities.PurposeSMS, Granted: true}}
+	repository.EXPECT().GetCurrent("customer-1").Return(current, nil).Once()
+	repository.EXPECT().ListHistory("customer-1", 100, "").
+		Return(&entities.ConsentHistoryPage{Records: []*entities.ConsentRecord{{ID: "consent-2"}}, NextCursor: "next"}, nil).
+		Once()
+	repository.EXPECT().ListHistory("customer-1", 100, "next").
+		Return(&entities.ConsentHistoryPage{Records: []*entities.ConsentRecord{{ID: "consent-1"}}}, nil).
+		Once()
+
+	data, err := dataexport.NewConsentDataContributor(repository).Collect("customer-1")
+
+	assert.NoError(t, err)
+	consents := data.(*dataexport.ConsentData)
+	assert.Equal(t, current, consents.Current)
+	assert.Len(t, consents.History, 2)
+	assert.Equal(t, "consent-1", consents.History[1].ID)
+}
+
+func TestConsentDataContributor_WithHistoryError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockConsentRepository(t)
+	expectedError := errors.New("query failed")
+	repository.EXPECT().GetCurrent("customer-1").Return(nil, nil).Once()
+	repository.EXPECT().ListHistory("customer-1", 100, "").Return(nil, expectedError).Once()
+
+	data, err := dataexport.NewConsentDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,266 @@
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.ConsentRepository = (*ConsentRepositoryImpl)(nil)
+)
+
+const (
+	// The consent table keeps, per customer, the current record of each purpose and the
+	// history of every record, sorted chronologically.
+	currentPrefix = "CURRENT#"
+	historyPrefix = "HISTORY#"
+
+	// sortKeyLayout keeps a fixed width so history sort keys order chronologically.
+	sortKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+	conditionalCheckFailed = "ConditionalCheckFailed"
+)
+
+type historyRecord struct {
+	entities.ConsentRecord
+	SortKey string `dynamodbav:"sk"`
+}
+
+// currentRecord is the latest record of a purpose. OptedInPurpose is only set on grants, so
+// the opted-in index holds exactly the customers consenting to each purpose. RecordedKey is the
+// fixed-width time and ID of the record, as in the history sort key, so records compare
+// chronologically in a condition.
+type currentRecord struct {
+	entities.ConsentRecord
+	SortKey        string `dynamodbav:"sk"`
+	RecordedKey    string `dynamodbav:"recorded_key"`
+	OptedInPurpose string `dynamodbav:"opted_in_purpose,omitempty"`
+}
+
+type ConsentRepositoryImpl struct {
+	db dynamodbpkg.Client
+}
+
+func NewConsentRepositoryImpl(db dynamodbpkg.Client) *ConsentRepositoryImpl {
+	return &ConsentRepositoryImpl{db: db}
+}
+
+// Record writes the history item and the current item in one transaction, so the current
+// consent never disagrees with the history. The history item is never overwritten, and the
+// current item only by a later record: a record older than the current one, delivered late or
+// written concurrently, only joins the history.
+func (r *ConsentRepositoryImpl) Record(record *entities.ConsentRecord) error {
+	if record.ID == "" {
+		id, err := newRecordID()
+		if err != nil {
+			return err
+		}
+		record.ID = id
+	}
+	if record.RecordedAt.IsZero() {
+		record.RecordedAt = time.Now().UTC()
+	}
+
+	recordedKey := record.RecordedAt.UTC().Format(sortKeyLayout) + "#" + record.ID
+	historyItem, err := attributevalue.MarshalMap(historyRecord{
+		ConsentRecord: *record,
+		SortKey:       historyPrefix + recordedKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal consent record: %w", err)
+	}
+
+	current := currentRecord{ConsentRecord: *record, SortKey: currentPrefix + string(record.Purpose), RecordedKey: recordedKey}
+	if record.Granted {
+		current.OptedInPurpose = string(record.Purpose)
+	}
+	currentItem, err := attributevalue.MarshalMap(current)
+	if err != nil {
+		return fmt.Errorf("failed to marshal consent record: %w", err)
+	}
+
+	historyPut := &types.Put{
+		TableName:           aws.String(dynamodbpkg.ConsentTableName),
+		Item:                historyItem,
+		ConditionExpression: aws.String("attribute_not_exists(sk)"),
+	}
+	_, err = r.db.TransactWriteItems(context.Background(), &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{
+			{Put: historyPut},
+			{Put: &types.Put{
+				TableName: aws.String(dynamodbpkg.ConsentTableName),
+				Item:      currentItem,
+				// Current items written before recorded_key existed are replaced by any record.
+				ConditionExpression: aws.String("attribute_not_exists(recorded_key) OR recorded_key < :recorded_key"),
+				ExpressionAttributeValues: map[string]types.AttributeValue{
+					":recorded_key": &types.AttributeValueMemberS{Value: recordedKey},
+				},
+			}},
+		},
+	})
+	if err == nil {
+		return nil
+	}
+
+	var canceled *types.TransactionCanceledException
+	if !errors.As(err, &canceled) || cancellationReason(canceled, 0) == conditionalCheckFailed || cancellationReason(canceled, 1) != conditionalCheckFailed {
+		return fmt.Errorf("failed to record consent: %w", err)
+	}
+
+	// The current consent is more recent than this record, which only joins the history.
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName:           historyPut.TableName,
+		Item:                historyPut.Item,
+		ConditionExpression: historyPut.ConditionExpression,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record consent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ConsentRepositoryImpl) GetCurrent(customerID string) ([]*entities.ConsentRecord, error) {
+	result, err := r.db.Query(context.Background(), &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.ConsentTableName),
+		KeyConditionExpression: aws.String("customer_id = :customer_id AND begins_with(sk, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":customer_id": &types.AttributeValueMemberS{Value: customerID},
+			":prefix":      &types.AttributeValueMemberS{Value: currentPrefix},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query consents: %w", err)
+	}
+
+	var records []currentRecord
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal consents: %w", err)
+	}
+
+	consents := make([]*entities.ConsentRecord, 0, len(records))
+	for i := range records {
+		consents = append(consents, &records[i].ConsentRecord)
+	}
+	return consents, nil
+}
+
+func (r *ConsentRepositoryImpl) ListHistory(customerID string, limit int, cursor string) (*entities.ConsentHistoryPage, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.ConsentTableName),
+		KeyConditionExpression: aws.String("customer_id = :customer_id AND begins_with(sk, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":customer_id": &types.AttributeValueMemberS{Value: customerID},
+			":prefix":      &types.AttributeValueMemberS{Value: historyPrefix},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil || !strings.HasPrefix(string(decoded), historyPrefix) {
+			return nil, repositories.ErrInvalidCursor
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: string(decoded)},
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query consent history: %w", err)
+	}
+
+	var records []historyRecord
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal consent history: %w", err)
+	}
+
+	page := &entities.ConsentHistoryPage{Records: make([]*entities.ConsentRecord, 0, len(records))}
+	for i := range records {
+		page.Records = append(page.Records, &records[i].ConsentRecord)
+	}
+	if lastKey, ok := result.LastEvaluatedKey["sk"]; ok {
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(dynamodbpkg.StringValue(lastKey)))
+	}
+
+	return page, nil
+}
+
+func (r *ConsentRepositoryImpl) ListOptedIn(purpose entities.Purpose, limit int, cursor string) (*entities.OptedInPage, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.ConsentTableName),
+		IndexName:              aws.String(dynamodbpkg.ConsentOptedInIndexName),
+		KeyConditionExpression: aws.String("opted_in_purpose = :purpose"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":purpose": &types.AttributeValueMemberS{Value: string(purpose)},
+		},
+		Limit: aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		customerID, found := strings.CutSuffix(string(decoded), "\n"+currentPrefix+string(purpose))
+		if err != nil || !found || customerID == "" {
+			return nil, repositories.ErrInvalidCursor
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"opted_in_purpose": &types.AttributeValueMemberS{Value: string(purpose)},
+			"customer_id":      &types.AttributeValueMemberS{Value: customerID},
+			"sk":               &types.AttributeValueMemberS{Value: currentPrefix + string(purpose)},
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query opted-in customers: %w", err)
+	}
+
+	var records []currentRecord
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal opted-in customers: %w", err)
+	}
+
+	page := &entities.OptedInPage{Consents: make([]*entities.ConsentRecord, 0, len(records))}
+	for i := range records {
+		page.Consents = append(page.Consents, &records[i].ConsentRecord)
+	}
+	if len(result.LastEvaluatedKey) > 0 {
+		lastKey := dynamodbpkg.StringValue(result.LastEvaluatedKey["customer_id"]) + "\n" + dynamodbpkg.StringValue(result.LastEvaluatedKey["sk"])
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+	}
+
+	return page, nil
+}
+
+func cancellationReason(canceled *types.TransactionCanceledException, index int) string {
+	if index >= len(canceled.CancellationReasons) {
+		return ""
+	}
+	return aws.ToString(canceled.CancellationReasons[index].Code)
+}
+
+func newRecordID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate consent record id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
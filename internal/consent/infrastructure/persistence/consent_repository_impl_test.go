@@ -0,0 +1,249 @@
+package persistence_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/persistence"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// Mock DynamoDB Client
+type MockDynamoDBClient struct {
+	mock.Mock
+	dynamodbpkg.Client
+}
+
+func (m *MockDynamoDBClient) TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.TransactWriteItemsOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+type ConsentRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     *MockDynamoDBClient
+	repository *persistence.ConsentRepositoryImpl
+}
+
+func (suite *ConsentRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	suite.repository = persistence.NewConsentRepositoryImpl(suite.mockDB)
+}
+
+func TestConsentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentRepositoryTestSuite))
+}
+
+// Feature: Consent Persistence
+// Scenario: The current consent and the history are written together
+
+func (suite *ConsentRepositoryTestSuite) Test_Record_Grant_ShouldWriteHistoryAndIndexedCurrentItem() {
+	// GIVEN a grant
+	record := &entities.ConsentRecord{CustomerID: "customer-1", Purpose: entities.PurposeSMS, Granted: true, Source: entities.SourceKiosk, PolicyVersion: "2025-01"}
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		history := input.TransactItems[0].Put
+		current := input.TransactItems[1].Put
+		return len(input.TransactItems) == 2 &&
+			aws.ToString(history.ConditionExpression) == "attribute_not_exists(sk)" &&
+			len(dynamodbpkg.StringValue(history.Item["sk"])) > len("HISTORY#") &&
+			dynamodbpkg.StringValue(current.Item["sk"]) == "CURRENT#sms" &&
+			dynamodbpkg.StringValue(current.Item["opted_in_purpose"]) == "sms" &&
+			aws.ToString(current.ConditionExpression) == "attribute_not_exists(recorded_key) OR recorded_key < :recorded_key" &&
+			"HISTORY#"+dynamodbpkg.StringValue(current.ExpressionAttributeValues[":recorded_key"]) == dynamodbpkg.StringValue(history.Item["sk"])
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN recording it
+	err := suite.repository.Record(record)
+
+	// THEN both items should be written and the record completed
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), record.ID)
+	assert.False(suite.T(), record.RecordedAt.IsZero())
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_Record_Revocation_ShouldLeaveOptedInIndex() {
+	// GIVEN a revocation
+	record := &entities.ConsentRecord{CustomerID: "customer-1", Purpose: entities.PurposeSMS, Source: entities.SourceApp, RecordedAt: time.Now()}
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		_, indexed := input.TransactItems[1].Put.Item["opted_in_purpose"]
+		return !indexed
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN recording it
+	err := suite.repository.Record(record)
+
+	// THEN the current item should no longer be in the opted-in index
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_Record_OlderThanTheCurrentConsent_ShouldOnlyJoinTheHistory() {
+	// GIVEN a revocation recorded before the grant already stored as current
+	record := &entities.ConsentRecord{ID: "late", CustomerID: "customer-1", Purpose: entities.PurposeSMS, RecordedAt: time.Now().Add(-time.Minute)}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("None")}, {Code: aws.String("ConditionalCheckFailed")}},
+	}).Once()
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == "attribute_not_exists(sk)" &&
+			dynamodbpkg.StringValue(input.Item["sk"]) == "HISTORY#"+record.RecordedAt.UTC().Format("2006-01-02T15:04:05.000000Z")+"#late"
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN recording it
+	err := suite.repository.Record(record)
+
+	// THEN only the history item should be written, leaving the current grant
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_Record_WithDuplicateHistoryItem_ShouldReturnError() {
+	// GIVEN the history item already exists
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("ConditionalCheckFailed")}, {Code: aws.String("None")}},
+	}).Once()
+
+	// WHEN recording
+	err := suite.repository.Record(&entities.ConsentRecord{ID: "dup", CustomerID: "customer-1", Purpose: entities.PurposeSMS})
+
+	// THEN it should fail without writing anything else
+	assert.ErrorContains(suite.T(), err, "failed to record consent")
+	suite.mockDB.AssertNotCalled(suite.T(), "PutItem", mock.Anything)
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_Record_WithDatabaseError_ShouldReturnError() {
+	// GIVEN the transaction fails
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+	// WHEN recording
+	err := suite.repository.Record(&entities.ConsentRecord{CustomerID: "customer-1", Purpose: entities.PurposeSMS})
+
+	// THEN the error should be returned
+	assert.ErrorContains(suite.T(), err, "throttled")
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_GetCurrent_ShouldQueryCurrentItems() {
+	// GIVEN a customer with one current consent
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExpressionAttributeValues[":prefix"]) == "CURRENT#"
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: "CURRENT#sms"},
+			"purpose":     &types.AttributeValueMemberS{Value: "sms"},
+			"granted":     &types.AttributeValueMemberBOOL{Value: true},
+		}},
+	}, nil).Once()
+
+	// WHEN reading the current consents
+	consents, err := suite.repository.GetCurrent("customer-1")
+
+	// THEN the consent should be returned
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), consents, 1)
+	assert.Equal(suite.T(), entities.PurposeSMS, consents[0].Purpose)
+	assert.True(suite.T(), consents[0].Granted)
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_ListHistory_ShouldQueryNewestFirst() {
+	// GIVEN a history longer than the page
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExpressionAttributeValues[":prefix"]) == "HISTORY#" &&
+			!aws.ToBool(input.ScanIndexForward) &&
+			aws.ToInt32(input.Limit) == 1
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"id":      &types.AttributeValueMemberS{Value: "record-1"},
+			"purpose": &types.AttributeValueMemberS{Value: "sms"},
+		}},
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: "HISTORY#2025-01-01T00:00:00.000000Z#record-1"},
+		},
+	}, nil).Once()
+
+	// WHEN listing the first page
+	page, err := suite.repository.ListHistory("customer-1", 1, "")
+
+	// THEN the record and the next cursor should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "record-1", page.Records[0].ID)
+	assert.Equal(suite.T(), base64.RawURLEncoding.EncodeToString([]byte("HISTORY#2025-01-01T00:00:00.000000Z#record-1")), page.NextCursor)
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_ListHistory_WithInvalidCursor_ShouldReturnInvalidCursor() {
+	// GIVEN a cursor that does not point to a history record
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("CURRENT#sms"))
+
+	// WHEN listing
+	_, err := suite.repository.ListHistory("customer-1", 10, cursor)
+
+	// THEN the cursor should be rejected
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_ListOptedIn_ShouldQueryIndexAfterCursor() {
+	// GIVEN a cursor from a previous page
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("customer-1\nCURRENT#sms"))
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == "opted-in-index" &&
+			dynamodbpkg.StringValue(input.ExpressionAttributeValues[":purpose"]) == "sms" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["customer_id"]) == "customer-1" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["sk"]) == "CURRENT#sms"
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-2"},
+			"purpose":     &types.AttributeValueMemberS{Value: "sms"},
+			"granted":     &types.AttributeValueMemberBOOL{Value: true},
+		}},
+	}, nil).Once()
+
+	// WHEN listing the next page
+	page, err := suite.repository.ListOptedIn(entities.PurposeSMS, 100, cursor)
+
+	// THEN the customers of the last page should be returned without a cursor
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "customer-2", page.Consents[0].CustomerID)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *ConsentRepositoryTestSuite) Test_ListOptedIn_WithCursorOfOtherPurpose_ShouldReturnInvalidCursor() {
+	// GIVEN a cursor issued for another purpose
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("customer-1\nCURRENT#marketing_email"))
+
+	// WHEN listing SMS consents
+	_, err := suite.repository.ListOptedIn(entities.PurposeSMS, 100, cursor)
+
+	// THEN the cursor should be rejected
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+}
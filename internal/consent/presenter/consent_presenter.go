@@ -0,0 +1,13 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+)
+
+type ConsentPresenter interface {
+	PresentConsents(customerID string, consents []*entities.ConsentRecord) *dto.ConsentsResponseDto
+	PresentRecord(record *entities.ConsentRecord) *dto.ConsentRecordResponseDto
+	PresentHistory(page *entities.ConsentHistoryPage) *dto.ConsentHistoryResponseDto
+	PresentOptedIn(purpose entities.Purpose, page *entities.OptedInPage) *dto.OptedInResponseDto
+}
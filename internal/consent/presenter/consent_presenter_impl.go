@@ -0,0 +1,83 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+)
+
+var (
+	_ ConsentPresenter = (*ConsentPresenterImpl)(nil)
+)
+
+type ConsentPresenterImpl struct {
+}
+
+func NewConsentPresenterImpl() *ConsentPresenterImpl {
+	return &ConsentPresenterImpl{}
+}
+
+func (p *ConsentPresenterImpl) PresentConsents(customerID string, consents []*entities.ConsentRecord) *dto.ConsentsResponseDto {
+	response := &dto.ConsentsResponseDto{
+		CustomerID: customerID,
+		Consents:   make([]dto.ConsentResponseDto, 0, len(consents)),
+	}
+	for _, consent := range consents {
+		item := dto.ConsentResponseDto{
+			Purpose:       string(consent.Purpose),
+			Granted:       consent.Granted,
+			Source:        string(consent.Source),
+			PolicyVersion: consent.PolicyVersion,
+		}
+		if !consent.RecordedAt.IsZero() {
+			updatedAt := consent.RecordedAt
+			item.UpdatedAt = &updatedAt
+		}
+		response.Consents = append(response.Consents, item)
+	}
+	return response
+}
+
+func (p *ConsentPresenterImpl) PresentRecord(record *entities.ConsentRecord) *dto.ConsentRecordResponseDto {
+	response := presentRecord(record)
+	return &response
+}
+
+func (p *ConsentPresenterImpl) PresentHistory(page *entities.ConsentHistoryPage) *dto.ConsentHistoryResponseDto {
+	response := &dto.ConsentHistoryResponseDto{
+		Records:    make([]dto.ConsentRecordResponseDto, 0, len(page.Records)),
+		NextCursor: page.NextCursor,
+	}
+	for _, record := range page.Records {
+		response.Records = append(response.Records, presentRecord(record))
+	}
+	return response
+}
+
+func (p *ConsentPresenterImpl) PresentOptedIn(purpose entities.Purpose, page *entities.OptedInPage) *dto.OptedInResponseDto {
+	response := &dto.OptedInResponseDto{
+		Purpose:    string(purpose),
+		Customers:  make([]dto.OptedInCustomerResponseDto, 0, len(page.Consents)),
+		NextCursor: page.NextCursor,
+	}
+	for _, consent := range page.Consents {
+		response.Customers = append(response.Customers, dto.OptedInCustomerResponseDto{
+			CustomerID:    consent.CustomerID,
+			Source:        string(consent.Source),
+			PolicyVersion: consent.PolicyVersion,
+			GrantedAt:     consent.RecordedAt,
+		})
+	}
+	return response
+}
+
+func presentRecord(record *entities.ConsentRecord) dto.ConsentRecordResponseDto {
+	return dto.ConsentRecordResponseDto{
+		ID:            record.ID,
+		Purpose:       string(record.Purpose),
+		Granted:       record.Granted,
+		Source:        string(record.Source),
+		PolicyVersion: record.PolicyVersion,
+		RecordedBy:    record.RecordedBy,
+		RecordedAt:    record.RecordedAt,
+	}
+}
@@ -0,0 +1,89 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/presenter"
+)
+
+type ConsentPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.ConsentPresenter
+}
+
+func (suite *ConsentPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewConsentPresenterImpl()
+}
+
+func TestConsentPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentPresenterTestSuite))
+}
+
+// Feature: Consent Presentation
+// Scenario: Transform consents to the response DTOs
+
+func (suite *ConsentPresenterTestSuite) Test_PresentConsents_ShouldOmitDateOfUnansweredPurposes() {
+	// GIVEN one answered and one unanswered purpose
+	recordedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	consents := []*entities.ConsentRecord{
+		{Purpose: entities.PurposeMarketingEmail, Granted: true, Source: entities.SourceKiosk, PolicyVersion: "2025-01", RecordedAt: recordedAt},
+		{Purpose: entities.PurposeSMS},
+	}
+
+	// WHEN presenting them
+	result := suite.presenter.PresentConsents("customer-1", consents)
+
+	// THEN the answered purpose should carry its details and the other none
+	assert.Equal(suite.T(), "customer-1", result.CustomerID)
+	assert.Len(suite.T(), result.Consents, 2)
+	assert.True(suite.T(), result.Consents[0].Granted)
+	assert.Equal(suite.T(), "kiosk", result.Consents[0].Source)
+	assert.Equal(suite.T(), &recordedAt, result.Consents[0].UpdatedAt)
+	assert.False(suite.T(), result.Consents[1].Granted)
+	assert.Nil(suite.T(), result.Consents[1].UpdatedAt)
+}
+
+func (suite *ConsentPresenterTestSuite) Test_PresentHistory_ShouldMapRecordsAndCursor() {
+	// GIVEN a page of history
+	page := &entities.ConsentHistoryPage{
+		Records:    []*entities.ConsentRecord{{ID: "record-1", Purpose: entities.PurposeSMS, Source: entities.SourceApp, RecordedBy: "customer-1"}},
+		NextCursor: "next",
+	}
+
+	// WHEN presenting it
+	result := suite.presenter.PresentHistory(page)
+
+	// THEN the records should be mapped
+	assert.Equal(suite.T(), "next", result.NextCursor)
+	assert.Equal(suite.T(), "record-1", result.Records[0].ID)
+	assert.Equal(suite.T(), "sms", result.Records[0].Purpose)
+	assert.Equal(suite.T(), "customer-1", result.Records[0].RecordedBy)
+}
+
+func (suite *ConsentPresenterTestSuite) Test_PresentOptedIn_ShouldMapCustomers() {
+	// GIVEN a page of opted-in customers
+	grantedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	page := &entities.OptedInPage{Consents: []*entities.ConsentRecord{{CustomerID: "customer-1", Source: entities.SourceWeb, PolicyVersion: "2025-01", RecordedAt: grantedAt}}}
+
+	// WHEN presenting it
+	result := suite.presenter.PresentOptedIn(entities.PurposeSMS, page)
+
+	// THEN the customers should be mapped
+	assert.Equal(suite.T(), "sms", result.Purpose)
+	assert.Equal(suite.T(), "customer-1", result.Customers[0].CustomerID)
+	assert.Equal(suite.T(), grantedAt, result.Customers[0].GrantedAt)
+}
+
+func (suite *ConsentPresenterTestSuite) Test_PresentOptedIn_WithEmptyPage_ShouldReturnEmptyList() {
+	// GIVEN no opted-in customers
+	// WHEN presenting the page
+	result := suite.presenter.PresentOptedIn(entities.PurposeSMS, &entities.OptedInPage{})
+
+	// THEN an empty list should be returned instead of null
+	assert.NotNil(suite.T(), result.Customers)
+	assert.Empty(suite.T(), result.Customers)
+}
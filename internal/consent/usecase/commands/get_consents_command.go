@@ -0,0 +1,11 @@
+package commands
+
+type GetConsentsCommand struct {
+	CustomerID string
+}
+
+func NewGetConsentsCommand(customerID string) *GetConsentsCommand {
+	return &GetConsentsCommand{
+		CustomerID: customerID,
+	}
+}
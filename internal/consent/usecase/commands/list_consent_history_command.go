@@ -0,0 +1,15 @@
+package commands
+
+type ListConsentHistoryCommand struct {
+	CustomerID string
+	Limit      int
+	Cursor     string
+}
+
+func NewListConsentHistoryCommand(customerID string, limit int, cursor string) *ListConsentHistoryCommand {
+	return &ListConsentHistoryCommand{
+		CustomerID: customerID,
+		Limit:      limit,
+		Cursor:     cursor,
+	}
+}
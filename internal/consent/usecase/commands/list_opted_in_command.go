@@ -0,0 +1,17 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+
+type ListOptedInCommand struct {
+	Purpose entities.Purpose
+	Limit   int
+	Cursor  string
+}
+
+func NewListOptedInCommand(purpose entities.Purpose, limit int, cursor string) *ListOptedInCommand {
+	return &ListOptedInCommand{
+		Purpose: purpose,
+		Limit:   limit,
+		Cursor:  cursor,
+	}
+}
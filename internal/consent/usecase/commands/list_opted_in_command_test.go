@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+func TestNewListOptedInCommand(t *testing.T) {
+	// GIVEN a purpose and a page request
+	// WHEN creating a new ListOptedInCommand
+	command := commands.NewListOptedInCommand(entities.PurposeSMS, 500, "cursor")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, entities.PurposeSMS, command.Purpose)
+	assert.Equal(t, 500, command.Limit)
+	assert.Equal(t, "cursor", command.Cursor)
+}
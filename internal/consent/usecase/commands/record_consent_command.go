@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type RecordConsentCommand struct {
+	CustomerID    string
+	Purpose       entities.Purpose
+	Granted       bool
+	Source        entities.Source
+	PolicyVersion string
+	RecordedBy    string
+	Actor         audit.Actor
+}
+
+func NewRecordConsentCommand(customerID string, purpose entities.Purpose, granted bool, source entities.Source, policyVersion string, recordedBy string) *RecordConsentCommand {
+	return &RecordConsentCommand{
+		CustomerID:    customerID,
+		Purpose:       purpose,
+		Granted:       granted,
+		Source:        source,
+		PolicyVersion: policyVersion,
+		RecordedBy:    recordedBy,
+	}
+}
@@ -0,0 +1,24 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+func TestNewRecordConsentCommand(t *testing.T) {
+	// GIVEN a customer granting marketing emails at the kiosk
+	// WHEN creating a new RecordConsentCommand
+	command := commands.NewRecordConsentCommand("customer-1", entities.PurposeMarketingEmail, true, entities.SourceKiosk, "2025-01", "kiosk-1")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, entities.PurposeMarketingEmail, command.Purpose)
+	assert.True(t, command.Granted)
+	assert.Equal(t, entities.SourceKiosk, command.Source)
+	assert.Equal(t, "2025-01", command.PolicyVersion)
+	assert.Equal(t, "kiosk-1", command.RecordedBy)
+}
@@ -0,0 +1,10 @@
+package getconsents
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+type GetConsentsUseCase interface {
+	Execute(command *commands.GetConsentsCommand) ([]*entities.ConsentRecord, error)
+}
@@ -0,0 +1,43 @@
+package getconsents
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+var (
+	_ GetConsentsUseCase = (*GetConsentsUseCaseImpl)(nil)
+)
+
+type GetConsentsUseCaseImpl struct {
+	consentRepository repositories.ConsentRepository
+}
+
+func NewGetConsentsUseCaseImpl(consentRepository repositories.ConsentRepository) *GetConsentsUseCaseImpl {
+	return &GetConsentsUseCaseImpl{consentRepository: consentRepository}
+}
+
+// Execute returns the consent of every purpose. Purposes the customer never answered are
+// returned as not granted: consent is never assumed.
+func (u *GetConsentsUseCaseImpl) Execute(command *commands.GetConsentsCommand) ([]*entities.ConsentRecord, error) {
+	records, err := u.consentRepository.GetCurrent(command.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	byPurpose := make(map[entities.Purpose]*entities.ConsentRecord, len(records))
+	for _, record := range records {
+		byPurpose[record.Purpose] = record
+	}
+
+	consents := make([]*entities.ConsentRecord, 0, len(entities.Purposes))
+	for _, purpose := range entities.Purposes {
+		record, found := byPurpose[purpose]
+		if !found {
+			record = &entities.ConsentRecord{CustomerID: command.CustomerID, Purpose: purpose}
+		}
+		consents = append(consents, record)
+	}
+	return consents, nil
+}
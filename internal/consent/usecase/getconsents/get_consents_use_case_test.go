@@ -0,0 +1,63 @@
+package getconsents_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/getconsents"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/domain/repositories"
+)
+
+type GetConsentsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockConsentRepository
+	useCase        getconsents.GetConsentsUseCase
+}
+
+func (suite *GetConsentsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockConsentRepository(suite.T())
+	suite.useCase = getconsents.NewGetConsentsUseCaseImpl(suite.mockRepository)
+}
+
+func TestGetConsentsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetConsentsUseCaseTestSuite))
+}
+
+// Feature: Get Consents Use Case
+// Scenario: Every purpose is reported, answered or not
+
+func (suite *GetConsentsUseCaseTestSuite) Test_GetConsents_ShouldFillUnansweredPurposes() {
+	// GIVEN a customer who only answered the SMS purpose
+	sms := &entities.ConsentRecord{CustomerID: "customer-1", Purpose: entities.PurposeSMS, Granted: true, RecordedAt: time.Now()}
+	suite.mockRepository.EXPECT().GetCurrent("customer-1").Return([]*entities.ConsentRecord{sms}, nil).Once()
+
+	// WHEN executing the use case
+	consents, err := suite.useCase.Execute(commands.NewGetConsentsCommand("customer-1"))
+
+	// THEN every purpose should be returned, unanswered ones not granted
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), consents, 3)
+	assert.Equal(suite.T(), entities.PurposeMarketingEmail, consents[0].Purpose)
+	assert.False(suite.T(), consents[0].Granted)
+	assert.Equal(suite.T(), sms, consents[1])
+	assert.Equal(suite.T(), entities.PurposePersonalizedOffers, consents[2].Purpose)
+	assert.False(suite.T(), consents[2].Granted)
+}
+
+func (suite *GetConsentsUseCaseTestSuite) Test_GetConsents_WithRepositoryError_ShouldReturnError() {
+	// GIVEN the consents cannot be read
+	expectedError := errors.New("query failed")
+	suite.mockRepository.EXPECT().GetCurrent("customer-1").Return(nil, expectedError).Once()
+
+	// WHEN executing the use case
+	consents, err := suite.useCase.Execute(commands.NewGetConsentsCommand("customer-1"))
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), consents)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,10 @@
+package listconsenthistory
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+type ListConsentHistoryUseCase interface {
+	Execute(command *commands.ListConsentHistoryCommand) (*entities.ConsentHistoryPage, error)
+}
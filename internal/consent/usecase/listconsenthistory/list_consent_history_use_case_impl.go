@@ -0,0 +1,36 @@
+package listconsenthistory
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+var (
+	_ ListConsentHistoryUseCase = (*ListConsentHistoryUseCaseImpl)(nil)
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type ListConsentHistoryUseCaseImpl struct {
+	consentRepository repositories.ConsentRepository
+}
+
+func NewListConsentHistoryUseCaseImpl(consentRepository repositories.ConsentRepository) *ListConsentHistoryUseCaseImpl {
+	return &ListConsentHistoryUseCaseImpl{consentRepository: consentRepository}
+}
+
+func (u *ListConsentHistoryUseCaseImpl) Execute(command *commands.ListConsentHistoryCommand) (*entities.ConsentHistoryPage, error) {
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return u.consentRepository.ListHistory(command.CustomerID, limit, command.Cursor)
+}
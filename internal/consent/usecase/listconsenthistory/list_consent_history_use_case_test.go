@@ -0,0 +1,54 @@
+package listconsenthistory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listconsenthistory"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/domain/repositories"
+)
+
+type ListConsentHistoryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockConsentRepository
+	useCase        listconsenthistory.ListConsentHistoryUseCase
+}
+
+func (suite *ListConsentHistoryUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockConsentRepository(suite.T())
+	suite.useCase = listconsenthistory.NewListConsentHistoryUseCaseImpl(suite.mockRepository)
+}
+
+func TestListConsentHistoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListConsentHistoryUseCaseTestSuite))
+}
+
+// Feature: List Consent History Use Case
+// Scenario: Page sizes are bounded
+
+func (suite *ListConsentHistoryUseCaseTestSuite) Test_ListHistory_WithoutLimit_ShouldUseDefaultPageSize() {
+	// GIVEN a request without a limit
+	page := &entities.ConsentHistoryPage{}
+	suite.mockRepository.EXPECT().ListHistory("customer-1", listconsenthistory.DefaultPageSize, "").Return(page, nil).Once()
+
+	// WHEN executing the use case
+	result, err := suite.useCase.Execute(commands.NewListConsentHistoryCommand("customer-1", 0, ""))
+
+	// THEN the default page size should be used
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
+
+func (suite *ListConsentHistoryUseCaseTestSuite) Test_ListHistory_WithLargeLimit_ShouldCapPageSize() {
+	// GIVEN a request above the maximum page size
+	suite.mockRepository.EXPECT().ListHistory("customer-1", listconsenthistory.MaxPageSize, "cursor").Return(&entities.ConsentHistoryPage{}, nil).Once()
+
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewListConsentHistoryCommand("customer-1", 1000, "cursor"))
+
+	// THEN the page size should be capped
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,10 @@
+package listoptedin
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+type ListOptedInUseCase interface {
+	Execute(command *commands.ListOptedInCommand) (*entities.OptedInPage, error)
+}
@@ -0,0 +1,45 @@
+package listoptedin
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+var (
+	_ ListOptedInUseCase = (*ListOptedInUseCaseImpl)(nil)
+
+	ErrUnknownPurpose = errors.New("unknown consent purpose")
+)
+
+// Exports are read by batch jobs, so pages are larger than the ones served to customers.
+const (
+	DefaultPageSize = 500
+	MaxPageSize     = 1000
+)
+
+type ListOptedInUseCaseImpl struct {
+	consentRepository repositories.ConsentRepository
+}
+
+func NewListOptedInUseCaseImpl(consentRepository repositories.ConsentRepository) *ListOptedInUseCaseImpl {
+	return &ListOptedInUseCaseImpl{consentRepository: consentRepository}
+}
+
+func (u *ListOptedInUseCaseImpl) Execute(command *commands.ListOptedInCommand) (*entities.OptedInPage, error) {
+	if !command.Purpose.Valid() {
+		return nil, ErrUnknownPurpose
+	}
+
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return u.consentRepository.ListOptedIn(command.Purpose, limit, command.Cursor)
+}
@@ -0,0 +1,53 @@
+package listoptedin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/listoptedin"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/domain/repositories"
+)
+
+type ListOptedInUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockConsentRepository
+	useCase        listoptedin.ListOptedInUseCase
+}
+
+func (suite *ListOptedInUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockConsentRepository(suite.T())
+	suite.useCase = listoptedin.NewListOptedInUseCaseImpl(suite.mockRepository)
+}
+
+func TestListOptedInUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListOptedInUseCaseTestSuite))
+}
+
+// Feature: List Opted-In Use Case
+// Scenario: Marketing exports the customers consenting to a purpose
+
+func (suite *ListOptedInUseCaseTestSuite) Test_ListOptedIn_ShouldQueryPurposeWithBoundedPage() {
+	// GIVEN customers consenting to SMS
+	page := &entities.OptedInPage{Consents: []*entities.ConsentRecord{{CustomerID: "customer-1", Purpose: entities.PurposeSMS, Granted: true}}}
+	suite.mockRepository.EXPECT().ListOptedIn(entities.PurposeSMS, listoptedin.MaxPageSize, "").Return(page, nil).Once()
+
+	// WHEN exporting with a limit above the maximum
+	result, err := suite.useCase.Execute(commands.NewListOptedInCommand(entities.PurposeSMS, 5000, ""))
+
+	// THEN the page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
+
+func (suite *ListOptedInUseCaseTestSuite) Test_ListOptedIn_WithUnknownPurpose_ShouldReturnError() {
+	// GIVEN a purpose that does not exist
+	// WHEN exporting it
+	result, err := suite.useCase.Execute(commands.NewListOptedInCommand("newsletter", 0, ""))
+
+	// THEN the purpose should be rejected
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, listoptedin.ErrUnknownPurpose)
+}
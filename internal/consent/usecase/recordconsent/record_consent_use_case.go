@@ -0,0 +1,10 @@
+package recordconsent
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+type RecordConsentUseCase interface {
+	Execute(command *commands.RecordConsentCommand) (*entities.ConsentRecord, error)
+}
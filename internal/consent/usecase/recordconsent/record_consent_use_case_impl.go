@@ -0,0 +1,48 @@
+package recordconsent
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+)
+
+var (
+	_ RecordConsentUseCase = (*RecordConsentUseCaseImpl)(nil)
+
+	ErrInvalidConsent = errors.New("consent needs a known purpose and source, and grants need a policy version")
+)
+
+type RecordConsentUseCaseImpl struct {
+	consentRepository repositories.ConsentRepository
+}
+
+func NewRecordConsentUseCaseImpl(consentRepository repositories.ConsentRepository) *RecordConsentUseCaseImpl {
+	return &RecordConsentUseCaseImpl{consentRepository: consentRepository}
+}
+
+// Execute records a grant or a revocation. A grant must name the version of the privacy policy
+// the customer accepted; a revocation is valid whatever policy was in force.
+func (u *RecordConsentUseCaseImpl) Execute(command *commands.RecordConsentCommand) (*entities.ConsentRecord, error) {
+	if !command.Purpose.Valid() || !command.Source.Valid() {
+		return nil, ErrInvalidConsent
+	}
+	if command.Granted && command.PolicyVersion == "" {
+		return nil, ErrInvalidConsent
+	}
+
+	record := &entities.ConsentRecord{
+		CustomerID:    command.CustomerID,
+		Purpose:       command.Purpose,
+		Granted:       command.Granted,
+		Source:        command.Source,
+		PolicyVersion: command.PolicyVersion,
+		RecordedBy:    command.RecordedBy,
+	}
+
+	if err := u.consentRepository.Record(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,102 @@
+package recordconsent_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/recordconsent"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/consent/domain/repositories"
+)
+
+type RecordConsentUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockConsentRepository
+	useCase        recordconsent.RecordConsentUseCase
+}
+
+func (suite *RecordConsentUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockConsentRepository(suite.T())
+	suite.useCase = recordconsent.NewRecordConsentUseCaseImpl(suite.mockRepository)
+}
+
+func TestRecordConsentUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RecordConsentUseCaseTestSuite))
+}
+
+// Feature: Record Consent Use Case
+// Scenario: Customers grant and revoke consent per purpose
+
+func (suite *RecordConsentUseCaseTestSuite) Test_RecordConsent_Grant_ShouldRecordGrant() {
+	// GIVEN a customer accepting marketing emails at the kiosk
+	suite.mockRepository.EXPECT().
+		Record(mock.MatchedBy(func(record *entities.ConsentRecord) bool {
+			return record.CustomerID == "customer-1" &&
+				record.Purpose == entities.PurposeMarketingEmail &&
+				record.Granted &&
+				record.Source == entities.SourceKiosk &&
+				record.PolicyVersion == "2025-01" &&
+				record.RecordedBy == "kiosk-1"
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN executing the use case
+	record, err := suite.useCase.Execute(commands.NewRecordConsentCommand("customer-1", entities.PurposeMarketingEmail, true, entities.SourceKiosk, "2025-01", "kiosk-1"))
+
+	// THEN the grant should be returned
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), record.Granted)
+}
+
+func (suite *RecordConsentUseCaseTestSuite) Test_RecordConsent_RevokeWithoutPolicyVersion_ShouldRecordRevocation() {
+	// GIVEN a customer withdrawing SMS consent without knowing the policy version
+	suite.mockRepository.EXPECT().
+		Record(mock.MatchedBy(func(record *entities.ConsentRecord) bool {
+			return !record.Granted && record.Purpose == entities.PurposeSMS
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN executing the use case
+	record, err := suite.useCase.Execute(commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, false, entities.SourceApp, "", "customer-1"))
+
+	// THEN the revocation should be recorded
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), record.Granted)
+}
+
+func (suite *RecordConsentUseCaseTestSuite) Test_RecordConsent_WithInvalidCommand_ShouldNotRecord() {
+	// GIVEN commands missing a purpose, a source or the policy version of a grant
+	invalid := []*commands.RecordConsentCommand{
+		commands.NewRecordConsentCommand("customer-1", "newsletter", true, entities.SourceKiosk, "2025-01", "kiosk-1"),
+		commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, true, "fax", "2025-01", "kiosk-1"),
+		commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, true, entities.SourceKiosk, "", "kiosk-1"),
+	}
+
+	for _, command := range invalid {
+		// WHEN executing the use case
+		record, err := suite.useCase.Execute(command)
+
+		// THEN the command should be rejected
+		assert.Nil(suite.T(), record)
+		assert.ErrorIs(suite.T(), err, recordconsent.ErrInvalidConsent)
+	}
+}
+
+func (suite *RecordConsentUseCaseTestSuite) Test_RecordConsent_WithRepositoryError_ShouldReturnError() {
+	// GIVEN the record cannot be written
+	expectedError := errors.New("transaction failed")
+	suite.mockRepository.EXPECT().Record(mock.Anything).Return(expectedError).Once()
+
+	// WHEN executing the use case
+	record, err := suite.useCase.Execute(commands.NewRecordConsentCommand("customer-1", entities.PurposeSMS, true, entities.SourceWeb, "2025-01", "customer-1"))
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), record)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -1,8 +1,25 @@
 package controller
 
-import "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
 
 type CustomerController interface {
-	GetByCpf(cpf string) (*dto.GetCustomerResponseDto, error)
-	Add(customer *dto.AddCustomerRequestDto) error
+	GetByCpf(cpf string, actor audit.Actor) (*dto.GetCustomerResponseDto, error)
+	Add(customer *dto.AddCustomerRequestDto, actor audit.Actor) error
+	Identify(request *dto.IdentifyCustomerRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error)
+	AddGuest(request *dto.AddGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error)
+	ClaimGuest(customerID string, request *dto.ClaimGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error)
+	Update(customerID string, request *dto.UpdateCustomerRequestDto, actor audit.Actor) (*dto.GetCustomerResponseDto, error)
+	Erase(customerID string, actor audit.Actor) error
+	ListHistory(customerID string, limit int, cursor string, actor audit.Actor) (*dto.CustomerHistoryResponseDto, error)
+	GetAsOf(customerID string, asOf time.Time, actor audit.Actor) (*dto.CustomerRevisionResponseDto, error)
+	Import(rows []dto.ImportCustomerRowDto, actor audit.Actor) (*dto.ImportCustomersResponseDto, error)
+	Export(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor) (int, error)
+	// Reindex returns what was done even when it fails, as the customers already moved stay moved.
+	Reindex(segments int, actor audit.Actor) (*dto.ReindexCustomersResponseDto, error)
 }
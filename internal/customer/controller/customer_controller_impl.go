@@ -1,11 +1,26 @@
 package controller
 
 import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
 	customerPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/customer/presenter"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addCustomer"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/erasecustomer"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
 	getbycpf "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getbycpf"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/identify"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/listcustomerhistory"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/reindexcustomers"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/updatecustomer"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
 )
 
 var (
@@ -13,24 +28,59 @@ var (
 )
 
 type CustomerControllerImpl struct {
-	presenter          customerPresenter.CustomerPresenter
-	addCustomerUseCase addCustomer.AddCustomerUseCase
-	getByCpfUseCase    getbycpf.GetByCpfUseCase
+	presenter                  customerPresenter.CustomerPresenter
+	addCustomerUseCase         addCustomer.AddCustomerUseCase
+	getByCpfUseCase            getbycpf.GetByCpfUseCase
+	identifyCustomerUseCase    identify.IdentifyCustomerUseCase
+	addGuestUseCase            addguest.AddGuestUseCase
+	claimGuestUseCase          claimguest.ClaimGuestUseCase
+	updateCustomerUseCase      updatecustomer.UpdateCustomerUseCase
+	eraseCustomerUseCase       erasecustomer.EraseCustomerUseCase
+	listCustomerHistoryUseCase listcustomerhistory.ListCustomerHistoryUseCase
+	getCustomerAsOfUseCase     getcustomerasof.GetCustomerAsOfUseCase
+	importCustomersUseCase     importcustomers.ImportCustomersUseCase
+	exportCustomersUseCase     exportcustomers.ExportCustomersUseCase
+	reindexCustomersUseCase    reindexcustomers.ReindexCustomersUseCase
+	tokenIssuer                auth.TokenIssuer
 }
 
 func NewCustomerControllerImpl(
 	presenter customerPresenter.CustomerPresenter,
 	addCustomerUseCase addCustomer.AddCustomerUseCase,
-	getByCpfUseCase getbycpf.GetByCpfUseCase) *CustomerControllerImpl {
+	getByCpfUseCase getbycpf.GetByCpfUseCase,
+	identifyCustomerUseCase identify.IdentifyCustomerUseCase,
+	addGuestUseCase addguest.AddGuestUseCase,
+	claimGuestUseCase claimguest.ClaimGuestUseCase,
+	updateCustomerUseCase updatecustomer.UpdateCustomerUseCase,
+	eraseCustomerUseCase erasecustomer.EraseCustomerUseCase,
+	listCustomerHistoryUseCase listcustomerhistory.ListCustomerHistoryUseCase,
+	getCustomerAsOfUseCase getcustomerasof.GetCustomerAsOfUseCase,
+	importCustomersUseCase importcustomers.ImportCustomersUseCase,
+	exportCustomersUseCase exportcustomers.ExportCustomersUseCase,
+	reindexCustomersUseCase reindexcustomers.ReindexCustomersUseCase,
+	tokenIssuer auth.TokenIssuer) *CustomerControllerImpl {
 	return &CustomerControllerImpl{
-		presenter:          presenter,
-		addCustomerUseCase: addCustomerUseCase,
-		getByCpfUseCase:    getByCpfUseCase,
+		presenter:                  presenter,
+		addCustomerUseCase:         addCustomerUseCase,
+		getByCpfUseCase:            getByCpfUseCase,
+		identifyCustomerUseCase:    identifyCustomerUseCase,
+		addGuestUseCase:            addGuestUseCase,
+		claimGuestUseCase:          claimGuestUseCase,
+		updateCustomerUseCase:      updateCustomerUseCase,
+		eraseCustomerUseCase:       eraseCustomerUseCase,
+		listCustomerHistoryUseCase: listCustomerHistoryUseCase,
+		getCustomerAsOfUseCase:     getCustomerAsOfUseCase,
+		importCustomersUseCase:     importCustomersUseCase,
+		exportCustomersUseCase:     exportCustomersUseCase,
+		reindexCustomersUseCase:    reindexCustomersUseCase,
+		tokenIssuer:                tokenIssuer,
 	}
 }
 
-func (c *CustomerControllerImpl) GetByCpf(cpf string) (*dto.GetCustomerResponseDto, error) {
-	customer, err := c.getByCpfUseCase.Execute(commands.NewGetCustomerByCpfCommand(cpf))
+func (c *CustomerControllerImpl) GetByCpf(cpf string, actor audit.Actor) (*dto.GetCustomerResponseDto, error) {
+	command := commands.NewGetCustomerByCpfCommand(cpf)
+	command.Actor = actor
+	customer, err := c.getByCpfUseCase.Execute(command)
 	if err != nil {
 		return nil, err
 	}
@@ -38,11 +88,141 @@ func (c *CustomerControllerImpl) GetByCpf(cpf string) (*dto.GetCustomerResponseD
 	return c.presenter.Present(customer), nil
 }
 
-func (c *CustomerControllerImpl) Add(customer *dto.AddCustomerRequestDto) error {
+func (c *CustomerControllerImpl) Add(customer *dto.AddCustomerRequestDto, actor audit.Actor) error {
 	command := commands.NewAddCustomerCommand(customer.Name, customer.Email, customer.CPF)
+	command.Actor = actor
 	err := c.addCustomerUseCase.Execute(command)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+func (c *CustomerControllerImpl) Identify(request *dto.IdentifyCustomerRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	command := commands.NewIdentifyCustomerCommand(request.CPF, request.AutoRegister, request.Name, request.Email)
+	command.Actor = actor
+	customer, err := c.identifyCustomerUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presentSession(customer)
+}
+
+func (c *CustomerControllerImpl) AddGuest(request *dto.AddGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	command := commands.NewAddGuestCommand(request.Nickname)
+	command.Actor = actor
+	customer, err := c.addGuestUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presentSession(customer)
+}
+
+func (c *CustomerControllerImpl) ClaimGuest(customerID string, request *dto.ClaimGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	command := commands.NewClaimGuestCommand(customerID, request.CPF, request.Name, request.Email)
+	command.Actor = actor
+	customer, err := c.claimGuestUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presentSession(customer)
+}
+
+func (c *CustomerControllerImpl) Update(customerID string, request *dto.UpdateCustomerRequestDto, actor audit.Actor) (*dto.GetCustomerResponseDto, error) {
+	command := commands.NewUpdateCustomerCommand(customerID, request.Name, request.Email)
+	command.Actor = actor
+	customer, err := c.updateCustomerUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(customer), nil
+}
+
+func (c *CustomerControllerImpl) Erase(customerID string, actor audit.Actor) error {
+	command := commands.NewEraseCustomerCommand(customerID)
+	command.Actor = actor
+	return c.eraseCustomerUseCase.Execute(command)
+}
+
+func (c *CustomerControllerImpl) ListHistory(customerID string, limit int, cursor string, actor audit.Actor) (*dto.CustomerHistoryResponseDto, error) {
+	command := commands.NewListCustomerHistoryCommand(customerID, limit, cursor)
+	command.Actor = actor
+	page, err := c.listCustomerHistoryUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentHistory(page), nil
+}
+
+func (c *CustomerControllerImpl) GetAsOf(customerID string, asOf time.Time, actor audit.Actor) (*dto.CustomerRevisionResponseDto, error) {
+	command := commands.NewGetCustomerAsOfCommand(customerID, asOf)
+	command.Actor = actor
+	revision, err := c.getCustomerAsOfUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentRevision(revision), nil
+}
+
+func (c *CustomerControllerImpl) Import(rows []dto.ImportCustomerRowDto, actor audit.Actor) (*dto.ImportCustomersResponseDto, error) {
+	commandRows := make([]*commands.ImportCustomerRow, len(rows))
+	for i, row := range rows {
+		commandRows[i] = &commands.ImportCustomerRow{
+			Line:  row.Line,
+			Name:  row.Name,
+			Email: row.Email,
+			CPF:   row.CPF,
+			Error: row.Error,
+		}
+	}
+
+	command := commands.NewImportCustomersCommand(commandRows)
+	command.Actor = actor
+	report, err := c.importCustomersUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentImport(report), nil
+}
+
+func (c *CustomerControllerImpl) Export(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor) (int, error) {
+	command := commands.NewExportCustomersCommand(segments, maskPII)
+	command.Actor = actor
+	return c.exportCustomersUseCase.Execute(command, writer)
+}
+
+func (c *CustomerControllerImpl) Reindex(segments int, actor audit.Actor) (*dto.ReindexCustomersResponseDto, error) {
+	command := commands.NewReindexCustomersCommand(segments)
+	command.Actor = actor
+	report, err := c.reindexCustomersUseCase.Execute(command)
+	if report == nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentReindex(report), err
+}
+
+// presentSession issues a session token for the customer, scoped to guests until they are claimed.
+func (c *CustomerControllerImpl) presentSession(customer *entities.Customer) (*dto.CustomerSessionResponseDto, error) {
+	role := auth.RoleCustomer
+	if customer.Guest {
+		role = auth.RoleGuest
+	}
+
+	token, expiresAt, err := c.tokenIssuer.Issue(customer.ID, map[string]any{
+		"customer_id": customer.ID,
+		"roles":       []string{string(role)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentSession(customer, token, expiresAt), nil
+}
@@ -11,9 +11,22 @@ import (
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/controller"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
 	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/presenter"
 	mockAddCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/addCustomer"
+	mockAddGuest "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/addguest"
+	mockClaimGuest "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/claimguest"
+	mockEraseCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/erasecustomer"
+	mockExportCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/exportcustomers"
 	mockGetByCpf "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/getbycpf"
+	mockGetCustomerAsOf "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/getcustomerasof"
+	mockIdentify "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/identify"
+	mockImportCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/importcustomers"
+	mockListCustomerHistory "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/listcustomerhistory"
+	mockReindexCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/reindexcustomers"
+	mockUpdateCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/updatecustomer"
+	mockAuth "github.com/viniciuscluna/tc-fiap-customer/mocks/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
 )
 
 type CustomerControllerTestSuite struct {
@@ -21,6 +34,17 @@ type CustomerControllerTestSuite struct {
 	mockPresenter          *mockPresenter.MockCustomerPresenter
 	mockAddCustomerUseCase *mockAddCustomer.MockAddCustomerUseCase
 	mockGetByCpfUseCase    *mockGetByCpf.MockGetByCpfUseCase
+	mockIdentifyUseCase    *mockIdentify.MockIdentifyCustomerUseCase
+	mockAddGuestUseCase    *mockAddGuest.MockAddGuestUseCase
+	mockClaimGuestUseCase  *mockClaimGuest.MockClaimGuestUseCase
+	mockUpdateUseCase      *mockUpdateCustomer.MockUpdateCustomerUseCase
+	mockEraseUseCase       *mockEraseCustomer.MockEraseCustomerUseCase
+	mockHistoryUseCase     *mockListCustomerHistory.MockListCustomerHistoryUseCase
+	mockAsOfUseCase        *mockGetCustomerAsOf.MockGetCustomerAsOfUseCase
+	mockImportUseCase      *mockImportCustomers.MockImportCustomersUseCase
+	mockExportUseCase      *mockExportCustomers.MockExportCustomersUseCase
+	mockReindexUseCase     *mockReindexCustomers.MockReindexCustomersUseCase
+	mockTokenIssuer        *mockAuth.MockTokenIssuer
 	controller             controller.CustomerController
 }
 
@@ -28,11 +52,33 @@ func (suite *CustomerControllerTestSuite) SetupTest() {
 	suite.mockPresenter = mockPresenter.NewMockCustomerPresenter(suite.T())
 	suite.mockAddCustomerUseCase = mockAddCustomer.NewMockAddCustomerUseCase(suite.T())
 	suite.mockGetByCpfUseCase = mockGetByCpf.NewMockGetByCpfUseCase(suite.T())
+	suite.mockIdentifyUseCase = mockIdentify.NewMockIdentifyCustomerUseCase(suite.T())
+	suite.mockAddGuestUseCase = mockAddGuest.NewMockAddGuestUseCase(suite.T())
+	suite.mockClaimGuestUseCase = mockClaimGuest.NewMockClaimGuestUseCase(suite.T())
+	suite.mockUpdateUseCase = mockUpdateCustomer.NewMockUpdateCustomerUseCase(suite.T())
+	suite.mockEraseUseCase = mockEraseCustomer.NewMockEraseCustomerUseCase(suite.T())
+	suite.mockHistoryUseCase = mockListCustomerHistory.NewMockListCustomerHistoryUseCase(suite.T())
+	suite.mockAsOfUseCase = mockGetCustomerAsOf.NewMockGetCustomerAsOfUseCase(suite.T())
+	suite.mockImportUseCase = mockImportCustomers.NewMockImportCustomersUseCase(suite.T())
+	suite.mockExportUseCase = mockExportCustomers.NewMockExportCustomersUseCase(suite.T())
+	suite.mockReindexUseCase = mockReindexCustomers.NewMockReindexCustomersUseCase(suite.T())
+	suite.mockTokenIssuer = mockAuth.NewMockTokenIssuer(suite.T())
 
 	suite.controller = controller.NewCustomerControllerImpl(
 		suite.mockPresenter,
 		suite.mockAddCustomerUseCase,
 		suite.mockGetByCpfUseCase,
+		suite.mockIdentifyUseCase,
+		suite.mockAddGuestUseCase,
+		suite.mockClaimGuestUseCase,
+		suite.mockUpdateUseCase,
+		suite.mockEraseUseCase,
+		suite.mockHistoryUseCase,
+		suite.mockAsOfUseCase,
+		suite.mockImportUseCase,
+		suite.mockExportUseCase,
+		suite.mockReindexUseCase,
+		suite.mockTokenIssuer,
 	)
 }
 
@@ -75,7 +121,7 @@ func (suite *CustomerControllerTestSuite) Test_CustomerRetrieval_WithValidCPF_Sh
 		Once()
 
 	// WHEN the controller retrieves and presents the customer
-	result, err := suite.controller.GetByCpf(cpf)
+	result, err := suite.controller.GetByCpf(cpf, audit.System())
 
 	// THEN the customer should be returned without errors
 	assert.NoError(suite.T(), err)
@@ -101,7 +147,7 @@ func (suite *CustomerControllerTestSuite) Test_CustomerRetrieval_WithNonExistent
 		Once()
 
 	// WHEN the controller attempts to retrieve the customer
-	result, err := suite.controller.GetByCpf(cpf)
+	result, err := suite.controller.GetByCpf(cpf, audit.System())
 
 	// THEN an error should be returned
 	assert.Error(suite.T(), err)
@@ -130,7 +176,7 @@ func (suite *CustomerControllerTestSuite) Test_CustomerRegistration_WithValidReq
 		Once()
 
 	// WHEN the controller processes the customer registration
-	err := suite.controller.Add(requestDto)
+	err := suite.controller.Add(requestDto, audit.System())
 
 	// THEN the operation should complete without errors
 	assert.NoError(suite.T(), err)
@@ -155,7 +201,7 @@ func (suite *CustomerControllerTestSuite) Test_CustomerRegistration_WithUseCaseF
 		Once()
 
 	// WHEN the controller processes the registration
-	err := suite.controller.Add(requestDto)
+	err := suite.controller.Add(requestDto, audit.System())
 
 	// THEN an error should be returned
 	assert.Error(suite.T(), err)
@@ -164,3 +210,371 @@ func (suite *CustomerControllerTestSuite) Test_CustomerRegistration_WithUseCaseF
 	// AND the use case should have been called
 	suite.mockAddCustomerUseCase.AssertExpectations(suite.T())
 }
+
+// Feature: Customer Controller - Identify Customer
+// Scenario: Identify a customer and issue a session token
+
+func (suite *CustomerControllerTestSuite) Test_CustomerIdentification_WithKnownCPF_ShouldIssueSessionToken() {
+	// GIVEN an identification request for an existing customer
+	requestDto := &dto.IdentifyCustomerRequestDto{CPF: "12345678901"}
+	customerEntity := &entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "John Doe"}
+	expiresAt := time.Now().Add(15 * time.Minute)
+	expectedDto := &dto.CustomerSessionResponseDto{AccessToken: "signed-token", TokenType: "Bearer", ExpiresAt: expiresAt}
+
+	suite.mockIdentifyUseCase.EXPECT().
+		Execute(mock.Anything).
+		Return(customerEntity, nil).
+		Once()
+
+	suite.mockTokenIssuer.EXPECT().
+		Issue("customer-1", mock.Anything).
+		Return("signed-token", expiresAt, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentSession(customerEntity, "signed-token", expiresAt).
+		Return(expectedDto).
+		Once()
+
+	// WHEN the controller identifies the customer
+	result, err := suite.controller.Identify(requestDto, audit.System())
+
+	// THEN the presented session should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerIdentification_WithUseCaseFailure_ShouldNotIssueToken() {
+	// GIVEN the identification fails
+	expectedError := errors.New("customer not found")
+
+	suite.mockIdentifyUseCase.EXPECT().
+		Execute(mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	// WHEN the controller identifies the customer
+	result, err := suite.controller.Identify(&dto.IdentifyCustomerRequestDto{CPF: "99999999999"}, audit.System())
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+	// AND no token should have been issued
+	suite.mockTokenIssuer.AssertNotCalled(suite.T(), "Issue", mock.Anything, mock.Anything)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerIdentification_WithSigningFailure_ShouldReturnError() {
+	// GIVEN the token cannot be signed
+	customerEntity := &entities.Customer{ID: "customer-1"}
+	expectedError := errors.New("failed to sign token")
+
+	suite.mockIdentifyUseCase.EXPECT().
+		Execute(mock.Anything).
+		Return(customerEntity, nil).
+		Once()
+
+	suite.mockTokenIssuer.EXPECT().
+		Issue("customer-1", mock.Anything).
+		Return("", time.Time{}, expectedError).
+		Once()
+
+	// WHEN the controller identifies the customer
+	result, err := suite.controller.Identify(&dto.IdentifyCustomerRequestDto{CPF: "12345678901"}, audit.System())
+
+	// THEN the signing error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
+
+// Feature: Customer Controller - Guest Customers
+// Scenario: Create and claim anonymous guests
+
+func (suite *CustomerControllerTestSuite) Test_GuestCreation_ShouldIssueGuestSessionToken() {
+	// GIVEN a guest creation request
+	guest := &entities.Customer{ID: "guest-1", Guest: true, Nickname: "Johnny"}
+	expiresAt := time.Now().Add(15 * time.Minute)
+	expectedDto := &dto.CustomerSessionResponseDto{AccessToken: "guest-token"}
+
+	suite.mockAddGuestUseCase.EXPECT().
+		Execute(mock.Anything).
+		Return(guest, nil).
+		Once()
+
+	suite.mockTokenIssuer.EXPECT().
+		Issue("guest-1", mock.MatchedBy(func(claims map[string]any) bool {
+			return assert.ObjectsAreEqual([]string{"guest"}, claims["roles"])
+		})).
+		Return("guest-token", expiresAt, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentSession(guest, "guest-token", expiresAt).
+		Return(expectedDto).
+		Once()
+
+	// WHEN the controller creates the guest
+	result, err := suite.controller.AddGuest(&dto.AddGuestRequestDto{Nickname: "Johnny"}, audit.System())
+
+	// THEN a guest-scoped session should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_GuestClaim_ShouldIssueCustomerSessionToken() {
+	// GIVEN a claim request for an existing guest
+	claimed := &entities.Customer{ID: "guest-1", CPF: "12345678901", Name: "John Doe"}
+	expiresAt := time.Now().Add(15 * time.Minute)
+	expectedDto := &dto.CustomerSessionResponseDto{AccessToken: "customer-token"}
+	actor := audit.Actor{ID: "guest-1", Roles: []string{"guest"}}
+	expectedCommand := commands.NewClaimGuestCommand("guest-1", "12345678901", "John Doe", "")
+	expectedCommand.Actor = actor
+
+	suite.mockClaimGuestUseCase.EXPECT().
+		Execute(expectedCommand).
+		Return(claimed, nil).
+		Once()
+
+	suite.mockTokenIssuer.EXPECT().
+		Issue("guest-1", mock.MatchedBy(func(claims map[string]any) bool {
+			return assert.ObjectsAreEqual([]string{"customer"}, claims["roles"])
+		})).
+		Return("customer-token", expiresAt, nil).
+		Once()
+
+	suite.mockPresenter.EXPECT().
+		PresentSession(claimed, "customer-token", expiresAt).
+		Return(expectedDto).
+		Once()
+
+	// WHEN the controller claims the guest
+	result, err := suite.controller.ClaimGuest("guest-1", &dto.ClaimGuestRequestDto{CPF: "12345678901", Name: "John Doe"}, actor)
+
+	// THEN a customer session should be returned for the same ID
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_GuestClaim_WithUseCaseFailure_ShouldReturnError() {
+	// GIVEN the claim fails
+	expectedError := errors.New("customer already exists")
+
+	suite.mockClaimGuestUseCase.EXPECT().
+		Execute(mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	// WHEN the controller claims the guest
+	result, err := suite.controller.ClaimGuest("guest-1", &dto.ClaimGuestRequestDto{CPF: "12345678901"}, audit.System())
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
+
+// Feature: Customer Controller - Update and Erase
+// Scenario: Change contact data and forget a customer
+
+func (suite *CustomerControllerTestSuite) Test_CustomerUpdate_ShouldPresentUpdatedCustomer() {
+	// GIVEN a new email for a customer
+	request := &dto.UpdateCustomerRequestDto{Email: "doe@example.com"}
+	updated := &entities.Customer{ID: "customer-1", CPF: "12345678901", Email: "doe@example.com"}
+	expectedDto := &dto.GetCustomerResponseDto{ID: "customer-1", CPF: "12345678901", Email: "doe@example.com"}
+
+	suite.mockUpdateUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.UpdateCustomerCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Email == "doe@example.com" && cmd.Actor.ID == "staff-1"
+		})).
+		Return(updated, nil).
+		Once()
+	suite.mockPresenter.EXPECT().Present(updated).Return(expectedDto).Once()
+
+	// WHEN updating the customer
+	result, err := suite.controller.Update("customer-1", request, audit.Actor{ID: "staff-1", Roles: []string{"staff"}})
+
+	// THEN the updated customer should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerUpdate_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the use case fails
+	expectedError := errors.New("update failed")
+	suite.mockUpdateUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN updating the customer
+	result, err := suite.controller.Update("customer-1", &dto.UpdateCustomerRequestDto{}, audit.System())
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerErasure_ShouldCallUseCase() {
+	// GIVEN a customer ID
+	suite.mockEraseUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.EraseCustomerCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Actor.ID == audit.SystemActorID
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN erasing the customer
+	err := suite.controller.Erase("customer-1", audit.System())
+
+	// THEN no error should be returned
+	assert.NoError(suite.T(), err)
+}
+
+// Feature: Customer Controller - History
+// Scenario: Support staff list the revisions of a customer and rebuild a past profile
+
+func (suite *CustomerControllerTestSuite) Test_CustomerHistory_ShouldPresentPage() {
+	// GIVEN a page of revisions
+	page := &entities.CustomerHistoryPage{Revisions: []*entities.CustomerRevision{{ID: "rev-1"}}}
+	expectedDto := &dto.CustomerHistoryResponseDto{Revisions: []dto.CustomerRevisionResponseDto{{ID: "rev-1"}}}
+	suite.mockHistoryUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListCustomerHistoryCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Limit == 10 && cmd.Cursor == "cursor" && cmd.Actor.ID == "staff-1"
+		})).
+		Return(page, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentHistory(page).Return(expectedDto).Once()
+
+	// WHEN listing the history
+	result, err := suite.controller.ListHistory("customer-1", 10, "cursor", audit.Actor{ID: "staff-1"})
+
+	// THEN the page should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerAsOf_ShouldPresentRevision() {
+	// GIVEN the revision current at a date
+	asOf := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	revision := &entities.CustomerRevision{ID: "rev-1", Customer: &entities.Customer{ID: "customer-1"}}
+	expectedDto := &dto.CustomerRevisionResponseDto{ID: "rev-1"}
+	suite.mockAsOfUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.GetCustomerAsOfCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.AsOf.Equal(asOf) && cmd.Actor.ID == "staff-1"
+		})).
+		Return(revision, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentRevision(revision).Return(expectedDto).Once()
+
+	// WHEN rebuilding the profile as of the date
+	result, err := suite.controller.GetAsOf("customer-1", asOf, audit.Actor{ID: "staff-1"})
+
+	// THEN the revision should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerAsOf_WithUseCaseError_ShouldReturnError() {
+	// GIVEN no revision existed at the date
+	suite.mockAsOfUseCase.EXPECT().Execute(mock.Anything).Return(nil, errors.New("not found")).Once()
+
+	// WHEN rebuilding the profile
+	result, err := suite.controller.GetAsOf("customer-1", time.Now(), audit.System())
+
+	// THEN the error should be returned without presenting
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+// Feature: Customer Controller - Import Customers
+// Scenario: Rows of an import file are imported and the report presented
+
+func (suite *CustomerControllerTestSuite) Test_CustomerImport_ShouldPresentReport() {
+	// GIVEN a parsed import file
+	rows := []dto.ImportCustomerRowDto{{Line: 2, Name: "Jane Doe", CPF: "52998224725"}, {Line: 3, Error: "invalid JSON"}}
+	report := &entities.ImportReport{Created: 1, Invalid: 1}
+	expectedDto := &dto.ImportCustomersResponseDto{Created: 1, Invalid: 1}
+	suite.mockImportUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ImportCustomersCommand) bool {
+			return len(cmd.Rows) == 2 &&
+				*cmd.Rows[0] == commands.ImportCustomerRow{Line: 2, Name: "Jane Doe", CPF: "52998224725"} &&
+				cmd.Rows[1].Error == "invalid JSON" &&
+				cmd.Actor.ID == "staff-1"
+		})).
+		Return(report, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentImport(report).Return(expectedDto).Once()
+
+	// WHEN importing the rows
+	result, err := suite.controller.Import(rows, audit.Actor{ID: "staff-1"})
+
+	// THEN the report should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerImport_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the file is over the row limit
+	suite.mockImportUseCase.EXPECT().Execute(mock.Anything).Return(nil, errors.New("too many rows")).Once()
+
+	// WHEN importing the rows
+	result, err := suite.controller.Import(nil, audit.Actor{})
+
+	// THEN the error should be returned
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+// Feature: Customer Controller - Export Customers
+// Scenario: The customer base is streamed to a writer
+
+func (suite *CustomerControllerTestSuite) Test_CustomerExport_ShouldPassOptionsAndWriter() {
+	// GIVEN a writer for the export
+	writer := mockExportCustomers.NewMockCustomerWriter(suite.T())
+	suite.mockExportUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ExportCustomersCommand) bool {
+			return cmd.Segments == 8 && cmd.MaskPII && cmd.Actor.ID == "analytics"
+		}), writer).
+		Return(42, nil).
+		Once()
+
+	// WHEN exporting the customers
+	count, err := suite.controller.Export(writer, 8, true, audit.Actor{ID: "analytics"})
+
+	// THEN the number of exported customers should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 42, count)
+}
+
+// Feature: Customer Controller - Reindex Customers
+// Scenario: Stored customers are moved to the current encryption key
+
+func (suite *CustomerControllerTestSuite) Test_CustomerReindex_ShouldPresentReport() {
+	// GIVEN a reindex moving one customer
+	report := &entities.ReindexReport{Scanned: 2, Encrypted: 1}
+	expectedDto := &dto.ReindexCustomersResponseDto{Scanned: 2, Encrypted: 1, Conflicts: []string{}}
+	suite.mockReindexUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ReindexCustomersCommand) bool {
+			return cmd.Segments == 8 && cmd.Actor.ID == "system"
+		})).
+		Return(report, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentReindex(report).Return(expectedDto).Once()
+
+	// WHEN reindexing
+	result, err := suite.controller.Reindex(8, audit.Actor{ID: "system"})
+
+	// THEN the report should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *CustomerControllerTestSuite) Test_CustomerReindex_WithFailedSegment_ShouldPresentReportAndError() {
+	// GIVEN a segment fails after the others moved customers
+	report := &entities.ReindexReport{Scanned: 5, Encrypted: 5}
+	expectedDto := &dto.ReindexCustomersResponseDto{Scanned: 5, Encrypted: 5, Conflicts: []string{}}
+	suite.mockReindexUseCase.EXPECT().Execute(mock.Anything).Return(report, errors.New("throttled")).Once()
+	suite.mockPresenter.EXPECT().PresentReindex(report).Return(expectedDto).Once()
+
+	// WHEN reindexing
+	result, err := suite.controller.Reindex(0, audit.Actor{})
+
+	// THEN both the error and what was done should be returned
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
@@ -8,4 +8,10 @@ type Customer struct {
 	Name      string    `json:"name" dynamodbav:"name"`
 	Email     string    `json:"email" dynamodbav:"email"`
 	CreatedAt time.Time `json:"created_at" dynamodbav:"created_at"`
+	// Guest customers are identified only by their generated ID until they are claimed with a CPF.
+	Guest     bool       `json:"guest" dynamodbav:"guest,omitempty"`
+	Nickname  string     `json:"nickname,omitempty" dynamodbav:"nickname,omitempty"`
+	ClaimedAt *time.Time `json:"claimed_at,omitempty" dynamodbav:"claimed_at,omitempty"`
+	// Tier is the loyalty tier, owned by the loyalty program and filled in on reads.
+	Tier string `json:"tier,omitempty" dynamodbav:"-"`
 }
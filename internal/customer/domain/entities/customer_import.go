@@ -0,0 +1,26 @@
+package entities
+
+// Outcomes of an imported row.
+const (
+	ImportCreated   = "created"
+	ImportDuplicate = "duplicate"
+	ImportInvalid   = "invalid"
+	ImportFailed    = "failed"
+)
+
+// ImportRowResult is the outcome of one row of an import file. Reason explains every outcome but
+// created, which carries the ID of the new customer instead.
+type ImportRowResult struct {
+	Line       int
+	Status     string
+	Reason     string
+	CustomerID string
+}
+
+type ImportReport struct {
+	Created    int
+	Duplicates int
+	Invalid    int
+	Failed     int
+	Rows       []*ImportRowResult
+}
@@ -0,0 +1,21 @@
+package entities
+
+// ReindexReport counts what a reindex did with the stored customers.
+type ReindexReport struct {
+	Scanned int
+	// Encrypted customers were stored in plaintext, from before personal data was encrypted.
+	Encrypted int
+	// Reencrypted customers were encrypted under a previous master key.
+	Reencrypted int
+	// Conflicts lists the IDs of the customers left as they were, because they changed while being
+	// rewritten or their CPF was registered again since they were stored in plaintext.
+	Conflicts []string
+}
+
+// Add sums another report into this one, such as the report of another segment.
+func (r *ReindexReport) Add(other *ReindexReport) {
+	r.Scanned += other.Scanned
+	r.Encrypted += other.Encrypted
+	r.Reencrypted += other.Reencrypted
+	r.Conflicts = append(r.Conflicts, other.Conflicts...)
+}
@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// CustomerRevision is a snapshot of a customer as stored by one write. Change is the type of the
+// event raised by that write.
+type CustomerRevision struct {
+	ID         string    `json:"id"`
+	Change     string    `json:"change"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Customer   *Customer `json:"customer"`
+}
+
+type CustomerHistoryPage struct {
+	Revisions  []*CustomerRevision
+	NextCursor string
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+)
+
+var (
+	ErrInvalidCPF   = errors.New("cpf must have 11 digits with valid check digits")
+	ErrInvalidEmail = errors.New("email is not a valid address")
+)
+
+// NormalizeCPF strips the usual punctuation of a CPF (123.456.789-09) and checks its digits.
+func NormalizeCPF(cpf string) (string, error) {
+	digits := strings.NewReplacer(".", "", "-", "", " ", "").Replace(strings.TrimSpace(cpf))
+	if len(digits) != 11 {
+		return "", ErrInvalidCPF
+	}
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return "", ErrInvalidCPF
+		}
+	}
+	// Repeated digits pass the check digit algorithm but are never issued.
+	if strings.Count(digits, digits[:1]) == len(digits) {
+		return "", ErrInvalidCPF
+	}
+	if cpfCheckDigit(digits[:9]) != digits[9] || cpfCheckDigit(digits[:10]) != digits[10] {
+		return "", ErrInvalidCPF
+	}
+	return digits, nil
+}
+
+// CompleteCPF appends the two check digits to the first nine digits of a CPF.
+func CompleteCPF(base string) string {
+	withFirst := base + string(cpfCheckDigit(base))
+	return withFirst + string(cpfCheckDigit(withFirst))
+}
+
+// cpfCheckDigit computes the check digit that follows the given digits.
+func cpfCheckDigit(digits string) byte {
+	sum := 0
+	weight := len(digits) + 1
+	for i := range len(digits) {
+		sum += int(digits[i]-'0') * (weight - i)
+	}
+	remainder := sum * 10 % 11
+	if remainder == 10 {
+		remainder = 0
+	}
+	return byte('0' + remainder)
+}
+
+// ValidateEmail accepts an empty email, which is optional, or a bare address without a display name.
+func ValidateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return ErrInvalidEmail
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package events
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+)
+
+const (
+	CustomerRegisteredType = "customer.registered"
+	CustomerUpdatedType    = "customer.updated"
+	CustomerErasedType     = "customer.erased"
+)
+
+// Event is a change in the customer lifecycle that other services may react to. Events carry no
+// personal data, as they wait in the outbox table in plaintext and are then published: consumers
+// read the customer by its ID.
+type Event interface {
+	EventType() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+// CustomerRegistered is raised when a customer, registered or guest, is created.
+type CustomerRegistered struct {
+	CustomerID string    `json:"customer_id"`
+	Guest      bool      `json:"guest"`
+	Timestamp  time.Time `json:"occurred_at"`
+}
+
+func NewCustomerRegistered(customer *entities.Customer) CustomerRegistered {
+	return CustomerRegistered{
+		CustomerID: customer.ID,
+		Guest:      customer.Guest,
+		Timestamp:  time.Now(),
+	}
+}
+
+func (e CustomerRegistered) EventType() string     { return CustomerRegisteredType }
+func (e CustomerRegistered) AggregateID() string   { return e.CustomerID }
+func (e CustomerRegistered) OccurredAt() time.Time { return e.Timestamp }
+
+// CustomerUpdated is raised when the data of a customer changes, including a guest being claimed.
+type CustomerUpdated struct {
+	CustomerID string    `json:"customer_id"`
+	Guest      bool      `json:"guest"`
+	Timestamp  time.Time `json:"occurred_at"`
+}
+
+func NewCustomerUpdated(customer *entities.Customer) CustomerUpdated {
+	return CustomerUpdated{
+		CustomerID: customer.ID,
+		Guest:      customer.Guest,
+		Timestamp:  time.Now(),
+	}
+}
+
+func (e CustomerUpdated) EventType() string     { return CustomerUpdatedType }
+func (e CustomerUpdated) AggregateID() string   { return e.CustomerID }
+func (e CustomerUpdated) OccurredAt() time.Time { return e.Timestamp }
+
+// CustomerErased is raised when a customer is erased. Consumers are expected to delete what they
+// hold for the customer ID.
+type CustomerErased struct {
+	CustomerID string    `json:"customer_id"`
+	Timestamp  time.Time `json:"occurred_at"`
+}
+
+func NewCustomerErased(customer *entities.Customer) CustomerErased {
+	return CustomerErased{
+		CustomerID: customer.ID,
+		Timestamp:  time.Now(),
+	}
+}
+
+func (e CustomerErased) EventType() string     { return CustomerErasedType }
+func (e CustomerErased) AggregateID() string   { return e.CustomerID }
+func (e CustomerErased) OccurredAt() time.Time { return e.Timestamp }
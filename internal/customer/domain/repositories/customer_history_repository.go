@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("customer revision not found")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+)
+
+// CustomerHistoryRepository reads the revisions the CustomerRepository stores with every write
+// of a customer.
+type CustomerHistoryRepository interface {
+	// ListRevisions returns up to limit revisions of a customer, newest first, starting after cursor.
+	ListRevisions(customerID string, limit int, cursor string) (*entities.CustomerHistoryPage, error)
+	// GetAsOf returns the latest revision recorded at or before asOf.
+	GetAsOf(customerID string, asOf time.Time) (*entities.CustomerRevision, error)
+	// Purge deletes every revision of a customer.
+	Purge(customerID string) error
+}
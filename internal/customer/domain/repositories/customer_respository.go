@@ -1,8 +1,45 @@
 package repositories
 
-import "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+import (
+	"errors"
 
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+)
+
+var (
+	ErrCustomerNotFound      = errors.New("customer not found")
+	ErrCustomerAlreadyExists = errors.New("customer already exists")
+)
+
+// CustomerRepository persists customers. Every write also stores the matching
+// domain event in the outbox, in the same transaction as the change.
 type CustomerRepository interface {
 	GetByCpf(cpf string) (*entities.Customer, error)
+	GetByID(id string) (*entities.Customer, error)
+	// FindByEmail returns every customer registered with the email, which is not unique. Case and
+	// surrounding spaces are ignored.
+	FindByEmail(email string) ([]*entities.Customer, error)
+	// Add stores a new customer and raises CustomerRegistered.
 	Add(customer *entities.Customer) error
+	// Claim replaces a guest customer with a registered one keeping the same ID and raises CustomerUpdated.
+	Claim(customer *entities.Customer) error
+	// Update stores the new data of an existing customer and raises CustomerUpdated.
+	Update(customer *entities.Customer) error
+	// Erase deletes a customer and raises CustomerErased.
+	Erase(customer *entities.Customer) error
+	// FindRegisteredCPFs returns which of the CPFs already belong to a customer.
+	FindRegisteredCPFs(cpfs []string) (map[string]bool, error)
+	// AddBatch stores new customers in bulk and raises CustomerRegistered for each of them. Like
+	// Add it fails with ErrCustomerAlreadyExists for a CPF already registered; callers look the
+	// CPFs up with FindRegisteredCPFs first so most duplicates never reach a write. The errors
+	// follow the order of the customers, nil for each one stored.
+	AddBatch(customers []*entities.Customer) []error
+	// Scan reads one of totalSegments segments of a parallel scan of every customer, guests
+	// included, a page at a time. It stops at the first error visit returns and returns it.
+	Scan(segment int, totalSegments int, visit func(customer *entities.Customer) error) error
+	// Reindex rewrites the customers of one of totalSegments segments of a parallel scan that are
+	// stored in plaintext or encrypted under a previous master key, so they end up encrypted under
+	// the current key and looked up by blind indexes. The data of the customers is unchanged, so no
+	// event is raised and no revision stored.
+	Reindex(segment int, totalSegments int) (*entities.ReindexReport, error)
 }
@@ -0,0 +1,7 @@
+package repositories
+
+// CustomerTierRepository reads the loyalty tier of a customer, which the loyalty program owns.
+type CustomerTierRepository interface {
+	// GetTier returns the tier name, empty when the customer has no tier yet.
+	GetTier(customerID string) (string, error)
+}
@@ -2,27 +2,93 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	customerController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/exporter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/importer"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/ratelimit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
 )
 
+var (
+	readCustomersRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin},
+		Scopes: []string{auth.ScopeCustomersRead},
+	}
+	writeCustomersRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin},
+		Scopes: []string{auth.ScopeCustomersWrite},
+	}
+	identifyCustomersRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin},
+	}
+	eraseCustomersRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+	// Past profiles are for support staff settling disputes.
+	readHistoryRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+	// Bulk imports onboard the members of a new franchise.
+	importCustomersRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+	// Full dumps feed the analytics pipeline, which holds an API key with the export scope.
+	exportCustomersRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+		Scopes: []string{auth.ScopeCustomersExport},
+	}
+	// Guests may only claim themselves; the handler checks the token subject.
+	claimGuestRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleGuest, auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin},
+	}
+)
+
+// maxImportBytes bounds an import body, comfortably above importcustomers.MaxRows rows.
+const maxImportBytes = 16 << 20
+
 type customerApiController struct {
-	controller customerController.CustomerController
+	controller    customerController.CustomerController
+	lookupLimiter *ratelimit.Limiter
 }
 
-func NewCustomerController(controller customerController.CustomerController) *customerApiController {
+// NewCustomerController builds the customer routes. The lookup limiter throttles the CPF
+// lookup, whose 200/404 answers would otherwise make enumerating valid CPFs trivial; nil disables it.
+func NewCustomerController(controller customerController.CustomerController, lookupLimiter *ratelimit.Limiter) *customerApiController {
 	return &customerApiController{
-		controller: controller,
+		controller:    controller,
+		lookupLimiter: lookupLimiter,
 	}
 }
 
 func (c *customerApiController) RegisterRoutes(r chi.Router) {
 	prefix := "/v1/customer"
-	r.Get(prefix, c.Get)
-	r.Post(prefix, c.Add)
+	r.With(c.lookupLimiter.Handler, auth.Authorize(readCustomersRule)).Get(prefix, c.Get)
+	r.With(auth.Authorize(writeCustomersRule)).Post(prefix, c.Add)
+	r.With(auth.Authorize(identifyCustomersRule)).Post(prefix+"/identify", c.Identify)
+	r.With(auth.Authorize(identifyCustomersRule)).Post(prefix+"/guest", c.AddGuest)
+	r.With(auth.Authorize(claimGuestRule)).Post(prefix+"/{id}/claim", c.ClaimGuest)
+	r.With(auth.Authorize(writeCustomersRule)).Put(prefix+"/{id}", c.Update)
+	r.With(auth.Authorize(eraseCustomersRule)).Delete(prefix+"/{id}", c.Erase)
+	r.With(auth.Authorize(readHistoryRule)).Get(prefix+"/{id}/history", c.ListHistory)
+	r.With(auth.Authorize(readHistoryRule)).Get(prefix+"/{id}/history/snapshot", c.GetAsOf)
+	r.With(auth.Authorize(importCustomersRule)).Post("/v1/customers/import", c.Import)
+	r.With(auth.Authorize(exportCustomersRule)).Get("/v1/customers/export", c.Export)
 }
 
 // @Summary     Get customer
@@ -32,6 +98,12 @@ func (c *customerApiController) RegisterRoutes(r chi.Router) {
 // @Produce     json
 // @Param       cpf query uint true "CPF"
 // @Success     200  {object} dto.GetCustomerResponseDto
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Failure     429  {object} map[string]string
+// @Header      200  {integer} RateLimit-Remaining "Requests left in the current window"
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
 // @Router      /v1/customer [get]
 func (h *customerApiController) Get(w http.ResponseWriter, r *http.Request) {
 	cpf := r.URL.Query().Get("cpf")
@@ -41,14 +113,14 @@ func (h *customerApiController) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	customer, err := h.controller.GetByCpf(cpf)
+	customer, err := h.controller.GetByCpf(cpf, audit.ActorFromRequest(r))
 
 	if err != nil {
-		if err.Error() == "customer not found" {
+		if errors.Is(err, repositories.ErrCustomerNotFound) {
 			http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
 			return
 		}
-		http.Error(w, `{"error":"Error processing request"}`, http.StatusInternalServerError)
+		rest.WriteError(w, err)
 		return
 	}
 
@@ -64,6 +136,12 @@ func (h *customerApiController) Get(w http.ResponseWriter, r *http.Request) {
 // @Produce     json
 // @Param       body body dto.AddCustomerRequestDto true "Body"
 // @Success     201  {object} map[string]string
+// @Failure     400  {object} map[string]string
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Failure     409  {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
 // @Router      /v1/customer [post]
 func (h *customerApiController) Add(w http.ResponseWriter, r *http.Request) {
 	var customerRequest dto.AddCustomerRequestDto
@@ -73,10 +151,15 @@ func (h *customerApiController) Add(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.controller.Add(&customerRequest)
+	err := h.controller.Add(&customerRequest, audit.ActorFromRequest(r))
 
 	if err != nil {
-		http.Error(w, `{"error":"Error processing request"}`, http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, repositories.ErrCustomerAlreadyExists):
+			http.Error(w, `{"error":"CPF already registered"}`, http.StatusConflict)
+		case !writeValidationError(w, err):
+			rest.WriteError(w, err)
+		}
 		return
 	}
 
@@ -84,3 +167,454 @@ func (h *customerApiController) Add(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]string{"message": "Customer created successfully"})
 }
+
+// @Summary     Identify customer
+// @Description Identify a customer by CPF, optionally registering it, and issue a short-lived session token
+// @Tags        Customer
+// @Accept      json
+// @Produce     json
+// @Param       body body dto.IdentifyCustomerRequestDto true "Body"
+// @Success     200  {object} dto.CustomerSessionResponseDto
+// @Failure     400  {object} map[string]string
+// @Failure     404  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/identify [post]
+func (h *customerApiController) Identify(w http.ResponseWriter, r *http.Request) {
+	var identifyRequest dto.IdentifyCustomerRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&identifyRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	if identifyRequest.CPF == "" {
+		http.Error(w, `{"error":"Invalid CPF parameter"}`, http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.controller.Identify(&identifyRequest, audit.ActorFromRequest(r))
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrCustomerNotFound):
+			http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
+		case !writeValidationError(w, err):
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(session)
+}
+
+// @Summary     Add guest customer
+// @Description Create an anonymous guest customer, identified only by a generated ID, and issue a session token
+// @Tags        Customer
+// @Accept      json
+// @Produce     json
+// @Param       body body dto.AddGuestRequestDto false "Body"
+// @Success     201  {object} dto.CustomerSessionResponseDto
+// @Security    BearerAuth
+// @Router      /v1/customer/guest [post]
+func (h *customerApiController) AddGuest(w http.ResponseWriter, r *http.Request) {
+	var guestRequest dto.AddGuestRequestDto
+
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&guestRequest); err != nil {
+			http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+			return
+		}
+	}
+
+	session, err := h.controller.AddGuest(&guestRequest, audit.ActorFromRequest(r))
+
+	if err != nil {
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(session)
+}
+
+// @Summary     Claim guest customer
+// @Description Attach CPF and contact data to a guest customer, keeping its ID
+// @Tags        Customer
+// @Accept      json
+// @Produce     json
+// @Param       id   path string true "Guest customer ID"
+// @Param       body body dto.ClaimGuestRequestDto true "Body"
+// @Success     200  {object} dto.CustomerSessionResponseDto
+// @Failure     400  {object} map[string]string
+// @Failure     404  {object} map[string]string
+// @Failure     409  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/{id}/claim [post]
+func (h *customerApiController) ClaimGuest(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !claimsOwnGuest(principal, customerID) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	var claimRequest dto.ClaimGuestRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&claimRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	if claimRequest.CPF == "" {
+		http.Error(w, `{"error":"Invalid CPF parameter"}`, http.StatusBadRequest)
+		return
+	}
+
+	session, err := h.controller.ClaimGuest(customerID, &claimRequest, audit.ActorFromRequest(r))
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrCustomerNotFound):
+			http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
+		case errors.Is(err, repositories.ErrCustomerAlreadyExists):
+			http.Error(w, `{"error":"CPF already registered"}`, http.StatusConflict)
+		case errors.Is(err, claimguest.ErrCustomerNotGuest):
+			http.Error(w, `{"error":"Customer is not a guest"}`, http.StatusConflict)
+		case !writeValidationError(w, err):
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(session)
+}
+
+// @Summary     Update customer
+// @Description Update the name and email of a customer; the CPF cannot be changed
+// @Tags        Customer
+// @Accept      json
+// @Produce     json
+// @Param       id   path string true "Customer ID"
+// @Param       body body dto.UpdateCustomerRequestDto true "Body"
+// @Success     200  {object} dto.GetCustomerResponseDto
+// @Failure     400  {object} map[string]string
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Failure     404  {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id} [put]
+func (h *customerApiController) Update(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	var updateRequest dto.UpdateCustomerRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&updateRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	customer, err := h.controller.Update(customerID, &updateRequest, audit.ActorFromRequest(r))
+
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrCustomerNotFound):
+			http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
+		case !writeValidationError(w, err):
+			rest.WriteError(w, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(customer)
+}
+
+// @Summary     Erase customer
+// @Description Delete a customer and notify other services so they drop their copies
+// @Tags        Customer
+// @Param       id   path string true "Customer ID"
+// @Success     204
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Failure     404  {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/{id} [delete]
+func (h *customerApiController) Erase(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	if err := h.controller.Erase(customerID, audit.ActorFromRequest(r)); err != nil {
+		if errors.Is(err, repositories.ErrCustomerNotFound) {
+			http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary     List customer history
+// @Description List the revisions stored by every write of the customer, newest first
+// @Tags        Customer
+// @Produce     json
+// @Param       id     path  string true  "Customer ID"
+// @Param       limit  query int    false "Page size (default 20, max 100)"
+// @Param       cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success     200 {object} dto.CustomerHistoryResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/{id}/history [get]
+func (h *customerApiController) ListHistory(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, `{"error":"Invalid limit parameter"}`, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	history, err := h.controller.ListHistory(customerID, limit, r.URL.Query().Get("cursor"), audit.ActorFromRequest(r))
+
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidCursor) {
+			http.Error(w, `{"error":"Invalid cursor parameter"}`, http.StatusBadRequest)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+// @Summary     Get customer as of a date
+// @Description Rebuild the customer profile as it was stored at a date
+// @Tags        Customer
+// @Produce     json
+// @Param       id    path  string true "Customer ID"
+// @Param       as_of query string true "Date in RFC 3339 format, e.g. 2024-05-01T10:00:00-03:00"
+// @Success     200 {object} dto.CustomerRevisionResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     404 {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/{id}/history/snapshot [get]
+func (h *customerApiController) GetAsOf(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	asOf, err := time.Parse(time.RFC3339, r.URL.Query().Get("as_of"))
+	if err != nil {
+		http.Error(w, `{"error":"Invalid as_of parameter"}`, http.StatusBadRequest)
+		return
+	}
+
+	revision, err := h.controller.GetAsOf(customerID, asOf, audit.ActorFromRequest(r))
+
+	if err != nil {
+		if errors.Is(err, getcustomerasof.ErrInvalidAsOf) {
+			http.Error(w, `{"error":"Invalid as_of parameter"}`, http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, repositories.ErrRevisionNotFound) {
+			http.Error(w, `{"error":"No revision of the customer at that date"}`, http.StatusNotFound)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(revision)
+}
+
+// @Summary     Import customers
+// @Description Import customers in bulk from a CSV file with a name, email and cpf header, or from NDJSON
+// @Description with one {"name","email","cpf"} object per line. Each row needs a CPF with valid check digits and,
+// @Description when given, a valid email; every row is reported as created, duplicate, invalid or failed.
+// @Tags        Customer
+// @Accept      text/csv
+// @Accept      application/x-ndjson
+// @Produce     json
+// @Param       format query string false "csv or ndjson, taken from Content-Type when omitted"
+// @Param       body   body  string true  "Import file"
+// @Success     200 {object} dto.ImportCustomersResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     413 {object} map[string]string
+// @Failure     415 {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customers/import [post]
+func (h *customerApiController) Import(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = importer.FormatFromContentType(r.Header.Get("Content-Type"))
+	}
+	if format != importer.FormatCSV && format != importer.FormatNDJSON {
+		http.Error(w, `{"error":"Import format must be csv or ndjson"}`, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	rows, err := importer.Parse(format, http.MaxBytesReader(w, r.Body, maxImportBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		switch {
+		case errors.As(err, &tooLarge):
+			http.Error(w, `{"error":"Import file too large"}`, http.StatusRequestEntityTooLarge)
+		case errors.Is(err, importer.ErrMissingCPFColumn):
+			http.Error(w, `{"error":"CSV header must have a cpf column"}`, http.StatusBadRequest)
+		case errors.Is(err, importer.ErrEmptyFile):
+			http.Error(w, `{"error":"Import file is empty"}`, http.StatusBadRequest)
+		default:
+			http.Error(w, `{"error":"Invalid import file"}`, http.StatusBadRequest)
+		}
+		return
+	}
+
+	report, err := h.controller.Import(rows, audit.ActorFromRequest(r))
+
+	if err != nil {
+		if errors.Is(err, importcustomers.ErrTooManyRows) {
+			http.Error(w, `{"error":"Too many rows, split the import file"}`, http.StatusRequestEntityTooLarge)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(report)
+}
+
+// @Summary     Export customers
+// @Description Stream every customer, guests included, as CSV, NDJSON or Parquet. The table is scanned in parallel
+// @Description segments and written as it is read. An error after the first bytes were sent cannot change the
+// @Description status anymore: the download is cut short and the X-Export-Error trailer says why.
+// @Tags        Customer
+// @Produce     text/csv
+// @Produce     application/x-ndjson
+// @Produce     application/vnd.apache.parquet
+// @Param       format   query string false "csv (default), ndjson or parquet"
+// @Param       mask_pii query bool   false "Mask CPF, name, nickname and email"
+// @Param       segments query int    false "Parallel scan segments (default 4, max 16)"
+// @Success     200 {file}   file
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     500 {object} map[string]string
+// @Header      200 {string} X-Export-Error "Trailer set when the export failed midway"
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customers/export [get]
+func (h *customerApiController) Export(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = exporter.FormatCSV
+	}
+
+	maskPII := false
+	if value := query.Get("mask_pii"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			http.Error(w, `{"error":"Invalid mask_pii parameter"}`, http.StatusBadRequest)
+			return
+		}
+		maskPII = parsed
+	}
+
+	segments := 0
+	if value := query.Get("segments"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, `{"error":"Invalid segments parameter"}`, http.StatusBadRequest)
+			return
+		}
+		segments = parsed
+	}
+
+	body := &trackingResponseWriter{ResponseWriter: w}
+	writer, err := exporter.NewWriter(format, body)
+	if err != nil {
+		http.Error(w, `{"error":"Export format must be csv, ndjson or parquet"}`, http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", exporter.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "customers-"+time.Now().UTC().Format("20060102")+"."+format))
+	w.Header().Set("Trailer", "X-Export-Error")
+
+	count, err := h.controller.Export(writer, segments, maskPII, audit.ActorFromRequest(r))
+	if err == nil {
+		err = writer.Close()
+	}
+	if err == nil {
+		return
+	}
+
+	log.Printf("Warning: customer export failed after %d customers: %v\n", count, err)
+	if !body.written {
+		w.Header().Del("Content-Disposition")
+		w.Header().Del("Trailer")
+		rest.WriteError(w, err)
+		return
+	}
+	w.Header().Set("X-Export-Error", "export failed, the file is incomplete")
+}
+
+// trackingResponseWriter remembers whether the response has started, after which an export error can only be
+// reported in a trailer.
+type trackingResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *trackingResponseWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
+
+// claimsOwnGuest lets staff-like roles claim any guest while guest tokens may only claim themselves.
+// writeValidationError answers 400 to a CPF or email the customer rules reject, reporting whether
+// the error was one of them.
+func writeValidationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, entities.ErrInvalidCPF):
+		http.Error(w, `{"error":"Invalid CPF parameter"}`, http.StatusBadRequest)
+	case errors.Is(err, entities.ErrInvalidEmail):
+		http.Error(w, `{"error":"Invalid email"}`, http.StatusBadRequest)
+	default:
+		return false
+	}
+	return true
+}
+
+func claimsOwnGuest(principal *auth.Principal, customerID string) bool {
+	if principal.HasRole(auth.RoleKiosk) || principal.HasRole(auth.RoleStaff) || principal.HasRole(auth.RoleAdmin) {
+		return true
+	}
+	return principal.HasRole(auth.RoleGuest) && principal.Subject == customerID
+}
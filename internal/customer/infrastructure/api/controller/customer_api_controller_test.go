@@ -4,28 +4,52 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/controller"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
 	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/ratelimit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/resilience"
 )
 
 type CustomerApiControllerTestSuite struct {
 	suite.Suite
 	mockController *mockController.MockCustomerController
 	router         *chi.Mux
+	principal      *auth.Principal
 }
 
 func (suite *CustomerApiControllerTestSuite) SetupTest() {
 	suite.mockController = mockController.NewMockCustomerController(suite.T())
-	apiCtrl := apiController.NewCustomerController(suite.mockController)
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	apiCtrl := apiController.NewCustomerController(suite.mockController, nil)
 	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
 	apiCtrl.RegisterRoutes(suite.router)
 }
 
@@ -47,7 +71,7 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoi
 	}
 
 	suite.mockController.EXPECT().
-		GetByCpf("12345678901").
+		GetByCpf("12345678901", mock.Anything).
 		Return(expectedResponse, nil).
 		Once()
 
@@ -75,7 +99,7 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoi
 		Name:  "Test User",
 		Email: "test@test.com",
 	}
-	suite.mockController.EXPECT().GetByCpf("invalid").Return(customerResponse, nil).Once()
+	suite.mockController.EXPECT().GetByCpf("invalid", mock.Anything).Return(customerResponse, nil).Once()
 	
 	// WHEN a GET request is made to /v1/customer with invalid CPF
 	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=invalid", nil)
@@ -104,8 +128,8 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoi
 	cpf := "99999999999"
 
 	suite.mockController.EXPECT().
-		GetByCpf("99999999999").
-		Return(nil, errors.New("customer not found")).
+		GetByCpf("99999999999", mock.Anything).
+		Return(nil, repositories.ErrCustomerNotFound).
 		Once()
 
 	// WHEN a GET request is made to /v1/customer with non-existent CPF
@@ -122,7 +146,7 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoi
 	cpf := "12345678901"
 
 	suite.mockController.EXPECT().
-		GetByCpf("12345678901").
+		GetByCpf("12345678901", mock.Anything).
 		Return(nil, errors.New("database error")).
 		Once()
 
@@ -135,6 +159,23 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoi
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
 }
 
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoint_WithDatabaseUnavailable_ShouldReturnServiceUnavailable() {
+	// GIVEN a valid CPF but the circuit breaker of the database is open
+	suite.mockController.EXPECT().
+		GetByCpf("12345678901", mock.Anything).
+		Return(nil, fmt.Errorf("failed to get customer: %w", &resilience.UnavailableError{Name: "dynamodb", RetryAfter: 8 * time.Second})).
+		Once()
+
+	// WHEN a GET request is made to /v1/customer
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response should be 503 telling when to retry
+	assert.Equal(suite.T(), http.StatusServiceUnavailable, w.Code)
+	assert.Equal(suite.T(), "8", w.Header().Get("Retry-After"))
+}
+
 // Feature: Customer REST API - Post Endpoint
 // Scenario: Register a new customer via HTTP
 
@@ -147,7 +188,7 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_ViaPostEn
 	}
 
 	suite.mockController.EXPECT().
-		Add(requestDto).
+		Add(requestDto, mock.Anything).
 		Return(nil).
 		Once()
 
@@ -190,7 +231,7 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_ViaPostEn
 	}
 
 	suite.mockController.EXPECT().
-		Add(requestDto).
+		Add(requestDto, mock.Anything).
 		Return(errors.New("validation error")).
 		Once()
 
@@ -216,3 +257,712 @@ func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_ViaPostEn
 	// THEN the response status should be 400 Bad Request
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
 }
+
+// Feature: Customer REST API - Authorization
+// Scenario: Only authorized callers can reach customer data
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_WithoutPrincipal_ShouldReturnUnauthorized() {
+	// GIVEN an unauthenticated caller
+	suite.principal = nil
+
+	// WHEN a GET request is made to /v1/customer
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 401 Unauthorized
+	assert.Equal(suite.T(), http.StatusUnauthorized, w.Code)
+	// AND the controller should not have been called
+	suite.mockController.AssertNotCalled(suite.T(), "GetByCpf", "12345678901", mock.Anything)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_WithoutAllowedRole_ShouldReturnForbidden() {
+	// GIVEN an authenticated caller without any customer role
+	suite.principal = &auth.Principal{Subject: "someone"}
+
+	// WHEN a GET request is made to /v1/customer
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_WithServiceReadScope_ShouldBeAllowed() {
+	// GIVEN a service caller holding the customers:read scope
+	suite.principal = &auth.Principal{Subject: "order-service", Roles: []auth.Role{auth.RoleService}, Scopes: []string{auth.ScopeCustomersRead}}
+
+	suite.mockController.EXPECT().
+		GetByCpf("12345678901", mock.Anything).
+		Return(&dto.GetCustomerResponseDto{ID: "test-id"}, nil).
+		Once()
+
+	// WHEN a GET request is made to /v1/customer
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_WithServiceReadScopeOnly_ShouldReturnForbidden() {
+	// GIVEN a service caller that can only read customers
+	suite.principal = &auth.Principal{Subject: "order-service", Roles: []auth.Role{auth.RoleService}, Scopes: []string{auth.ScopeCustomersRead}}
+
+	// WHEN a POST request is made to /v1/customer
+	body, _ := json.Marshal(&dto.AddCustomerRequestDto{Name: "Jane Doe", Email: "jane@example.com", CPF: "98765432109"})
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+// Feature: Customer REST API - Identify Endpoint
+// Scenario: Kiosk identifies a customer and receives a session token
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerIdentification_ViaPostEndpoint_WithKnownCPF_ShouldReturnSession() {
+	// GIVEN a kiosk identifying a registered customer
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+	requestDto := &dto.IdentifyCustomerRequestDto{CPF: "12345678901"}
+	session := &dto.CustomerSessionResponseDto{
+		Customer:    dto.GetCustomerResponseDto{ID: "customer-1"},
+		AccessToken: "signed-token",
+		TokenType:   "Bearer",
+	}
+
+	suite.mockController.EXPECT().
+		Identify(requestDto, mock.Anything).
+		Return(session, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/identify
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/identify", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	// AND the session token should be returned and not cached
+	var response dto.CustomerSessionResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(suite.T(), "signed-token", response.AccessToken)
+	assert.Equal(suite.T(), "customer-1", response.Customer.ID)
+	assert.Equal(suite.T(), "no-store", w.Header().Get("Cache-Control"))
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerIdentification_ViaPostEndpoint_WithUnknownCPF_ShouldReturnNotFound() {
+	// GIVEN an identification request for an unknown CPF without auto registration
+	requestDto := &dto.IdentifyCustomerRequestDto{CPF: "99999999999"}
+
+	suite.mockController.EXPECT().
+		Identify(requestDto, mock.Anything).
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/identify
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/identify", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 404 Not Found
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerIdentification_ViaPostEndpoint_WithoutCPF_ShouldReturnBadRequest() {
+	// GIVEN an identification request without CPF
+	// WHEN a POST request is made to /v1/customer/identify
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/identify", bytes.NewBufferString(`{"auto_register":true}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerIdentification_ViaPostEndpoint_WithServiceCaller_ShouldReturnForbidden() {
+	// GIVEN a service caller with full customer scopes
+	suite.principal = &auth.Principal{Subject: "order-service", Roles: []auth.Role{auth.RoleService}, Scopes: []string{auth.ScopeCustomersRead, auth.ScopeCustomersWrite}}
+
+	// WHEN a POST request is made to /v1/customer/identify
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/identify", bytes.NewBufferString(`{"cpf":"12345678901"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+// Feature: Customer REST API - Guest Endpoints
+// Scenario: Order without identification and claim the guest later
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestCreation_ViaPostEndpoint_ShouldReturnGuestSession() {
+	// GIVEN a kiosk creating a guest with a nickname
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+	session := &dto.CustomerSessionResponseDto{
+		Customer:    dto.GetCustomerResponseDto{ID: "guest-1", Guest: true, Nickname: "Johnny"},
+		AccessToken: "guest-token",
+	}
+
+	suite.mockController.EXPECT().
+		AddGuest(&dto.AddGuestRequestDto{Nickname: "Johnny"}, mock.Anything).
+		Return(session, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/guest
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/guest", bytes.NewBufferString(`{"nickname":"Johnny"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	// AND the guest session should be returned
+	var response dto.CustomerSessionResponseDto
+	assert.NoError(suite.T(), json.NewDecoder(w.Body).Decode(&response))
+	assert.True(suite.T(), response.Customer.Guest)
+	assert.Equal(suite.T(), "guest-token", response.AccessToken)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestCreation_ViaPostEndpoint_WithoutBody_ShouldCreateAnonymousGuest() {
+	// GIVEN a guest creation request without body
+	suite.mockController.EXPECT().
+		AddGuest(&dto.AddGuestRequestDto{}, mock.Anything).
+		Return(&dto.CustomerSessionResponseDto{}, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/guest
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/guest", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestClaim_ViaPostEndpoint_WithOwnGuestToken_ShouldReturnCustomerSession() {
+	// GIVEN a guest claiming itself
+	suite.principal = &auth.Principal{Subject: "guest-1", Roles: []auth.Role{auth.RoleGuest}}
+	requestDto := &dto.ClaimGuestRequestDto{CPF: "12345678901", Name: "John Doe"}
+
+	suite.mockController.EXPECT().
+		ClaimGuest("guest-1", requestDto, mock.Anything).
+		Return(&dto.CustomerSessionResponseDto{Customer: dto.GetCustomerResponseDto{ID: "guest-1"}}, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/guest-1/claim
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/guest-1/claim", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestClaim_ViaPostEndpoint_WithAnotherGuestToken_ShouldReturnForbidden() {
+	// GIVEN a guest trying to claim someone else
+	suite.principal = &auth.Principal{Subject: "guest-2", Roles: []auth.Role{auth.RoleGuest}}
+
+	// WHEN a POST request is made to /v1/customer/guest-1/claim
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/guest-1/claim", bytes.NewBufferString(`{"cpf":"12345678901"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestClaim_ViaPostEndpoint_WithRegisteredCPF_ShouldReturnConflict() {
+	// GIVEN the CPF already belongs to another customer
+	suite.mockController.EXPECT().
+		ClaimGuest("guest-1", &dto.ClaimGuestRequestDto{CPF: "12345678901"}, mock.Anything).
+		Return(nil, repositories.ErrCustomerAlreadyExists).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/guest-1/claim
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/guest-1/claim", bytes.NewBufferString(`{"cpf":"12345678901"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_GuestClaim_ViaPostEndpoint_WithRegisteredCustomer_ShouldReturnConflict() {
+	// GIVEN the customer is not a guest
+	suite.mockController.EXPECT().
+		ClaimGuest("customer-1", &dto.ClaimGuestRequestDto{CPF: "12345678901"}, mock.Anything).
+		Return(nil, claimguest.ErrCustomerNotGuest).
+		Once()
+
+	// WHEN a POST request is made to /v1/customer/customer-1/claim
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer/customer-1/claim", bytes.NewBufferString(`{"cpf":"12345678901"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+// Feature: Customer REST API - Lookup Rate Limiting
+// Scenario: Prevent CPF enumeration through the lookup endpoint
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRetrieval_ViaGetEndpoint_AfterTooManyNotFound_ShouldReturnTooManyRequests() {
+	// GIVEN a lookup limiter allowing a single not-found answer
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:          "customer-lookup",
+		Limit:         ratelimit.Limit{Requests: 100, Period: time.Minute},
+		NotFoundLimit: ratelimit.Limit{Requests: 1, Period: time.Minute},
+	})
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal)))
+		})
+	})
+	apiController.NewCustomerController(suite.mockController, limiter).RegisterRoutes(suite.router)
+
+	suite.mockController.EXPECT().
+		GetByCpf("11111111111", mock.Anything).
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	// WHEN the client probes an unknown CPF
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=11111111111", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Equal(suite.T(), "100", w.Header().Get("RateLimit-Limit"))
+
+	// AND tries another one
+	req = httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=22222222222", nil)
+	w = httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the lookup should be rejected before reaching the controller
+	assert.Equal(suite.T(), http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(suite.T(), w.Header().Get("Retry-After"))
+}
+
+// Feature: Customer REST API - Update and Erase Endpoints
+// Scenario: Staff changes contact data and erases customers
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_ViaPostEndpoint_WithRegisteredCPF_ShouldReturnConflict() {
+	// GIVEN the CPF is already registered
+	requestDto := &dto.AddCustomerRequestDto{Name: "Jane Doe", Email: "jane@example.com", CPF: "98765432109"}
+	suite.mockController.EXPECT().Add(requestDto, mock.Anything).Return(repositories.ErrCustomerAlreadyExists).Once()
+
+	// WHEN a POST request is made to /v1/customer
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerRegistration_ViaPostEndpoint_WithInvalidCPF_ShouldReturnBadRequest() {
+	// GIVEN a CPF with wrong check digits
+	requestDto := &dto.AddCustomerRequestDto{Name: "Jane Doe", CPF: "12345678901"}
+	suite.mockController.EXPECT().Add(requestDto, mock.Anything).Return(entities.ErrInvalidCPF).Once()
+
+	// WHEN a POST request is made to /v1/customer
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPost, "/v1/customer", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.JSONEq(suite.T(), `{"error":"Invalid CPF parameter"}`, w.Body.String())
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerUpdate_ViaPutEndpoint_WithInvalidEmail_ShouldReturnBadRequest() {
+	// GIVEN an email that is not an address
+	suite.mockController.EXPECT().Update("customer-1", mock.Anything, mock.Anything).Return(nil, entities.ErrInvalidEmail).Once()
+
+	// WHEN a PUT request is made to /v1/customer/customer-1
+	req := httptest.NewRequest(http.MethodPut, "/v1/customer/customer-1", bytes.NewBufferString(`{"email":"doe@"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.JSONEq(suite.T(), `{"error":"Invalid email"}`, w.Body.String())
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerUpdate_ViaPutEndpoint_ShouldReturnUpdatedCustomer() {
+	// GIVEN a new email for an existing customer
+	requestDto := &dto.UpdateCustomerRequestDto{Email: "doe@example.com"}
+	suite.mockController.EXPECT().
+		Update("customer-1", requestDto, mock.Anything).
+		Return(&dto.GetCustomerResponseDto{ID: "customer-1", Email: "doe@example.com"}, nil).
+		Once()
+
+	// WHEN a PUT request is made to /v1/customer/customer-1
+	body, _ := json.Marshal(requestDto)
+	req := httptest.NewRequest(http.MethodPut, "/v1/customer/customer-1", bytes.NewBuffer(body))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the updated customer should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.GetCustomerResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), "doe@example.com", response.Email)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerUpdate_ViaPutEndpoint_WithUnknownID_ShouldReturnNotFound() {
+	// GIVEN the customer does not exist
+	suite.mockController.EXPECT().Update("missing", mock.Anything, mock.Anything).Return(nil, repositories.ErrCustomerNotFound).Once()
+
+	// WHEN a PUT request is made to /v1/customer/missing
+	req := httptest.NewRequest(http.MethodPut, "/v1/customer/missing", bytes.NewBufferString(`{"name":"Jane"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 404 Not Found
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerErasure_ViaDeleteEndpoint_ShouldReturnNoContent() {
+	// GIVEN an existing customer
+	suite.mockController.EXPECT().Erase("customer-1", audit.Actor{ID: "staff-1", Roles: []string{"staff"}}).Return(nil).Once()
+
+	// WHEN a DELETE request is made to /v1/customer/customer-1
+	req := httptest.NewRequest(http.MethodDelete, "/v1/customer/customer-1", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 204 No Content
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerErasure_WithKioskRole_ShouldReturnForbidden() {
+	// GIVEN a kiosk caller
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+
+	// WHEN a DELETE request is made to /v1/customer/customer-1
+	req := httptest.NewRequest(http.MethodDelete, "/v1/customer/customer-1", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+// Feature: Customer REST API - History
+// Scenario: Support staff list revisions and rebuild the profile at a date
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerHistory_ViaGetEndpoint_ShouldReturnRevisions() {
+	// GIVEN the customer has revisions
+	history := &dto.CustomerHistoryResponseDto{
+		Revisions:  []dto.CustomerRevisionResponseDto{{ID: "rev-1", Change: "customer.updated"}},
+		NextCursor: "next",
+	}
+	suite.mockController.EXPECT().ListHistory("customer-1", 10, "cursor", mock.Anything).Return(history, nil).Once()
+
+	// WHEN a GET request is made to /v1/customer/customer-1/history
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history?limit=10&cursor=cursor", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the revisions should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.CustomerHistoryResponseDto
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), *history, response)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerHistory_WithInvalidCursor_ShouldReturnBadRequest() {
+	// GIVEN the cursor is rejected
+	suite.mockController.EXPECT().ListHistory("customer-1", 0, "bad", mock.Anything).Return(nil, repositories.ErrInvalidCursor).Once()
+
+	// WHEN a GET request is made with the cursor
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history?cursor=bad", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerHistory_WithInvalidLimit_ShouldReturnBadRequest() {
+	// WHEN a GET request is made with a negative limit
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history?limit=-1", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerHistory_WithKioskRole_ShouldReturnForbidden() {
+	// GIVEN a kiosk caller
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+
+	// WHEN a GET request is made to /v1/customer/customer-1/history
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerAsOf_ViaGetEndpoint_ShouldReturnRevision() {
+	// GIVEN a revision current at the date
+	asOf := time.Date(2024, 5, 1, 10, 0, 0, 0, time.FixedZone("", -3*60*60))
+	revision := &dto.CustomerRevisionResponseDto{ID: "rev-1", Customer: dto.GetCustomerResponseDto{ID: "customer-1", Name: "Jane Doe"}}
+	suite.mockController.EXPECT().
+		GetAsOf("customer-1", mock.MatchedBy(func(value time.Time) bool { return value.Equal(asOf) }), mock.Anything).
+		Return(revision, nil).
+		Once()
+
+	// WHEN a GET request is made to /v1/customer/customer-1/history/snapshot
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history/snapshot?as_of=2024-05-01T10:00:00-03:00", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the past profile should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.CustomerRevisionResponseDto
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), "Jane Doe", response.Customer.Name)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerAsOf_WithoutDate_ShouldReturnBadRequest() {
+	// WHEN a GET request is made without as_of
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history/snapshot", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerAsOf_BeforeFirstRevision_ShouldReturnNotFound() {
+	// GIVEN no revision existed at the date
+	suite.mockController.EXPECT().GetAsOf("customer-1", mock.Anything, mock.Anything).Return(nil, repositories.ErrRevisionNotFound).Once()
+
+	// WHEN a GET request is made for the date
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history/snapshot?as_of=2020-01-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 404 Not Found
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerAsOf_WithInvalidAsOf_ShouldReturnBadRequest() {
+	// GIVEN the use case rejects the date
+	suite.mockController.EXPECT().GetAsOf("customer-1", mock.Anything, mock.Anything).Return(nil, getcustomerasof.ErrInvalidAsOf).Once()
+
+	// WHEN a GET request is made for the date
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/history/snapshot?as_of=0001-01-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// Feature: Customer REST API - Import
+// Scenario: Staff import the loyalty members of a new franchise
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_ViaPostEndpoint_WithCSV_ShouldReturnReport() {
+	// GIVEN a CSV file with one member
+	report := &dto.ImportCustomersResponseDto{
+		Created: 1,
+		Rows:    []dto.ImportRowResultResponseDto{{Line: 2, Status: "created", CustomerID: "customer-1"}},
+	}
+	suite.mockController.EXPECT().
+		Import([]dto.ImportCustomerRowDto{{Name: "Jane Doe", Email: "jane@example.com", CPF: "52998224725", Line: 2}}, mock.Anything).
+		Return(report, nil).
+		Once()
+
+	// WHEN a POST request is made to /v1/customers/import with the file
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import", strings.NewReader("name,email,cpf\nJane Doe,jane@example.com,52998224725\n"))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the report should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.ImportCustomersResponseDto
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(suite.T(), *report, response)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_WithFormatParameter_ShouldParseNDJSON() {
+	// GIVEN an NDJSON file sent without a specific content type
+	suite.mockController.EXPECT().
+		Import([]dto.ImportCustomerRowDto{{CPF: "52998224725", Line: 1}}, mock.Anything).
+		Return(&dto.ImportCustomersResponseDto{Created: 1}, nil).
+		Once()
+
+	// WHEN a POST request is made with format=ndjson
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import?format=ndjson", strings.NewReader(`{"cpf":"52998224725"}`))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the file should be read as NDJSON
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_WithUnknownFormat_ShouldReturnUnsupportedMediaType() {
+	// WHEN a POST request is made with a JSON array
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import", strings.NewReader(`[]`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 415 Unsupported Media Type
+	assert.Equal(suite.T(), http.StatusUnsupportedMediaType, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_WithoutCPFColumn_ShouldReturnBadRequest() {
+	// WHEN a POST request is made with a CSV file missing the cpf column
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import?format=csv", strings.NewReader("name\nJane Doe\n"))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_WithTooManyRows_ShouldReturnRequestEntityTooLarge() {
+	// GIVEN the file is over the row limit
+	suite.mockController.EXPECT().Import(mock.Anything, mock.Anything).Return(nil, importcustomers.ErrTooManyRows).Once()
+
+	// WHEN a POST request is made with the file
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import?format=ndjson", strings.NewReader(`{"cpf":"52998224725"}`))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 413 Request Entity Too Large
+	assert.Equal(suite.T(), http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerImport_WithKioskRole_ShouldReturnForbidden() {
+	// GIVEN a kiosk caller
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+
+	// WHEN a POST request is made to /v1/customers/import
+	req := httptest.NewRequest(http.MethodPost, "/v1/customers/import?format=csv", strings.NewReader("cpf\n52998224725\n"))
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+// Feature: Customer REST API - Export
+// Scenario: The analytics pipeline downloads the customer base
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_ViaGetEndpoint_ShouldStreamNDJSON() {
+	// GIVEN an API key holding the export scope
+	suite.principal = &auth.Principal{Subject: "analytics", Scopes: []string{auth.ScopeCustomersExport}}
+	suite.mockController.EXPECT().
+		Export(mock.Anything, 2, true, mock.Anything).
+		RunAndReturn(func(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor) (int, error) {
+			if err := writer.Write(&entities.Customer{ID: "customer-1", CPF: "***.***.***-25"}); err != nil {
+				return 0, err
+			}
+			return 1, nil
+		}).
+		Once()
+
+	// WHEN a GET request is made to /v1/customers/export
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/export?format=ndjson&mask_pii=true&segments=2", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the customers should be streamed as an NDJSON attachment
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Contains(suite.T(), w.Header().Get("Content-Disposition"), ".ndjson")
+	assert.Contains(suite.T(), w.Body.String(), `"id":"customer-1"`)
+	assert.Empty(suite.T(), w.Result().Trailer.Get("X-Export-Error"))
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_WithoutFormat_ShouldWriteCSV() {
+	// GIVEN an empty customer base
+	suite.mockController.EXPECT().Export(mock.Anything, 0, false, mock.Anything).Return(0, nil).Once()
+
+	// WHEN a GET request is made without a format
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/export", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN a CSV with only the header should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), "id,cpf,name,email,nickname,guest,created_at,claimed_at\n", w.Body.String())
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_FailingBeforeAnyCustomer_ShouldReturnInternalServerError() {
+	// GIVEN the scan fails right away
+	suite.mockController.EXPECT().Export(mock.Anything, 0, false, mock.Anything).Return(0, errors.New("scan failed")).Once()
+
+	// WHEN a GET request is made to /v1/customers/export
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/export", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 500 Internal Server Error
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	assert.Empty(suite.T(), w.Header().Get("Content-Disposition"))
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_FailingMidway_ShouldSetErrorTrailer() {
+	// GIVEN the scan fails after a customer was streamed
+	suite.mockController.EXPECT().
+		Export(mock.Anything, 0, false, mock.Anything).
+		RunAndReturn(func(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor) (int, error) {
+			writer.Write(&entities.Customer{ID: "customer-1"})
+			return 1, errors.New("scan failed")
+		}).
+		Once()
+
+	// WHEN a GET request is made to /v1/customers/export as NDJSON
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/export?format=ndjson", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the partial download should carry the error trailer
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.NotEmpty(suite.T(), w.Result().Trailer.Get("X-Export-Error"))
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_WithInvalidParameters_ShouldReturnBadRequest() {
+	for _, query := range []string{"format=xlsx", "mask_pii=maybe", "segments=0"} {
+		// WHEN a GET request is made with an invalid parameter
+		req := httptest.NewRequest(http.MethodGet, "/v1/customers/export?"+query, nil)
+		w := httptest.NewRecorder()
+		suite.router.ServeHTTP(w, req)
+
+		// THEN the response status should be 400 Bad Request
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+	}
+}
+
+func (suite *CustomerApiControllerTestSuite) Test_CustomerExport_WithKioskRole_ShouldReturnForbidden() {
+	// GIVEN a kiosk caller
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}, Scopes: []string{auth.ScopeCustomersRead}}
+
+	// WHEN a GET request is made to /v1/customers/export
+	req := httptest.NewRequest(http.MethodGet, "/v1/customers/export", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
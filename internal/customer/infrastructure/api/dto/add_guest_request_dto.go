@@ -0,0 +1,5 @@
+package dto
+
+type AddGuestRequestDto struct {
+	Nickname string `json:"nickname,omitempty" example:"Johnny"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ClaimGuestRequestDto struct {
+	CPF   string `json:"cpf" example:"12345678909"`
+	Name  string `json:"name,omitempty" example:"John Doe"`
+	Email string `json:"email,omitempty" example:"john@doe.com"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type CustomerHistoryResponseDto struct {
+	Revisions  []CustomerRevisionResponseDto `json:"revisions"`
+	NextCursor string                        `json:"next_cursor,omitempty"`
+}
+
+// CustomerRevisionResponseDto is the customer as stored by one write, which Change names.
+type CustomerRevisionResponseDto struct {
+	ID         string                 `json:"id"`
+	Change     string                 `json:"change"`
+	RecordedAt time.Time              `json:"recorded_at"`
+	Customer   GetCustomerResponseDto `json:"customer"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type CustomerSessionResponseDto struct {
+	Customer    GetCustomerResponseDto `json:"customer"`
+	AccessToken string                 `json:"access_token"`
+	TokenType   string                 `json:"token_type"`
+	ExpiresAt   time.Time              `json:"expires_at"`
+}
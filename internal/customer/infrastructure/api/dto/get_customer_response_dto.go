@@ -8,4 +8,7 @@ type GetCustomerResponseDto struct {
 	Name      string    `json:"name"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
+	Guest     bool      `json:"guest"`
+	Nickname  string    `json:"nickname,omitempty"`
+	Tier      string    `json:"tier,omitempty"`
 }
@@ -0,0 +1,8 @@
+package dto
+
+type IdentifyCustomerRequestDto struct {
+	CPF          string `json:"cpf" example:"12345678909"`
+	AutoRegister bool   `json:"auto_register" example:"false"`
+	Name         string `json:"name,omitempty" example:"John Doe"`
+	Email        string `json:"email,omitempty" example:"john@doe.com"`
+}
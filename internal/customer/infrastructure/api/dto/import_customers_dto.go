@@ -0,0 +1,27 @@
+package dto
+
+// ImportCustomerRowDto is a row of a CSV or NDJSON import file. Line and Error are filled in by
+// the parser, Error only when the row could not be read.
+type ImportCustomerRowDto struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	CPF   string `json:"cpf"`
+	Line  int    `json:"-"`
+	Error string `json:"-"`
+}
+
+type ImportCustomersResponseDto struct {
+	Created    int                          `json:"created"`
+	Duplicates int                          `json:"duplicates"`
+	Invalid    int                          `json:"invalid"`
+	Failed     int                          `json:"failed"`
+	Rows       []ImportRowResultResponseDto `json:"rows"`
+}
+
+// ImportRowResultResponseDto is the outcome of a row: created, duplicate, invalid or failed.
+type ImportRowResultResponseDto struct {
+	Line       int    `json:"line"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+	CustomerID string `json:"customer_id,omitempty"`
+}
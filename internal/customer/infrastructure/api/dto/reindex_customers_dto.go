@@ -0,0 +1,8 @@
+package dto
+
+type ReindexCustomersResponseDto struct {
+	Scanned     int      `json:"scanned"`
+	Encrypted   int      `json:"encrypted"`
+	Reencrypted int      `json:"reencrypted"`
+	Conflicts   []string `json:"conflicts"`
+}
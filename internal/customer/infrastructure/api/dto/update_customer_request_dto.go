@@ -0,0 +1,6 @@
+package dto
+
+type UpdateCustomerRequestDto struct {
+	Name  string `json:"name,omitempty" example:"John Doe"`
+	Email string `json:"email,omitempty" example:"john@doe.com"`
+}
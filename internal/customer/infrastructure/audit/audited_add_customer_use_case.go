@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addCustomer"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ addCustomer.AddCustomerUseCase = (*AuditedAddCustomerUseCase)(nil)
+)
+
+// AuditedAddCustomerUseCase records registrations. The use case does not return the customer,
+// so the ID of the new customer is read back by CPF.
+type AuditedAddCustomerUseCase struct {
+	next               addCustomer.AddCustomerUseCase
+	customerRepository repositories.CustomerRepository
+	recorder           recorder
+}
+
+func NewAuditedAddCustomerUseCase(next addCustomer.AddCustomerUseCase, customerRepository repositories.CustomerRepository, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedAddCustomerUseCase {
+	return &AuditedAddCustomerUseCase{
+		next:               next,
+		customerRepository: customerRepository,
+		recorder:           recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedAddCustomerUseCase) Execute(command *commands.AddCustomerCommand) error {
+	err := u.next.Execute(command)
+
+	id := ""
+	if err == nil {
+		if customer, lookupErr := u.customerRepository.GetByCpf(command.CPF); lookupErr == nil {
+			id = customer.ID
+		}
+	}
+	added := &entities.Customer{CPF: command.CPF, Name: command.Name, Email: command.Email}
+	// A registered CPF passed validation, so it normalizes as it was stored.
+	if cpf, normalizeErr := entities.NormalizeCPF(command.CPF); normalizeErr == nil {
+		added.CPF = cpf
+	}
+	u.recorder.record(command.Actor, ActionCreate, id, changes(nil, added), err)
+
+	return err
+}
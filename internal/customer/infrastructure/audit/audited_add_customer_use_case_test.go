@@ -0,0 +1,91 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+	mockAddCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/addCustomer"
+)
+
+type AuditedAddCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockNext       *mockAddCustomer.MockAddCustomerUseCase
+	mockRepository *mockRepositories.MockCustomerRepository
+	mockRecord     *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase        *audit.AuditedAddCustomerUseCase
+}
+
+func (suite *AuditedAddCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockAddCustomer.NewMockAddCustomerUseCase(suite.T())
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedAddCustomerUseCase(suite.mockNext, suite.mockRepository, suite.mockRecord)
+}
+
+func TestAuditedAddCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedAddCustomerUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Registrations are recorded with the registered fields marked sensitive
+
+func (suite *AuditedAddCustomerUseCaseTestSuite) Test_Add_ShouldRecordRegisteredFields() {
+	// GIVEN a new customer
+	command := commands.NewAddCustomerCommand("John Doe", "john@example.com", "12345678900")
+	command.Actor = kioskActor
+	suite.mockNext.EXPECT().Execute(command).Return(nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf("12345678900").Return(&entities.Customer{ID: "customer-1"}, nil).Once()
+	var recorded *auditCommands.RecordAuditEntryCommand
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Run(func(cmd *auditCommands.RecordAuditEntryCommand) {
+		recorded = cmd
+	}).Return(nil, nil).Once()
+
+	// WHEN registering it
+	err := suite.useCase.Execute(command)
+
+	// THEN the new customer and its sensitive fields should be recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), audit.ActionCreate, recorded.Action)
+	assert.Equal(suite.T(), "customer-1", recorded.CustomerID)
+	assert.Len(suite.T(), recorded.Changes, 3)
+	for _, change := range recorded.Changes {
+		assert.True(suite.T(), change.Sensitive)
+		assert.Empty(suite.T(), change.Old)
+	}
+	assert.Equal(suite.T(), "12345678900", findChange(recorded.Changes, "cpf").New)
+}
+
+func (suite *AuditedAddCustomerUseCaseTestSuite) Test_Add_WithError_ShouldRecordFailureWithoutLookup() {
+	// GIVEN the registration fails
+	command := commands.NewAddCustomerCommand("John Doe", "john@example.com", "12345678900")
+	expectedError := errors.New("customer already exists")
+	suite.mockNext.EXPECT().Execute(command).Return(expectedError).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.CustomerID == ""
+	})).Return(nil, nil).Once()
+
+	// WHEN registering it
+	err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failure recorded
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockRepository.AssertNotCalled(suite.T(), "GetByCpf", mock.Anything)
+}
+
+func findChange(changes []*auditCommands.FieldChange, field string) *auditCommands.FieldChange {
+	for _, change := range changes {
+		if change.Field == field {
+			return change
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ addguest.AddGuestUseCase = (*AuditedAddGuestUseCase)(nil)
+)
+
+type AuditedAddGuestUseCase struct {
+	next     addguest.AddGuestUseCase
+	recorder recorder
+}
+
+func NewAuditedAddGuestUseCase(next addguest.AddGuestUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedAddGuestUseCase {
+	return &AuditedAddGuestUseCase{next: next, recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase}}
+}
+
+func (u *AuditedAddGuestUseCase) Execute(command *commands.AddGuestCommand) (*entities.Customer, error) {
+	customer, err := u.next.Execute(command)
+	u.recorder.record(command.Actor, ActionCreateGuest, customerID(customer), changes(nil, &entities.Customer{Nickname: command.Nickname}), err)
+	return customer, err
+}
@@ -0,0 +1,53 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockAddGuest "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/addguest"
+)
+
+type AuditedAddGuestUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockAddGuest.MockAddGuestUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedAddGuestUseCase
+}
+
+func (suite *AuditedAddGuestUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockAddGuest.NewMockAddGuestUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedAddGuestUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedAddGuestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedAddGuestUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Guest creations are recorded with the nickname
+
+func (suite *AuditedAddGuestUseCaseTestSuite) Test_AddGuest_ShouldRecordNickname() {
+	// GIVEN a guest
+	command := commands.NewAddGuestCommand("Johnny")
+	command.Actor = kioskActor
+	suite.mockNext.EXPECT().Execute(command).Return(&entities.Customer{ID: "guest-1", Guest: true, Nickname: "Johnny"}, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		nickname := findChange(cmd.Changes, "nickname")
+		return cmd.Action == audit.ActionCreateGuest && cmd.CustomerID == "guest-1" &&
+			len(cmd.Changes) == 1 && nickname.New == "Johnny" && nickname.Sensitive
+	})).Return(nil, nil).Once()
+
+	// WHEN creating it
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the creation should be recorded
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ claimguest.ClaimGuestUseCase = (*AuditedClaimGuestUseCase)(nil)
+)
+
+// AuditedClaimGuestUseCase records claims with the fields that changed from the guest record.
+type AuditedClaimGuestUseCase struct {
+	next               claimguest.ClaimGuestUseCase
+	customerRepository repositories.CustomerRepository
+	recorder           recorder
+}
+
+func NewAuditedClaimGuestUseCase(next claimguest.ClaimGuestUseCase, customerRepository repositories.CustomerRepository, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedClaimGuestUseCase {
+	return &AuditedClaimGuestUseCase{
+		next:               next,
+		customerRepository: customerRepository,
+		recorder:           recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedClaimGuestUseCase) Execute(command *commands.ClaimGuestCommand) (*entities.Customer, error) {
+	guest, _ := u.customerRepository.GetByID(command.CustomerID)
+
+	claimed, err := u.next.Execute(command)
+
+	var changed []*auditCommands.FieldChange
+	if err == nil {
+		changed = changes(guest, claimed)
+	}
+	u.recorder.record(command.Actor, ActionClaim, command.CustomerID, changed, err)
+
+	return claimed, err
+}
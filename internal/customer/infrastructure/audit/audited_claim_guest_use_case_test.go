@@ -0,0 +1,79 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+	mockClaimGuest "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/claimguest"
+)
+
+type AuditedClaimGuestUseCaseTestSuite struct {
+	suite.Suite
+	mockNext       *mockClaimGuest.MockClaimGuestUseCase
+	mockRepository *mockRepositories.MockCustomerRepository
+	mockRecord     *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase        *audit.AuditedClaimGuestUseCase
+}
+
+func (suite *AuditedClaimGuestUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockClaimGuest.NewMockClaimGuestUseCase(suite.T())
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedClaimGuestUseCase(suite.mockNext, suite.mockRepository, suite.mockRecord)
+}
+
+func TestAuditedClaimGuestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedClaimGuestUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Claims are recorded with the fields changed from the guest record
+
+func (suite *AuditedClaimGuestUseCaseTestSuite) Test_Claim_ShouldRecordChangesFromGuest() {
+	// GIVEN a guest claiming their record
+	command := commands.NewClaimGuestCommand("guest-1", "12345678900", "John Doe", "")
+	guest := &entities.Customer{ID: "guest-1", Guest: true, Nickname: "Johnny"}
+	claimed := &entities.Customer{ID: "guest-1", CPF: "12345678900", Name: "John Doe", Nickname: "Johnny"}
+	suite.mockRepository.EXPECT().GetByID("guest-1").Return(guest, nil).Once()
+	suite.mockNext.EXPECT().Execute(command).Return(claimed, nil).Once()
+	var recorded *auditCommands.RecordAuditEntryCommand
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Run(func(cmd *auditCommands.RecordAuditEntryCommand) {
+		recorded = cmd
+	}).Return(nil, nil).Once()
+
+	// WHEN claiming it
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the CPF, the name and the end of the guest status should be recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), audit.ActionClaim, recorded.Action)
+	assert.Equal(suite.T(), "guest-1", recorded.CustomerID)
+	assert.Len(suite.T(), recorded.Changes, 3)
+	assert.Equal(suite.T(), &auditCommands.FieldChange{Field: "guest", Old: "true", New: "false"}, findChange(recorded.Changes, "guest"))
+	assert.Nil(suite.T(), findChange(recorded.Changes, "nickname"))
+}
+
+func (suite *AuditedClaimGuestUseCaseTestSuite) Test_Claim_OfRegisteredCustomer_ShouldRecordFailure() {
+	// GIVEN a customer that is not a guest
+	command := commands.NewClaimGuestCommand("customer-1", "12345678900", "", "")
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(&entities.Customer{ID: "customer-1"}, nil).Once()
+	suite.mockNext.EXPECT().Execute(command).Return(nil, claimguest.ErrCustomerNotGuest).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.CustomerID == "customer-1" && cmd.Changes == nil
+	})).Return(nil, nil).Once()
+
+	// WHEN claiming it
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the failed attempt should be recorded
+	assert.ErrorIs(suite.T(), err, claimguest.ErrCustomerNotGuest)
+}
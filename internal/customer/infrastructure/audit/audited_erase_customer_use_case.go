@@ -0,0 +1,43 @@
+package audit
+
+import (
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/erasecustomer"
+)
+
+var (
+	_ erasecustomer.EraseCustomerUseCase = (*AuditedEraseCustomerUseCase)(nil)
+)
+
+// AuditedEraseCustomerUseCase records erasures with the hashes of the erased data, which is all
+// that remains of it afterwards.
+type AuditedEraseCustomerUseCase struct {
+	next               erasecustomer.EraseCustomerUseCase
+	customerRepository repositories.CustomerRepository
+	recorder           recorder
+}
+
+func NewAuditedEraseCustomerUseCase(next erasecustomer.EraseCustomerUseCase, customerRepository repositories.CustomerRepository, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedEraseCustomerUseCase {
+	return &AuditedEraseCustomerUseCase{
+		next:               next,
+		customerRepository: customerRepository,
+		recorder:           recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedEraseCustomerUseCase) Execute(command *commands.EraseCustomerCommand) error {
+	before, _ := u.customerRepository.GetByID(command.CustomerID)
+
+	err := u.next.Execute(command)
+
+	var changed []*auditCommands.FieldChange
+	if err == nil {
+		changed = changes(before, nil)
+	}
+	u.recorder.record(command.Actor, ActionErase, command.CustomerID, changed, err)
+
+	return err
+}
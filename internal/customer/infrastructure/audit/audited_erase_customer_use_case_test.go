@@ -0,0 +1,74 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+	mockEraseCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/erasecustomer"
+)
+
+type AuditedEraseCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockNext       *mockEraseCustomer.MockEraseCustomerUseCase
+	mockRepository *mockRepositories.MockCustomerRepository
+	mockRecord     *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase        *audit.AuditedEraseCustomerUseCase
+}
+
+func (suite *AuditedEraseCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockEraseCustomer.NewMockEraseCustomerUseCase(suite.T())
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedEraseCustomerUseCase(suite.mockNext, suite.mockRepository, suite.mockRecord)
+}
+
+func TestAuditedEraseCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedEraseCustomerUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Erasures are recorded with the erased fields
+
+func (suite *AuditedEraseCustomerUseCaseTestSuite) Test_Erase_ShouldRecordErasedFields() {
+	// GIVEN a registered customer
+	command := commands.NewEraseCustomerCommand("customer-1")
+	suite.mockRepository.EXPECT().GetByID("customer-1").
+		Return(&entities.Customer{ID: "customer-1", CPF: "12345678900", Name: "John Doe", Email: "john@example.com"}, nil).
+		Once()
+	suite.mockNext.EXPECT().Execute(command).Return(nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		cpf := findChange(cmd.Changes, "cpf")
+		return cmd.Action == audit.ActionErase && len(cmd.Changes) == 3 && cpf.Old == "12345678900" && cpf.New == ""
+	})).Return(nil, nil).Once()
+
+	// WHEN erasing it
+	err := suite.useCase.Execute(command)
+
+	// THEN the erased fields should be recorded
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditedEraseCustomerUseCaseTestSuite) Test_Erase_UnknownCustomer_ShouldRecordFailure() {
+	// GIVEN a customer that does not exist
+	command := commands.NewEraseCustomerCommand("customer-404")
+	suite.mockRepository.EXPECT().GetByID("customer-404").Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockNext.EXPECT().Execute(command).Return(repositories.ErrCustomerNotFound).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.CustomerID == "customer-404" && cmd.Changes == nil
+	})).Return(nil, nil).Once()
+
+	// WHEN erasing it
+	err := suite.useCase.Execute(command)
+
+	// THEN the failed attempt should be recorded
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
@@ -0,0 +1,35 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+)
+
+var (
+	_ exportcustomers.ExportCustomersUseCase = (*AuditedExportCustomersUseCase)(nil)
+)
+
+// AuditedExportCustomersUseCase records a single entry per export, once it is over.
+type AuditedExportCustomersUseCase struct {
+	next     exportcustomers.ExportCustomersUseCase
+	recorder recorder
+}
+
+func NewAuditedExportCustomersUseCase(next exportcustomers.ExportCustomersUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedExportCustomersUseCase {
+	return &AuditedExportCustomersUseCase{
+		next:     next,
+		recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedExportCustomersUseCase) Execute(command *commands.ExportCustomersCommand, writer exportcustomers.CustomerWriter) (int, error) {
+	count, err := u.next.Execute(command, writer)
+
+	action := ActionExport
+	if command.MaskPII {
+		action = ActionExportMasked
+	}
+	u.recorder.record(command.Actor, action, "", nil, err)
+	return count, err
+}
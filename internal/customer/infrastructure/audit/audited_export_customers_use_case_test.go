@@ -0,0 +1,91 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockExportCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/exportcustomers"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedExportCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockExportCustomers.MockExportCustomersUseCase
+	mockWriter *mockExportCustomers.MockCustomerWriter
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedExportCustomersUseCase
+}
+
+func (suite *AuditedExportCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockExportCustomers.NewMockExportCustomersUseCase(suite.T())
+	suite.mockWriter = mockExportCustomers.NewMockCustomerWriter(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedExportCustomersUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedExportCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedExportCustomersUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Every export of the customer base is recorded once
+
+func (suite *AuditedExportCustomersUseCaseTestSuite) Test_Export_ShouldRecordOneEntry() {
+	// GIVEN a full export by the analytics pipeline
+	command := commands.NewExportCustomersCommand(4, false)
+	command.Actor = auditpkg.Actor{ID: "analytics"}
+	suite.mockNext.EXPECT().Execute(command, suite.mockWriter).Return(3, nil).Once()
+	var recorded *auditCommands.RecordAuditEntryCommand
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Run(func(cmd *auditCommands.RecordAuditEntryCommand) {
+		recorded = cmd
+	}).Return(nil, nil).Once()
+
+	// WHEN exporting
+	count, err := suite.useCase.Execute(command, suite.mockWriter)
+
+	// THEN a single successful entry should be recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, count)
+	assert.Equal(suite.T(), audit.ActionExport, recorded.Action)
+	assert.Empty(suite.T(), recorded.CustomerID)
+	assert.Equal(suite.T(), "analytics", recorded.Actor.ID)
+	assert.True(suite.T(), recorded.Succeeded)
+}
+
+func (suite *AuditedExportCustomersUseCaseTestSuite) Test_Export_WithMaskedPII_ShouldRecordMaskedAction() {
+	// GIVEN a masked export
+	command := commands.NewExportCustomersCommand(4, true)
+	suite.mockNext.EXPECT().Execute(command, suite.mockWriter).Return(0, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Action == audit.ActionExportMasked
+	})).Return(nil, nil).Once()
+
+	// WHEN exporting
+	_, err := suite.useCase.Execute(command, suite.mockWriter)
+
+	// THEN it should be recorded as masked
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditedExportCustomersUseCaseTestSuite) Test_Export_WithError_ShouldRecordFailure() {
+	// GIVEN the scan fails midway
+	command := commands.NewExportCustomersCommand(4, false)
+	suite.mockNext.EXPECT().Execute(command, suite.mockWriter).Return(10, errors.New("scan failed")).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.Action == audit.ActionExport
+	})).Return(nil, nil).Once()
+
+	// WHEN exporting
+	count, err := suite.useCase.Execute(command, suite.mockWriter)
+
+	// THEN the error should be returned and the failure recorded
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), 10, count)
+}
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getbycpf"
+)
+
+var (
+	_ getbycpf.GetByCpfUseCase = (*AuditedGetByCpfUseCase)(nil)
+)
+
+// AuditedGetByCpfUseCase records every lookup, including the ones that found no customer, so
+// CPF enumeration shows up in the entries of the actor.
+type AuditedGetByCpfUseCase struct {
+	next     getbycpf.GetByCpfUseCase
+	recorder recorder
+}
+
+func NewAuditedGetByCpfUseCase(next getbycpf.GetByCpfUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedGetByCpfUseCase {
+	return &AuditedGetByCpfUseCase{next: next, recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase}}
+}
+
+func (u *AuditedGetByCpfUseCase) Execute(command *commands.GetCustomerByCpfCommand) (*entities.Customer, error) {
+	customer, err := u.next.Execute(command)
+	u.recorder.record(command.Actor, ActionRead, customerID(customer), nil, err)
+	return customer, err
+}
@@ -0,0 +1,91 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockGetByCpf "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/getbycpf"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+var kioskActor = auditpkg.Actor{ID: "kiosk-1", Roles: []string{"kiosk"}, RequestID: "request-1"}
+
+type AuditedGetByCpfUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockGetByCpf.MockGetByCpfUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedGetByCpfUseCase
+}
+
+func (suite *AuditedGetByCpfUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockGetByCpf.NewMockGetByCpfUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedGetByCpfUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedGetByCpfUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedGetByCpfUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Every CPF lookup is recorded with its actor
+
+func (suite *AuditedGetByCpfUseCaseTestSuite) Test_GetByCpf_ShouldRecordReadOfFoundCustomer() {
+	// GIVEN a kiosk looking up a registered customer
+	command := commands.NewGetCustomerByCpfCommand("12345678900")
+	command.Actor = kioskActor
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678900"}
+	suite.mockNext.EXPECT().Execute(command).Return(customer, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Actor.ID == "kiosk-1" && cmd.Actor.RequestID == "request-1" &&
+			cmd.Action == audit.ActionRead && cmd.CustomerID == "customer-1" && cmd.Changes == nil && cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN looking the customer up
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the customer should be returned and the read recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), customer, result)
+}
+
+func (suite *AuditedGetByCpfUseCaseTestSuite) Test_GetByCpf_NotFound_ShouldRecordFailedRead() {
+	// GIVEN a CPF that is not registered
+	command := commands.NewGetCustomerByCpfCommand("00000000000")
+	command.Actor = kioskActor
+	suite.mockNext.EXPECT().Execute(command).Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.CustomerID == "" && !cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN looking it up
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failed read recorded
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
+
+func (suite *AuditedGetByCpfUseCaseTestSuite) Test_GetByCpf_WithAuditFailure_ShouldStillReturnCustomer() {
+	// GIVEN the audit table is unavailable
+	command := commands.NewGetCustomerByCpfCommand("12345678900")
+	customer := &entities.Customer{ID: "customer-1"}
+	suite.mockNext.EXPECT().Execute(command).Return(customer, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Return(nil, errors.New("dynamodb error")).Once()
+
+	// WHEN looking the customer up
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the lookup should not fail because of the audit log
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), customer, result)
+}
@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+)
+
+var (
+	_ getcustomerasof.GetCustomerAsOfUseCase = (*AuditedGetCustomerAsOfUseCase)(nil)
+)
+
+// AuditedGetCustomerAsOfUseCase records every past profile rebuilt.
+type AuditedGetCustomerAsOfUseCase struct {
+	next     getcustomerasof.GetCustomerAsOfUseCase
+	recorder recorder
+}
+
+func NewAuditedGetCustomerAsOfUseCase(next getcustomerasof.GetCustomerAsOfUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedGetCustomerAsOfUseCase {
+	return &AuditedGetCustomerAsOfUseCase{next: next, recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase}}
+}
+
+func (u *AuditedGetCustomerAsOfUseCase) Execute(command *commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error) {
+	revision, err := u.next.Execute(command)
+	u.recorder.record(command.Actor, ActionReadHistory, command.CustomerID, nil, err)
+	return revision, err
+}
@@ -0,0 +1,53 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockGetCustomerAsOf "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/getcustomerasof"
+)
+
+type AuditedGetCustomerAsOfUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockGetCustomerAsOf.MockGetCustomerAsOfUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedGetCustomerAsOfUseCase
+}
+
+func (suite *AuditedGetCustomerAsOfUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockGetCustomerAsOf.NewMockGetCustomerAsOfUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedGetCustomerAsOfUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedGetCustomerAsOfUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedGetCustomerAsOfUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Rebuilding a past profile is recorded, even when there was none
+
+func (suite *AuditedGetCustomerAsOfUseCaseTestSuite) Test_GetAsOf_NotFound_ShouldRecordFailedHistoryRead() {
+	// GIVEN no revision existed at the date
+	command := commands.NewGetCustomerAsOfCommand("customer-1", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	command.Actor = kioskActor
+	suite.mockNext.EXPECT().Execute(command).Return(nil, repositories.ErrRevisionNotFound).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Action == audit.ActionReadHistory && cmd.CustomerID == "customer-1" && !cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN rebuilding the profile
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failed read recorded
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, repositories.ErrRevisionNotFound)
+}
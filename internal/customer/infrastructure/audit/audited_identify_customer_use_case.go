@@ -0,0 +1,40 @@
+package audit
+
+import (
+	"time"
+
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/identify"
+)
+
+var (
+	_ identify.IdentifyCustomerUseCase = (*AuditedIdentifyCustomerUseCase)(nil)
+)
+
+// AuditedIdentifyCustomerUseCase records identifications at the kiosk, with the registered
+// fields when the customer was registered on the spot.
+type AuditedIdentifyCustomerUseCase struct {
+	next     identify.IdentifyCustomerUseCase
+	recorder recorder
+}
+
+func NewAuditedIdentifyCustomerUseCase(next identify.IdentifyCustomerUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedIdentifyCustomerUseCase {
+	return &AuditedIdentifyCustomerUseCase{next: next, recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase}}
+}
+
+func (u *AuditedIdentifyCustomerUseCase) Execute(command *commands.IdentifyCustomerCommand) (*entities.Customer, error) {
+	startedAt := time.Now()
+	customer, err := u.next.Execute(command)
+
+	// A customer created during this invocation was registered by it.
+	var registered []*auditCommands.FieldChange
+	if err == nil && command.AutoRegister && !customer.CreatedAt.Before(startedAt) {
+		registered = changes(nil, customer)
+	}
+	u.recorder.record(command.Actor, ActionIdentify, customerID(customer), registered, err)
+
+	return customer, err
+}
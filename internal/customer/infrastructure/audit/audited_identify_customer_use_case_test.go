@@ -0,0 +1,69 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockIdentify "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/identify"
+)
+
+type AuditedIdentifyCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockIdentify.MockIdentifyCustomerUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedIdentifyCustomerUseCase
+}
+
+func (suite *AuditedIdentifyCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockIdentify.NewMockIdentifyCustomerUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedIdentifyCustomerUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedIdentifyCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedIdentifyCustomerUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Identifications are recorded, with the registered fields when the kiosk registered the customer
+
+func (suite *AuditedIdentifyCustomerUseCaseTestSuite) Test_Identify_ExistingCustomer_ShouldRecordWithoutChanges() {
+	// GIVEN a customer registered long ago
+	command := commands.NewIdentifyCustomerCommand("12345678900", true, "John Doe", "")
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678900", CreatedAt: time.Now().Add(-24 * time.Hour)}
+	suite.mockNext.EXPECT().Execute(command).Return(customer, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Action == audit.ActionIdentify && cmd.CustomerID == "customer-1" && cmd.Changes == nil
+	})).Return(nil, nil).Once()
+
+	// WHEN identifying the customer
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the identification should be recorded without changes
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *AuditedIdentifyCustomerUseCaseTestSuite) Test_Identify_AutoRegistered_ShouldRecordRegisteredFields() {
+	// GIVEN a customer registered by this identification
+	command := commands.NewIdentifyCustomerCommand("12345678900", true, "John Doe", "")
+	suite.mockNext.EXPECT().Execute(command).RunAndReturn(func(*commands.IdentifyCustomerCommand) (*entities.Customer, error) {
+		return &entities.Customer{ID: "customer-1", CPF: "12345678900", Name: "John Doe", CreatedAt: time.Now()}, nil
+	}).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return len(cmd.Changes) == 2 && findChange(cmd.Changes, "name") != nil && findChange(cmd.Changes, "email") == nil
+	})).Return(nil, nil).Once()
+
+	// WHEN identifying the customer
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the registered fields should be recorded
+	assert.NoError(suite.T(), err)
+}
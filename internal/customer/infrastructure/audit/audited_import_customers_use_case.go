@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+)
+
+var (
+	_ importcustomers.ImportCustomersUseCase = (*AuditedImportCustomersUseCase)(nil)
+)
+
+// AuditedImportCustomersUseCase records every customer an import creates, like a single
+// registration. Rows that were not created touched no customer and are left to the report.
+type AuditedImportCustomersUseCase struct {
+	next     importcustomers.ImportCustomersUseCase
+	recorder recorder
+}
+
+func NewAuditedImportCustomersUseCase(next importcustomers.ImportCustomersUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedImportCustomersUseCase {
+	return &AuditedImportCustomersUseCase{
+		next:     next,
+		recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedImportCustomersUseCase) Execute(command *commands.ImportCustomersCommand) (*entities.ImportReport, error) {
+	report, err := u.next.Execute(command)
+	if err != nil {
+		u.recorder.record(command.Actor, ActionImport, "", nil, err)
+		return report, err
+	}
+
+	rows := make(map[int]*commands.ImportCustomerRow, len(command.Rows))
+	for _, row := range command.Rows {
+		rows[row.Line] = row
+	}
+	for _, result := range report.Rows {
+		row, ok := rows[result.Line]
+		if result.Status != entities.ImportCreated || !ok {
+			continue
+		}
+		// Created rows passed validation, so the CPF normalizes as it was stored.
+		cpf, _ := entities.NormalizeCPF(row.CPF)
+		added := &entities.Customer{CPF: cpf, Name: strings.TrimSpace(row.Name), Email: strings.TrimSpace(row.Email)}
+		u.recorder.record(command.Actor, ActionImport, result.CustomerID, changes(nil, added), nil)
+	}
+
+	return report, nil
+}
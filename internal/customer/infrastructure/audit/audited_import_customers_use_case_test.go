@@ -0,0 +1,84 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockImportCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/importcustomers"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedImportCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockImportCustomers.MockImportCustomersUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedImportCustomersUseCase
+}
+
+func (suite *AuditedImportCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockImportCustomers.NewMockImportCustomersUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedImportCustomersUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedImportCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedImportCustomersUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Every customer created by an import is recorded
+
+func (suite *AuditedImportCustomersUseCaseTestSuite) Test_Import_ShouldRecordCreatedCustomersOnly() {
+	// GIVEN an import creating one customer and rejecting another
+	command := commands.NewImportCustomersCommand([]*commands.ImportCustomerRow{
+		{Line: 2, Name: "Jane Doe", CPF: "529.982.247-25"},
+		{Line: 3, CPF: "123"},
+	})
+	command.Actor = auditpkg.System()
+	suite.mockNext.EXPECT().Execute(command).Return(&entities.ImportReport{
+		Created: 1,
+		Invalid: 1,
+		Rows: []*entities.ImportRowResult{
+			{Line: 2, Status: entities.ImportCreated, CustomerID: "customer-1"},
+			{Line: 3, Status: entities.ImportInvalid, Reason: "invalid cpf"},
+		},
+	}, nil).Once()
+	var recorded *auditCommands.RecordAuditEntryCommand
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Run(func(cmd *auditCommands.RecordAuditEntryCommand) {
+		recorded = cmd
+	}).Return(nil, nil).Once()
+
+	// WHEN importing
+	report, err := suite.useCase.Execute(command)
+
+	// THEN only the created customer should be recorded, with the CPF as stored
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), audit.ActionImport, recorded.Action)
+	assert.Equal(suite.T(), "customer-1", recorded.CustomerID)
+	assert.Equal(suite.T(), auditpkg.System(), recorded.Actor)
+	assert.Equal(suite.T(), "52998224725", findChange(recorded.Changes, "cpf").New)
+}
+
+func (suite *AuditedImportCustomersUseCaseTestSuite) Test_Import_WithError_ShouldRecordFailure() {
+	// GIVEN the import is rejected as a whole
+	command := commands.NewImportCustomersCommand(nil)
+	suite.mockNext.EXPECT().Execute(command).Return(nil, importcustomers.ErrTooManyRows).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.Action == audit.ActionImport && cmd.CustomerID == ""
+	})).Return(nil, nil).Once()
+
+	// WHEN importing
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failure recorded
+	assert.ErrorIs(suite.T(), err, importcustomers.ErrTooManyRows)
+}
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/listcustomerhistory"
+)
+
+var (
+	_ listcustomerhistory.ListCustomerHistoryUseCase = (*AuditedListCustomerHistoryUseCase)(nil)
+)
+
+// AuditedListCustomerHistoryUseCase records every page of past profiles read, since each one
+// exposes the personal data the customer had.
+type AuditedListCustomerHistoryUseCase struct {
+	next     listcustomerhistory.ListCustomerHistoryUseCase
+	recorder recorder
+}
+
+func NewAuditedListCustomerHistoryUseCase(next listcustomerhistory.ListCustomerHistoryUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedListCustomerHistoryUseCase {
+	return &AuditedListCustomerHistoryUseCase{next: next, recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase}}
+}
+
+func (u *AuditedListCustomerHistoryUseCase) Execute(command *commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error) {
+	page, err := u.next.Execute(command)
+	u.recorder.record(command.Actor, ActionReadHistory, command.CustomerID, nil, err)
+	return page, err
+}
@@ -0,0 +1,55 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockListCustomerHistory "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/listcustomerhistory"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedListCustomerHistoryUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockListCustomerHistory.MockListCustomerHistoryUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedListCustomerHistoryUseCase
+}
+
+func (suite *AuditedListCustomerHistoryUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockListCustomerHistory.NewMockListCustomerHistoryUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedListCustomerHistoryUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedListCustomerHistoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedListCustomerHistoryUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Reading the history of a customer is recorded
+
+func (suite *AuditedListCustomerHistoryUseCaseTestSuite) Test_ListHistory_ShouldRecordHistoryRead() {
+	// GIVEN staff listing the revisions of a customer
+	command := commands.NewListCustomerHistoryCommand("customer-1", 20, "")
+	command.Actor = auditpkg.Actor{ID: "staff-1", Roles: []string{"staff"}}
+	page := &entities.CustomerHistoryPage{}
+	suite.mockNext.EXPECT().Execute(command).Return(page, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Actor.ID == "staff-1" && cmd.Action == audit.ActionReadHistory &&
+			cmd.CustomerID == "customer-1" && cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN listing the history
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the page should be returned and the read recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
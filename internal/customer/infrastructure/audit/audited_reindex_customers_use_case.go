@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/reindexcustomers"
+)
+
+var (
+	_ reindexcustomers.ReindexCustomersUseCase = (*AuditedReindexCustomersUseCase)(nil)
+)
+
+// AuditedReindexCustomersUseCase records a single entry per reindex. The data of the customers
+// does not change, so there are no changes to record.
+type AuditedReindexCustomersUseCase struct {
+	next     reindexcustomers.ReindexCustomersUseCase
+	recorder recorder
+}
+
+func NewAuditedReindexCustomersUseCase(next reindexcustomers.ReindexCustomersUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedReindexCustomersUseCase {
+	return &AuditedReindexCustomersUseCase{
+		next:     next,
+		recorder: recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedReindexCustomersUseCase) Execute(command *commands.ReindexCustomersCommand) (*entities.ReindexReport, error) {
+	report, err := u.next.Execute(command)
+	u.recorder.record(command.Actor, ActionReindex, "", nil, err)
+	return report, err
+}
@@ -0,0 +1,69 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockReindexCustomers "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/reindexcustomers"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedReindexCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockReindexCustomers.MockReindexCustomersUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedReindexCustomersUseCase
+}
+
+func (suite *AuditedReindexCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockReindexCustomers.NewMockReindexCustomersUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedReindexCustomersUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedReindexCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedReindexCustomersUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Every reindex of the customer table is recorded once
+
+func (suite *AuditedReindexCustomersUseCaseTestSuite) Test_Reindex_ShouldRecordOneEntry() {
+	// GIVEN a reindex run by an operator
+	command := commands.NewReindexCustomersCommand(4)
+	command.Actor = auditpkg.System()
+	suite.mockNext.EXPECT().Execute(command).Return(&entities.ReindexReport{Scanned: 2, Encrypted: 1}, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Succeeded && cmd.Action == audit.ActionReindex && cmd.CustomerID == "" && cmd.Actor.ID == auditpkg.System().ID
+	})).Return(nil, nil).Once()
+
+	// WHEN reindexing
+	report, err := suite.useCase.Execute(command)
+
+	// THEN the report should be returned and a single entry recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Encrypted)
+}
+
+func (suite *AuditedReindexCustomersUseCaseTestSuite) Test_Reindex_WithError_ShouldRecordFailure() {
+	// GIVEN a segment fails
+	command := commands.NewReindexCustomersCommand(4)
+	suite.mockNext.EXPECT().Execute(command).Return(&entities.ReindexReport{}, errors.New("throttled")).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return !cmd.Succeeded && cmd.Action == audit.ActionReindex
+	})).Return(nil, nil).Once()
+
+	// WHEN reindexing
+	_, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failure recorded
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/updatecustomer"
+)
+
+var (
+	_ updatecustomer.UpdateCustomerUseCase = (*AuditedUpdateCustomerUseCase)(nil)
+)
+
+// AuditedUpdateCustomerUseCase records updates with the old and new value of every changed field.
+type AuditedUpdateCustomerUseCase struct {
+	next               updatecustomer.UpdateCustomerUseCase
+	customerRepository repositories.CustomerRepository
+	recorder           recorder
+}
+
+func NewAuditedUpdateCustomerUseCase(next updatecustomer.UpdateCustomerUseCase, customerRepository repositories.CustomerRepository, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedUpdateCustomerUseCase {
+	return &AuditedUpdateCustomerUseCase{
+		next:               next,
+		customerRepository: customerRepository,
+		recorder:           recorder{recordAuditEntryUseCase: recordAuditEntryUseCase},
+	}
+}
+
+func (u *AuditedUpdateCustomerUseCase) Execute(command *commands.UpdateCustomerCommand) (*entities.Customer, error) {
+	before, _ := u.customerRepository.GetByID(command.CustomerID)
+
+	updated, err := u.next.Execute(command)
+
+	var changed []*auditCommands.FieldChange
+	if err == nil {
+		changed = changes(before, updated)
+	}
+	u.recorder.record(command.Actor, ActionUpdate, command.CustomerID, changed, err)
+
+	return updated, err
+}
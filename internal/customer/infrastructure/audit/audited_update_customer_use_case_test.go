@@ -0,0 +1,61 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+	mockUpdateCustomer "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/usecase/updatecustomer"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedUpdateCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockNext       *mockUpdateCustomer.MockUpdateCustomerUseCase
+	mockRepository *mockRepositories.MockCustomerRepository
+	mockRecord     *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase        *audit.AuditedUpdateCustomerUseCase
+}
+
+func (suite *AuditedUpdateCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockUpdateCustomer.NewMockUpdateCustomerUseCase(suite.T())
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedUpdateCustomerUseCase(suite.mockNext, suite.mockRepository, suite.mockRecord)
+}
+
+func TestAuditedUpdateCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedUpdateCustomerUseCaseTestSuite))
+}
+
+// Feature: Customer Audit
+// Scenario: Updates are recorded with the old and new value of the changed fields only
+
+func (suite *AuditedUpdateCustomerUseCaseTestSuite) Test_Update_ShouldRecordChangedFieldsOnly() {
+	// GIVEN staff changing the email of a customer
+	command := commands.NewUpdateCustomerCommand("customer-1", "", "new@example.com")
+	command.Actor = auditpkg.Actor{ID: "staff-1", Roles: []string{"staff"}}
+	before := &entities.Customer{ID: "customer-1", CPF: "12345678900", Name: "John Doe", Email: "old@example.com"}
+	after := &entities.Customer{ID: "customer-1", CPF: "12345678900", Name: "John Doe", Email: "new@example.com"}
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(before, nil).Once()
+	suite.mockNext.EXPECT().Execute(command).Return(after, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Actor.ID == "staff-1" && cmd.Action == audit.ActionUpdate && cmd.Succeeded &&
+			len(cmd.Changes) == 1 &&
+			*cmd.Changes[0] == auditCommands.FieldChange{Field: "email", Old: "old@example.com", New: "new@example.com", Sensitive: true}
+	})).Return(nil, nil).Once()
+
+	// WHEN updating it
+	result, err := suite.useCase.Execute(command)
+
+	// THEN only the email change should be recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), after, result)
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"log"
+	"strconv"
+
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+// Actions recorded in the audit log for the customer use cases.
+const (
+	ActionRead        = "customer.read"
+	ActionCreate      = "customer.create"
+	ActionIdentify    = "customer.identify"
+	ActionCreateGuest = "customer.create_guest"
+	ActionClaim       = "customer.claim"
+	ActionUpdate      = "customer.update"
+	ActionErase       = "customer.erase"
+	ActionImport      = "customer.import"
+	// Exports touch every customer, so they are one entry without a customer ID. A masked export
+	// holds no personal data and is told apart from a full dump.
+	ActionExport       = "customer.export"
+	ActionExportMasked = "customer.export_masked"
+	// ActionReindex moves the stored customers to the current encryption key, also one entry.
+	ActionReindex = "customer.reindex"
+	// ActionReadHistory covers both listing the revisions and rebuilding a past profile.
+	ActionReadHistory = "customer.read_history"
+)
+
+// recorder appends the entries of the audited use cases. Failing to record an entry is logged
+// instead of failing the invocation, so an outage of the audit table does not stop the kiosks.
+type recorder struct {
+	recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase
+}
+
+func (r recorder) record(actor auditpkg.Actor, action string, customerID string, changes []*auditCommands.FieldChange, err error) {
+	command := auditCommands.NewRecordAuditEntryCommand(actor, action, customerID, changes, err == nil)
+	if _, recordErr := r.recordAuditEntryUseCase.Execute(command); recordErr != nil {
+		log.Printf("Warning: failed to record audit entry %s of customer %q by %s: %v\n", action, customerID, actor.ID, recordErr)
+	}
+}
+
+// changes lists the fields that differ between two versions of a customer, where nil stands
+// for a customer that does not exist (before a registration or after an erasure). Personal
+// data is marked sensitive so only its hash reaches the log.
+func changes(before *entities.Customer, after *entities.Customer) []*auditCommands.FieldChange {
+	var old, updated entities.Customer
+	if before != nil {
+		old = *before
+	}
+	if after != nil {
+		updated = *after
+	}
+
+	fields := []*auditCommands.FieldChange{
+		{Field: "cpf", Old: old.CPF, New: updated.CPF, Sensitive: true},
+		{Field: "name", Old: old.Name, New: updated.Name, Sensitive: true},
+		{Field: "email", Old: old.Email, New: updated.Email, Sensitive: true},
+		{Field: "nickname", Old: old.Nickname, New: updated.Nickname, Sensitive: true},
+	}
+	// Guest is only a change when a guest is claimed; on creation and erasure the other fields tell the story.
+	if before != nil && after != nil {
+		fields = append(fields, &auditCommands.FieldChange{Field: "guest", Old: strconv.FormatBool(old.Guest), New: strconv.FormatBool(updated.Guest)})
+	}
+
+	var changed []*auditCommands.FieldChange
+	for _, field := range fields {
+		if field.Old != field.New {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+func customerID(customer *entities.Customer) string {
+	if customer == nil {
+		return ""
+	}
+	return customer.ID
+}
@@ -0,0 +1,286 @@
+package cache
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"sync/atomic"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	cachepkg "github.com/viniciuscluna/tc-fiap-customer/pkg/cache"
+)
+
+var (
+	_ repositories.CustomerRepository = (*CachedCustomerRepository)(nil)
+)
+
+// cached is what is kept for a CPF: the customer, or nil when no customer has it.
+type cached struct {
+	customer *entities.Customer
+}
+
+// CachedCustomerRepository answers CPF lookups from an in-process cache, as the kiosks and the
+// order service look the same CPFs up again and again. Concurrent misses of a CPF share a single
+// read, CPFs without a customer are remembered for the shorter negative TTL, and every write
+// drops the CPFs it touches.
+//
+// Without a shared cache, writes made by other replicas are only seen once the entries expire.
+// With one, misses are looked up there before the repository, and writes are broadcast so every
+// replica drops the CPFs from its own cache. A failing shared cache is skipped, and while the
+// broadcasts cannot be received the entries again only go away when they expire.
+type CachedCustomerRepository struct {
+	next    repositories.CustomerRepository
+	config  cachepkg.Config
+	entries *cachepkg.LRU[string, cached]
+	loads   cachepkg.Group[string, *entities.Customer]
+	shared  *SharedCustomerCache
+	stop    func()
+
+	// generation changes on every invalidation, so a read that started before a write does not
+	// store what it read after the write dropped the entry.
+	mu         sync.Mutex
+	generation uint64
+
+	hits          atomic.Int64
+	negativeHits  atomic.Int64
+	misses        atomic.Int64
+	coalesced     atomic.Int64
+	invalidations atomic.Int64
+
+	sharedHits          atomic.Int64
+	sharedErrors        atomic.Int64
+	remoteInvalidations atomic.Int64
+}
+
+// NewCachedCustomerRepository caches in process only when shared is nil.
+func NewCachedCustomerRepository(next repositories.CustomerRepository, config cachepkg.Config, shared *SharedCustomerCache) *CachedCustomerRepository {
+	return &CachedCustomerRepository{next: next, config: config, entries: cachepkg.NewLRU[string, cached](config.Size), shared: shared}
+}
+
+// Start listens to the invalidations broadcast by every replica. It does nothing without a shared cache.
+func (r *CachedCustomerRepository) Start() error {
+	if r.shared == nil {
+		return nil
+	}
+	stop, err := r.shared.Subscribe(func(keys []string) {
+		r.remoteInvalidations.Add(1)
+		r.drop(keys)
+	})
+	if err != nil {
+		return err
+	}
+	r.stop = stop
+	return nil
+}
+
+func (r *CachedCustomerRepository) Stop() {
+	if r.stop != nil {
+		r.stop()
+	}
+}
+
+// GetByCpf reads the repository with the normalized CPF, the one the entry is cached under, so a
+// punctuated lookup finds the same customer as the bare CPF.
+func (r *CachedCustomerRepository) GetByCpf(cpf string) (*entities.Customer, error) {
+	cpf = normalizeCPF(cpf)
+	key := r.key(cpf)
+	if value, ok := r.entries.Get(key); ok {
+		if value.customer == nil {
+			r.negativeHits.Add(1)
+			return nil, repositories.ErrCustomerNotFound
+		}
+		r.hits.Add(1)
+		return copyCustomer(value.customer), nil
+	}
+
+	customer, err, shared := r.loads.Do(key, func() (*entities.Customer, error) {
+		return r.load(key, cpf)
+	})
+	if shared {
+		r.coalesced.Add(1)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Callers fill in the tier and change what they read, so each one gets its own copy.
+	return copyCustomer(customer), nil
+}
+
+func (r *CachedCustomerRepository) load(key string, cpf string) (*entities.Customer, error) {
+	r.mu.Lock()
+	generation := r.generation
+	r.mu.Unlock()
+
+	if r.shared != nil {
+		value, found, err := r.shared.Get(key)
+		if err != nil {
+			r.sharedErrors.Add(1)
+		}
+		if found {
+			r.sharedHits.Add(1)
+			r.store(generation, key, value, false)
+			if value.customer == nil {
+				return nil, repositories.ErrCustomerNotFound
+			}
+			return value.customer, nil
+		}
+	}
+
+	r.misses.Add(1)
+	customer, err := r.next.GetByCpf(cpf)
+	switch {
+	case err == nil:
+		r.store(generation, key, cached{customer: copyCustomer(customer)}, true)
+	case errors.Is(err, repositories.ErrCustomerNotFound) && r.config.NegativeTTL > 0:
+		r.store(generation, key, cached{}, true)
+	}
+	return customer, err
+}
+
+// store keeps what was read unless a write happened meanwhile, in the shared cache too when it
+// was read from the repository.
+func (r *CachedCustomerRepository) store(generation uint64, key string, value cached, share bool) {
+	ttl := r.config.TTL
+	if value.customer == nil {
+		ttl = r.config.NegativeTTL
+	}
+
+	r.mu.Lock()
+	current := generation == r.generation
+	if current {
+		r.entries.Set(key, value, ttl)
+	}
+	r.mu.Unlock()
+
+	if current && share && r.shared != nil {
+		if err := r.shared.Set(key, value, ttl); err != nil {
+			r.sharedErrors.Add(1)
+		}
+	}
+}
+
+// invalidate drops the CPFs of the customers a write touched, found or not, here and, through the
+// shared cache, in every replica.
+func (r *CachedCustomerRepository) invalidate(customers ...*entities.Customer) {
+	keys := make([]string, 0, len(customers))
+	for _, customer := range customers {
+		if customer != nil && customer.CPF != "" {
+			keys = append(keys, r.key(customer.CPF))
+		}
+	}
+	r.drop(keys)
+
+	if r.shared != nil {
+		if err := r.shared.Invalidate(keys); err != nil {
+			r.sharedErrors.Add(1)
+			log.Printf("Warning: failed to broadcast the invalidation of %d cached customers: %v\n", len(keys), err)
+		}
+	}
+}
+
+func (r *CachedCustomerRepository) drop(keys []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+	for _, key := range keys {
+		if r.entries.Delete(key) {
+			r.invalidations.Add(1)
+		}
+	}
+}
+
+func (r *CachedCustomerRepository) GetByID(id string) (*entities.Customer, error) {
+	return r.next.GetByID(id)
+}
+
+func (r *CachedCustomerRepository) FindByEmail(email string) ([]*entities.Customer, error) {
+	return r.next.FindByEmail(email)
+}
+
+func (r *CachedCustomerRepository) Add(customer *entities.Customer) error {
+	defer r.invalidate(customer)
+	return r.next.Add(customer)
+}
+
+func (r *CachedCustomerRepository) Claim(customer *entities.Customer) error {
+	defer r.invalidate(customer)
+	return r.next.Claim(customer)
+}
+
+func (r *CachedCustomerRepository) Update(customer *entities.Customer) error {
+	defer r.invalidate(customer)
+	return r.next.Update(customer)
+}
+
+func (r *CachedCustomerRepository) Erase(customer *entities.Customer) error {
+	defer r.invalidate(customer)
+	return r.next.Erase(customer)
+}
+
+func (r *CachedCustomerRepository) FindRegisteredCPFs(cpfs []string) (map[string]bool, error) {
+	return r.next.FindRegisteredCPFs(cpfs)
+}
+
+func (r *CachedCustomerRepository) AddBatch(customers []*entities.Customer) []error {
+	defer r.invalidate(customers...)
+	return r.next.AddBatch(customers)
+}
+
+func (r *CachedCustomerRepository) Scan(segment int, totalSegments int, visit func(customer *entities.Customer) error) error {
+	return r.next.Scan(segment, totalSegments, visit)
+}
+
+// Reindex leaves the data of the customers unchanged, so the cached entries stay valid.
+func (r *CachedCustomerRepository) Reindex(segment int, totalSegments int) (*entities.ReindexReport, error) {
+	return r.next.Reindex(segment, totalSegments)
+}
+
+// Metrics counts the lookups answered from the cache, as hits or negative hits, the ones answered
+// by the shared cache, as shared hits, the ones read from the repository, as misses, and the ones
+// that waited for a read already in flight, as coalesced, along with the entries dropped by
+// writes, the invalidations received from the shared cache, the failed calls to it and the
+// entries evicted to make room.
+func (r *CachedCustomerRepository) Metrics() map[string]int64 {
+	return map[string]int64{
+		"hits":                 r.hits.Load(),
+		"negative_hits":        r.negativeHits.Load(),
+		"shared_hits":          r.sharedHits.Load(),
+		"misses":               r.misses.Load(),
+		"coalesced":            r.coalesced.Load(),
+		"invalidations":        r.invalidations.Load(),
+		"remote_invalidations": r.remoteInvalidations.Load(),
+		"shared_errors":        r.sharedErrors.Load(),
+		"evictions":            r.entries.Evictions(),
+		"entries":              int64(r.entries.Len()),
+	}
+}
+
+// key normalizes the CPF, so punctuated and bare forms of a CPF share an entry and writes, which
+// carry the bare form, drop it. With a shared cache the key is the one of the shared cache, which
+// the broadcasts carry.
+func (r *CachedCustomerRepository) key(cpf string) string {
+	cpf = normalizeCPF(cpf)
+	if r.shared != nil {
+		return r.shared.Key(cpf)
+	}
+	return cpf
+}
+
+// normalizeCPF leaves what is not a valid CPF as it is, for the repository to answer.
+func normalizeCPF(cpf string) string {
+	if normalized, err := entities.NormalizeCPF(cpf); err == nil {
+		return normalized
+	}
+	return cpf
+}
+
+func copyCustomer(customer *entities.Customer) *entities.Customer {
+	copied := *customer
+	if customer.ClaimedAt != nil {
+		claimedAt := *customer.ClaimedAt
+		copied.ClaimedAt = &claimedAt
+	}
+	return &copied
+}
@@ -0,0 +1,287 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/cache"
+	cachepkg "github.com/viniciuscluna/tc-fiap-customer/pkg/cache"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+const cpf = "12345678909"
+
+type CachedCustomerRepositoryTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	repository     *cache.CachedCustomerRepository
+	customer       *entities.Customer
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.repository = cache.NewCachedCustomerRepository(suite.mockRepository, cachepkg.Config{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute}, nil)
+	suite.customer = &entities.Customer{ID: "customer-1", CPF: cpf, Name: "Maria Souza", Email: "maria@example.com"}
+}
+
+func TestCachedCustomerRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedCustomerRepositoryTestSuite))
+}
+
+// Feature: Customer Cache
+// Scenario: Repeated lookups are answered from the cache
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldReadRepositoryOnce() {
+	// GIVEN a registered customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+
+	// WHEN looking the CPF up twice, once punctuated
+	first, firstErr := suite.repository.GetByCpf(cpf)
+	second, secondErr := suite.repository.GetByCpf("123.456.789-09")
+
+	// THEN the second lookup should be a hit
+	assert.NoError(suite.T(), firstErr)
+	assert.NoError(suite.T(), secondErr)
+	assert.Equal(suite.T(), suite.customer, first)
+	assert.Equal(suite.T(), suite.customer, second)
+	assert.Equal(suite.T(), int64(1), suite.repository.Metrics()["misses"])
+	assert.Equal(suite.T(), int64(1), suite.repository.Metrics()["hits"])
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldReturnCopies() {
+	// GIVEN a cached customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	first, _ := suite.repository.GetByCpf(cpf)
+
+	// WHEN a caller changes what it read
+	first.Tier = "gold"
+	first.Name = "Changed"
+
+	// THEN the next caller should not see the change
+	second, _ := suite.repository.GetByCpf(cpf)
+	assert.Equal(suite.T(), "Maria Souza", second.Name)
+	assert.Empty(suite.T(), second.Tier)
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_WithPunctuatedCPF_ShouldNotHideBareCPF() {
+	// GIVEN a registered customer, which the repository finds by the bare CPF only
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+
+	// WHEN looking the CPF up punctuated, then bare
+	punctuated, punctuatedErr := suite.repository.GetByCpf("123.456.789-09")
+	bare, bareErr := suite.repository.GetByCpf(cpf)
+
+	// THEN both lookups should find the customer instead of caching a miss
+	assert.NoError(suite.T(), punctuatedErr)
+	assert.NoError(suite.T(), bareErr)
+	assert.Equal(suite.T(), suite.customer, punctuated)
+	assert.Equal(suite.T(), suite.customer, bare)
+	assert.Zero(suite.T(), suite.repository.Metrics()["negative_hits"])
+}
+
+// Scenario: CPFs without a customer are remembered for the negative TTL
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldCacheNotFound() {
+	// GIVEN a CPF without a customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Once()
+
+	// WHEN looking it up twice
+	_, firstErr := suite.repository.GetByCpf(cpf)
+	_, secondErr := suite.repository.GetByCpf(cpf)
+
+	// THEN the second lookup should be a negative hit
+	assert.ErrorIs(suite.T(), firstErr, repositories.ErrCustomerNotFound)
+	assert.ErrorIs(suite.T(), secondErr, repositories.ErrCustomerNotFound)
+	assert.Equal(suite.T(), int64(1), suite.repository.Metrics()["negative_hits"])
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_WithoutNegativeTTL_ShouldNotCacheNotFound() {
+	// GIVEN negative caching disabled
+	repository := cache.NewCachedCustomerRepository(suite.mockRepository, cachepkg.Config{Size: 10, TTL: time.Minute}, nil)
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Twice()
+
+	// WHEN looking a CPF without a customer up twice
+	repository.GetByCpf(cpf)
+	_, err := repository.GetByCpf(cpf)
+
+	// THEN both lookups should read the repository
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Equal(suite.T(), int64(2), repository.Metrics()["misses"])
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldNotCacheFailures() {
+	// GIVEN a repository failing once
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, errors.New("throttled")).Once()
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+
+	// WHEN looking the CPF up twice
+	_, firstErr := suite.repository.GetByCpf(cpf)
+	customer, secondErr := suite.repository.GetByCpf(cpf)
+
+	// THEN the failure should not be remembered
+	assert.Error(suite.T(), firstErr)
+	assert.NoError(suite.T(), secondErr)
+	assert.Equal(suite.T(), suite.customer, customer)
+}
+
+// Scenario: Concurrent misses share a single read
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldCoalesceConcurrentMisses() {
+	// GIVEN a slow read
+	release := make(chan struct{})
+	suite.mockRepository.EXPECT().GetByCpf(cpf).
+		RunAndReturn(func(string) (*entities.Customer, error) {
+			<-release
+			return suite.customer, nil
+		}).Once()
+
+	// WHEN many lookups of the CPF arrive while it is in flight
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			customer, err := suite.repository.GetByCpf(cpf)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), suite.customer, customer)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// THEN the repository should be read once
+	metrics := suite.repository.Metrics()
+	assert.Equal(suite.T(), int64(1), metrics["misses"])
+	assert.Equal(suite.T(), int64(10), metrics["misses"]+metrics["coalesced"]+metrics["hits"])
+}
+
+// Scenario: Writes drop the CPFs they touch
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_Add_ShouldDropNegativeEntry() {
+	// GIVEN a CPF remembered as not found
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.repository.GetByCpf(cpf)
+
+	// WHEN a customer is registered with it
+	suite.mockRepository.EXPECT().Add(suite.customer).Return(nil).Once()
+	err := suite.repository.Add(suite.customer)
+
+	// THEN the next lookup should find the customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	customer, lookupErr := suite.repository.GetByCpf(cpf)
+	assert.NoError(suite.T(), err)
+	assert.NoError(suite.T(), lookupErr)
+	assert.Equal(suite.T(), suite.customer, customer)
+	assert.Equal(suite.T(), int64(1), suite.repository.Metrics()["invalidations"])
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_Update_ShouldDropEntry() {
+	// GIVEN a cached customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.repository.GetByCpf(cpf)
+
+	// WHEN the customer is updated
+	updated := *suite.customer
+	updated.Name = "Maria Lima"
+	suite.mockRepository.EXPECT().Update(&updated).Return(nil).Once()
+	suite.repository.Update(&updated)
+
+	// THEN the next lookup should read the update
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(&updated, nil).Once()
+	customer, _ := suite.repository.GetByCpf(cpf)
+	assert.Equal(suite.T(), "Maria Lima", customer.Name)
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_Erase_ShouldDropEntryEvenWhenItFails() {
+	// GIVEN a cached customer
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.repository.GetByCpf(cpf)
+
+	// WHEN erasing the customer fails
+	suite.mockRepository.EXPECT().Erase(suite.customer).Return(errors.New("conditional check failed")).Once()
+	err := suite.repository.Erase(suite.customer)
+
+	// THEN the entry should be dropped anyway, as the write may have happened
+	assert.Error(suite.T(), err)
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Once()
+	_, lookupErr := suite.repository.GetByCpf(cpf)
+	assert.ErrorIs(suite.T(), lookupErr, repositories.ErrCustomerNotFound)
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_AddBatch_ShouldDropEveryCPF() {
+	// GIVEN two CPFs remembered as not found
+	other := &entities.Customer{ID: "customer-2", CPF: "52998224725"}
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockRepository.EXPECT().GetByCpf(other.CPF).Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.repository.GetByCpf(cpf)
+	suite.repository.GetByCpf(other.CPF)
+
+	// WHEN both are imported
+	customers := []*entities.Customer{suite.customer, other}
+	suite.mockRepository.EXPECT().AddBatch(customers).Return([]error{nil, nil}).Once()
+	suite.repository.AddBatch(customers)
+
+	// THEN both entries should be dropped
+	assert.Equal(suite.T(), int64(2), suite.repository.Metrics()["invalidations"])
+	assert.Equal(suite.T(), int64(0), suite.repository.Metrics()["entries"])
+}
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ReadStartedBeforeWrite_ShouldNotBeCached() {
+	// GIVEN a lookup reading the old data while the customer is updated
+	updated := *suite.customer
+	updated.Name = "Maria Lima"
+	suite.mockRepository.EXPECT().Update(&updated).Return(nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf(cpf).
+		RunAndReturn(func(string) (*entities.Customer, error) {
+			suite.repository.Update(&updated)
+			return suite.customer, nil
+		}).Once()
+	suite.repository.GetByCpf(cpf)
+
+	// WHEN looking the CPF up again
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(&updated, nil).Once()
+	customer, _ := suite.repository.GetByCpf(cpf)
+
+	// THEN the old data should not have been kept
+	assert.Equal(suite.T(), "Maria Lima", customer.Name)
+}
+
+// Scenario: The least recently used entries are evicted to make room
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByCpf_ShouldEvictWhenFull() {
+	// GIVEN a cache of a single entry
+	repository := cache.NewCachedCustomerRepository(suite.mockRepository, cachepkg.Config{Size: 1, TTL: time.Minute}, nil)
+	other := &entities.Customer{ID: "customer-2", CPF: "52998224725"}
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf(other.CPF).Return(other, nil).Once()
+
+	// WHEN looking two CPFs up
+	repository.GetByCpf(cpf)
+	repository.GetByCpf(other.CPF)
+
+	// THEN the first one should be evicted
+	assert.Equal(suite.T(), int64(1), repository.Metrics()["evictions"])
+	assert.Equal(suite.T(), int64(1), repository.Metrics()["entries"])
+}
+
+// Scenario: Other reads go to the repository
+
+func (suite *CachedCustomerRepositoryTestSuite) Test_GetByID_ShouldNotBeCached() {
+	// GIVEN a registered customer
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(suite.customer, nil).Twice()
+
+	// WHEN reading it by ID twice
+	suite.repository.GetByID("customer-1")
+	customer, err := suite.repository.GetByID("customer-1")
+
+	// THEN both reads should reach the repository
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.customer, customer)
+}
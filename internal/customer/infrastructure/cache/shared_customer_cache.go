@@ -0,0 +1,200 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	cachepkg "github.com/viniciuscluna/tc-fiap-customer/pkg/cache"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+const (
+	// InvalidationChannel carries the keys of the customers a replica wrote.
+	InvalidationChannel = "customer-cache-invalidations"
+
+	keyPrefix = "customer:cpf:"
+	// cpfKeyDomain differs from the domain of the CPF index of the table, so the keys of the
+	// shared cache cannot be matched with the items of the table.
+	cpfKeyDomain = "customer.cache.cpf"
+	// maxOpenedKeys bounds the data keys of other replicas kept decrypted.
+	maxOpenedKeys = 64
+)
+
+// sharedEntry is what the shared cache keeps for a CPF. Customer is the customer sealed under the
+// data key of the replica that stored it, and is empty when no customer has the CPF. A tombstone
+// marks a CPF written recently, which is looked up in the repository.
+type sharedEntry struct {
+	KeyID        string `json:"key_id,omitempty"`
+	EncryptedKey []byte `json:"encrypted_key,omitempty"`
+	Customer     []byte `json:"customer,omitempty"`
+	Tombstone    bool   `json:"tombstone,omitempty"`
+}
+
+// SharedCustomerCache keeps CPF lookups in a store shared by every replica without exposing
+// personal data to it: keys are blind indexes of the CPFs and customers are encrypted. Each replica
+// seals with a data key of its own, generated once, and keeps the keys of the other replicas it
+// opened, so the key provider is not called on every lookup.
+//
+// A replica may read a customer, lose the race to a write made by another one and store what it
+// read after the write invalidated it. Invalidations therefore leave a tombstone for tombstoneTTL
+// instead of deleting the entry, and entries are only stored where there is none, so a read that
+// started before a write does not replace its tombstone.
+type SharedCustomerCache struct {
+	store        cachepkg.SharedStore
+	encryptor    *encryption.Encryptor
+	blindIndex   *encryption.BlindIndex
+	tombstoneTTL time.Duration
+
+	mu      sync.Mutex
+	sealing *encryption.ItemCipher
+	opened  map[string]*encryption.ItemCipher
+}
+
+func NewSharedCustomerCache(store cachepkg.SharedStore, encryptor *encryption.Encryptor, blindIndex *encryption.BlindIndex, tombstoneTTL time.Duration) *SharedCustomerCache {
+	return &SharedCustomerCache{
+		store:        store,
+		encryptor:    encryptor,
+		blindIndex:   blindIndex,
+		tombstoneTTL: tombstoneTTL,
+		opened:       make(map[string]*encryption.ItemCipher),
+	}
+}
+
+// Key returns the key of a normalized CPF, used in the shared store, in the in-process cache of
+// every replica and in invalidations.
+func (c *SharedCustomerCache) Key(cpf string) string {
+	return keyPrefix + c.blindIndex.Compute(cpfKeyDomain, cpf)
+}
+
+func (c *SharedCustomerCache) Get(key string) (cached, bool, error) {
+	value, found, err := c.store.Get(key)
+	if err != nil || !found {
+		return cached{}, false, err
+	}
+
+	var entry sharedEntry
+	if err := json.Unmarshal(value, &entry); err != nil {
+		return cached{}, false, fmt.Errorf("malformed shared cache entry: %w", err)
+	}
+	if entry.Tombstone {
+		return cached{}, false, nil
+	}
+	if len(entry.Customer) == 0 {
+		return cached{}, true, nil
+	}
+
+	cipher, err := c.open(entry.KeyID, entry.EncryptedKey)
+	if err != nil {
+		return cached{}, false, err
+	}
+	plaintext, err := cipher.Decrypt(entry.Customer, key)
+	if err != nil {
+		return cached{}, false, err
+	}
+	customer := &entities.Customer{}
+	if err := json.Unmarshal([]byte(plaintext), customer); err != nil {
+		return cached{}, false, fmt.Errorf("malformed shared cache entry: %w", err)
+	}
+	return cached{customer: customer}, true, nil
+}
+
+// Set stores what was read for key unless the key holds an entry already, such as the tombstone
+// of a write made since.
+func (c *SharedCustomerCache) Set(key string, value cached, ttl time.Duration) error {
+	var entry sharedEntry
+	if value.customer != nil {
+		cipher, err := c.sealingCipher()
+		if err != nil {
+			return err
+		}
+		plaintext, err := json.Marshal(value.customer)
+		if err != nil {
+			return err
+		}
+		// The key is the associated data, so an entry copied under another CPF does not decrypt.
+		sealed, err := cipher.Encrypt(string(plaintext), key)
+		if err != nil {
+			return err
+		}
+		entry = sharedEntry{KeyID: cipher.KeyID, EncryptedKey: cipher.EncryptedKey, Customer: sealed}
+	}
+
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = c.store.SetIfAbsent(key, encoded, ttl)
+	return err
+}
+
+// Invalidate replaces the entries of the keys with tombstones and tells every replica, this one
+// included, to drop them.
+func (c *SharedCustomerCache) Invalidate(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	tombstone, err := json.Marshal(sharedEntry{Tombstone: true})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := c.store.Set(key, tombstone, c.tombstoneTTL); err != nil {
+			return err
+		}
+	}
+	return c.store.Publish(InvalidationChannel, strings.Join(keys, " "))
+}
+
+// Subscribe calls drop with every key invalidated by any replica until stop is called.
+func (c *SharedCustomerCache) Subscribe(drop func(keys []string)) (stop func(), err error) {
+	return c.store.Subscribe(InvalidationChannel, func(message string) {
+		drop(strings.Fields(message))
+	})
+}
+
+func (c *SharedCustomerCache) sealingCipher() (*encryption.ItemCipher, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sealing == nil {
+		cipher, err := c.encryptor.NewItemCipher()
+		if err != nil {
+			return nil, err
+		}
+		c.sealing = cipher
+		c.opened[openedKey(cipher.KeyID, cipher.EncryptedKey)] = cipher
+	}
+	return c.sealing, nil
+}
+
+func (c *SharedCustomerCache) open(keyID string, encryptedKey []byte) (*encryption.ItemCipher, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := openedKey(keyID, encryptedKey)
+	if cipher, ok := c.opened[id]; ok {
+		return cipher, nil
+	}
+	cipher, err := c.encryptor.OpenItemCipher(keyID, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	// Replicas come and go with their keys, so past the limit the keys of the others are forgotten
+	// and opened again as needed.
+	if len(c.opened) >= maxOpenedKeys {
+		c.opened = make(map[string]*encryption.ItemCipher)
+		if c.sealing != nil {
+			c.opened[openedKey(c.sealing.KeyID, c.sealing.EncryptedKey)] = c.sealing
+		}
+	}
+	c.opened[id] = cipher
+	return cipher, nil
+}
+
+func openedKey(keyID string, encryptedKey []byte) string {
+	return keyID + "/" + string(encryptedKey)
+}
@@ -0,0 +1,219 @@
+package cache_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/cache"
+	cachepkg "github.com/viniciuscluna/tc-fiap-customer/pkg/cache"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+var (
+	masterKey = bytes.Repeat([]byte{1}, 32)
+	indexKey  = bytes.Repeat([]byte{2}, 32)
+	config    = cachepkg.Config{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute}
+)
+
+const tombstoneTTL = 10 * time.Second
+
+// SharedCustomerCacheTestSuite runs two replicas against the same in-process Redis server.
+type SharedCustomerCacheTestSuite struct {
+	suite.Suite
+	server         *miniredis.Miniredis
+	mockRepository *mockRepositories.MockCustomerRepository
+	first          *cache.CachedCustomerRepository
+	second         *cache.CachedCustomerRepository
+	customer       *entities.Customer
+}
+
+func (suite *SharedCustomerCacheTestSuite) SetupTest() {
+	suite.server = miniredis.RunT(suite.T())
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.first = suite.newReplica()
+	suite.second = suite.newReplica()
+	suite.customer = &entities.Customer{
+		ID:        "customer-1",
+		CPF:       cpf,
+		Name:      "Maria Souza",
+		Email:     "maria@example.com",
+		CreatedAt: time.Date(2026, 1, 9, 1, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *SharedCustomerCacheTestSuite) newReplica() *cache.CachedCustomerRepository {
+	repository := cache.NewCachedCustomerRepository(suite.mockRepository, config, suite.newSharedCache())
+	suite.Require().NoError(repository.Start())
+	suite.T().Cleanup(repository.Stop)
+	return repository
+}
+
+func (suite *SharedCustomerCacheTestSuite) newSharedCache() *cache.SharedCustomerCache {
+	provider, err := encryption.NewLocalKeyProvider("key", map[string][]byte{"key": masterKey}, indexKey)
+	suite.Require().NoError(err)
+	store, err := cachepkg.NewRedisStore("redis://"+suite.server.Addr(), time.Second)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { store.Close() })
+	return cache.NewSharedCustomerCache(store, encryption.NewEncryptor(provider), encryption.NewBlindIndex(provider), tombstoneTTL)
+}
+
+func TestSharedCustomerCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(SharedCustomerCacheTestSuite))
+}
+
+// Feature: Shared Customer Cache
+// Scenario: Replicas share what they read
+
+func (suite *SharedCustomerCacheTestSuite) Test_GetByCpf_ShouldBeAnsweredByAnotherReplica() {
+	// GIVEN a customer read by the first replica
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	_, err := suite.first.GetByCpf(cpf)
+	suite.Require().NoError(err)
+
+	// WHEN the second replica looks the CPF up
+	customer, err := suite.second.GetByCpf(cpf)
+
+	// THEN it should be answered by the shared cache
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.customer, customer)
+	assert.Equal(suite.T(), int64(1), suite.second.Metrics()["shared_hits"])
+	assert.Equal(suite.T(), int64(0), suite.second.Metrics()["misses"])
+}
+
+func (suite *SharedCustomerCacheTestSuite) Test_GetByCpf_ShouldShareNotFound() {
+	// GIVEN a CPF without a customer looked up by the first replica
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.first.GetByCpf(cpf)
+
+	// WHEN the second replica looks it up
+	_, err := suite.second.GetByCpf(cpf)
+
+	// THEN it should not read the repository either
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Equal(suite.T(), int64(1), suite.second.Metrics()["shared_hits"])
+}
+
+func (suite *SharedCustomerCacheTestSuite) Test_Set_ShouldNotExposePersonalData() {
+	// GIVEN a customer read by a replica
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.first.GetByCpf(cpf)
+
+	// THEN neither the key nor the value should hold the CPF, name or email
+	keys := suite.server.Keys()
+	suite.Require().Len(keys, 1)
+	value, err := suite.server.Get(keys[0])
+	suite.Require().NoError(err)
+	for _, secret := range []string{cpf, suite.customer.Name, suite.customer.Email} {
+		assert.NotContains(suite.T(), keys[0], secret)
+		assert.NotContains(suite.T(), value, secret)
+	}
+}
+
+func (suite *SharedCustomerCacheTestSuite) Test_Get_WithEntryCopiedToAnotherCPF_ShouldFail() {
+	// GIVEN an entry copied under the key of another CPF
+	sharedCache := suite.newSharedCache()
+	key := sharedCache.Key(cpf)
+	otherKey := sharedCache.Key("52998224725")
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.first.GetByCpf(cpf)
+	value, _ := suite.server.Get(key)
+	suite.server.Set(otherKey, value)
+
+	// WHEN the other CPF is looked up
+	suite.mockRepository.EXPECT().GetByCpf("52998224725").Return(nil, repositories.ErrCustomerNotFound).Once()
+	_, err := suite.second.GetByCpf("52998224725")
+
+	// THEN the copy should not decrypt and the repository should be read instead
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Equal(suite.T(), int64(1), suite.second.Metrics()["shared_errors"])
+}
+
+// Scenario: Writes are broadcast to every replica
+
+func (suite *SharedCustomerCacheTestSuite) Test_Update_ShouldDropEntryInEveryReplica() {
+	// GIVEN a customer cached by both replicas
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	suite.first.GetByCpf(cpf)
+	suite.second.GetByCpf(cpf)
+
+	// WHEN the first replica updates the customer
+	updated := *suite.customer
+	updated.Name = "Maria Lima"
+	suite.mockRepository.EXPECT().Update(&updated).Return(nil).Once()
+	suite.Require().NoError(suite.first.Update(&updated))
+
+	// THEN the second replica should drop its entry and read the update
+	assert.Eventually(suite.T(), func() bool {
+		return suite.second.Metrics()["entries"] == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(suite.T(), int64(1), suite.second.Metrics()["remote_invalidations"])
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(&updated, nil).Once()
+	customer, err := suite.second.GetByCpf(cpf)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Maria Lima", customer.Name)
+}
+
+func (suite *SharedCustomerCacheTestSuite) Test_GetByCpf_ReadStartedBeforeWriteOfAnotherReplica_ShouldNotBeShared() {
+	// GIVEN the second replica reads the customer while the first one updates it
+	updated := *suite.customer
+	updated.Name = "Maria Lima"
+	suite.mockRepository.EXPECT().Update(&updated).Return(nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf(cpf).RunAndReturn(func(string) (*entities.Customer, error) {
+		suite.Require().NoError(suite.first.Update(&updated))
+		return suite.customer, nil
+	}).Once()
+	_, err := suite.second.GetByCpf(cpf)
+	suite.Require().NoError(err)
+
+	// WHEN a third replica looks the CPF up
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(&updated, nil).Once()
+	customer, err := suite.newReplica().GetByCpf(cpf)
+
+	// THEN the tombstone of the update should have kept the stale read out of the shared cache
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Maria Lima", customer.Name)
+}
+
+func (suite *SharedCustomerCacheTestSuite) Test_GetByCpf_AfterTombstoneExpires_ShouldShareAgain() {
+	// GIVEN a customer written, and read once its tombstone expired
+	suite.mockRepository.EXPECT().Update(suite.customer).Return(nil).Once()
+	suite.Require().NoError(suite.first.Update(suite.customer))
+	suite.server.FastForward(tombstoneTTL)
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	_, err := suite.first.GetByCpf(cpf)
+	suite.Require().NoError(err)
+
+	// WHEN the second replica looks it up
+	customer, err := suite.second.GetByCpf(cpf)
+
+	// THEN it should be answered by the shared cache
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.customer, customer)
+	assert.Equal(suite.T(), int64(1), suite.second.Metrics()["shared_hits"])
+}
+
+// Scenario: A failing shared cache is skipped
+
+func (suite *SharedCustomerCacheTestSuite) Test_GetByCpf_WithSharedCacheDown_ShouldReadRepository() {
+	// GIVEN the shared cache went away
+	suite.server.Close()
+
+	// WHEN looking a CPF up
+	suite.mockRepository.EXPECT().GetByCpf(cpf).Return(suite.customer, nil).Once()
+	customer, err := suite.first.GetByCpf(cpf)
+
+	// THEN the repository should answer and the in-process cache still work
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.customer, customer)
+	_, err = suite.first.GetByCpf(cpf)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), suite.first.Metrics()["hits"])
+	assert.Equal(suite.T(), int64(2), suite.first.Metrics()["shared_errors"])
+}
@@ -0,0 +1,41 @@
+package dataexport
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+var (
+	_ dataExportRepositories.DataContributor = (*ProfileDataContributor)(nil)
+)
+
+// ProfileDataContributor adds the customer profile to data exports. It is the contributor that
+// decides whether the customer exists at all.
+type ProfileDataContributor struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewProfileDataContributor(customerRepository repositories.CustomerRepository) *ProfileDataContributor {
+	return &ProfileDataContributor{customerRepository: customerRepository}
+}
+
+func (c *ProfileDataContributor) Section() string {
+	return "profile"
+}
+
+func (c *ProfileDataContributor) Title() string {
+	return "Profile"
+}
+
+func (c *ProfileDataContributor) Collect(customerID string) (any, error) {
+	customer, err := c.customerRepository.GetByID(customerID)
+	if errors.Is(err, repositories.ErrCustomerNotFound) {
+		return nil, dataExportRepositories.ErrSubjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
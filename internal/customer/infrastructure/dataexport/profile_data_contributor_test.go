@@ -0,0 +1,44 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/dataexport"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+func TestProfileDataContributor_ShouldReturnCustomer(t *testing.T) {
+	repository := mockRepositories.NewMockCustomerRepository(t)
+	customer := &entities.Customer{ID: "customer-1", Name: "Maria"}
+	repository.EXPECT().GetByID("customer-1").Return(customer, nil).Once()
+
+	data, err := dataexport.NewProfileDataContributor(repository).Collect("customer-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, customer, data)
+}
+
+func TestProfileDataContributor_WithUnknownCustomer_ShouldReturnSubjectNotFound(t *testing.T) {
+	repository := mockRepositories.NewMockCustomerRepository(t)
+	repository.EXPECT().GetByID("customer-1").Return(nil, repositories.ErrCustomerNotFound).Once()
+
+	data, err := dataexport.NewProfileDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.ErrorIs(t, err, dataExportRepositories.ErrSubjectNotFound)
+}
+
+func TestProfileDataContributor_WithRepositoryError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockCustomerRepository(t)
+	expectedError := errors.New("get failed")
+	repository.EXPECT().GetByID("customer-1").Return(nil, expectedError).Once()
+
+	_, err := dataexport.NewProfileDataContributor(repository).Collect("customer-1")
+
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,46 @@
+package dataexport
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+const revisionsPageSize = 100
+
+var (
+	_ dataExportRepositories.DataContributor = (*ProfileHistoryDataContributor)(nil)
+)
+
+// ProfileHistoryDataContributor adds to data exports every past version of the profile, newest first.
+type ProfileHistoryDataContributor struct {
+	customerHistoryRepository repositories.CustomerHistoryRepository
+}
+
+func NewProfileHistoryDataContributor(customerHistoryRepository repositories.CustomerHistoryRepository) *ProfileHistoryDataContributor {
+	return &ProfileHistoryDataContributor{customerHistoryRepository: customerHistoryRepository}
+}
+
+func (c *ProfileHistoryDataContributor) Section() string {
+	return "profile_history"
+}
+
+func (c *ProfileHistoryDataContributor) Title() string {
+	return "Profile history"
+}
+
+func (c *ProfileHistoryDataContributor) Collect(customerID string) (any, error) {
+	revisions := []*entities.CustomerRevision{}
+	cursor := ""
+	for {
+		page, err := c.customerHistoryRepository.ListRevisions(customerID, revisionsPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, page.Revisions...)
+		if page.NextCursor == "" {
+			return revisions, nil
+		}
+		cursor = page.NextCursor
+	}
+}
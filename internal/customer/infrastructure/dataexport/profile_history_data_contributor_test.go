@@ -0,0 +1,41 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/dataexport"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+func TestProfileHistoryDataContributor_ShouldCollectEveryRevision(t *testing.T) {
+	repository := mockRepositories.NewMockCustomerHistoryRepository(t)
+	repository.EXPECT().ListRevisions("customer-1", 100, "").
+		Return(&entities.CustomerHistoryPage{Revisions: []*entities.CustomerRevision{{ID: "rev-2"}}, NextCursor: "next"}, nil).
+		Once()
+	repository.EXPECT().ListRevisions("customer-1", 100, "next").
+		Return(&entities.CustomerHistoryPage{Revisions: []*entities.CustomerRevision{{ID: "rev-1"}}}, nil).
+		Once()
+
+	contributor := dataexport.NewProfileHistoryDataContributor(repository)
+	data, err := contributor.Collect("customer-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "profile_history", contributor.Section())
+	revisions := data.([]*entities.CustomerRevision)
+	assert.Len(t, revisions, 2)
+	assert.Equal(t, "rev-1", revisions[1].ID)
+}
+
+func TestProfileHistoryDataContributor_WithRepositoryError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockCustomerHistoryRepository(t)
+	expectedError := errors.New("query failed")
+	repository.EXPECT().ListRevisions("customer-1", 100, "").Return(nil, expectedError).Once()
+
+	data, err := dataexport.NewProfileHistoryDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.Equal(t, expectedError, err)
+}
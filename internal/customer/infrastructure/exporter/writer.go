@@ -0,0 +1,154 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+)
+
+// Supported formats of an export.
+const (
+	FormatCSV     = "csv"
+	FormatNDJSON  = "ndjson"
+	FormatParquet = "parquet"
+)
+
+var ErrUnsupportedFormat = errors.New("export format must be csv, ndjson or parquet")
+
+// parquetRowGroupSize bounds the rows the Parquet writer buffers before flushing a row group.
+const parquetRowGroupSize = 10000
+
+// Writer writes customers in one of the export formats. Close must be called once every customer
+// is written: it flushes what is buffered and, for Parquet, writes the footer.
+type Writer interface {
+	exportcustomers.CustomerWriter
+	Close() error
+}
+
+// row is an exported customer. The tier is left out, it is owned by the loyalty program and not
+// part of the stored customer. Times are nanosecond timestamps in Parquet, a nil claimed_at is null.
+type row struct {
+	ID        string     `json:"id" parquet:"id"`
+	CPF       string     `json:"cpf" parquet:"cpf"`
+	Name      string     `json:"name" parquet:"name"`
+	Email     string     `json:"email" parquet:"email"`
+	Nickname  string     `json:"nickname" parquet:"nickname"`
+	Guest     bool       `json:"guest" parquet:"guest"`
+	CreatedAt time.Time  `json:"created_at" parquet:"created_at"`
+	ClaimedAt *time.Time `json:"claimed_at" parquet:"claimed_at"`
+}
+
+var csvHeader = []string{"id", "cpf", "name", "email", "nickname", "guest", "created_at", "claimed_at"}
+
+func newRow(customer *entities.Customer) row {
+	return row{
+		ID:        customer.ID,
+		CPF:       customer.CPF,
+		Name:      customer.Name,
+		Email:     customer.Email,
+		Nickname:  customer.Nickname,
+		Guest:     customer.Guest,
+		CreatedAt: customer.CreatedAt,
+		ClaimedAt: customer.ClaimedAt,
+	}
+}
+
+// ContentType is the media type of a format, empty when the format is not supported.
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatParquet:
+		return "application/vnd.apache.parquet"
+	}
+	return ""
+}
+
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{encoder: json.NewEncoder(w)}, nil
+	case FormatParquet:
+		return &parquetWriter{
+			writer: parquet.NewGenericWriter[row](w, parquet.Compression(&parquet.Snappy), parquet.MaxRowsPerRowGroup(parquetRowGroupSize)),
+		}, nil
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// csvWriter writes the header with the first customer, so an export that fails before reading
+// anything writes nothing at all.
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) Write(customer *entities.Customer) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	claimedAt := ""
+	if customer.ClaimedAt != nil {
+		claimedAt = customer.ClaimedAt.UTC().Format(time.RFC3339)
+	}
+	return w.writer.Write([]string{
+		customer.ID,
+		customer.CPF,
+		customer.Name,
+		customer.Email,
+		customer.Nickname,
+		strconv.FormatBool(customer.Guest),
+		customer.CreatedAt.UTC().Format(time.RFC3339),
+		claimedAt,
+	})
+}
+
+func (w *csvWriter) Close() error {
+	if !w.headerWritten {
+		if err := w.writer.Write(csvHeader); err != nil {
+			return err
+		}
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(customer *entities.Customer) error {
+	return w.encoder.Encode(newRow(customer))
+}
+
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
+
+type parquetWriter struct {
+	writer *parquet.GenericWriter[row]
+}
+
+func (w *parquetWriter) Write(customer *entities.Customer) error {
+	_, err := w.writer.Write([]row{newRow(customer)})
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.writer.Close()
+}
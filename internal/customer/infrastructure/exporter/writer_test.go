@@ -0,0 +1,123 @@
+package exporter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/exporter"
+)
+
+type WriterTestSuite struct {
+	suite.Suite
+	createdAt time.Time
+	claimedAt time.Time
+	customers []*entities.Customer
+}
+
+func (suite *WriterTestSuite) SetupTest() {
+	suite.createdAt = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	suite.claimedAt = time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	suite.customers = []*entities.Customer{
+		{ID: "customer-1", CPF: "52998224725", Name: "Doe, Jane", Email: "jane@example.com", CreatedAt: suite.createdAt, ClaimedAt: &suite.claimedAt},
+		{ID: "guest-1", Guest: true, Nickname: "Jay", CreatedAt: suite.createdAt},
+	}
+}
+
+func TestWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(WriterTestSuite))
+}
+
+func (suite *WriterTestSuite) write(format string) []byte {
+	var output bytes.Buffer
+	writer, err := exporter.NewWriter(format, &output)
+	suite.Require().NoError(err)
+	for _, customer := range suite.customers {
+		suite.Require().NoError(writer.Write(customer))
+	}
+	suite.Require().NoError(writer.Close())
+	return output.Bytes()
+}
+
+// Feature: Customer Export Writers
+// Scenario: Customers are written as CSV, NDJSON or Parquet
+
+func (suite *WriterTestSuite) Test_CSV_ShouldWriteHeaderAndQuotedRows() {
+	// WHEN writing the customers as CSV
+	output := suite.write(exporter.FormatCSV)
+
+	// THEN a header and one row per customer should be written
+	assert.Equal(suite.T(), "id,cpf,name,email,nickname,guest,created_at,claimed_at\n"+
+		"customer-1,52998224725,\"Doe, Jane\",jane@example.com,,false,2024-05-01T10:00:00Z,2024-05-02T10:00:00Z\n"+
+		"guest-1,,,,Jay,true,2024-05-01T10:00:00Z,\n", string(output))
+}
+
+func (suite *WriterTestSuite) Test_CSV_WithoutCustomers_ShouldWriteHeaderOnly() {
+	// GIVEN no customers
+	suite.customers = nil
+
+	// WHEN writing them as CSV
+	output := suite.write(exporter.FormatCSV)
+
+	// THEN only the header should be written
+	assert.Equal(suite.T(), "id,cpf,name,email,nickname,guest,created_at,claimed_at\n", string(output))
+}
+
+func (suite *WriterTestSuite) Test_NDJSON_ShouldWriteOneObjectPerLine() {
+	// WHEN writing the customers as NDJSON
+	lines := strings.Split(strings.TrimSpace(string(suite.write(exporter.FormatNDJSON))), "\n")
+
+	// THEN every line should be a customer
+	suite.Require().Len(lines, 2)
+	var first map[string]any
+	suite.Require().NoError(json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(suite.T(), "customer-1", first["id"])
+	assert.Equal(suite.T(), "2024-05-02T10:00:00Z", first["claimed_at"])
+	assert.Contains(suite.T(), lines[1], `"claimed_at":null`)
+}
+
+func (suite *WriterTestSuite) Test_Parquet_ShouldBeReadableBack() {
+	// WHEN writing the customers as Parquet
+	output := suite.write(exporter.FormatParquet)
+
+	// THEN the file should hold every customer
+	type row struct {
+		ID        string    `parquet:"id"`
+		Guest     bool      `parquet:"guest"`
+		Nickname  string    `parquet:"nickname"`
+		CreatedAt time.Time `parquet:"created_at,timestamp(millisecond)"`
+		ClaimedAt time.Time `parquet:"claimed_at,optional,timestamp(millisecond)"`
+	}
+	rows, err := parquet.Read[row](bytes.NewReader(output), int64(len(output)))
+	suite.Require().NoError(err)
+	suite.Require().Len(rows, 2)
+	assert.Equal(suite.T(), "customer-1", rows[0].ID)
+	assert.True(suite.T(), rows[0].CreatedAt.Equal(suite.createdAt))
+	assert.True(suite.T(), rows[0].ClaimedAt.Equal(suite.claimedAt))
+	assert.True(suite.T(), rows[1].Guest)
+	assert.Equal(suite.T(), "Jay", rows[1].Nickname)
+
+	// AND a guest that was never claimed should have a null claimed_at
+	file, err := parquet.OpenFile(bytes.NewReader(output), int64(len(output)))
+	suite.Require().NoError(err)
+	column, ok := file.Schema().Lookup("claimed_at")
+	suite.Require().True(ok)
+	values := make([]parquet.Row, 2)
+	read, _ := file.RowGroups()[0].Rows().ReadRows(values)
+	suite.Require().Equal(2, read)
+	assert.True(suite.T(), values[1][column.ColumnIndex].IsNull())
+}
+
+func (suite *WriterTestSuite) Test_NewWriter_WithUnknownFormat_ShouldReturnError() {
+	// WHEN creating a writer for an unsupported format
+	_, err := exporter.NewWriter("xlsx", &bytes.Buffer{})
+
+	// THEN the format should be rejected
+	assert.ErrorIs(suite.T(), err, exporter.ErrUnsupportedFormat)
+}
@@ -0,0 +1,158 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+)
+
+// Supported formats of an import file.
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("import format must be csv or ndjson")
+	ErrMissingCPFColumn  = errors.New("csv header must have a cpf column")
+	ErrEmptyFile         = errors.New("import file is empty")
+)
+
+// maxLineSize bounds a single NDJSON line, far above any customer row.
+const maxLineSize = 64 * 1024
+
+// FormatFromContentType maps the media type of a request body to its format, empty when unknown.
+func FormatFromContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch mediaType {
+	case "text/csv", "application/csv":
+		return FormatCSV
+	case "application/x-ndjson", "application/ndjson", "application/jsonl", "application/jsonlines":
+		return FormatNDJSON
+	}
+	return ""
+}
+
+// Parse reads every row of an import file. A row that cannot be read is still returned, with its
+// Error set, so it is reported on its line instead of failing the whole file.
+func Parse(format string, r io.Reader) ([]dto.ImportCustomerRowDto, error) {
+	switch format {
+	case FormatCSV:
+		return parseCSV(r)
+	case FormatNDJSON:
+		return parseNDJSON(r)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// parseCSV expects a header naming the name, email and cpf columns in any order and case. Files
+// exported by spreadsheets set up for Brazil separate fields with semicolons, which is detected
+// from the header.
+func parseCSV(r io.Reader) ([]dto.ImportCustomerRowDto, error) {
+	buffered := bufio.NewReader(r)
+	// A failed peek returns what could be read, the error surfaces again on the first Read.
+	header, _ := buffered.Peek(buffered.Size())
+	header, _, _ = bytes.Cut(header, []byte("\n"))
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	columns, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrEmptyFile
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	positions := map[string]int{"name": -1, "email": -1, "cpf": -1}
+	for i, column := range columns {
+		// Spreadsheets often start the file with a byte order mark.
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if _, ok := positions[column]; ok {
+			positions[column] = i
+		}
+	}
+	if positions["cpf"] < 0 {
+		return nil, ErrMissingCPFColumn
+	}
+
+	var rows []dto.ImportCustomerRowDto
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, dto.ImportCustomerRowDto{Line: parseErr.StartLine, Error: "malformed csv row"})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read import file: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(columns) {
+			rows = append(rows, dto.ImportCustomerRowDto{
+				Line:  line,
+				Error: fmt.Sprintf("expected %d fields, found %d", len(columns), len(record)),
+			})
+			continue
+		}
+		rows = append(rows, dto.ImportCustomerRowDto{
+			Name:  field(record, positions["name"]),
+			Email: field(record, positions["email"]),
+			CPF:   field(record, positions["cpf"]),
+			Line:  line,
+		})
+	}
+}
+
+func field(record []string, position int) string {
+	if position < 0 {
+		return ""
+	}
+	return record[position]
+}
+
+// parseNDJSON reads one JSON object per line, skipping blank lines.
+func parseNDJSON(r io.Reader) ([]dto.ImportCustomerRowDto, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineSize)
+
+	var rows []dto.ImportCustomerRowDto
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		row := dto.ImportCustomerRowDto{}
+		if err := json.Unmarshal(text, &row); err != nil {
+			row = dto.ImportCustomerRowDto{Error: "malformed json row"}
+		}
+		row.Line = line
+		rows = append(rows, row)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, ErrEmptyFile
+	}
+	return rows, nil
+}
@@ -0,0 +1,111 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/importer"
+)
+
+type ParserTestSuite struct {
+	suite.Suite
+}
+
+func TestParserTestSuite(t *testing.T) {
+	suite.Run(t, new(ParserTestSuite))
+}
+
+// Feature: Import File Parser
+// Scenario: CSV and NDJSON files are read into rows with their line numbers
+
+func (suite *ParserTestSuite) Test_ParseCSV_ShouldMapColumnsByHeader() {
+	// GIVEN a CSV file with its columns in another order and a quoted name
+	file := "CPF,Email,Name\n529.982.247-25,jane@example.com,\"Doe, Jane\"\n11144477735,,John Doe\n"
+
+	// WHEN parsing it
+	rows, err := importer.Parse(importer.FormatCSV, strings.NewReader(file))
+
+	// THEN every row should be read with the line it came from
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.ImportCustomerRowDto{
+		{Name: "Doe, Jane", Email: "jane@example.com", CPF: "529.982.247-25", Line: 2},
+		{Name: "John Doe", CPF: "11144477735", Line: 3},
+	}, rows)
+}
+
+func (suite *ParserTestSuite) Test_ParseCSV_WithSemicolons_ShouldDetectSeparator() {
+	// GIVEN a CSV file exported with semicolons and a byte order mark
+	file := "\ufeffnome;cpf;name\nignored;52998224725;Jane Doe\n"
+
+	// WHEN parsing it
+	rows, err := importer.Parse(importer.FormatCSV, strings.NewReader(file))
+
+	// THEN the fields should be split on semicolons, ignoring unknown columns
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.ImportCustomerRowDto{{Name: "Jane Doe", CPF: "52998224725", Line: 2}}, rows)
+}
+
+func (suite *ParserTestSuite) Test_ParseCSV_WithWrongFieldCount_ShouldReportRow() {
+	// GIVEN a row with a missing field
+	file := "name,email,cpf\nJane Doe,52998224725\n"
+
+	// WHEN parsing it
+	rows, err := importer.Parse(importer.FormatCSV, strings.NewReader(file))
+
+	// THEN the row should carry the error instead of failing the file
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(rows, 1)
+	assert.Equal(suite.T(), 2, rows[0].Line)
+	assert.Equal(suite.T(), "expected 3 fields, found 2", rows[0].Error)
+}
+
+func (suite *ParserTestSuite) Test_ParseCSV_WithoutCPFColumn_ShouldReturnError() {
+	// WHEN parsing a CSV file without a cpf column
+	_, err := importer.Parse(importer.FormatCSV, strings.NewReader("name,email\nJane Doe,jane@example.com\n"))
+
+	// THEN the file should be rejected
+	assert.ErrorIs(suite.T(), err, importer.ErrMissingCPFColumn)
+}
+
+func (suite *ParserTestSuite) Test_ParseNDJSON_ShouldReadOneRowPerLine() {
+	// GIVEN an NDJSON file with a blank and a malformed line
+	file := "{\"name\":\"Jane Doe\",\"cpf\":\"52998224725\"}\n\n{\"name\":\n{\"cpf\":\"11144477735\",\"email\":\"john@example.com\"}\n"
+
+	// WHEN parsing it
+	rows, err := importer.Parse(importer.FormatNDJSON, strings.NewReader(file))
+
+	// THEN every non blank line should be a row, the malformed one with its error
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []dto.ImportCustomerRowDto{
+		{Name: "Jane Doe", CPF: "52998224725", Line: 1},
+		{Line: 3, Error: "malformed json row"},
+		{Email: "john@example.com", CPF: "11144477735", Line: 4},
+	}, rows)
+}
+
+func (suite *ParserTestSuite) Test_Parse_WithEmptyFile_ShouldReturnError() {
+	// WHEN parsing empty files
+	_, csvErr := importer.Parse(importer.FormatCSV, strings.NewReader(""))
+	_, ndjsonErr := importer.Parse(importer.FormatNDJSON, strings.NewReader("\n"))
+
+	// THEN both should be rejected
+	assert.ErrorIs(suite.T(), csvErr, importer.ErrEmptyFile)
+	assert.ErrorIs(suite.T(), ndjsonErr, importer.ErrEmptyFile)
+}
+
+func (suite *ParserTestSuite) Test_Parse_WithUnknownFormat_ShouldReturnError() {
+	// WHEN parsing a format that is not supported
+	_, err := importer.Parse("xlsx", strings.NewReader(""))
+
+	// THEN the format should be rejected
+	assert.ErrorIs(suite.T(), err, importer.ErrUnsupportedFormat)
+}
+
+func (suite *ParserTestSuite) Test_FormatFromContentType_ShouldMapMediaTypes() {
+	assert.Equal(suite.T(), importer.FormatCSV, importer.FormatFromContentType("text/csv; charset=utf-8"))
+	assert.Equal(suite.T(), importer.FormatNDJSON, importer.FormatFromContentType("application/x-ndjson"))
+	assert.Empty(suite.T(), importer.FormatFromContentType("application/json"))
+}
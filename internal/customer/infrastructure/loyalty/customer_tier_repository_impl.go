@@ -0,0 +1,28 @@
+package loyalty
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	loyaltyRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+)
+
+var (
+	_ repositories.CustomerTierRepository = (*CustomerTierRepositoryImpl)(nil)
+)
+
+// CustomerTierRepositoryImpl reads tiers from the loyalty ledger, keeping the customer context
+// unaware of how the loyalty program stores them.
+type CustomerTierRepositoryImpl struct {
+	loyaltyRepository loyaltyRepositories.LoyaltyRepository
+}
+
+func NewCustomerTierRepositoryImpl(loyaltyRepository loyaltyRepositories.LoyaltyRepository) *CustomerTierRepositoryImpl {
+	return &CustomerTierRepositoryImpl{loyaltyRepository: loyaltyRepository}
+}
+
+func (r *CustomerTierRepositoryImpl) GetTier(customerID string) (string, error) {
+	tier, err := r.loyaltyRepository.GetTier(customerID)
+	if err != nil {
+		return "", err
+	}
+	return tier.Tier, nil
+}
@@ -0,0 +1,51 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// maxBatchWriteItems is the most requests DynamoDB accepts in a single BatchWriteItem.
+const maxBatchWriteItems = 25
+
+// maxBatchGetKeys is the most keys DynamoDB accepts in a single BatchGetItem.
+const maxBatchGetKeys = 100
+
+// batchWrite fails when requests are left unprocessed. The client resends them with the backoff
+// of its guard, so any left are the ones its last attempt did not get through.
+func batchWrite(db dynamodbpkg.Client, requests map[string][]types.WriteRequest) error {
+	result, err := db.BatchWriteItem(context.Background(), &dynamodb.BatchWriteItemInput{RequestItems: requests})
+	if err != nil {
+		return err
+	}
+	if count := countRequests(result.UnprocessedItems); count > 0 {
+		return fmt.Errorf("%d requests left unprocessed", count)
+	}
+	return nil
+}
+
+// batchGet reads the keys of a single table, failing like batchWrite when keys are left unprocessed.
+func batchGet(db dynamodbpkg.Client, tableName string, keys types.KeysAndAttributes) ([]map[string]types.AttributeValue, error) {
+	result, err := db.BatchGetItem(context.Background(), &dynamodb.BatchGetItemInput{
+		RequestItems: map[string]types.KeysAndAttributes{tableName: keys},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if unprocessed := len(result.UnprocessedKeys[tableName].Keys); unprocessed > 0 {
+		return nil, fmt.Errorf("%d keys left unprocessed", unprocessed)
+	}
+	return result.Responses[tableName], nil
+}
+
+func countRequests(requests map[string][]types.WriteRequest) int {
+	count := 0
+	for _, tableRequests := range requests {
+		count += len(tableRequests)
+	}
+	return count
+}
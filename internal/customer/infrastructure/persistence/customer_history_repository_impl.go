@@ -0,0 +1,219 @@
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/events"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.CustomerHistoryRepository = (*CustomerHistoryRepositoryImpl)(nil)
+)
+
+// revisionSortKeyLayout keeps a fixed width so the revisions of a customer sort chronologically.
+const revisionSortKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+// revisionItem holds the attributes a revision adds to the stored customer item, which is copied
+// as is, so the personal data of a revision stays encrypted like the customer itself.
+type revisionItem struct {
+	CustomerID string    `dynamodbav:"customer_id"`
+	SortKey    string    `dynamodbav:"sk"`
+	RevisionID string    `dynamodbav:"revision_id"`
+	Change     string    `dynamodbav:"change"`
+	RecordedAt time.Time `dynamodbav:"recorded_at"`
+}
+
+type CustomerHistoryRepositoryImpl struct {
+	db dynamodbpkg.Client
+	customerCodec
+}
+
+func NewCustomerHistoryRepositoryImpl(db dynamodbpkg.Client, encryptor *encryption.Encryptor, blindIndex *encryption.BlindIndex) *CustomerHistoryRepositoryImpl {
+	return &CustomerHistoryRepositoryImpl{db: db, customerCodec: customerCodec{encryptor: encryptor, blindIndex: blindIndex}}
+}
+
+func (r *CustomerHistoryRepositoryImpl) ListRevisions(customerID string, limit int, cursor string) (*entities.CustomerHistoryPage, error) {
+	expr, err := buildQuery(revisionsOf(customerID))
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerHistoryTableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil || len(decoded) == 0 {
+			return nil, repositories.ErrInvalidCursor
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"customer_id": dynamodbpkg.String(customerID),
+			"sk":          dynamodbpkg.String(string(decoded)),
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query customer history: %w", err)
+	}
+
+	page := &entities.CustomerHistoryPage{Revisions: make([]*entities.CustomerRevision, 0, len(result.Items))}
+	for _, item := range result.Items {
+		revision, err := r.unmarshalRevision(item)
+		if err != nil {
+			return nil, err
+		}
+		page.Revisions = append(page.Revisions, revision)
+	}
+	if lastKey, ok := result.LastEvaluatedKey["sk"]; ok {
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(dynamodbpkg.StringValue(lastKey)))
+	}
+
+	return page, nil
+}
+
+// GetAsOf reads the revisions backwards from asOf, so only the one it returns is read. The bound
+// sorts after every revision ID recorded within the same microsecond.
+func (r *CustomerHistoryRepositoryImpl) GetAsOf(customerID string, asOf time.Time) (*entities.CustomerRevision, error) {
+	bound := asOf.UTC().Format(revisionSortKeyLayout) + "#~"
+	expr, err := buildQuery(revisionsOf(customerID).And(expression.Key("sk").LessThanEqual(expression.Value(bound))))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.db.Query(context.Background(), &dynamodb.QueryInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerHistoryTableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		ScanIndexForward:          aws.Bool(false),
+		Limit:                     aws.Int32(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query customer history: %w", err)
+	}
+
+	if len(result.Items) == 0 {
+		return nil, repositories.ErrRevisionNotFound
+	}
+
+	return r.unmarshalRevision(result.Items[0])
+}
+
+// Purge deletes the revisions in batches, reading only their keys.
+func (r *CustomerHistoryRepositoryImpl) Purge(customerID string) error {
+	expr, err := buildQuery(revisionsOf(customerID), expression.Name("customer_id"), expression.Name("sk"))
+	if err != nil {
+		return err
+	}
+
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerHistoryTableName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ProjectionExpression:      expr.Projection(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+
+	for {
+		result, err := r.db.Query(context.Background(), input)
+		if err != nil {
+			return fmt.Errorf("failed to query customer history: %w", err)
+		}
+
+		for start := 0; start < len(result.Items); start += maxBatchWriteItems {
+			end := min(start+maxBatchWriteItems, len(result.Items))
+			requests := make([]types.WriteRequest, 0, end-start)
+			for _, key := range result.Items[start:end] {
+				requests = append(requests, types.WriteRequest{DeleteRequest: &types.DeleteRequest{Key: key}})
+			}
+			err := batchWrite(r.db, map[string][]types.WriteRequest{dynamodbpkg.CustomerHistoryTableName: requests})
+			if err != nil {
+				return fmt.Errorf("failed to purge customer history: %w", err)
+			}
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+func (r *CustomerHistoryRepositoryImpl) unmarshalRevision(av map[string]types.AttributeValue) (*entities.CustomerRevision, error) {
+	item := &revisionItem{}
+	if err := attributevalue.UnmarshalMap(av, item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal customer revision: %w", err)
+	}
+
+	customer, err := r.unmarshalCustomer(av)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.CustomerRevision{
+		ID:         item.RevisionID,
+		Change:     item.Change,
+		RecordedAt: item.RecordedAt,
+		Customer:   customer,
+	}, nil
+}
+
+// revisionWrite stores the customer item av as the revision written by the event.
+func revisionWrite(av map[string]types.AttributeValue, customerID string, event events.Event) (types.TransactWriteItem, error) {
+	id, err := newRevisionID()
+	if err != nil {
+		return types.TransactWriteItem{}, err
+	}
+
+	recordedAt := event.OccurredAt().UTC()
+	revision, err := attributevalue.MarshalMap(revisionItem{
+		CustomerID: customerID,
+		SortKey:    recordedAt.Format(revisionSortKeyLayout) + "#" + id,
+		RevisionID: id,
+		Change:     event.EventType(),
+		RecordedAt: recordedAt,
+	})
+	if err != nil {
+		return types.TransactWriteItem{}, fmt.Errorf("failed to marshal customer revision: %w", err)
+	}
+
+	item := maps.Clone(av)
+	maps.Copy(item, revision)
+
+	return conditionalPut(dynamodbpkg.CustomerHistoryTableName, item, expression.AttributeNotExists(expression.Name("sk")))
+}
+
+// revisionsOf selects the revisions of a customer.
+func revisionsOf(customerID string) expression.KeyConditionBuilder {
+	return expression.Key("customer_id").Equal(expression.Value(customerID))
+}
+
+func newRevisionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate customer revision id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,247 @@
+package persistence_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/events"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/persistence"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+type CustomerHistoryRepositoryTestSuite struct {
+	suite.Suite
+	mockDB             *MockDynamoDBClient
+	blindIndex         *encryption.BlindIndex
+	customerRepository *persistence.CustomerRepositoryImpl
+	repository         *persistence.CustomerHistoryRepositoryImpl
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	provider, err := encryption.NewLocalKeyProvider("new-key", map[string][]byte{"new-key": newMasterKey}, indexKey)
+	suite.Require().NoError(err)
+	encryptor := encryption.NewEncryptor(provider)
+	suite.blindIndex = encryption.NewBlindIndex(provider)
+	suite.customerRepository = persistence.NewCustomerRepositoryImpl(suite.mockDB, encryptor, suite.blindIndex)
+	suite.repository = persistence.NewCustomerHistoryRepositoryImpl(suite.mockDB, encryptor, suite.blindIndex)
+}
+
+func TestCustomerHistoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CustomerHistoryRepositoryTestSuite))
+}
+
+// storeRevision updates the customer and returns the revision item the update wrote.
+func (suite *CustomerHistoryRepositoryTestSuite) storeRevision(customer *entities.Customer) map[string]types.AttributeValue {
+	storedUnder(suite.mockDB, suite.blindIndex.Compute("customer.cpf", customer.CPF), customer.ID)
+	var revision map[string]types.AttributeValue
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Run(func(args mock.Arguments) {
+		for _, write := range args.Get(0).(*dynamodb.TransactWriteItemsInput).TransactItems {
+			if write.Put != nil && aws.ToString(write.Put.TableName) == dynamodbpkg.CustomerHistoryTableName {
+				revision = write.Put.Item
+			}
+		}
+	}).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	suite.Require().NoError(suite.customerRepository.Update(customer))
+	suite.Require().NotNil(revision)
+	return revision
+}
+
+// Feature: Customer History Repository - Revisions
+// Scenario: Every write stores an encrypted snapshot that can be listed back
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_Update_ShouldStoreEncryptedRevision() {
+	// GIVEN a customer update
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "Jane Roe", Email: "jane@example.com"}
+
+	// WHEN the customer is updated
+	revision := suite.storeRevision(customer)
+
+	// THEN the revision should be keyed by the customer and the date of the change
+	assert.Equal(suite.T(), "customer-1", dynamodbpkg.StringValue(revision["customer_id"]))
+	assert.Regexp(suite.T(), `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}Z#[0-9a-f]{32}$`, dynamodbpkg.StringValue(revision["sk"]))
+	assert.Equal(suite.T(), events.CustomerUpdatedType, dynamodbpkg.StringValue(revision["change"]))
+	// AND it should not hold the personal data in plaintext
+	for name, value := range revision {
+		for _, plaintext := range []string{"12345678901", "Jane Roe", "jane@example.com"} {
+			assert.NotContains(suite.T(), fmt.Sprint(value), plaintext, name)
+		}
+	}
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_ListRevisions_ShouldDecryptNewestFirst() {
+	// GIVEN a stored revision and more revisions after it
+	revision := suite.storeRevision(&entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "Jane Roe"})
+	lastKey := map[string]types.AttributeValue{
+		"customer_id": revision["customer_id"],
+		"sk":          revision["sk"],
+	}
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.TableName) == dynamodbpkg.CustomerHistoryTableName &&
+			hasValue(input.ExpressionAttributeValues, "customer-1") &&
+			!aws.ToBool(input.ScanIndexForward) &&
+			aws.ToInt32(input.Limit) == 1 &&
+			input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{
+		Items:            []map[string]types.AttributeValue{revision},
+		LastEvaluatedKey: lastKey,
+	}, nil).Once()
+
+	// WHEN listing the first revision
+	page, err := suite.repository.ListRevisions("customer-1", 1, "")
+
+	// THEN the snapshot should be decrypted
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(page.Revisions, 1)
+	assert.Equal(suite.T(), events.CustomerUpdatedType, page.Revisions[0].Change)
+	assert.NotEmpty(suite.T(), page.Revisions[0].ID)
+	assert.Equal(suite.T(), "Jane Roe", page.Revisions[0].Customer.Name)
+	assert.Equal(suite.T(), "12345678901", page.Revisions[0].Customer.CPF)
+	// AND the cursor should resume after it
+	decoded, err := base64.RawURLEncoding.DecodeString(page.NextCursor)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), dynamodbpkg.StringValue(revision["sk"]), string(decoded))
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_ListRevisions_WithCursor_ShouldStartAfterIt() {
+	// GIVEN a cursor
+	sortKey := "2024-05-01T10:00:00.000000Z#abc"
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExclusiveStartKey["sk"]) == sortKey &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["customer_id"]) == "customer-1"
+	})).Return(&dynamodb.QueryOutput{}, nil).Once()
+
+	// WHEN listing the next page
+	page, err := suite.repository.ListRevisions("customer-1", 20, base64.RawURLEncoding.EncodeToString([]byte(sortKey)))
+
+	// THEN the query should start after the cursor and the page should be empty, not nil
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), page.Revisions)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_ListRevisions_WithMalformedCursor_ShouldReturnInvalidCursor() {
+	// WHEN listing with a cursor that is not base64
+	_, err := suite.repository.ListRevisions("customer-1", 20, "not base64!")
+
+	// THEN the cursor should be rejected without querying
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+	suite.mockDB.AssertNotCalled(suite.T(), "Query", mock.Anything)
+}
+
+// Feature: Customer History Repository - Point in Time
+// Scenario: The profile is rebuilt from the latest revision at or before a date
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_GetAsOf_ShouldReadLatestRevisionUpToDate() {
+	// GIVEN a revision recorded before the date
+	revision := suite.storeRevision(&entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "Jane Doe"})
+	asOf := time.Date(2024, 5, 1, 10, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return resolveNames(input.KeyConditionExpression, input.ExpressionAttributeNames) == "(customer_id = :0) AND (sk <= :1)" &&
+			hasValue(input.ExpressionAttributeValues, "2024-05-01T13:00:00.000000Z#~") &&
+			!aws.ToBool(input.ScanIndexForward) &&
+			aws.ToInt32(input.Limit) == 1
+	})).Return(&dynamodb.QueryOutput{Items: []map[string]types.AttributeValue{revision}}, nil).Once()
+
+	// WHEN the profile is requested as of the date
+	result, err := suite.repository.GetAsOf("customer-1", asOf)
+
+	// THEN the revision should be decrypted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Jane Doe", result.Customer.Name)
+	assert.Equal(suite.T(), "customer-1", result.Customer.ID)
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_GetAsOf_BeforeFirstRevision_ShouldReturnNotFound() {
+	// GIVEN no revision up to the date
+	suite.mockDB.On("Query", mock.Anything).Return(&dynamodb.QueryOutput{}, nil).Once()
+
+	// WHEN the profile is requested
+	_, err := suite.repository.GetAsOf("customer-1", time.Now())
+
+	// THEN no revision should be found
+	assert.ErrorIs(suite.T(), err, repositories.ErrRevisionNotFound)
+}
+
+// Feature: Customer History Repository - Erasure
+// Scenario: Every revision of an erased customer is deleted
+
+func revisionKeys(count int) []map[string]types.AttributeValue {
+	keys := make([]map[string]types.AttributeValue, 0, count)
+	for i := range count {
+		keys = append(keys, map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: fmt.Sprintf("2024-05-01T10:00:00.%06dZ#id", i)},
+		})
+	}
+	return keys
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_Purge_ShouldDeleteEveryRevisionInBatches() {
+	// GIVEN 30 revisions spread over two pages
+	keys := revisionKeys(30)
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return resolveNames(input.ProjectionExpression, input.ExpressionAttributeNames) == "customer_id, sk" && input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{Items: keys[:28], LastEvaluatedKey: keys[27]}, nil).Once()
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(&dynamodb.QueryOutput{Items: keys[28:]}, nil).Once()
+
+	deleted := 0
+	suite.mockDB.On("BatchWriteItem", mock.MatchedBy(func(input *dynamodb.BatchWriteItemInput) bool {
+		return len(input.RequestItems[dynamodbpkg.CustomerHistoryTableName]) <= 25
+	})).Run(func(args mock.Arguments) {
+		deleted += len(args.Get(0).(*dynamodb.BatchWriteItemInput).RequestItems[dynamodbpkg.CustomerHistoryTableName])
+	}).Return(&dynamodb.BatchWriteItemOutput{}, nil).Times(3)
+
+	// WHEN the history is purged
+	err := suite.repository.Purge("customer-1")
+
+	// THEN every revision should be deleted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 30, deleted)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_Purge_WithItemsLeftUnprocessed_ShouldReturnError() {
+	// GIVEN DynamoDB still leaves a deletion unprocessed after the retries of the client
+	keys := revisionKeys(2)
+	suite.mockDB.On("Query", mock.Anything).Return(&dynamodb.QueryOutput{Items: keys}, nil).Once()
+	unprocessed := []types.WriteRequest{{DeleteRequest: &types.DeleteRequest{Key: keys[1]}}}
+	suite.mockDB.On("BatchWriteItem", mock.Anything).Return(&dynamodb.BatchWriteItemOutput{
+		UnprocessedItems: map[string][]types.WriteRequest{dynamodbpkg.CustomerHistoryTableName: unprocessed},
+	}, nil).Once()
+
+	// WHEN the history is purged
+	err := suite.repository.Purge("customer-1")
+
+	// THEN the purge should fail so the erasure is not reported as done
+	assert.ErrorContains(suite.T(), err, "1 requests left unprocessed")
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerHistoryRepositoryTestSuite) Test_Purge_WithDynamoDBError_ShouldReturnError() {
+	// GIVEN DynamoDB is unavailable
+	suite.mockDB.On("Query", mock.Anything).Return(nil, errors.New("unavailable")).Once()
+
+	// WHEN the history is purged
+	err := suite.repository.Purge("customer-1")
+
+	// THEN the error should be returned
+	assert.Error(suite.T(), err)
+	assert.True(suite.T(), strings.Contains(err.Error(), "unavailable"))
+}
@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// unencrypted conditions a write on the item still being written before encryption.
+var unencrypted = customerExists.And(expression.AttributeNotExists(expression.Name("key_id")))
+
+// Outcomes of reindexing one item.
+const (
+	reindexCurrent = iota
+	reindexEncrypted
+	reindexReencrypted
+	reindexConflict
+)
+
+// Reindex tells the current master key from the key ID of a new data key, which is what every
+// write stores, so the comparison holds for both the local keys and KMS.
+func (r *CustomerRepositoryImpl) Reindex(segment int, totalSegments int) (*entities.ReindexReport, error) {
+	current, err := r.encryptor.NewItemCipher()
+	if err != nil {
+		return nil, err
+	}
+
+	input := &dynamodb.ScanInput{
+		TableName:     aws.String(dynamodbpkg.CustomerTableName),
+		Segment:       aws.Int32(int32(segment)),
+		TotalSegments: aws.Int32(int32(totalSegments)),
+	}
+	report := &entities.ReindexReport{}
+	for {
+		result, err := r.db.Scan(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reindex customers: %w", err)
+		}
+
+		for _, av := range result.Items {
+			outcome, customerID, err := r.reindexItem(av, current.KeyID)
+			if err != nil {
+				return nil, err
+			}
+			report.Scanned++
+			switch outcome {
+			case reindexEncrypted:
+				report.Encrypted++
+			case reindexReencrypted:
+				report.Reencrypted++
+			case reindexConflict:
+				report.Conflicts = append(report.Conflicts, customerID)
+			}
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return report, nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// reindexItem rewrites an item unless it is already under the current key. Every write is
+// conditioned on the item being as it was read, so a concurrent update is never overwritten.
+func (r *CustomerRepositoryImpl) reindexItem(av map[string]types.AttributeValue, currentKeyID string) (int, string, error) {
+	item := &customerItem{}
+	if err := attributevalue.UnmarshalMap(av, item); err != nil {
+		return 0, "", fmt.Errorf("failed to unmarshal customer: %w", err)
+	}
+	if item.KeyID == currentKeyID {
+		return reindexCurrent, item.ID, nil
+	}
+
+	customer, err := r.unmarshalCustomer(av)
+	if err != nil {
+		return 0, "", err
+	}
+	updated, err := r.marshalCustomer(customer)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if item.KeyID != "" {
+		err = r.reindexPut(updated, expression.Name("key_id").Equal(expression.Value(item.KeyID)))
+		return reindexOutcome(reindexReencrypted, item.ID, err)
+	}
+
+	// Guests keep their synthetic key, registered customers move from the plain CPF, which may
+	// even be a number, to its blind index.
+	if dynamodbpkg.StringValue(updated["cpf"]) == dynamodbpkg.StringValue(av["cpf"]) {
+		err = r.reindexPut(updated, unencrypted)
+		return reindexOutcome(reindexEncrypted, item.ID, err)
+	}
+	deletePlain, err := conditionalDelete(dynamodbpkg.CustomerTableName, map[string]types.AttributeValue{"cpf": av["cpf"]}, unencrypted)
+	if err != nil {
+		return 0, "", err
+	}
+	put, err := conditionalPut(dynamodbpkg.CustomerTableName, updated, customerNotExists)
+	if err != nil {
+		return 0, "", err
+	}
+	_, err = r.db.TransactWriteItems(context.Background(), &dynamodb.TransactWriteItemsInput{
+		TransactItems: []types.TransactWriteItem{deletePlain, put},
+	})
+	var canceled *types.TransactionCanceledException
+	if errors.As(err, &canceled) &&
+		(cancellationReason(canceled, 0) == conditionalCheckFailed || cancellationReason(canceled, 1) == conditionalCheckFailed) {
+		return reindexConflict, item.ID, nil
+	}
+	return reindexOutcome(reindexEncrypted, item.ID, err)
+}
+
+func (r *CustomerRepositoryImpl) reindexPut(av map[string]types.AttributeValue, condition expression.ConditionBuilder) error {
+	expr, err := buildCondition(condition)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerTableName),
+		Item:                      av,
+		ConditionExpression:       expr.Condition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	return err
+}
+
+func reindexOutcome(outcome int, customerID string, err error) (int, string, error) {
+	var conditionFailed *types.ConditionalCheckFailedException
+	switch {
+	case errors.As(err, &conditionFailed):
+		return reindexConflict, customerID, nil
+	case err != nil:
+		return 0, "", fmt.Errorf("failed to reindex customer %s: %w", customerID, err)
+	}
+	return outcome, customerID, nil
+}
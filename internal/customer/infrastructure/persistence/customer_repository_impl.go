@@ -1,15 +1,24 @@
 package persistence
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/events"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
 	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
 )
 
@@ -17,67 +26,582 @@ var (
 	_ repositories.CustomerRepository = (*CustomerRepositoryImpl)(nil)
 )
 
+// guestKeyPrefix prefixes the partition key of guest customers, which have no CPF yet.
+const guestKeyPrefix = "guest#"
+
+const conditionalCheckFailed = "ConditionalCheckFailed"
+
+// maxConcurrentRegistrations bounds the transactions AddBatch runs at a time.
+const maxConcurrentRegistrations = 8
+
+// Blind index domains of the looked up fields.
+const (
+	cpfIndexDomain   = "customer.cpf"
+	emailIndexDomain = "customer.email"
+)
+
+// customerItem is how a customer is stored. The CPF, name and email are encrypted under the data
+// key of the item, kept in data_key encrypted under the master key key_id. The partition key and
+// email_index hold blind indexes of the CPF and email, so both can still be looked up.
+type customerItem struct {
+	Key            string     `dynamodbav:"cpf"`
+	ID             string     `dynamodbav:"id"`
+	EmailIndex     string     `dynamodbav:"email_index,omitempty"`
+	KeyID          string     `dynamodbav:"key_id,omitempty"`
+	DataKey        []byte     `dynamodbav:"data_key,omitempty"`
+	EncryptedCPF   []byte     `dynamodbav:"cpf_encrypted,omitempty"`
+	EncryptedName  []byte     `dynamodbav:"name_encrypted,omitempty"`
+	EncryptedEmail []byte     `dynamodbav:"email_encrypted,omitempty"`
+	CreatedAt      time.Time  `dynamodbav:"created_at"`
+	Guest          bool       `dynamodbav:"guest,omitempty"`
+	Nickname       string     `dynamodbav:"nickname,omitempty"`
+	ClaimedAt      *time.Time `dynamodbav:"claimed_at,omitempty"`
+	// Name and Email are only set on items written before encryption, which are still readable.
+	Name  string `dynamodbav:"name,omitempty"`
+	Email string `dynamodbav:"email,omitempty"`
+}
+
+// customerCodec converts customers to and from stored items, shared by the customer table and the
+// revisions of the customer history table.
+type customerCodec struct {
+	encryptor  *encryption.Encryptor
+	blindIndex *encryption.BlindIndex
+}
+
+// CustomerRepositoryImpl also stores a revision of the customer in the customer history table with
+// every write but the erasure, in the same transaction.
 type CustomerRepositoryImpl struct {
-	db dynamodbiface.DynamoDBAPI
+	db dynamodbpkg.Client
+	customerCodec
 }
 
-func NewCustomerRepositoryImpl(db dynamodbiface.DynamoDBAPI) *CustomerRepositoryImpl {
-	return &CustomerRepositoryImpl{db: db}
+func NewCustomerRepositoryImpl(db dynamodbpkg.Client, encryptor *encryption.Encryptor, blindIndex *encryption.BlindIndex) *CustomerRepositoryImpl {
+	return &CustomerRepositoryImpl{db: db, customerCodec: customerCodec{encryptor: encryptor, blindIndex: blindIndex}}
 }
 
+// GetByCpf falls back to the plain CPF key of the customers written before the encryption, until
+// the reindex command moves them to the blind index.
 func (r *CustomerRepositoryImpl) GetByCpf(cpf string) (*entities.Customer, error) {
-	result, err := r.db.GetItem(&dynamodb.GetItemInput{
-		TableName: aws.String(dynamodbpkg.CustomerTableName),
-		Key: map[string]*dynamodb.AttributeValue{
-			"cpf": {
-				S: aws.String(cpf),
-			},
-		},
+	item, err := r.findItem(cpf)
+	if err != nil {
+		return nil, err
+	}
+	return r.unmarshalCustomer(item)
+}
+
+func (r *CustomerRepositoryImpl) GetByID(id string) (*entities.Customer, error) {
+	expr, err := buildQuery(expression.Key("id").Equal(expression.Value(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.db.Query(context.Background(), &dynamodb.QueryInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerTableName),
+		IndexName:                 aws.String(dynamodbpkg.CustomerIDIndexName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+		Limit:                     aws.Int32(1),
 	})
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get customer: %w", err)
 	}
 
-	if result.Item == nil {
-		return nil, fmt.Errorf("customer not found")
+	if len(result.Items) == 0 {
+		return nil, repositories.ErrCustomerNotFound
 	}
 
-	customer := &entities.Customer{}
-	err = dynamodbattribute.UnmarshalMap(result.Item, customer)
+	return r.unmarshalCustomer(result.Items[0])
+}
+
+func (r *CustomerRepositoryImpl) FindByEmail(email string) ([]*entities.Customer, error) {
+	expr, err := buildQuery(expression.Key("email_index").Equal(expression.Value(r.emailIndex(email))))
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal customer: %w", err)
+		return nil, err
 	}
 
-	return customer, nil
+	input := &dynamodb.QueryInput{
+		TableName:                 aws.String(dynamodbpkg.CustomerTableName),
+		IndexName:                 aws.String(dynamodbpkg.CustomerEmailIndexName),
+		KeyConditionExpression:    expr.KeyCondition(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	}
+
+	customers := []*entities.Customer{}
+	for {
+		result, err := r.db.Query(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find customers: %w", err)
+		}
+		for _, item := range result.Items {
+			customer, err := r.unmarshalCustomer(item)
+			if err != nil {
+				return nil, err
+			}
+			customers = append(customers, customer)
+		}
+		if len(result.LastEvaluatedKey) == 0 {
+			return customers, nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
 }
 
 func (r *CustomerRepositoryImpl) Add(customer *entities.Customer) error {
 	// Generate UUID for ID
-	customer.ID = generateUUID()
+	customer.ID = uuid.NewString()
 	// Set created timestamp
 	customer.CreatedAt = time.Now()
 
 	// Marshal customer to DynamoDB attribute value map
-	av, err := dynamodbattribute.MarshalMap(customer)
+	av, err := r.marshalCustomer(customer)
 	if err != nil {
-		return fmt.Errorf("failed to marshal customer: %w", err)
+		return err
 	}
 
-	// Put item in DynamoDB
-	_, err = r.db.PutItem(&dynamodb.PutItemInput{
-		TableName: aws.String(dynamodbpkg.CustomerTableName),
-		Item:      av,
-	})
+	// Put item, its revision and its event in DynamoDB
+	event := events.NewCustomerRegistered(customer)
+	revision, err := revisionWrite(av, customer.ID, event)
+	if err != nil {
+		return err
+	}
+	put, err := conditionalPut(dynamodbpkg.CustomerTableName, av, customerNotExists)
+	if err != nil {
+		return err
+	}
+	writes := []types.TransactWriteItem{put}
+	if !customer.Guest {
+		plainAbsent, err := plainCPFAbsent(customer.CPF)
+		if err != nil {
+			return err
+		}
+		writes = append(writes, plainAbsent)
+	}
+	err = r.transact(event, append(writes, revision)...)
 
 	if err != nil {
+		var canceled *types.TransactionCanceledException
+		if errors.As(err, &canceled) && (cancellationReason(canceled, 0) == conditionalCheckFailed ||
+			!customer.Guest && cancellationReason(canceled, 1) == conditionalCheckFailed) {
+			return repositories.ErrCustomerAlreadyExists
+		}
 		return fmt.Errorf("failed to add customer: %w", err)
 	}
 
 	return nil
 }
 
-// generateUUID generates a simple UUID-like string
-func generateUUID() string {
-	return fmt.Sprintf("%d-%d", time.Now().UnixNano(), time.Now().Unix())
+// Claim turns a guest into a registered customer. The guest item is replaced by an item keyed
+// by the CPF in a single transaction, so the customer ID referenced by orders is preserved.
+func (r *CustomerRepositoryImpl) Claim(customer *entities.Customer) error {
+	av, err := r.marshalCustomer(customer)
+	if err != nil {
+		return err
+	}
+
+	event := events.NewCustomerUpdated(customer)
+	revision, err := revisionWrite(av, customer.ID, event)
+	if err != nil {
+		return err
+	}
+	guestKey := map[string]types.AttributeValue{"cpf": dynamodbpkg.String(guestKeyPrefix + customer.ID)}
+	deleteGuest, err := conditionalDelete(dynamodbpkg.CustomerTableName, guestKey, customerExists)
+	if err != nil {
+		return err
+	}
+	put, err := conditionalPut(dynamodbpkg.CustomerTableName, av, customerNotExists)
+	if err != nil {
+		return err
+	}
+	plainAbsent, err := plainCPFAbsent(customer.CPF)
+	if err != nil {
+		return err
+	}
+	err = r.transact(event, deleteGuest, put, plainAbsent, revision)
+
+	if err != nil {
+		var canceled *types.TransactionCanceledException
+		if errors.As(err, &canceled) {
+			return claimCancellationError(canceled)
+		}
+		return fmt.Errorf("failed to claim customer: %w", err)
+	}
+
+	return nil
+}
+
+// Update overwrites the item of an existing customer. The partition key cannot change, so the CPF
+// of a registered customer is fixed and guests keep their synthetic key. A customer written before
+// the encryption moves from its plain CPF to the blind index in the same transaction.
+func (r *CustomerRepositoryImpl) Update(customer *entities.Customer) error {
+	key, err := r.storedKey(customer)
+	if err != nil {
+		return err
+	}
+	av, err := r.marshalCustomer(customer)
+	if err != nil {
+		return err
+	}
+
+	event := events.NewCustomerUpdated(customer)
+	revision, err := revisionWrite(av, customer.ID, event)
+	if err != nil {
+		return err
+	}
+	var writes []types.TransactWriteItem
+	if key == dynamodbpkg.StringValue(av["cpf"]) {
+		put, err := conditionalPut(dynamodbpkg.CustomerTableName, av, customerIs(customer.ID))
+		if err != nil {
+			return err
+		}
+		writes = append(writes, put)
+	} else {
+		deletePlain, err := conditionalDelete(dynamodbpkg.CustomerTableName, itemKey(key), customerIs(customer.ID))
+		if err != nil {
+			return err
+		}
+		put, err := conditionalPut(dynamodbpkg.CustomerTableName, av, customerNotExists)
+		if err != nil {
+			return err
+		}
+		writes = append(writes, deletePlain, put)
+	}
+	err = r.transact(event, append(writes, revision)...)
+
+	if err != nil {
+		var canceled *types.TransactionCanceledException
+		if errors.As(err, &canceled) && cancellationReason(canceled, 0) == conditionalCheckFailed {
+			return repositories.ErrCustomerNotFound
+		}
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+
+	return nil
+}
+
+// Erase deletes the item under the key that holds it, which is still the plain CPF for a customer
+// written before the encryption.
+func (r *CustomerRepositoryImpl) Erase(customer *entities.Customer) error {
+	key, err := r.storedKey(customer)
+	if err != nil {
+		return err
+	}
+	deleteCustomer, err := conditionalDelete(dynamodbpkg.CustomerTableName, itemKey(key), customerIs(customer.ID))
+	if err != nil {
+		return err
+	}
+	err = r.transact(events.NewCustomerErased(customer), deleteCustomer)
+
+	if err != nil {
+		var canceled *types.TransactionCanceledException
+		if errors.As(err, &canceled) && cancellationReason(canceled, 0) == conditionalCheckFailed {
+			return repositories.ErrCustomerNotFound
+		}
+		return fmt.Errorf("failed to erase customer: %w", err)
+	}
+
+	return nil
+}
+
+// storedKey is the key holding the item of a customer. Guests are always under their synthetic
+// key, registered customers are looked up as GetByCpf does.
+func (r *CustomerRepositoryImpl) storedKey(customer *entities.Customer) (string, error) {
+	if customer.Guest {
+		return r.partitionKey(customer), nil
+	}
+	item, err := r.findItem(customer.CPF)
+	if err != nil {
+		return "", err
+	}
+	return dynamodbpkg.StringValue(item["cpf"]), nil
+}
+
+// findItem reads the item stored under the blind index of the CPF or, failing that, under the
+// plain CPF. Only a valid CPF is looked up as a plain key, so no other item, such as a guest, is
+// ever returned for it.
+func (r *CustomerRepositoryImpl) findItem(cpf string) (map[string]types.AttributeValue, error) {
+	keys := []string{r.cpfIndex(cpf)}
+	if _, err := entities.NormalizeCPF(cpf); err == nil && !strings.HasPrefix(cpf, guestKeyPrefix) {
+		keys = append(keys, cpf)
+	}
+
+	for _, key := range keys {
+		result, err := r.db.GetItem(context.Background(), &dynamodb.GetItemInput{
+			TableName: aws.String(dynamodbpkg.CustomerTableName),
+			Key:       itemKey(key),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get customer: %w", err)
+		}
+
+		if result.Item != nil {
+			return result.Item, nil
+		}
+	}
+
+	return nil, repositories.ErrCustomerNotFound
+}
+
+// FindRegisteredCPFs looks the blind indexes of the CPFs up in batches, reading only the keys, as
+// well as the plain CPFs of the customers written before the encryption.
+func (r *CustomerRepositoryImpl) FindRegisteredCPFs(cpfs []string) (map[string]bool, error) {
+	projection, err := expression.NewBuilder().WithProjection(expression.NamesList(expression.Name("cpf"))).Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build projection: %w", err)
+	}
+
+	registered := make(map[string]bool)
+	// Each CPF takes two keys of the batch.
+	batchSize := maxBatchGetKeys / 2
+	for start := 0; start < len(cpfs); start += batchSize {
+		end := min(start+batchSize, len(cpfs))
+		byIndex := make(map[string]string, 2*(end-start))
+		keys := make([]map[string]types.AttributeValue, 0, 2*(end-start))
+		for _, cpf := range cpfs[start:end] {
+			index := r.cpfIndex(cpf)
+			if _, ok := byIndex[index]; ok {
+				continue
+			}
+			byIndex[index] = cpf
+			byIndex[cpf] = cpf
+			keys = append(keys,
+				map[string]types.AttributeValue{"cpf": dynamodbpkg.String(index)},
+				map[string]types.AttributeValue{"cpf": dynamodbpkg.String(cpf)})
+		}
+
+		items, err := batchGet(r.db, dynamodbpkg.CustomerTableName, types.KeysAndAttributes{
+			Keys:                     keys,
+			ProjectionExpression:     projection.Projection(),
+			ExpressionAttributeNames: projection.Names(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find registered cpfs: %w", err)
+		}
+		for _, item := range items {
+			if cpf, ok := byIndex[dynamodbpkg.StringValue(item["cpf"])]; ok {
+				registered[cpf] = true
+			}
+		}
+	}
+	return registered, nil
+}
+
+// AddBatch registers each customer in a transaction of its own, as Add does, a few at a time. A
+// CPF registered after the caller looked it up fails with ErrCustomerAlreadyExists instead of
+// overwriting the customer stored under it.
+func (r *CustomerRepositoryImpl) AddBatch(customers []*entities.Customer) []error {
+	errs := make([]error, len(customers))
+	slots := make(chan struct{}, maxConcurrentRegistrations)
+	var wg sync.WaitGroup
+	for i, customer := range customers {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = r.Add(customer)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func (r *CustomerRepositoryImpl) Scan(segment int, totalSegments int, visit func(customer *entities.Customer) error) error {
+	input := &dynamodb.ScanInput{
+		TableName:     aws.String(dynamodbpkg.CustomerTableName),
+		Segment:       aws.Int32(int32(segment)),
+		TotalSegments: aws.Int32(int32(totalSegments)),
+	}
+
+	for {
+		result, err := r.db.Scan(context.Background(), input)
+		if err != nil {
+			return fmt.Errorf("failed to scan customers: %w", err)
+		}
+
+		for _, item := range result.Items {
+			customer, err := r.unmarshalCustomer(item)
+			if err != nil {
+				return err
+			}
+			if err := visit(customer); err != nil {
+				return err
+			}
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// transact applies the writes together with the outbox message of the event,
+// which is always the last item of the transaction.
+func (r *CustomerRepositoryImpl) transact(event events.Event, writes ...types.TransactWriteItem) error {
+	outboxWrite, err := outboxPut(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.TransactWriteItems(context.Background(), &dynamodb.TransactWriteItemsInput{
+		TransactItems: append(writes, outboxWrite),
+	})
+	return err
+}
+
+func outboxPut(event events.Event) (types.TransactWriteItem, error) {
+	message, err := outbox.NewMessage(event.EventType(), event.AggregateID(), event, event.OccurredAt())
+	if err != nil {
+		return types.TransactWriteItem{}, err
+	}
+	return outbox.TransactPut(dynamodbpkg.OutboxTableName, message)
+}
+
+// claimCancellationError maps the per-item cancellation reasons of a claim transaction.
+func claimCancellationError(canceled *types.TransactionCanceledException) error {
+	if cancellationReason(canceled, 0) == conditionalCheckFailed {
+		return repositories.ErrCustomerNotFound
+	}
+	if cancellationReason(canceled, 1) == conditionalCheckFailed || cancellationReason(canceled, 2) == conditionalCheckFailed {
+		return repositories.ErrCustomerAlreadyExists
+	}
+	return fmt.Errorf("failed to claim customer: %w", canceled)
+}
+
+// plainCPFAbsent checks that no customer written before the encryption is still keyed by the plain
+// CPF, which the blind index key of a new customer would not collide with.
+func plainCPFAbsent(cpf string) (types.TransactWriteItem, error) {
+	expr, err := buildCondition(customerNotExists)
+	if err != nil {
+		return types.TransactWriteItem{}, err
+	}
+	return types.TransactWriteItem{
+		ConditionCheck: &types.ConditionCheck{
+			TableName:                 aws.String(dynamodbpkg.CustomerTableName),
+			Key:                       map[string]types.AttributeValue{"cpf": dynamodbpkg.String(cpf)},
+			ConditionExpression:       expr.Condition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+		},
+	}, nil
+}
+
+func cancellationReason(canceled *types.TransactionCanceledException, index int) string {
+	if index >= len(canceled.CancellationReasons) {
+		return ""
+	}
+	return aws.ToString(canceled.CancellationReasons[index].Code)
+}
+
+func itemKey(key string) map[string]types.AttributeValue {
+	return map[string]types.AttributeValue{"cpf": dynamodbpkg.String(key)}
+}
+
+// partitionKey is the blind index of the CPF, or a synthetic key for guests, who have no CPF yet.
+func (c *customerCodec) partitionKey(customer *entities.Customer) string {
+	if customer.Guest {
+		return guestKeyPrefix + customer.ID
+	}
+	return c.cpfIndex(customer.CPF)
+}
+
+// cpfIndex ignores the punctuation of a valid CPF, so 123.456.789-09 and 12345678909 share a key.
+func (c *customerCodec) cpfIndex(cpf string) string {
+	if normalized, err := entities.NormalizeCPF(cpf); err == nil {
+		cpf = normalized
+	}
+	return c.blindIndex.Compute(cpfIndexDomain, cpf)
+}
+
+// emailIndex ignores case and surrounding spaces, which do not make a different address in practice.
+func (c *customerCodec) emailIndex(email string) string {
+	return c.blindIndex.Compute(emailIndexDomain, strings.ToLower(strings.TrimSpace(email)))
+}
+
+// marshalCustomer encrypts the personal data under a new data key, so every write also moves the
+// item to the current master key.
+func (c *customerCodec) marshalCustomer(customer *entities.Customer) (map[string]types.AttributeValue, error) {
+	itemCipher, err := c.encryptor.NewItemCipher()
+	if err != nil {
+		return nil, err
+	}
+
+	item := &customerItem{
+		Key:       c.partitionKey(customer),
+		ID:        customer.ID,
+		KeyID:     itemCipher.KeyID,
+		DataKey:   itemCipher.EncryptedKey,
+		CreatedAt: customer.CreatedAt,
+		Guest:     customer.Guest,
+		Nickname:  customer.Nickname,
+		ClaimedAt: customer.ClaimedAt,
+	}
+	if customer.Email != "" {
+		item.EmailIndex = c.emailIndex(customer.Email)
+	}
+	if item.EncryptedCPF, err = itemCipher.Encrypt(customer.CPF, associatedData(customer.ID, "cpf")); err != nil {
+		return nil, fmt.Errorf("failed to encrypt customer: %w", err)
+	}
+	if item.EncryptedName, err = itemCipher.Encrypt(customer.Name, associatedData(customer.ID, "name")); err != nil {
+		return nil, fmt.Errorf("failed to encrypt customer: %w", err)
+	}
+	if item.EncryptedEmail, err = itemCipher.Encrypt(customer.Email, associatedData(customer.ID, "email")); err != nil {
+		return nil, fmt.Errorf("failed to encrypt customer: %w", err)
+	}
+
+	av, err := attributevalue.MarshalMap(item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal customer: %w", err)
+	}
+	return av, nil
+}
+
+func (c *customerCodec) unmarshalCustomer(av map[string]types.AttributeValue) (*entities.Customer, error) {
+	item := &customerItem{}
+	err := attributevalue.UnmarshalMap(av, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal customer: %w", err)
+	}
+
+	customer := &entities.Customer{
+		ID:        item.ID,
+		CreatedAt: item.CreatedAt,
+		Guest:     item.Guest,
+		Nickname:  item.Nickname,
+		ClaimedAt: item.ClaimedAt,
+	}
+
+	// Items written before encryption carry the plain fields and the plain CPF as key.
+	if item.KeyID == "" {
+		customer.Name = item.Name
+		customer.Email = item.Email
+		if !item.Guest {
+			customer.CPF = item.Key
+		}
+		return customer, nil
+	}
+
+	itemCipher, err := c.encryptor.OpenItemCipher(item.KeyID, item.DataKey)
+	if err != nil {
+		return nil, err
+	}
+	if customer.CPF, err = itemCipher.Decrypt(item.EncryptedCPF, associatedData(item.ID, "cpf")); err != nil {
+		return nil, fmt.Errorf("failed to decrypt customer: %w", err)
+	}
+	if customer.Name, err = itemCipher.Decrypt(item.EncryptedName, associatedData(item.ID, "name")); err != nil {
+		return nil, fmt.Errorf("failed to decrypt customer: %w", err)
+	}
+	if customer.Email, err = itemCipher.Decrypt(item.EncryptedEmail, associatedData(item.ID, "email")); err != nil {
+		return nil, fmt.Errorf("failed to decrypt customer: %w", err)
+	}
+	return customer, nil
+}
+
+// associatedData binds an encrypted field to its customer and attribute.
+func associatedData(customerID string, field string) string {
+	return "customer/" + customerID + "/" + field
 }
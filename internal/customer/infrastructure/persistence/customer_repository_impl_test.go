@@ -1,26 +1,38 @@
 package persistence_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/events"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/persistence"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
 )
 
 // Mock DynamoDB Client
 type MockDynamoDBClient struct {
 	mock.Mock
-	dynamodbiface.DynamoDBAPI
+	dynamodbpkg.Client
 }
 
-func (m *MockDynamoDBClient) GetItem(input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+func (m *MockDynamoDBClient) GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
 	args := m.Called(input)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -28,7 +40,7 @@ func (m *MockDynamoDBClient) GetItem(input *dynamodb.GetItemInput) (*dynamodb.Ge
 	return args.Get(0).(*dynamodb.GetItemOutput), args.Error(1)
 }
 
-func (m *MockDynamoDBClient) PutItem(input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
 	args := m.Called(input)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -36,15 +48,125 @@ func (m *MockDynamoDBClient) PutItem(input *dynamodb.PutItemInput) (*dynamodb.Pu
 	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
 }
 
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.TransactWriteItemsOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) BatchWriteItem(ctx context.Context, input *dynamodb.BatchWriteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchWriteItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.BatchWriteItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) BatchGetItem(ctx context.Context, input *dynamodb.BatchGetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchGetItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.BatchGetItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Scan(ctx context.Context, input *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.ScanOutput), args.Error(1)
+}
+
+var (
+	oldMasterKey = []byte(strings.Repeat("o", encryption.KeySize))
+	newMasterKey = []byte(strings.Repeat("n", encryption.KeySize))
+	indexKey     = []byte(strings.Repeat("i", encryption.KeySize))
+)
+
 type CustomerRepositoryTestSuite struct {
 	suite.Suite
 	mockDB     *MockDynamoDBClient
+	blindIndex *encryption.BlindIndex
 	repository *persistence.CustomerRepositoryImpl
 }
 
 func (suite *CustomerRepositoryTestSuite) SetupTest() {
 	suite.mockDB = new(MockDynamoDBClient)
-	suite.repository = persistence.NewCustomerRepositoryImpl(suite.mockDB)
+	suite.repository = suite.newRepository("new-key")
+}
+
+// newRepository encrypts with currentKeyID while still reading items of both test master keys.
+func (suite *CustomerRepositoryTestSuite) newRepository(currentKeyID string) *persistence.CustomerRepositoryImpl {
+	provider, err := encryption.NewLocalKeyProvider(currentKeyID, map[string][]byte{
+		"old-key": oldMasterKey,
+		"new-key": newMasterKey,
+	}, indexKey)
+	suite.Require().NoError(err)
+	suite.blindIndex = encryption.NewBlindIndex(provider)
+	return persistence.NewCustomerRepositoryImpl(suite.mockDB, encryption.NewEncryptor(provider), suite.blindIndex)
+}
+
+func (suite *CustomerRepositoryTestSuite) cpfKey(cpf string) string {
+	return suite.blindIndex.Compute("customer.cpf", cpf)
+}
+
+// storedUnder makes the next read of key find the item of the customer.
+func storedUnder(mockDB *MockDynamoDBClient, key string, customerID string) {
+	mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == key
+	})).Return(&dynamodb.GetItemOutput{Item: map[string]types.AttributeValue{
+		"cpf": &types.AttributeValueMemberS{Value: key},
+		"id":  &types.AttributeValueMemberS{Value: customerID},
+	}}, nil).Once()
+}
+
+// captureWrite returns the customer item put by the next transaction.
+func (suite *CustomerRepositoryTestSuite) captureWrite() *map[string]types.AttributeValue {
+	item := &map[string]types.AttributeValue{}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Run(func(args mock.Arguments) {
+		*item = args.Get(0).(*dynamodb.TransactWriteItemsInput).TransactItems[0].Put.Item
+	}).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+	return item
+}
+
+// resolveNames resolves the attribute names of an expression built by the expression builder.
+func resolveNames(expression *string, names map[string]string) string {
+	resolved := aws.ToString(expression)
+	placeholders := slices.Collect(maps.Keys(names))
+	// #10 must be resolved before #1.
+	slices.SortFunc(placeholders, func(a, b string) int { return len(b) - len(a) })
+	for _, placeholder := range placeholders {
+		resolved = strings.ReplaceAll(resolved, placeholder, names[placeholder])
+	}
+	return resolved
+}
+
+// hasValue tells whether an expression was given the string value want.
+func hasValue(values map[string]types.AttributeValue, want string) bool {
+	for _, value := range values {
+		if dynamodbpkg.StringValue(value) == want {
+			return true
+		}
+	}
+	return false
+}
+
+func binaryValue(av types.AttributeValue) []byte {
+	if b, ok := av.(*types.AttributeValueMemberB); ok {
+		return b.Value
+	}
+	return nil
 }
 
 func TestCustomerRepositoryTestSuite(t *testing.T) {
@@ -55,7 +177,7 @@ func TestCustomerRepositoryTestSuite(t *testing.T) {
 // Scenario: Retrieve customer data from DynamoDB
 
 func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithExistingCPF_ShouldReturnCustomerFromDynamoDB() {
-	// GIVEN a customer exists in DynamoDB with CPF 12345678901
+	// GIVEN a customer stored in DynamoDB with CPF 12345678901 before encryption
 	cpf := "12345678901"
 	expectedCustomer := &entities.Customer{
 		ID:    "test-id-123",
@@ -65,11 +187,11 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithExistingCPF
 	}
 
 	output := &dynamodb.GetItemOutput{
-		Item: map[string]*dynamodb.AttributeValue{
-			"ID":    {S: aws.String("test-id-123")},
-			"CPF":   {N: aws.String("12345678901")},
-			"Name":  {S: aws.String("John Doe")},
-			"Email": {S: aws.String("john@example.com")},
+		Item: map[string]types.AttributeValue{
+			"ID":    &types.AttributeValueMemberS{Value: "test-id-123"},
+			"CPF":   &types.AttributeValueMemberN{Value: "12345678901"},
+			"Name":  &types.AttributeValueMemberS{Value: "John Doe"},
+			"Email": &types.AttributeValueMemberS{Value: "john@example.com"},
 		},
 	}
 
@@ -92,12 +214,13 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithExistingCPF
 
 func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithNonExistentCPF_ShouldReturnNotFoundError() {
 	// GIVEN a CPF for a customer that does not exist in DynamoDB
-	cpf := "99999999999"
+	cpf := "52998224725"
 	output := &dynamodb.GetItemOutput{
 		Item: nil, // Empty result
 	}
 
-	suite.mockDB.On("GetItem", mock.Anything).Return(output, nil).Once()
+	// AND neither under the blind index nor under the plain CPF
+	suite.mockDB.On("GetItem", mock.Anything).Return(output, nil).Twice()
 
 	// WHEN retrieving the customer by CPF from the repository
 	result, err := suite.repository.GetByCpf(cpf)
@@ -112,6 +235,58 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithNonExistent
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithCustomerWrittenBeforeEncryption_ShouldFallBackToPlainCPF() {
+	// GIVEN a customer still keyed by the plain CPF
+	cpf := "52998224725"
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == suite.cpfKey(cpf)
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == cpf
+	})).Return(&dynamodb.GetItemOutput{Item: map[string]types.AttributeValue{
+		"cpf":  &types.AttributeValueMemberS{Value: cpf},
+		"id":   &types.AttributeValueMemberS{Value: "legacy-1"},
+		"name": &types.AttributeValueMemberS{Value: "John Doe"},
+	}}, nil).Once()
+
+	// WHEN retrieving the customer by CPF
+	result, err := suite.repository.GetByCpf(cpf)
+
+	// THEN it should be found under its plain CPF
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "legacy-1", result.ID)
+	assert.Equal(suite.T(), cpf, result.CPF)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithPunctuatedCPF_ShouldLookUpBlindIndexOfBareCPF() {
+	// GIVEN a customer stored under the blind index of the bare CPF
+	storedUnder(suite.mockDB, suite.cpfKey("52998224725"), "customer-1")
+
+	// WHEN retrieving the customer by the punctuated CPF
+	result, err := suite.repository.GetByCpf("529.982.247-25")
+
+	// THEN it should be found
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "customer-1", result.ID)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithGuestKey_ShouldNotFallBackToIt() {
+	// GIVEN the synthetic key of a guest, which has no item under its blind index
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == suite.cpfKey("guest#guest-1")
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+
+	// WHEN retrieving a customer by it
+	result, err := suite.repository.GetByCpf("guest#guest-1")
+
+	// THEN the guest item should never be read
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Nil(suite.T(), result)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithDynamoDBError_ShouldReturnError() {
 	// GIVEN a CPF for a customer lookup
 	cpf := "12345678901"
@@ -145,8 +320,8 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithValidCust
 		Email: "jane@example.com",
 	}
 
-	output := &dynamodb.PutItemOutput{}
-	suite.mockDB.On("PutItem", mock.Anything).Return(output, nil).Once()
+	output := &dynamodb.TransactWriteItemsOutput{}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(output, nil).Once()
 
 	// WHEN adding the customer to the repository
 	err := suite.repository.Add(customer)
@@ -155,7 +330,7 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithValidCust
 	assert.NoError(suite.T(), err)
 	// AND the customer should have been assigned a unique ID
 	assert.NotEmpty(suite.T(), customer.ID)
-	// AND DynamoDB PutItem should have been called
+	// AND DynamoDB TransactWriteItems should have been called
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
@@ -169,7 +344,7 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithDynamoDBE
 
 	// AND DynamoDB encounters a write error
 	expectedError := errors.New("DynamoDB write error")
-	suite.mockDB.On("PutItem", mock.Anything).Return(nil, expectedError).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, expectedError).Once()
 
 	// WHEN adding the customer to the repository
 	err := suite.repository.Add(customer)
@@ -179,7 +354,7 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithDynamoDBE
 	// AND the error should indicate the failure
 	assert.Contains(suite.T(), err.Error(), "failed to add customer")
 	assert.Contains(suite.T(), err.Error(), "DynamoDB write error")
-	// AND DynamoDB PutItem should have been called
+	// AND DynamoDB TransactWriteItems should have been called
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
@@ -192,8 +367,8 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithProvidedI
 		Email: "jane@example.com",
 	}
 
-	output := &dynamodb.PutItemOutput{}
-	suite.mockDB.On("PutItem", mock.Anything).Return(output, nil).Once()
+	output := &dynamodb.TransactWriteItemsOutput{}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(output, nil).Once()
 
 	// WHEN adding the customer to the repository
 	err := suite.repository.Add(customer)
@@ -204,7 +379,7 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithProvidedI
 	assert.NotEqual(suite.T(), "existing-id", customer.ID)
 	// AND a new unique ID should have been assigned
 	assert.NotEmpty(suite.T(), customer.ID)
-	// AND DynamoDB PutItem should have been called
+	// AND DynamoDB TransactWriteItems should have been called
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
@@ -215,8 +390,8 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithUnmarshalEr
 	// AND DynamoDB returns an item with invalid structure for unmarshaling
 	// e.g. CPF is expected to be uint, but we return a complex map that can't be converted
 	output := &dynamodb.GetItemOutput{
-		Item: map[string]*dynamodb.AttributeValue{
-			"CPF": {M: map[string]*dynamodb.AttributeValue{}}, // Invalid type for uint
+		Item: map[string]types.AttributeValue{
+			"CPF": &types.AttributeValueMemberM{Value: map[string]types.AttributeValue{}}, // Invalid type for uint
 		},
 	}
 
@@ -234,3 +409,697 @@ func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithUnmarshalEr
 	// AND DynamoDB GetItem should have been called
 	suite.mockDB.AssertExpectations(suite.T())
 }
+
+// Feature: Customer Repository - Guest Customers
+// Scenario: Persist, find and claim guests keyed by ID
+
+func (suite *CustomerRepositoryTestSuite) Test_GuestPersistence_ShouldUseGuestPartitionKey() {
+	// GIVEN a guest customer without CPF
+	customer := &entities.Customer{Guest: true, Nickname: "Johnny"}
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		item := input.TransactItems[0].Put.Item
+		return dynamodbpkg.StringValue(item["cpf"]) == "guest#"+dynamodbpkg.StringValue(item["id"])
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN adding the guest to the repository
+	err := suite.repository.Add(customer)
+
+	// THEN the guest should be stored under a synthetic key derived from its ID
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), customer.ID)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_ByID_ShouldQueryIDIndex() {
+	// GIVEN a guest stored in DynamoDB
+	output := &dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"id":       &types.AttributeValueMemberS{Value: "guest-1"},
+			"cpf":      &types.AttributeValueMemberS{Value: "guest#guest-1"},
+			"guest":    &types.AttributeValueMemberBOOL{Value: true},
+			"nickname": &types.AttributeValueMemberS{Value: "Johnny"},
+		}},
+	}
+
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == "id-index"
+	})).Return(output, nil).Once()
+
+	// WHEN retrieving the customer by ID
+	result, err := suite.repository.GetByID("guest-1")
+
+	// THEN the guest should be returned without the synthetic key as CPF
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "guest-1", result.ID)
+	assert.True(suite.T(), result.Guest)
+	assert.Empty(suite.T(), result.CPF)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_ByUnknownID_ShouldReturnNotFound() {
+	// GIVEN no customer with the ID
+	suite.mockDB.On("Query", mock.Anything).Return(&dynamodb.QueryOutput{}, nil).Once()
+
+	// WHEN retrieving the customer by ID
+	result, err := suite.repository.GetByID("missing")
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_GuestClaim_ShouldReplaceGuestItemInOneTransaction() {
+	// GIVEN a claimed guest
+	claimedAt := time.Now()
+	customer := &entities.Customer{ID: "guest-1", CPF: "12345678901", Name: "John Doe", ClaimedAt: &claimedAt}
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		deleteKey := dynamodbpkg.StringValue(input.TransactItems[0].Delete.Key["cpf"])
+		putKey := dynamodbpkg.StringValue(input.TransactItems[1].Put.Item["cpf"])
+		putID := dynamodbpkg.StringValue(input.TransactItems[1].Put.Item["id"])
+		return deleteKey == "guest#guest-1" && putKey == suite.cpfKey("12345678901") && putID == "guest-1"
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN claiming the guest
+	err := suite.repository.Claim(customer)
+
+	// THEN the guest item should be swapped for the item keyed by the CPF blind index
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_GuestClaim_WithTakenCPF_ShouldReturnAlreadyExists() {
+	// GIVEN the CPF item already exists
+	canceled := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("None")},
+			{Code: aws.String("ConditionalCheckFailed")},
+		},
+	}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceled).Once()
+
+	// WHEN claiming the guest
+	err := suite.repository.Claim(&entities.Customer{ID: "guest-1", CPF: "12345678901"})
+
+	// THEN a conflict should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerAlreadyExists)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_GuestClaim_WithMissingGuest_ShouldReturnNotFound() {
+	// GIVEN the guest item no longer exists
+	canceled := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("ConditionalCheckFailed")},
+			{Code: aws.String("None")},
+		},
+	}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceled).Once()
+
+	// WHEN claiming the guest
+	err := suite.repository.Claim(&entities.Customer{ID: "guest-1", CPF: "12345678901"})
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
+
+// Feature: Customer Repository - Domain Events
+// Scenario: Every write stores its event in the outbox within the same transaction
+
+// outboxEvent returns the event type written to the outbox by the transaction, if any.
+func outboxEvent(input *dynamodb.TransactWriteItemsInput) string {
+	last := input.TransactItems[len(input.TransactItems)-1]
+	if last.Put == nil || aws.ToString(last.Put.TableName) != dynamodbpkg.OutboxTableName {
+		return ""
+	}
+	return dynamodbpkg.StringValue(last.Put.Item["type"])
+}
+
+// revisionChange returns the change of the revision written to the customer history by the transaction, if any.
+func revisionChange(input *dynamodb.TransactWriteItemsInput) string {
+	for _, write := range input.TransactItems {
+		if write.Put != nil && aws.ToString(write.Put.TableName) == dynamodbpkg.CustomerHistoryTableName {
+			return dynamodbpkg.StringValue(write.Put.Item["change"])
+		}
+	}
+	return ""
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_ShouldWriteRegisteredEventInSameTransaction() {
+	// GIVEN a new customer
+	customer := &entities.Customer{CPF: "12345678901", Name: "Jane Doe"}
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		plainCheck := input.TransactItems[1].ConditionCheck
+		return len(input.TransactItems) == 4 &&
+			resolveNames(input.TransactItems[0].Put.ConditionExpression, input.TransactItems[0].Put.ExpressionAttributeNames) == "attribute_not_exists (cpf)" &&
+			dynamodbpkg.StringValue(plainCheck.Key["cpf"]) == "12345678901" &&
+			resolveNames(plainCheck.ConditionExpression, plainCheck.ExpressionAttributeNames) == "attribute_not_exists (cpf)" &&
+			revisionChange(input) == events.CustomerRegisteredType &&
+			outboxEvent(input) == events.CustomerRegisteredType
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN adding the customer
+	err := suite.repository.Add(customer)
+
+	// THEN the customer, its first revision and its CustomerRegistered event should be written together
+	// AND only if no customer is still keyed by the plain CPF
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithRegisteredCPF_ShouldReturnAlreadyExists() {
+	// GIVEN the CPF is already stored
+	canceled := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("ConditionalCheckFailed")},
+			{Code: aws.String("None")},
+		},
+	}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceled).Once()
+
+	// WHEN adding the customer
+	err := suite.repository.Add(&entities.Customer{CPF: "12345678901"})
+
+	// THEN the existing customer should not be overwritten
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerAlreadyExists)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_WithCPFWrittenBeforeEncryption_ShouldReturnAlreadyExists() {
+	// GIVEN the CPF is still stored under its plain key
+	canceled := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("None")},
+			{Code: aws.String("ConditionalCheckFailed")},
+			{Code: aws.String("None")},
+			{Code: aws.String("None")},
+		},
+	}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceled).Once()
+
+	// WHEN adding the customer
+	err := suite.repository.Add(&entities.Customer{CPF: "12345678901"})
+
+	// THEN the existing customer should not be duplicated under the blind index
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerAlreadyExists)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerUpdate_ShouldWriteUpdatedEventInSameTransaction() {
+	// GIVEN an existing customer with new data
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "Jane Roe"}
+	storedUnder(suite.mockDB, suite.cpfKey("12345678901"), "customer-1")
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		put := input.TransactItems[0].Put
+		return put.Item["name"] == nil && len(binaryValue(put.Item["name_encrypted"])) > 0 &&
+			hasValue(put.ExpressionAttributeValues, "customer-1") &&
+			revisionChange(input) == events.CustomerUpdatedType &&
+			outboxEvent(input) == events.CustomerUpdatedType
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN updating the customer
+	err := suite.repository.Update(customer)
+
+	// THEN the customer and its CustomerUpdated event should be written together
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerUpdate_WithMissingCustomer_ShouldReturnNotFound() {
+	// GIVEN the customer was removed meanwhile
+	storedUnder(suite.mockDB, suite.cpfKey("12345678901"), "customer-1")
+	canceled := &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("ConditionalCheckFailed")},
+			{Code: aws.String("None")},
+		},
+	}
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceled).Once()
+
+	// WHEN updating the customer
+	err := suite.repository.Update(&entities.Customer{ID: "customer-1", CPF: "12345678901"})
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerErasure_ShouldDeleteAndWriteErasedEvent() {
+	// GIVEN a registered customer
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901", Name: "Jane Doe", Email: "jane@example.com"}
+	storedUnder(suite.mockDB, suite.cpfKey("12345678901"), "customer-1")
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		payload := dynamodbpkg.StringValue(input.TransactItems[1].Put.Item["payload"])
+		return dynamodbpkg.StringValue(input.TransactItems[0].Delete.Key["cpf"]) == suite.cpfKey("12345678901") &&
+			revisionChange(input) == "" &&
+			outboxEvent(input) == events.CustomerErasedType &&
+			!strings.Contains(payload, "jane@example.com")
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN erasing the customer
+	err := suite.repository.Erase(customer)
+
+	// THEN the item should be deleted with a CustomerErased event carrying no personal data
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerUpdate_WithCustomerWrittenBeforeEncryption_ShouldMoveItToBlindIndex() {
+	// GIVEN a customer still keyed by the plain CPF
+	cpf := "52998224725"
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == suite.cpfKey(cpf)
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+	storedUnder(suite.mockDB, cpf, "legacy-1")
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		deletePlain, put := input.TransactItems[0].Delete, input.TransactItems[1].Put
+		return deletePlain != nil && dynamodbpkg.StringValue(deletePlain.Key["cpf"]) == cpf &&
+			hasValue(deletePlain.ExpressionAttributeValues, "legacy-1") &&
+			put != nil && dynamodbpkg.StringValue(put.Item["cpf"]) == suite.cpfKey(cpf) &&
+			resolveNames(put.ConditionExpression, put.ExpressionAttributeNames) == "attribute_not_exists (cpf)" &&
+			revisionChange(input) == events.CustomerUpdatedType &&
+			outboxEvent(input) == events.CustomerUpdatedType
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN updating the customer
+	err := suite.repository.Update(&entities.Customer{ID: "legacy-1", CPF: cpf, Name: "Jane Roe"})
+
+	// THEN the plain CPF item should be replaced by one under the blind index in the same transaction
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerErasure_WithCustomerWrittenBeforeEncryption_ShouldDeletePlainCPF() {
+	// GIVEN a customer still keyed by the plain CPF
+	cpf := "52998224725"
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == suite.cpfKey(cpf)
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+	storedUnder(suite.mockDB, cpf, "legacy-1")
+
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		return dynamodbpkg.StringValue(input.TransactItems[0].Delete.Key["cpf"]) == cpf &&
+			outboxEvent(input) == events.CustomerErasedType
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN erasing the customer
+	err := suite.repository.Erase(&entities.Customer{ID: "legacy-1", CPF: cpf})
+
+	// THEN the item under the plain CPF should be deleted
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerErasure_WithMissingCustomer_ShouldReturnNotFoundWithoutWriting() {
+	// GIVEN no item under the blind index nor under the plain CPF
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, nil).Twice()
+
+	// WHEN erasing the customer
+	err := suite.repository.Erase(&entities.Customer{ID: "customer-1", CPF: "52998224725"})
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	suite.mockDB.AssertNotCalled(suite.T(), "TransactWriteItems", mock.Anything)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_GuestErasure_ShouldDeleteGuestKey() {
+	// GIVEN a guest
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		return dynamodbpkg.StringValue(input.TransactItems[0].Delete.Key["cpf"]) == "guest#guest-1"
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN erasing the guest
+	err := suite.repository.Erase(&entities.Customer{ID: "guest-1", Guest: true})
+
+	// THEN the synthetic key should be deleted
+	assert.NoError(suite.T(), err)
+}
+
+// Feature: Customer Repository - Encryption at Rest
+// Scenario: Personal data is encrypted and still found by CPF and email
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerPersistence_ShouldStoreOnlyEncryptedPersonalData() {
+	// GIVEN a new customer
+	customer := &entities.Customer{CPF: "12345678901", Name: "Jane Doe", Email: "Jane@Example.com"}
+	item := suite.captureWrite()
+
+	// WHEN adding the customer
+	err := suite.repository.Add(customer)
+
+	// THEN no attribute should hold the CPF, name or email in plaintext
+	assert.NoError(suite.T(), err)
+	for name, value := range *item {
+		for _, plaintext := range []string{"12345678901", "Jane Doe", "Jane@Example.com"} {
+			assert.NotContains(suite.T(), fmt.Sprint(value), plaintext, name)
+		}
+	}
+	// AND the item should carry the master key ID and its encrypted data key
+	assert.Equal(suite.T(), "new-key", dynamodbpkg.StringValue((*item)["key_id"]))
+	assert.NotEmpty(suite.T(), binaryValue((*item)["data_key"]))
+	assert.Equal(suite.T(), suite.cpfKey("12345678901"), dynamodbpkg.StringValue((*item)["cpf"]))
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_ByCPF_ShouldDecryptItem() {
+	// GIVEN a customer stored encrypted
+	item := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "12345678901", Name: "Jane Doe", Email: "jane@example.com"}))
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["cpf"]) == suite.cpfKey("12345678901")
+	})).Return(&dynamodb.GetItemOutput{Item: *item}, nil).Once()
+
+	// WHEN retrieving the customer by CPF
+	result, err := suite.repository.GetByCpf("12345678901")
+
+	// THEN the item should be found through the blind index and decrypted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "12345678901", result.CPF)
+	assert.Equal(suite.T(), "Jane Doe", result.Name)
+	assert.Equal(suite.T(), "jane@example.com", result.Email)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_AfterKeyRotation_ShouldDecryptWithStoredKeyID() {
+	// GIVEN a customer encrypted before the master key was rotated
+	item := suite.captureWrite()
+	suite.Require().NoError(suite.newRepository("old-key").Add(&entities.Customer{CPF: "12345678901", Name: "Jane Doe"}))
+	assert.Equal(suite.T(), "old-key", dynamodbpkg.StringValue((*item)["key_id"]))
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{Item: *item}, nil).Once()
+
+	// WHEN retrieving it with the new current key
+	result, err := suite.repository.GetByCpf("12345678901")
+
+	// THEN the old master key should still decrypt it
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Jane Doe", result.Name)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_WithFieldsCopiedFromAnotherCustomer_ShouldReturnError() {
+	// GIVEN an item whose ID no longer matches the one its fields were encrypted for
+	item := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "12345678901", Name: "Jane Doe"}))
+	(*item)["id"] = &types.AttributeValueMemberS{Value: "another-customer"}
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{Item: *item}, nil).Once()
+
+	// WHEN retrieving it
+	result, err := suite.repository.GetByCpf("12345678901")
+
+	// THEN decryption should fail
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, encryption.ErrMalformedCiphertext)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_CustomerRetrieval_ByEmail_ShouldQueryEmailIndex() {
+	// GIVEN two customers sharing an email, returned over two pages
+	first := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "12345678901", Name: "Jane Doe", Email: "jane@example.com"}))
+	second := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "10987654321", Name: "John Doe", Email: "jane@example.com"}))
+	emailIndex := suite.blindIndex.Compute("customer.email", "jane@example.com")
+	lastKey := map[string]types.AttributeValue{"cpf": &types.AttributeValueMemberS{Value: "last"}}
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == "email-index" &&
+			hasValue(input.ExpressionAttributeValues, emailIndex) &&
+			input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{Items: []map[string]types.AttributeValue{*first}, LastEvaluatedKey: lastKey}, nil).Once()
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(&dynamodb.QueryOutput{Items: []map[string]types.AttributeValue{*second}}, nil).Once()
+
+	// WHEN finding customers by the email typed differently
+	result, err := suite.repository.FindByEmail("  JANE@example.com ")
+
+	// THEN both customers should be found and decrypted
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), "Jane Doe", result[0].Name)
+	assert.Equal(suite.T(), "John Doe", result[1].Name)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+// Feature: Customer Repository - Bulk Import
+// Scenario: Look up registered CPFs and store customers in batches
+
+func (suite *CustomerRepositoryTestSuite) Test_FindRegisteredCPFs_ShouldLookUpBlindIndexes() {
+	// GIVEN one of two CPFs is stored
+	registered := map[string]types.AttributeValue{"cpf": &types.AttributeValueMemberS{Value: suite.cpfKey("52998224725")}}
+	suite.mockDB.On("BatchGetItem", mock.MatchedBy(func(input *dynamodb.BatchGetItemInput) bool {
+		return len(input.RequestItems[dynamodbpkg.CustomerTableName].Keys) == 4
+	})).Return(&dynamodb.BatchGetItemOutput{
+		Responses: map[string][]map[string]types.AttributeValue{dynamodbpkg.CustomerTableName: {registered}},
+	}, nil).Once()
+
+	// WHEN looking the CPFs up
+	result, err := suite.repository.FindRegisteredCPFs([]string{"52998224725", "11144477735"})
+
+	// THEN only the stored CPF should be reported
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]bool{"52998224725": true}, result)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_FindRegisteredCPFs_WithKeysLeftUnprocessed_ShouldReturnError() {
+	// GIVEN DynamoDB still leaves a key unprocessed after the retries of the client
+	suite.mockDB.On("BatchGetItem", mock.Anything).Return(&dynamodb.BatchGetItemOutput{
+		UnprocessedKeys: map[string]types.KeysAndAttributes{
+			dynamodbpkg.CustomerTableName: {Keys: []map[string]types.AttributeValue{
+				{"cpf": &types.AttributeValueMemberS{Value: suite.cpfKey("52998224725")}},
+			}},
+		},
+	}, nil).Once()
+
+	// WHEN looking the CPF up
+	result, err := suite.repository.FindRegisteredCPFs([]string{"52998224725"})
+
+	// THEN the lookup should fail rather than report the CPF as free
+	assert.Nil(suite.T(), result)
+	assert.ErrorContains(suite.T(), err, "1 keys left unprocessed")
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_FindRegisteredCPFs_WithCustomerWrittenBeforeEncryption_ShouldReportIt() {
+	// GIVEN a CPF still stored under its plain key
+	suite.mockDB.On("BatchGetItem", mock.Anything).Return(&dynamodb.BatchGetItemOutput{
+		Responses: map[string][]map[string]types.AttributeValue{dynamodbpkg.CustomerTableName: {
+			{"cpf": &types.AttributeValueMemberS{Value: "52998224725"}},
+		}},
+	}, nil).Once()
+
+	// WHEN looking it up
+	result, err := suite.repository.FindRegisteredCPFs([]string{"52998224725"})
+
+	// THEN it should be reported as registered
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]bool{"52998224725": true}, result)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_AddBatch_ShouldRegisterEachCustomerInItsOwnTransaction() {
+	// GIVEN ten new customers
+	customers := make([]*entities.Customer, 10)
+	for i := range customers {
+		customers[i] = &entities.Customer{CPF: "5299822472" + string(rune('0'+i)), Name: "Jane Doe"}
+	}
+	var mu sync.Mutex
+	outboxWrites := 0
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Run(func(args mock.Arguments) {
+		items := args.Get(0).(*dynamodb.TransactWriteItemsInput).TransactItems
+		mu.Lock()
+		defer mu.Unlock()
+		// The put is conditional, the plain CPF is checked, then the revision and the event follow.
+		assert.Len(suite.T(), items, 4)
+		assert.Equal(suite.T(), "attribute_not_exists (cpf)", resolveNames(items[0].Put.ConditionExpression, items[0].Put.ExpressionAttributeNames))
+		assert.NotNil(suite.T(), items[1].ConditionCheck)
+		assert.Equal(suite.T(), dynamodbpkg.CustomerHistoryTableName, aws.ToString(items[2].Put.TableName))
+		assert.Equal(suite.T(), events.CustomerRegisteredType, dynamodbpkg.StringValue(items[3].Put.Item["type"]))
+		outboxWrites++
+	}).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Times(10)
+
+	// WHEN adding them in bulk
+	errs := suite.repository.AddBatch(customers)
+
+	// THEN every customer should be stored with its revision and CustomerRegistered event
+	assert.Equal(suite.T(), make([]error, 10), errs)
+	assert.Equal(suite.T(), 10, outboxWrites)
+	// AND each customer should have an ID of its own, even though they were added concurrently
+	ids := make(map[string]bool, len(customers))
+	for _, customer := range customers {
+		assert.NoError(suite.T(), uuid.Validate(customer.ID))
+		ids[customer.ID] = true
+	}
+	assert.Len(suite.T(), ids, len(customers))
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_AddBatch_WithCPFRegisteredMeanwhile_ShouldReportDuplicate() {
+	// GIVEN one of the CPFs was stored under its plain key after it was looked up
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		return dynamodbpkg.StringValue(input.TransactItems[1].ConditionCheck.Key["cpf"]) == "52998224725"
+	})).Return(nil, &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{
+			{Code: aws.String("None")},
+			{Code: aws.String("ConditionalCheckFailed")},
+		},
+	}).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN adding both in bulk
+	errs := suite.repository.AddBatch([]*entities.Customer{{CPF: "52998224725"}, {CPF: "11144477735"}})
+
+	// THEN only the registered CPF should fail, as a duplicate
+	assert.ErrorIs(suite.T(), errs[0], repositories.ErrCustomerAlreadyExists)
+	assert.NoError(suite.T(), errs[1])
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_AddBatch_WithDynamoDBError_ShouldFailOnlyThatCustomer() {
+	// GIVEN DynamoDB fails the transaction of one of the customers
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		return dynamodbpkg.StringValue(input.TransactItems[1].ConditionCheck.Key["cpf"]) == "52998224725"
+	})).Return(nil, errors.New("unavailable")).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN adding two customers in bulk
+	errs := suite.repository.AddBatch([]*entities.Customer{{CPF: "52998224725"}, {CPF: "11144477735"}})
+
+	// THEN only that customer should fail
+	assert.Len(suite.T(), errs, 2)
+	assert.ErrorContains(suite.T(), errs[0], "unavailable")
+	assert.NoError(suite.T(), errs[1])
+}
+
+// Feature: Customer Repository - Export
+// Scenario: Scan a segment of the customer table page by page
+
+func (suite *CustomerRepositoryTestSuite) Test_Scan_ShouldVisitDecryptedCustomersOfEveryPage() {
+	// GIVEN a segment holding two customers over two pages
+	first := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "52998224725", Name: "Jane Doe"}))
+	second := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "11144477735", Name: "John Doe"}))
+	lastKey := map[string]types.AttributeValue{"cpf": &types.AttributeValueMemberS{Value: "last"}}
+	suite.mockDB.On("Scan", mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return aws.ToInt32(input.Segment) == 1 && aws.ToInt32(input.TotalSegments) == 4 && input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.ScanOutput{Items: []map[string]types.AttributeValue{*first}, LastEvaluatedKey: lastKey}, nil).Once()
+	suite.mockDB.On("Scan", mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(&dynamodb.ScanOutput{Items: []map[string]types.AttributeValue{*second}}, nil).Once()
+
+	// WHEN scanning the segment
+	var names []string
+	err := suite.repository.Scan(1, 4, func(customer *entities.Customer) error {
+		names = append(names, customer.Name)
+		return nil
+	})
+
+	// THEN both customers should be visited decrypted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"Jane Doe", "John Doe"}, names)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_Scan_WhenVisitFails_ShouldStop() {
+	// GIVEN a segment with more pages to read
+	item := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "52998224725"}))
+	lastKey := map[string]types.AttributeValue{"cpf": &types.AttributeValueMemberS{Value: "last"}}
+	suite.mockDB.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{Items: []map[string]types.AttributeValue{*item}, LastEvaluatedKey: lastKey}, nil).Once()
+	stopped := errors.New("stopped")
+
+	// WHEN the visitor fails on the first customer
+	err := suite.repository.Scan(0, 1, func(*entities.Customer) error { return stopped })
+
+	// THEN the scan should stop with the error
+	assert.ErrorIs(suite.T(), err, stopped)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+// Feature: Customer Repository - Reindex
+// Scenario: Move plaintext and old key items to the current master key
+
+func (suite *CustomerRepositoryTestSuite) Test_Reindex_ShouldEncryptPlaintextAndReencryptOldKeyItems() {
+	// GIVEN a plaintext item keyed by a numeric CPF, an item under the old key and one under the current key
+	legacy := map[string]types.AttributeValue{
+		"cpf":        &types.AttributeValueMemberN{Value: "52998224725"},
+		"id":         &types.AttributeValueMemberS{Value: "legacy-1"},
+		"name":       &types.AttributeValueMemberS{Value: "Teste Direto"},
+		"email":      &types.AttributeValueMemberS{Value: "teste@direto.com"},
+		"created_at": &types.AttributeValueMemberS{Value: "2026-01-09T01:00:00Z"},
+	}
+	old := suite.captureWrite()
+	suite.Require().NoError(suite.newRepository("old-key").Add(&entities.Customer{CPF: "11144477735", Name: "John Doe"}))
+	current := suite.captureWrite()
+	suite.Require().NoError(suite.repository.Add(&entities.Customer{CPF: "39053344705", Name: "Ana Lima"}))
+	suite.mockDB.On("Scan", mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return aws.ToInt32(input.Segment) == 2 && aws.ToInt32(input.TotalSegments) == 8
+	})).Return(&dynamodb.ScanOutput{Items: []map[string]types.AttributeValue{legacy, *old, *current}}, nil).Once()
+	var moved *dynamodb.TransactWriteItemsInput
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Run(func(args mock.Arguments) {
+		moved = args.Get(0).(*dynamodb.TransactWriteItemsInput)
+	}).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+	var reencrypted *dynamodb.PutItemInput
+	suite.mockDB.On("PutItem", mock.Anything).Run(func(args mock.Arguments) {
+		reencrypted = args.Get(0).(*dynamodb.PutItemInput)
+	}).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN reindexing the segment
+	report, err := suite.repository.Reindex(2, 8)
+
+	// THEN the plaintext item should move to the blind index of its CPF, encrypted
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), entities.ReindexReport{Scanned: 3, Encrypted: 1, Reencrypted: 1}, *report)
+	suite.Require().Len(moved.TransactItems, 2)
+	assert.Equal(suite.T(), &types.AttributeValueMemberN{Value: "52998224725"}, moved.TransactItems[0].Delete.Key["cpf"])
+	put := moved.TransactItems[1].Put.Item
+	assert.Equal(suite.T(), suite.cpfKey("52998224725"), dynamodbpkg.StringValue(put["cpf"]))
+	assert.Equal(suite.T(), "new-key", dynamodbpkg.StringValue(put["key_id"]))
+	assert.NotEmpty(suite.T(), dynamodbpkg.StringValue(put["email_index"]))
+	assert.Nil(suite.T(), put["name"])
+	assert.Nil(suite.T(), put["email"])
+	// AND the old key item should be rewritten under the current key if still on the old one
+	assert.Equal(suite.T(), "new-key", dynamodbpkg.StringValue(reencrypted.Item["key_id"]))
+	assert.Equal(suite.T(), "key_id = :0", resolveNames(reencrypted.ConditionExpression, reencrypted.ExpressionAttributeNames))
+	assert.True(suite.T(), hasValue(reencrypted.ExpressionAttributeValues, "old-key"))
+	suite.mockDB.AssertExpectations(suite.T())
+
+	// AND the moved item should read back as the same customer
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{Item: put}, nil).Once()
+	customer, err := suite.repository.GetByCpf("52998224725")
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "legacy-1", customer.ID)
+	assert.Equal(suite.T(), "Teste Direto", customer.Name)
+	assert.Equal(suite.T(), "teste@direto.com", customer.Email)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_Reindex_WithChangedItems_ShouldReportConflicts() {
+	// GIVEN a plaintext item whose CPF was registered again and an old key item updated meanwhile
+	legacy := map[string]types.AttributeValue{
+		"cpf":        &types.AttributeValueMemberS{Value: "52998224725"},
+		"id":         &types.AttributeValueMemberS{Value: "legacy-1"},
+		"created_at": &types.AttributeValueMemberS{Value: "2026-01-09T01:00:00Z"},
+	}
+	old := suite.captureWrite()
+	suite.Require().NoError(suite.newRepository("old-key").Add(&entities.Customer{CPF: "11144477735"}))
+	suite.mockDB.On("Scan", mock.Anything).Return(&dynamodb.ScanOutput{Items: []map[string]types.AttributeValue{legacy, *old}}, nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, &types.TransactionCanceledException{
+		CancellationReasons: []types.CancellationReason{{Code: aws.String("None")}, {Code: aws.String("ConditionalCheckFailed")}},
+	}).Once()
+	suite.mockDB.On("PutItem", mock.Anything).Return(nil, &types.ConditionalCheckFailedException{}).Once()
+
+	// WHEN reindexing
+	report, err := suite.repository.Reindex(0, 1)
+
+	// THEN both customers should be left as they were and reported
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), 2, report.Scanned)
+	assert.Equal(suite.T(), []string{"legacy-1", dynamodbpkg.StringValue((*old)["id"])}, report.Conflicts)
+}
+
+func (suite *CustomerRepositoryTestSuite) Test_Reindex_WithDynamoDBError_ShouldReturnError() {
+	// GIVEN the scan fails
+	suite.mockDB.On("Scan", mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+	// WHEN reindexing
+	report, err := suite.repository.Reindex(0, 1)
+
+	// THEN the error should be returned
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), report)
+}
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// customerExists and customerNotExists condition the writes on whether the customer item is there.
+var (
+	customerExists    = expression.AttributeExists(expression.Name("cpf"))
+	customerNotExists = expression.AttributeNotExists(expression.Name("cpf"))
+)
+
+// customerIs conditions a write on the item existing and belonging to the customer, as a CPF
+// never moves to another customer.
+func customerIs(customerID string) expression.ConditionBuilder {
+	return customerExists.And(expression.Name("id").Equal(expression.Value(customerID)))
+}
+
+// buildCondition builds the expression of a conditional write.
+func buildCondition(condition expression.ConditionBuilder) (expression.Expression, error) {
+	expr, err := expression.NewBuilder().WithCondition(condition).Build()
+	if err != nil {
+		return expression.Expression{}, fmt.Errorf("failed to build condition: %w", err)
+	}
+	return expr, nil
+}
+
+// buildQuery builds the expression of a query, reading only the projected attributes when any is given.
+func buildQuery(keyCondition expression.KeyConditionBuilder, projection ...expression.NameBuilder) (expression.Expression, error) {
+	builder := expression.NewBuilder().WithKeyCondition(keyCondition)
+	if len(projection) > 0 {
+		builder = builder.WithProjection(expression.NamesList(projection[0], projection[1:]...))
+	}
+	expr, err := builder.Build()
+	if err != nil {
+		return expression.Expression{}, fmt.Errorf("failed to build query: %w", err)
+	}
+	return expr, nil
+}
+
+func conditionalPut(tableName string, item map[string]types.AttributeValue, condition expression.ConditionBuilder) (types.TransactWriteItem, error) {
+	expr, err := buildCondition(condition)
+	if err != nil {
+		return types.TransactWriteItem{}, err
+	}
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:                 aws.String(tableName),
+			Item:                      item,
+			ConditionExpression:       expr.Condition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+		},
+	}, nil
+}
+
+func conditionalDelete(tableName string, key map[string]types.AttributeValue, condition expression.ConditionBuilder) (types.TransactWriteItem, error) {
+	expr, err := buildCondition(condition)
+	if err != nil {
+		return types.TransactWriteItem{}, err
+	}
+	return types.TransactWriteItem{
+		Delete: &types.Delete{
+			TableName:                 aws.String(tableName),
+			Key:                       key,
+			ConditionExpression:       expr.Condition(),
+			ExpressionAttributeNames:  expr.Names(),
+			ExpressionAttributeValues: expr.Values(),
+		},
+	}, nil
+}
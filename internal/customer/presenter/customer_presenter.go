@@ -1,10 +1,17 @@
 package presenter
 
 import (
+	"time"
+
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
 )
 
 type CustomerPresenter interface {
 	Present(customer *entities.Customer) *dto.GetCustomerResponseDto
+	PresentSession(customer *entities.Customer, token string, expiresAt time.Time) *dto.CustomerSessionResponseDto
+	PresentRevision(revision *entities.CustomerRevision) *dto.CustomerRevisionResponseDto
+	PresentHistory(page *entities.CustomerHistoryPage) *dto.CustomerHistoryResponseDto
+	PresentImport(report *entities.ImportReport) *dto.ImportCustomersResponseDto
+	PresentReindex(report *entities.ReindexReport) *dto.ReindexCustomersResponseDto
 }
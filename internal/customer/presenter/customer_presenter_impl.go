@@ -1,6 +1,8 @@
 package presenter
 
 import (
+	"time"
+
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
 )
@@ -23,5 +25,66 @@ func (p *CustomerPresenterImpl) Present(customer *entities.Customer) *dto.GetCus
 		Name:      customer.Name,
 		CPF:       customer.CPF,
 		Email:     customer.Email,
+		Guest:     customer.Guest,
+		Nickname:  customer.Nickname,
+		Tier:      customer.Tier,
+	}
+}
+
+func (p *CustomerPresenterImpl) PresentSession(customer *entities.Customer, token string, expiresAt time.Time) *dto.CustomerSessionResponseDto {
+	return &dto.CustomerSessionResponseDto{
+		Customer:    *p.Present(customer),
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func (p *CustomerPresenterImpl) PresentRevision(revision *entities.CustomerRevision) *dto.CustomerRevisionResponseDto {
+	return &dto.CustomerRevisionResponseDto{
+		ID:         revision.ID,
+		Change:     revision.Change,
+		RecordedAt: revision.RecordedAt,
+		Customer:   *p.Present(revision.Customer),
+	}
+}
+
+func (p *CustomerPresenterImpl) PresentHistory(page *entities.CustomerHistoryPage) *dto.CustomerHistoryResponseDto {
+	response := &dto.CustomerHistoryResponseDto{
+		Revisions:  make([]dto.CustomerRevisionResponseDto, 0, len(page.Revisions)),
+		NextCursor: page.NextCursor,
+	}
+	for _, revision := range page.Revisions {
+		response.Revisions = append(response.Revisions, *p.PresentRevision(revision))
+	}
+	return response
+}
+
+func (p *CustomerPresenterImpl) PresentImport(report *entities.ImportReport) *dto.ImportCustomersResponseDto {
+	response := &dto.ImportCustomersResponseDto{
+		Created:    report.Created,
+		Duplicates: report.Duplicates,
+		Invalid:    report.Invalid,
+		Failed:     report.Failed,
+		Rows:       make([]dto.ImportRowResultResponseDto, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		response.Rows = append(response.Rows, dto.ImportRowResultResponseDto{
+			Line:       row.Line,
+			Status:     row.Status,
+			Reason:     row.Reason,
+			CustomerID: row.CustomerID,
+		})
+	}
+	return response
+}
+
+// PresentReindex lists the conflicts as an empty array rather than null when there were none.
+func (p *CustomerPresenterImpl) PresentReindex(report *entities.ReindexReport) *dto.ReindexCustomersResponseDto {
+	return &dto.ReindexCustomersResponseDto{
+		Scanned:     report.Scanned,
+		Encrypted:   report.Encrypted,
+		Reencrypted: report.Reencrypted,
+		Conflicts:   append([]string{}, report.Conflicts...),
 	}
 }
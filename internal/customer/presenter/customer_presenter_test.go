@@ -35,6 +35,7 @@ func (suite *CustomerPresenterTestSuite) Test_CustomerPresentation_WithValidCust
 		Name:      "John Doe",
 		Email:     "john@example.com",
 		CreatedAt: now,
+		Tier:      "gold",
 	}
 
 	// WHEN the presenter transforms the customer to DTO
@@ -48,6 +49,7 @@ func (suite *CustomerPresenterTestSuite) Test_CustomerPresentation_WithValidCust
 	assert.Equal(suite.T(), customer.Name, dto.Name)
 	assert.Equal(suite.T(), customer.Email, dto.Email)
 	assert.Equal(suite.T(), customer.CreatedAt, dto.CreatedAt)
+	assert.Equal(suite.T(), customer.Tier, dto.Tier)
 }
 
 func (suite *CustomerPresenterTestSuite) Test_CustomerPresentation_WithEmptyFields_ShouldPreserveEmptyValues() {
@@ -96,3 +98,99 @@ func (suite *CustomerPresenterTestSuite) Test_CustomerPresentation_WithAlternati
 	assert.Equal(suite.T(), "jane.smith@test.com", dto.Email)
 	assert.Equal(suite.T(), now, dto.CreatedAt)
 }
+
+// Scenario: Transform an identified customer into a session response
+
+func (suite *CustomerPresenterTestSuite) Test_SessionPresentation_WithIssuedToken_ShouldIncludeCustomerAndToken() {
+	// GIVEN an identified customer and an issued token
+	expiresAt := time.Now().Add(15 * time.Minute)
+	customer := &entities.Customer{
+		ID:    "customer-1",
+		CPF:   "12345678901",
+		Name:  "John Doe",
+		Email: "john@example.com",
+	}
+
+	// WHEN the presenter transforms the session
+	dto := suite.presenter.PresentSession(customer, "signed-token", expiresAt)
+
+	// THEN the token should be exposed as a bearer token
+	assert.Equal(suite.T(), "signed-token", dto.AccessToken)
+	assert.Equal(suite.T(), "Bearer", dto.TokenType)
+	assert.Equal(suite.T(), expiresAt, dto.ExpiresAt)
+	// AND the customer should be presented
+	assert.Equal(suite.T(), "customer-1", dto.Customer.ID)
+	assert.Equal(suite.T(), "John Doe", dto.Customer.Name)
+}
+
+// Scenario: Transform the customer history into a page of revisions
+
+func (suite *CustomerPresenterTestSuite) Test_HistoryPresentation_ShouldPresentEachRevision() {
+	// GIVEN a page of revisions
+	recordedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	page := &entities.CustomerHistoryPage{
+		Revisions: []*entities.CustomerRevision{
+			{ID: "rev-2", Change: "customer.updated", RecordedAt: recordedAt, Customer: &entities.Customer{ID: "customer-1", Name: "Jane Roe"}},
+			{ID: "rev-1", Change: "customer.registered", RecordedAt: recordedAt.Add(-time.Hour), Customer: &entities.Customer{ID: "customer-1", Name: "Jane Doe"}},
+		},
+		NextCursor: "next",
+	}
+
+	// WHEN the presenter transforms the page
+	dto := suite.presenter.PresentHistory(page)
+
+	// THEN every revision should be presented in order with its snapshot
+	suite.Require().Len(dto.Revisions, 2)
+	assert.Equal(suite.T(), "rev-2", dto.Revisions[0].ID)
+	assert.Equal(suite.T(), "customer.updated", dto.Revisions[0].Change)
+	assert.Equal(suite.T(), recordedAt, dto.Revisions[0].RecordedAt)
+	assert.Equal(suite.T(), "Jane Roe", dto.Revisions[0].Customer.Name)
+	assert.Equal(suite.T(), "Jane Doe", dto.Revisions[1].Customer.Name)
+	assert.Equal(suite.T(), "next", dto.NextCursor)
+}
+
+func (suite *CustomerPresenterTestSuite) Test_HistoryPresentation_WithoutRevisions_ShouldPresentEmptyList() {
+	// WHEN the presenter transforms an empty page
+	dto := suite.presenter.PresentHistory(&entities.CustomerHistoryPage{})
+
+	// THEN the revisions should be an empty list rather than null
+	assert.NotNil(suite.T(), dto.Revisions)
+	assert.Empty(suite.T(), dto.Revisions)
+}
+
+func (suite *CustomerPresenterTestSuite) Test_ImportPresentation_ShouldPresentCountsAndRows() {
+	// GIVEN the report of an import
+	report := &entities.ImportReport{
+		Created: 1,
+		Invalid: 1,
+		Rows: []*entities.ImportRowResult{
+			{Line: 2, Status: entities.ImportCreated, CustomerID: "customer-1"},
+			{Line: 3, Status: entities.ImportInvalid, Reason: "email is not a valid address"},
+		},
+	}
+
+	// WHEN the presenter transforms the report
+	dto := suite.presenter.PresentImport(report)
+
+	// THEN the counts and every row should be presented in order
+	assert.Equal(suite.T(), 1, dto.Created)
+	assert.Equal(suite.T(), 1, dto.Invalid)
+	suite.Require().Len(dto.Rows, 2)
+	assert.Equal(suite.T(), "customer-1", dto.Rows[0].CustomerID)
+	assert.Equal(suite.T(), "email is not a valid address", dto.Rows[1].Reason)
+}
+
+func (suite *CustomerPresenterTestSuite) Test_ReindexPresentation_WithoutConflicts_ShouldPresentEmptyList() {
+	// GIVEN the report of a reindex without conflicts
+	report := &entities.ReindexReport{Scanned: 3, Encrypted: 1, Reencrypted: 1}
+
+	// WHEN the presenter transforms the report
+	dto := suite.presenter.PresentReindex(report)
+
+	// THEN the counts should be presented and the conflicts be an empty list rather than null
+	assert.Equal(suite.T(), 3, dto.Scanned)
+	assert.Equal(suite.T(), 1, dto.Encrypted)
+	assert.Equal(suite.T(), 1, dto.Reencrypted)
+	assert.NotNil(suite.T(), dto.Conflicts)
+	assert.Empty(suite.T(), dto.Conflicts)
+}
@@ -1,6 +1,8 @@
 package addCustomer
 
 import (
+	"strings"
+
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
@@ -18,11 +20,22 @@ func NewAddCustomerUseCaseImpl(customerRepository repositories.CustomerRepositor
 	return &AddCustomerUseCaseImpl{customerRepository: customerRepository}
 }
 
+// Execute applies the CPF and email rules of the import, so the CPF is stored in its normalized
+// form whichever way the customer was registered.
 func (u *AddCustomerUseCaseImpl) Execute(command *commands.AddCustomerCommand) error {
+	cpf, err := entities.NormalizeCPF(command.CPF)
+	if err != nil {
+		return err
+	}
+	email := strings.TrimSpace(command.Email)
+	if err := entities.ValidateEmail(email); err != nil {
+		return err
+	}
+
 	entity := entities.Customer{
-		Name:  command.Name,
-		Email: command.Email,
-		CPF:   command.CPF,
+		Name:  strings.TrimSpace(command.Name),
+		Email: email,
+		CPF:   cpf,
 	}
 
 	return u.customerRepository.Add(&entity)
@@ -32,7 +32,7 @@ func TestAddCustomerUseCaseTestSuite(t *testing.T) {
 
 func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithValidInformation_ShouldPersistSuccessfully() {
 	// GIVEN a customer with valid name, email, and CPF
-	command := commands.NewAddCustomerCommand("John Doe", "john@example.com", "12345678901")
+	command := commands.NewAddCustomerCommand("John Doe", "john@example.com", "12345678909")
 
 	expectedCustomer := &entities.Customer{
 		Name:  command.Name,
@@ -56,7 +56,7 @@ func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithValidInf
 
 func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithRepositoryFailure_ShouldReturnError() {
 	// GIVEN a customer registration request
-	command := commands.NewAddCustomerCommand("Jane Doe", "jane@example.com", "98765432109")
+	command := commands.NewAddCustomerCommand("Jane Doe", "jane@example.com", "98765432100")
 
 	expectedCustomer := &entities.Customer{
 		Name:  command.Name,
@@ -83,26 +83,43 @@ func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithReposito
 	suite.mockRepository.AssertExpectations(suite.T())
 }
 
-func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithEmptyData_ShouldAcceptAndPersist() {
-	// GIVEN a customer registration request with empty data
-	command := commands.NewAddCustomerCommand("", "", "0")
-
-	expectedCustomer := &entities.Customer{
-		Name:  command.Name,
-		Email: command.Email,
-		CPF:   command.CPF,
-	}
+func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithPunctuatedCPF_ShouldPersistNormalizedCPF() {
+	// GIVEN a customer whose CPF is punctuated and email padded
+	command := commands.NewAddCustomerCommand("John Doe", " john@example.com ", "123.456.789-09")
 
 	// WHEN the customer registration is executed
 	suite.mockRepository.EXPECT().
-		Add(expectedCustomer).
+		Add(&entities.Customer{Name: "John Doe", Email: "john@example.com", CPF: "12345678909"}).
 		Return(nil).
 		Once()
 
 	err := suite.useCase.Execute(command)
 
-	// THEN the operation should complete without errors
+	// THEN the customer should be persisted with the bare CPF, as the import stores it
 	assert.NoError(suite.T(), err)
-	// AND the repository should have received the empty customer data
 	suite.mockRepository.AssertExpectations(suite.T())
 }
+
+func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithInvalidCPF_ShouldBeRejected() {
+	// GIVEN a customer registration request with a CPF that is not valid
+	command := commands.NewAddCustomerCommand("", "", "0")
+
+	// WHEN the customer registration is executed
+	err := suite.useCase.Execute(command)
+
+	// THEN the CPF should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidCPF)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add")
+}
+
+func (suite *AddCustomerUseCaseTestSuite) Test_CustomerRegistration_WithInvalidEmail_ShouldBeRejected() {
+	// GIVEN a customer registration request with an email that is not an address
+	command := commands.NewAddCustomerCommand("John Doe", "john@", "12345678909")
+
+	// WHEN the customer registration is executed
+	err := suite.useCase.Execute(command)
+
+	// THEN the email should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidEmail)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add")
+}
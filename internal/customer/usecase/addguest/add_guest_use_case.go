@@ -0,0 +1,10 @@
+package addguest
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type AddGuestUseCase interface {
+	Execute(command *commands.AddGuestCommand) (*entities.Customer, error)
+}
@@ -0,0 +1,32 @@
+package addguest
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ AddGuestUseCase = (*AddGuestUseCaseImpl)(nil)
+)
+
+type AddGuestUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewAddGuestUseCaseImpl(customerRepository repositories.CustomerRepository) *AddGuestUseCaseImpl {
+	return &AddGuestUseCaseImpl{customerRepository: customerRepository}
+}
+
+func (u *AddGuestUseCaseImpl) Execute(command *commands.AddGuestCommand) (*entities.Customer, error) {
+	entity := &entities.Customer{
+		Guest:    true,
+		Nickname: command.Nickname,
+	}
+
+	if err := u.customerRepository.Add(entity); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,69 @@
+package addguest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/addguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type AddGuestUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        addguest.AddGuestUseCase
+}
+
+func (suite *AddGuestUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = addguest.NewAddGuestUseCaseImpl(suite.mockRepository)
+}
+
+func TestAddGuestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AddGuestUseCaseTestSuite))
+}
+
+// Feature: Add Guest Use Case
+// Scenario: Order without identification
+
+func (suite *AddGuestUseCaseTestSuite) Test_GuestCreation_WithNickname_ShouldPersistGuestWithoutCPF() {
+	// GIVEN a guest with only a nickname
+	command := commands.NewAddGuestCommand("Johnny")
+
+	suite.mockRepository.EXPECT().
+		Add(&entities.Customer{Guest: true, Nickname: "Johnny"}).
+		Run(func(customer *entities.Customer) { customer.ID = "guest-1" }).
+		Return(nil).
+		Once()
+
+	// WHEN the guest is created
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the guest should be returned with its generated ID
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "guest-1", result.ID)
+	assert.True(suite.T(), result.Guest)
+	// AND no CPF should be set
+	assert.Empty(suite.T(), result.CPF)
+}
+
+func (suite *AddGuestUseCaseTestSuite) Test_GuestCreation_WithRepositoryFailure_ShouldReturnError() {
+	// GIVEN the repository fails
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		Add(&entities.Customer{Guest: true}).
+		Return(expectedError).
+		Once()
+
+	// WHEN the guest is created
+	result, err := suite.useCase.Execute(commands.NewAddGuestCommand(""))
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
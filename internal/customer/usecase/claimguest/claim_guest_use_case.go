@@ -0,0 +1,16 @@
+package claimguest
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	ErrCustomerNotGuest = errors.New("customer is not a guest")
+)
+
+type ClaimGuestUseCase interface {
+	Execute(command *commands.ClaimGuestCommand) (*entities.Customer, error)
+}
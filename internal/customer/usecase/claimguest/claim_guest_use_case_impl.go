@@ -0,0 +1,81 @@
+package claimguest
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ ClaimGuestUseCase = (*ClaimGuestUseCaseImpl)(nil)
+)
+
+type ClaimGuestUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewClaimGuestUseCaseImpl(customerRepository repositories.CustomerRepository) *ClaimGuestUseCaseImpl {
+	return &ClaimGuestUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute attaches a CPF and contact data to a guest while keeping its ID, so the
+// orders already placed by the guest stay linked to the registered customer. The CPF and
+// email follow the same rules as AddCustomer.
+func (u *ClaimGuestUseCaseImpl) Execute(command *commands.ClaimGuestCommand) (*entities.Customer, error) {
+	cpf, err := entities.NormalizeCPF(command.CPF)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.TrimSpace(command.Email)
+	if err := entities.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+
+	guest, err := u.customerRepository.GetByID(command.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	if !guest.Guest {
+		return nil, ErrCustomerNotGuest
+	}
+
+	_, err = u.customerRepository.GetByCpf(cpf)
+	if err == nil {
+		return nil, repositories.ErrCustomerAlreadyExists
+	}
+	if !errors.Is(err, repositories.ErrCustomerNotFound) {
+		return nil, err
+	}
+
+	claimed := merge(guest, cpf, strings.TrimSpace(command.Name), email)
+	if err := u.customerRepository.Claim(claimed); err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// merge keeps what the guest already told us unless the claim provides something better.
+func merge(guest *entities.Customer, cpf string, name string, email string) *entities.Customer {
+	claimedAt := time.Now()
+	claimed := &entities.Customer{
+		ID:        guest.ID,
+		CPF:       cpf,
+		Name:      name,
+		Email:     email,
+		CreatedAt: guest.CreatedAt,
+		Nickname:  guest.Nickname,
+		ClaimedAt: &claimedAt,
+	}
+	if claimed.Name == "" {
+		claimed.Name = guest.Nickname
+	}
+	if claimed.Email == "" {
+		claimed.Email = guest.Email
+	}
+	return claimed
+}
@@ -0,0 +1,166 @@
+package claimguest_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/claimguest"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type ClaimGuestUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        claimguest.ClaimGuestUseCase
+	guest          *entities.Customer
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = claimguest.NewClaimGuestUseCaseImpl(suite.mockRepository)
+	suite.guest = &entities.Customer{
+		ID:        "guest-1",
+		Guest:     true,
+		Nickname:  "Johnny",
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestClaimGuestUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ClaimGuestUseCaseTestSuite))
+}
+
+// Feature: Claim Guest Use Case
+// Scenario: Guest identifies after ordering
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithNewCPF_ShouldKeepIDAndMergeData() {
+	// GIVEN a guest and a CPF that is not registered yet
+	command := commands.NewClaimGuestCommand("guest-1", "12345678909", "", "john@example.com")
+
+	suite.mockRepository.EXPECT().GetByID("guest-1").Return(suite.guest, nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf("12345678909").Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockRepository.EXPECT().
+		Claim(mock.MatchedBy(func(customer *entities.Customer) bool {
+			return customer.ID == "guest-1" && !customer.Guest && customer.CPF == "12345678909"
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN the guest is claimed
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the registered customer should keep the guest ID and creation date
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "guest-1", result.ID)
+	assert.Equal(suite.T(), suite.guest.CreatedAt, result.CreatedAt)
+	assert.False(suite.T(), result.Guest)
+	// AND the nickname should fill the missing name
+	assert.Equal(suite.T(), "Johnny", result.Name)
+	assert.Equal(suite.T(), "john@example.com", result.Email)
+	assert.NotNil(suite.T(), result.ClaimedAt)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithRegisteredCPF_ShouldReturnAlreadyExists() {
+	// GIVEN a CPF that already belongs to another customer
+	command := commands.NewClaimGuestCommand("guest-1", "12345678909", "John Doe", "")
+
+	suite.mockRepository.EXPECT().GetByID("guest-1").Return(suite.guest, nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf("12345678909").Return(&entities.Customer{ID: "customer-2"}, nil).Once()
+
+	// WHEN the guest is claimed
+	result, err := suite.useCase.Execute(command)
+
+	// THEN a conflict should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerAlreadyExists)
+	assert.Nil(suite.T(), result)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Claim", mock.Anything)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithRegisteredCustomer_ShouldReturnNotGuest() {
+	// GIVEN a customer that was never a guest
+	command := commands.NewClaimGuestCommand("customer-1", "12345678909", "John Doe", "")
+
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(&entities.Customer{ID: "customer-1", CPF: "98765432100"}, nil).Once()
+
+	// WHEN it is claimed
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the claim should be refused
+	assert.ErrorIs(suite.T(), err, claimguest.ErrCustomerNotGuest)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithUnknownGuest_ShouldReturnNotFound() {
+	// GIVEN an unknown guest ID
+	suite.mockRepository.EXPECT().GetByID("missing").Return(nil, repositories.ErrCustomerNotFound).Once()
+
+	// WHEN it is claimed
+	result, err := suite.useCase.Execute(commands.NewClaimGuestCommand("missing", "12345678909", "", ""))
+
+	// THEN not found should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithLookupFailure_ShouldReturnError() {
+	// GIVEN the CPF lookup fails
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().GetByID("guest-1").Return(suite.guest, nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf("12345678909").Return(nil, expectedError).Once()
+
+	// WHEN the guest is claimed
+	result, err := suite.useCase.Execute(commands.NewClaimGuestCommand("guest-1", "12345678909", "", ""))
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
+
+// Scenario: The CPF and email follow the same rules as a registration
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithPunctuatedCPF_ShouldClaimBareCPF() {
+	// GIVEN a guest claiming a punctuated CPF
+	command := commands.NewClaimGuestCommand("guest-1", "123.456.789-09", "John Doe", "")
+
+	suite.mockRepository.EXPECT().GetByID("guest-1").Return(suite.guest, nil).Once()
+	suite.mockRepository.EXPECT().GetByCpf("12345678909").Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockRepository.EXPECT().
+		Claim(mock.MatchedBy(func(customer *entities.Customer) bool {
+			return customer.CPF == "12345678909"
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN the guest is claimed
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the bare CPF should be stored
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "12345678909", result.CPF)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithInvalidCPF_ShouldBeRejected() {
+	// WHEN claiming a guest with a CPF with wrong check digits
+	result, err := suite.useCase.Execute(commands.NewClaimGuestCommand("guest-1", "12345678901", "", ""))
+
+	// THEN the CPF should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidCPF)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *ClaimGuestUseCaseTestSuite) Test_GuestClaim_WithInvalidEmail_ShouldBeRejected() {
+	// WHEN claiming a guest with an email that is not an address
+	result, err := suite.useCase.Execute(commands.NewClaimGuestCommand("guest-1", "12345678909", "", "john@"))
+
+	// THEN the email should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidEmail)
+	assert.Nil(suite.T(), result)
+}
@@ -1,9 +1,12 @@
 package commands
 
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
 type AddCustomerCommand struct {
 	Name  string
 	Email string
 	CPF   string
+	Actor audit.Actor
 }
 
 func NewAddCustomerCommand(name string, email string, cpf string) *AddCustomerCommand {
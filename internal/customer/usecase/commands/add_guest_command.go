@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type AddGuestCommand struct {
+	Nickname string
+	Actor    audit.Actor
+}
+
+func NewAddGuestCommand(nickname string) *AddGuestCommand {
+	return &AddGuestCommand{
+		Nickname: nickname,
+	}
+}
@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewAddGuestCommand(t *testing.T) {
+	// GIVEN a guest nickname
+	nickname := "Johnny"
+
+	// WHEN creating a new AddGuestCommand
+	command := commands.NewAddGuestCommand(nickname)
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, nickname, command.Nickname)
+}
@@ -0,0 +1,20 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type ClaimGuestCommand struct {
+	CustomerID string
+	CPF        string
+	Name       string
+	Email      string
+	Actor      audit.Actor
+}
+
+func NewClaimGuestCommand(customerID string, cpf string, name string, email string) *ClaimGuestCommand {
+	return &ClaimGuestCommand{
+		CustomerID: customerID,
+		CPF:        cpf,
+		Name:       name,
+		Email:      email,
+	}
+}
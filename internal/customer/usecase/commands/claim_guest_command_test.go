@@ -0,0 +1,23 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewClaimGuestCommand(t *testing.T) {
+	// GIVEN a guest ID and the identification data
+	customerID := "guest-1"
+
+	// WHEN creating a new ClaimGuestCommand
+	command := commands.NewClaimGuestCommand(customerID, "12345678901", "John Doe", "john@example.com")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, customerID, command.CustomerID)
+	assert.Equal(t, "12345678901", command.CPF)
+	assert.Equal(t, "John Doe", command.Name)
+	assert.Equal(t, "john@example.com", command.Email)
+}
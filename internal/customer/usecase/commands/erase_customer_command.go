@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type EraseCustomerCommand struct {
+	CustomerID string
+	Actor      audit.Actor
+}
+
+func NewEraseCustomerCommand(customerID string) *EraseCustomerCommand {
+	return &EraseCustomerCommand{
+		CustomerID: customerID,
+	}
+}
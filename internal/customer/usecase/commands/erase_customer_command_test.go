@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewEraseCustomerCommand(t *testing.T) {
+	// GIVEN a customer ID
+	customerID := "customer-1"
+
+	// WHEN creating a new EraseCustomerCommand
+	command := commands.NewEraseCustomerCommand(customerID)
+
+	// THEN the command should carry the customer ID
+	assert.NotNil(t, command)
+	assert.Equal(t, customerID, command.CustomerID)
+}
@@ -0,0 +1,18 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type ExportCustomersCommand struct {
+	// Segments is how many parts of the table are scanned in parallel.
+	Segments int
+	// MaskPII hides most of the CPF, name, email and nickname of every customer.
+	MaskPII bool
+	Actor   audit.Actor
+}
+
+func NewExportCustomersCommand(segments int, maskPII bool) *ExportCustomersCommand {
+	return &ExportCustomersCommand{
+		Segments: segments,
+		MaskPII:  maskPII,
+	}
+}
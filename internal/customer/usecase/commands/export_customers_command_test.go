@@ -0,0 +1,18 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewExportCustomersCommand(t *testing.T) {
+	// WHEN creating a new ExportCustomersCommand
+	command := commands.NewExportCustomersCommand(8, true)
+
+	// THEN the command should carry the segments and the masking option
+	assert.NotNil(t, command)
+	assert.Equal(t, 8, command.Segments)
+	assert.True(t, command.MaskPII)
+}
@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type GetCustomerAsOfCommand struct {
+	CustomerID string
+	AsOf       time.Time
+	Actor      audit.Actor
+}
+
+func NewGetCustomerAsOfCommand(customerID string, asOf time.Time) *GetCustomerAsOfCommand {
+	return &GetCustomerAsOfCommand{
+		CustomerID: customerID,
+		AsOf:       asOf,
+	}
+}
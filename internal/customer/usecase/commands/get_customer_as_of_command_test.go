@@ -0,0 +1,22 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewGetCustomerAsOfCommand(t *testing.T) {
+	// GIVEN a customer ID and a date
+	asOf := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	// WHEN creating a new GetCustomerAsOfCommand
+	command := commands.NewGetCustomerAsOfCommand("customer-1", asOf)
+
+	// THEN the command should carry the customer ID and the date
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, asOf, command.AsOf)
+}
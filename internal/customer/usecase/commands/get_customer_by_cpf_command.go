@@ -1,7 +1,10 @@
 package commands
 
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
 type GetCustomerByCpfCommand struct {
-	CPF string
+	CPF   string
+	Actor audit.Actor
 }
 
 func NewGetCustomerByCpfCommand(cpf string) *GetCustomerByCpfCommand {
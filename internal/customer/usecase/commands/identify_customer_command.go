@@ -0,0 +1,20 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type IdentifyCustomerCommand struct {
+	CPF          string
+	AutoRegister bool
+	Name         string
+	Email        string
+	Actor        audit.Actor
+}
+
+func NewIdentifyCustomerCommand(cpf string, autoRegister bool, name string, email string) *IdentifyCustomerCommand {
+	return &IdentifyCustomerCommand{
+		CPF:          cpf,
+		AutoRegister: autoRegister,
+		Name:         name,
+		Email:        email,
+	}
+}
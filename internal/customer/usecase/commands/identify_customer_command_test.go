@@ -0,0 +1,23 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewIdentifyCustomerCommand(t *testing.T) {
+	// GIVEN identification data with auto registration enabled
+	cpf := "12345678901"
+
+	// WHEN creating a new IdentifyCustomerCommand
+	command := commands.NewIdentifyCustomerCommand(cpf, true, "John Doe", "john@example.com")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, cpf, command.CPF)
+	assert.True(t, command.AutoRegister)
+	assert.Equal(t, "John Doe", command.Name)
+	assert.Equal(t, "john@example.com", command.Email)
+}
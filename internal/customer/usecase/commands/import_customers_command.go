@@ -0,0 +1,24 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+// ImportCustomerRow is a row of an import file. Error is set when the row could not even be read,
+// such as malformed JSON, and is reported as the reason the row is invalid.
+type ImportCustomerRow struct {
+	Line  int
+	Name  string
+	Email string
+	CPF   string
+	Error string
+}
+
+type ImportCustomersCommand struct {
+	Rows  []*ImportCustomerRow
+	Actor audit.Actor
+}
+
+func NewImportCustomersCommand(rows []*ImportCustomerRow) *ImportCustomersCommand {
+	return &ImportCustomersCommand{
+		Rows: rows,
+	}
+}
@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewImportCustomersCommand(t *testing.T) {
+	// GIVEN the rows of an import file
+	rows := []*commands.ImportCustomerRow{{Line: 2, Name: "Jane Doe", CPF: "52998224725"}}
+
+	// WHEN creating a new ImportCustomersCommand
+	command := commands.NewImportCustomersCommand(rows)
+
+	// THEN the command should carry the rows
+	assert.NotNil(t, command)
+	assert.Equal(t, rows, command.Rows)
+}
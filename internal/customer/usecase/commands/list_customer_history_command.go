@@ -0,0 +1,18 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type ListCustomerHistoryCommand struct {
+	CustomerID string
+	Limit      int
+	Cursor     string
+	Actor      audit.Actor
+}
+
+func NewListCustomerHistoryCommand(customerID string, limit int, cursor string) *ListCustomerHistoryCommand {
+	return &ListCustomerHistoryCommand{
+		CustomerID: customerID,
+		Limit:      limit,
+		Cursor:     cursor,
+	}
+}
@@ -0,0 +1,22 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewListCustomerHistoryCommand(t *testing.T) {
+	// GIVEN a customer ID and a page request
+	customerID := "customer-1"
+
+	// WHEN creating a new ListCustomerHistoryCommand
+	command := commands.NewListCustomerHistoryCommand(customerID, 20, "cursor")
+
+	// THEN the command should carry the customer ID and the page request
+	assert.NotNil(t, command)
+	assert.Equal(t, customerID, command.CustomerID)
+	assert.Equal(t, 20, command.Limit)
+	assert.Equal(t, "cursor", command.Cursor)
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type ReindexCustomersCommand struct {
+	// Segments is how many parts of the table are reindexed in parallel.
+	Segments int
+	Actor    audit.Actor
+}
+
+func NewReindexCustomersCommand(segments int) *ReindexCustomersCommand {
+	return &ReindexCustomersCommand{
+		Segments: segments,
+	}
+}
@@ -0,0 +1,17 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewReindexCustomersCommand(t *testing.T) {
+	// WHEN creating a new ReindexCustomersCommand
+	command := commands.NewReindexCustomersCommand(8)
+
+	// THEN the command should carry the segments
+	assert.NotNil(t, command)
+	assert.Equal(t, 8, command.Segments)
+}
@@ -0,0 +1,18 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type UpdateCustomerCommand struct {
+	CustomerID string
+	Name       string
+	Email      string
+	Actor      audit.Actor
+}
+
+func NewUpdateCustomerCommand(customerID string, name string, email string) *UpdateCustomerCommand {
+	return &UpdateCustomerCommand{
+		CustomerID: customerID,
+		Name:       name,
+		Email:      email,
+	}
+}
@@ -0,0 +1,22 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+func TestNewUpdateCustomerCommand(t *testing.T) {
+	// GIVEN a customer ID and the new contact data
+	customerID := "customer-1"
+
+	// WHEN creating a new UpdateCustomerCommand
+	command := commands.NewUpdateCustomerCommand(customerID, "John Doe", "john@example.com")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, customerID, command.CustomerID)
+	assert.Equal(t, "John Doe", command.Name)
+	assert.Equal(t, "john@example.com", command.Email)
+}
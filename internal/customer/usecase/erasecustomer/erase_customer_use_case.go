@@ -0,0 +1,7 @@
+package erasecustomer
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+type EraseCustomerUseCase interface {
+	Execute(command *commands.EraseCustomerCommand) error
+}
@@ -0,0 +1,49 @@
+package erasecustomer
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ EraseCustomerUseCase = (*EraseCustomerUseCaseImpl)(nil)
+)
+
+type EraseCustomerUseCaseImpl struct {
+	customerRepository        repositories.CustomerRepository
+	customerHistoryRepository repositories.CustomerHistoryRepository
+}
+
+func NewEraseCustomerUseCaseImpl(customerRepository repositories.CustomerRepository, customerHistoryRepository repositories.CustomerHistoryRepository) *EraseCustomerUseCaseImpl {
+	return &EraseCustomerUseCaseImpl{customerRepository: customerRepository, customerHistoryRepository: customerHistoryRepository}
+}
+
+// Execute removes the customer so downstream services can drop their copies on CustomerErased.
+// The history goes first: if the erasure then fails, the customer is still found and a retry
+// finishes it. It is purged again once the customer is gone, as an update committed between the
+// first purge and the erasure leaves its revision behind, and no update succeeds afterwards. A
+// retry of an erasure whose last purge failed finds no customer and purges what is left.
+func (u *EraseCustomerUseCaseImpl) Execute(command *commands.EraseCustomerCommand) error {
+	customer, err := u.customerRepository.GetByID(command.CustomerID)
+	if errors.Is(err, repositories.ErrCustomerNotFound) {
+		if err := u.customerHistoryRepository.Purge(command.CustomerID); err != nil {
+			return err
+		}
+		return repositories.ErrCustomerNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := u.customerHistoryRepository.Purge(customer.ID); err != nil {
+		return err
+	}
+
+	if err := u.customerRepository.Erase(customer); err != nil {
+		return err
+	}
+
+	return u.customerHistoryRepository.Purge(customer.ID)
+}
@@ -0,0 +1,96 @@
+package erasecustomer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/erasecustomer"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type EraseCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository        *mockRepositories.MockCustomerRepository
+	mockHistoryRepository *mockRepositories.MockCustomerHistoryRepository
+	useCase               erasecustomer.EraseCustomerUseCase
+}
+
+func (suite *EraseCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.mockHistoryRepository = mockRepositories.NewMockCustomerHistoryRepository(suite.T())
+	suite.useCase = erasecustomer.NewEraseCustomerUseCaseImpl(suite.mockRepository, suite.mockHistoryRepository)
+}
+
+func TestEraseCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(EraseCustomerUseCaseTestSuite))
+}
+
+// Feature: Erase Customer Use Case
+// Scenario: Customer asks to be forgotten
+
+func (suite *EraseCustomerUseCaseTestSuite) Test_EraseCustomer_WithExistingCustomer_ShouldErase() {
+	// GIVEN a registered customer
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901"}
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(customer, nil).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("customer-1").Return(nil).Twice()
+	suite.mockRepository.EXPECT().Erase(customer).Return(nil).Once()
+
+	// WHEN the customer is erased
+	err := suite.useCase.Execute(commands.NewEraseCustomerCommand("customer-1"))
+
+	// THEN the repository should erase it along with its history, purged again once it is gone
+	// so no revision of an update committed in between survives
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *EraseCustomerUseCaseTestSuite) Test_EraseCustomer_WhenLastPurgeFails_ShouldPurgeOnRetry() {
+	// GIVEN the history cannot be purged after the customer is erased
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901"}
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(customer, nil).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("customer-1").Return(nil).Once()
+	suite.mockRepository.EXPECT().Erase(customer).Return(nil).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("customer-1").Return(errors.New("unavailable")).Once()
+
+	// WHEN the customer is erased
+	err := suite.useCase.Execute(commands.NewEraseCustomerCommand("customer-1"))
+
+	// THEN the error should be returned
+	assert.Error(suite.T(), err)
+
+	// AND a retry should purge the history left behind
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("customer-1").Return(nil).Once()
+	err = suite.useCase.Execute(commands.NewEraseCustomerCommand("customer-1"))
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
+
+func (suite *EraseCustomerUseCaseTestSuite) Test_EraseCustomer_WhenHistoryPurgeFails_ShouldKeepCustomer() {
+	// GIVEN the history cannot be purged
+	customer := &entities.Customer{ID: "customer-1", CPF: "12345678901"}
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(customer, nil).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("customer-1").Return(errors.New("unavailable")).Once()
+
+	// WHEN the customer is erased
+	err := suite.useCase.Execute(commands.NewEraseCustomerCommand("customer-1"))
+
+	// THEN the customer should be kept so the erasure can be retried
+	assert.Error(suite.T(), err)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Erase", customer)
+}
+
+func (suite *EraseCustomerUseCaseTestSuite) Test_EraseCustomer_WithUnknownID_ShouldReturnNotFound() {
+	// GIVEN the customer does not exist
+	suite.mockRepository.EXPECT().GetByID("missing").Return(nil, repositories.ErrCustomerNotFound).Once()
+	suite.mockHistoryRepository.EXPECT().Purge("missing").Return(nil).Once()
+
+	// WHEN the customer is erased
+	err := suite.useCase.Execute(commands.NewEraseCustomerCommand("missing"))
+
+	// THEN not found should be returned once anything left of its history is purged
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
@@ -0,0 +1,16 @@
+package exportcustomers
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+// CustomerWriter receives the exported customers one at a time, in no particular order.
+type CustomerWriter interface {
+	Write(customer *entities.Customer) error
+}
+
+type ExportCustomersUseCase interface {
+	// Execute writes every customer to writer and returns how many were written.
+	Execute(command *commands.ExportCustomersCommand, writer CustomerWriter) (int, error)
+}
@@ -0,0 +1,127 @@
+package exportcustomers
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ ExportCustomersUseCase = (*ExportCustomersUseCaseImpl)(nil)
+)
+
+const (
+	DefaultSegments = 4
+	MaxSegments     = 16
+)
+
+// bufferSize bounds how many customers the scans read ahead of the writer, so memory stays flat
+// however large the table is.
+const bufferSize = 256
+
+// errStopped ends the scans still running once the export has failed.
+var errStopped = errors.New("export stopped")
+
+type ExportCustomersUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewExportCustomersUseCaseImpl(customerRepository repositories.CustomerRepository) *ExportCustomersUseCaseImpl {
+	return &ExportCustomersUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute scans the segments in parallel and hands the customers to the writer from the calling
+// goroutine, so writers need not be safe for concurrent use. The first error of a scan or of the
+// writer stops the export.
+func (u *ExportCustomersUseCaseImpl) Execute(command *commands.ExportCustomersCommand, writer CustomerWriter) (int, error) {
+	segments := command.Segments
+	if segments <= 0 {
+		segments = DefaultSegments
+	}
+	if segments > MaxSegments {
+		segments = MaxSegments
+	}
+
+	customers := make(chan *entities.Customer, bufferSize)
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	var firstErr error
+	fail := func(err error) {
+		stopOnce.Do(func() {
+			firstErr = err
+			close(stop)
+		})
+	}
+
+	var scans sync.WaitGroup
+	for segment := range segments {
+		scans.Add(1)
+		go func() {
+			defer scans.Done()
+			err := u.customerRepository.Scan(segment, segments, func(customer *entities.Customer) error {
+				select {
+				case customers <- customer:
+					return nil
+				case <-stop:
+					return errStopped
+				}
+			})
+			if err != nil && !errors.Is(err, errStopped) {
+				fail(err)
+			}
+		}()
+	}
+	go func() {
+		scans.Wait()
+		close(customers)
+	}()
+
+	written := 0
+	for customer := range customers {
+		select {
+		case <-stop:
+			// Drain what the scans had already read so they can finish.
+			continue
+		default:
+		}
+		if command.MaskPII {
+			customer = mask(customer)
+		}
+		if err := writer.Write(customer); err != nil {
+			fail(err)
+			continue
+		}
+		written++
+	}
+
+	return written, firstErr
+}
+
+// mask keeps enough of the personal data to tell customers apart while reading the export, but
+// not to identify them: the last two digits of the CPF, initials and the email domain.
+func mask(customer *entities.Customer) *entities.Customer {
+	masked := *customer
+	if masked.CPF != "" {
+		masked.CPF = "***.***.***-" + masked.CPF[max(len(masked.CPF)-2, 0):]
+	}
+	masked.Name = maskWords(masked.Name)
+	masked.Nickname = maskWords(masked.Nickname)
+	if local, domain, found := strings.Cut(masked.Email, "@"); found && local != "" {
+		masked.Email = string([]rune(local)[:1]) + "***@" + domain
+	} else if masked.Email != "" {
+		masked.Email = "***"
+	}
+	return &masked
+}
+
+func maskWords(value string) string {
+	words := strings.Fields(value)
+	for i, word := range words {
+		words[i] = string([]rune(word)[:1]) + "***"
+	}
+	return strings.Join(words, " ")
+}
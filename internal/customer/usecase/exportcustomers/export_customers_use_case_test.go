@@ -0,0 +1,146 @@
+package exportcustomers_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+// collectingWriter keeps the written customers, failing from the failAt-th write when set.
+type collectingWriter struct {
+	customers []*entities.Customer
+	failAt    int
+}
+
+func (w *collectingWriter) Write(customer *entities.Customer) error {
+	if w.failAt > 0 && len(w.customers)+1 >= w.failAt {
+		return errors.New("connection closed")
+	}
+	w.customers = append(w.customers, customer)
+	return nil
+}
+
+type ExportCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        exportcustomers.ExportCustomersUseCase
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = exportcustomers.NewExportCustomersUseCaseImpl(suite.mockRepository)
+}
+
+func TestExportCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportCustomersUseCaseTestSuite))
+}
+
+// scanSegments makes every segment visit count customers identified by their segment.
+func (suite *ExportCustomersUseCaseTestSuite) scanSegments(totalSegments int, count int) {
+	suite.mockRepository.EXPECT().Scan(mock.Anything, totalSegments, mock.Anything).
+		RunAndReturn(func(segment int, _ int, visit func(*entities.Customer) error) error {
+			for i := range count {
+				customer := &entities.Customer{ID: string(rune('a'+segment)) + string(rune('0'+i)), CPF: "52998224725", Name: "Jane Doe", Email: "jane@example.com"}
+				if err := visit(customer); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Times(totalSegments)
+}
+
+// Feature: Export Customers Use Case
+// Scenario: The table is scanned in parallel segments and streamed to a writer
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_ShouldWriteCustomersOfEverySegment() {
+	// GIVEN three segments of five customers each
+	suite.scanSegments(3, 5)
+	writer := &collectingWriter{}
+
+	// WHEN exporting with three segments
+	written, err := suite.useCase.Execute(commands.NewExportCustomersCommand(3, false), writer)
+
+	// THEN every customer should be written once, unmasked
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 15, written)
+	ids := make([]string, 0, len(writer.customers))
+	for _, customer := range writer.customers {
+		ids = append(ids, customer.ID)
+	}
+	sort.Strings(ids)
+	assert.Equal(suite.T(), []string{"a0", "a1", "a2", "a3", "a4", "b0", "b1", "b2", "b3", "b4", "c0", "c1", "c2", "c3", "c4"}, ids)
+	assert.Equal(suite.T(), "52998224725", writer.customers[0].CPF)
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_WithoutSegments_ShouldUseDefault() {
+	// GIVEN the default number of segments
+	suite.scanSegments(exportcustomers.DefaultSegments, 1)
+
+	// WHEN exporting without choosing the segments
+	written, err := suite.useCase.Execute(commands.NewExportCustomersCommand(0, false), &collectingWriter{})
+
+	// THEN every default segment should be scanned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), exportcustomers.DefaultSegments, written)
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_WithTooManySegments_ShouldCapThem() {
+	// GIVEN the maximum number of segments
+	suite.scanSegments(exportcustomers.MaxSegments, 1)
+
+	// WHEN exporting with more segments than allowed
+	_, err := suite.useCase.Execute(commands.NewExportCustomersCommand(1000, false), &collectingWriter{})
+
+	// THEN the segments should be capped
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_WithMaskedPII_ShouldHidePersonalData() {
+	// GIVEN a customer with personal data
+	suite.mockRepository.EXPECT().Scan(0, 1, mock.Anything).
+		RunAndReturn(func(_ int, _ int, visit func(*entities.Customer) error) error {
+			return visit(&entities.Customer{ID: "customer-1", CPF: "52998224725", Name: "Jane Doe", Email: "jane@example.com", Nickname: "Jay"})
+		}).Once()
+	writer := &collectingWriter{}
+
+	// WHEN exporting with masking
+	_, err := suite.useCase.Execute(commands.NewExportCustomersCommand(1, true), writer)
+
+	// THEN only the ID and hints of the personal data should be written
+	assert.NoError(suite.T(), err)
+	suite.Require().Len(writer.customers, 1)
+	assert.Equal(suite.T(), &entities.Customer{ID: "customer-1", CPF: "***.***.***-25", Name: "J*** D***", Email: "j***@example.com", Nickname: "J***"}, writer.customers[0])
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_WhenWriterFails_ShouldStopScans() {
+	// GIVEN segments larger than the read ahead buffer and a writer failing on its third write
+	suite.scanSegments(2, 1000)
+	writer := &collectingWriter{failAt: 3}
+
+	// WHEN exporting
+	written, err := suite.useCase.Execute(commands.NewExportCustomersCommand(2, false), writer)
+
+	// THEN the export should stop with the writer error
+	assert.EqualError(suite.T(), err, "connection closed")
+	assert.Equal(suite.T(), 2, written)
+}
+
+func (suite *ExportCustomersUseCaseTestSuite) Test_Export_WhenScanFails_ShouldReturnError() {
+	// GIVEN one of the segments cannot be scanned
+	suite.mockRepository.EXPECT().Scan(0, 2, mock.Anything).Return(nil).Once()
+	suite.mockRepository.EXPECT().Scan(1, 2, mock.Anything).Return(errors.New("unavailable")).Once()
+
+	// WHEN exporting
+	_, err := suite.useCase.Execute(commands.NewExportCustomersCommand(2, false), &collectingWriter{})
+
+	// THEN the scan error should be returned
+	assert.EqualError(suite.T(), err, "unavailable")
+}
@@ -1,6 +1,8 @@
 package getbycpf
 
 import (
+	"log"
+
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
 	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
@@ -11,18 +13,28 @@ var (
 )
 
 type GetByCpfUseCaseImpl struct {
-	customerRepository repositories.CustomerRepository
+	customerRepository     repositories.CustomerRepository
+	customerTierRepository repositories.CustomerTierRepository
 }
 
-func NewGetByCpfUseCaseImpl(customerRepository repositories.CustomerRepository) *GetByCpfUseCaseImpl {
-	return &GetByCpfUseCaseImpl{customerRepository: customerRepository}
+func NewGetByCpfUseCaseImpl(customerRepository repositories.CustomerRepository, customerTierRepository repositories.CustomerTierRepository) *GetByCpfUseCaseImpl {
+	return &GetByCpfUseCaseImpl{customerRepository: customerRepository, customerTierRepository: customerTierRepository}
 }
 
+// Execute returns the customer with its loyalty tier. The tier is informative, so a failure to
+// read it is logged and the customer is returned without one.
 func (u *GetByCpfUseCaseImpl) Execute(command *commands.GetCustomerByCpfCommand) (*entities.Customer, error) {
 	entity, err := u.customerRepository.GetByCpf(command.CPF)
 	if err != nil {
 		return nil, err
 	}
 
-	return entity, err
+	tier, err := u.customerTierRepository.GetTier(entity.ID)
+	if err != nil {
+		log.Printf("Warning: failed to read loyalty tier of customer %s: %v\n", entity.ID, err)
+		return entity, nil
+	}
+	entity.Tier = tier
+
+	return entity, nil
 }
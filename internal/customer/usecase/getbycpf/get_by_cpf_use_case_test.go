@@ -15,13 +15,15 @@ import (
 
 type GetByCpfUseCaseTestSuite struct {
 	suite.Suite
-	mockRepository *mockRepositories.MockCustomerRepository
-	useCase        getbycpf.GetByCpfUseCase
+	mockRepository     *mockRepositories.MockCustomerRepository
+	mockTierRepository *mockRepositories.MockCustomerTierRepository
+	useCase            getbycpf.GetByCpfUseCase
 }
 
 func (suite *GetByCpfUseCaseTestSuite) SetupTest() {
 	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
-	suite.useCase = getbycpf.NewGetByCpfUseCaseImpl(suite.mockRepository)
+	suite.mockTierRepository = mockRepositories.NewMockCustomerTierRepository(suite.T())
+	suite.useCase = getbycpf.NewGetByCpfUseCaseImpl(suite.mockRepository, suite.mockTierRepository)
 }
 
 func TestGetByCpfUseCaseTestSuite(t *testing.T) {
@@ -48,6 +50,7 @@ func (suite *GetByCpfUseCaseTestSuite) Test_CustomerRetrieval_WithExistingCPF_Sh
 		GetByCpf(cpf).
 		Return(expectedCustomer, nil).
 		Once()
+	suite.mockTierRepository.EXPECT().GetTier("123").Return("silver", nil).Once()
 
 	// WHEN searching for the customer by CPF
 	customer, err := suite.useCase.Execute(command)
@@ -60,6 +63,8 @@ func (suite *GetByCpfUseCaseTestSuite) Test_CustomerRetrieval_WithExistingCPF_Sh
 	assert.Equal(suite.T(), expectedCustomer.CPF, customer.CPF)
 	assert.Equal(suite.T(), expectedCustomer.Name, customer.Name)
 	assert.Equal(suite.T(), expectedCustomer.Email, customer.Email)
+	// AND the loyalty tier should be included
+	assert.Equal(suite.T(), "silver", customer.Tier)
 	// AND the repository should have been called
 	suite.mockRepository.AssertExpectations(suite.T())
 }
@@ -114,3 +119,22 @@ func (suite *GetByCpfUseCaseTestSuite) Test_CustomerRetrieval_WithRepositoryFail
 	// AND the repository should have been called
 	suite.mockRepository.AssertExpectations(suite.T())
 }
+
+func (suite *GetByCpfUseCaseTestSuite) Test_CustomerRetrieval_WithTierFailure_ShouldReturnCustomerWithoutTier() {
+	// GIVEN an existing customer whose loyalty tier cannot be read
+	cpf := "12345678901"
+	suite.mockRepository.EXPECT().
+		GetByCpf(cpf).
+		Return(&entities.Customer{ID: "123", CPF: cpf}, nil).
+		Once()
+	suite.mockTierRepository.EXPECT().GetTier("123").Return("", errors.New("throttled")).Once()
+
+	// WHEN searching for the customer by CPF
+	customer, err := suite.useCase.Execute(commands.NewGetCustomerByCpfCommand(cpf))
+
+	// THEN the customer should still be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "123", customer.ID)
+	// AND without a tier
+	assert.Empty(suite.T(), customer.Tier)
+}
@@ -0,0 +1,10 @@
+package getcustomerasof
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type GetCustomerAsOfUseCase interface {
+	Execute(command *commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error)
+}
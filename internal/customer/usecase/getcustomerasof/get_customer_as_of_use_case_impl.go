@@ -0,0 +1,35 @@
+package getcustomerasof
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ GetCustomerAsOfUseCase = (*GetCustomerAsOfUseCaseImpl)(nil)
+)
+
+var (
+	ErrInvalidAsOf = errors.New("as of date is required")
+)
+
+type GetCustomerAsOfUseCaseImpl struct {
+	customerHistoryRepository repositories.CustomerHistoryRepository
+}
+
+func NewGetCustomerAsOfUseCaseImpl(customerHistoryRepository repositories.CustomerHistoryRepository) *GetCustomerAsOfUseCaseImpl {
+	return &GetCustomerAsOfUseCaseImpl{customerHistoryRepository: customerHistoryRepository}
+}
+
+// Execute returns the revision that was current at the date, the latest one for future dates.
+// Customers stored before the history existed have no revision until their next write.
+func (u *GetCustomerAsOfUseCaseImpl) Execute(command *commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error) {
+	if command.AsOf.IsZero() {
+		return nil, ErrInvalidAsOf
+	}
+
+	return u.customerHistoryRepository.GetAsOf(command.CustomerID, command.AsOf)
+}
@@ -0,0 +1,66 @@
+package getcustomerasof_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/getcustomerasof"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type GetCustomerAsOfUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerHistoryRepository
+	useCase        getcustomerasof.GetCustomerAsOfUseCase
+}
+
+func (suite *GetCustomerAsOfUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerHistoryRepository(suite.T())
+	suite.useCase = getcustomerasof.NewGetCustomerAsOfUseCaseImpl(suite.mockRepository)
+}
+
+func TestGetCustomerAsOfUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(GetCustomerAsOfUseCaseTestSuite))
+}
+
+// Feature: Get Customer As Of Use Case
+// Scenario: Support staff see the profile as it was at a date
+
+func (suite *GetCustomerAsOfUseCaseTestSuite) Test_GetAsOf_ShouldReturnRevisionAtDate() {
+	// GIVEN a revision current at the date
+	asOf := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	revision := &entities.CustomerRevision{ID: "rev-1", Customer: &entities.Customer{ID: "customer-1", Name: "Jane Doe"}}
+	suite.mockRepository.EXPECT().GetAsOf("customer-1", asOf).Return(revision, nil).Once()
+
+	// WHEN executing the use case
+	result, err := suite.useCase.Execute(commands.NewGetCustomerAsOfCommand("customer-1", asOf))
+
+	// THEN the revision should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), revision, result)
+}
+
+func (suite *GetCustomerAsOfUseCaseTestSuite) Test_GetAsOf_BeforeFirstRevision_ShouldReturnNotFound() {
+	// GIVEN no revision existed at the date
+	asOf := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockRepository.EXPECT().GetAsOf("customer-1", asOf).Return(nil, repositories.ErrRevisionNotFound).Once()
+
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewGetCustomerAsOfCommand("customer-1", asOf))
+
+	// THEN not found should be returned
+	assert.ErrorIs(suite.T(), err, repositories.ErrRevisionNotFound)
+}
+
+func (suite *GetCustomerAsOfUseCaseTestSuite) Test_GetAsOf_WithoutDate_ShouldReturnInvalidAsOf() {
+	// WHEN executing the use case without a date
+	_, err := suite.useCase.Execute(commands.NewGetCustomerAsOfCommand("customer-1", time.Time{}))
+
+	// THEN the request should be rejected without reading the history
+	assert.ErrorIs(suite.T(), err, getcustomerasof.ErrInvalidAsOf)
+}
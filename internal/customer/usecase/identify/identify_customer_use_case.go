@@ -0,0 +1,10 @@
+package identify
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type IdentifyCustomerUseCase interface {
+	Execute(command *commands.IdentifyCustomerCommand) (*entities.Customer, error)
+}
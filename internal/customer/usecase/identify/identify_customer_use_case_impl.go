@@ -0,0 +1,54 @@
+package identify
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ IdentifyCustomerUseCase = (*IdentifyCustomerUseCaseImpl)(nil)
+)
+
+type IdentifyCustomerUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewIdentifyCustomerUseCaseImpl(customerRepository repositories.CustomerRepository) *IdentifyCustomerUseCaseImpl {
+	return &IdentifyCustomerUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute looks the customer up by CPF, registering it first when the command allows it with the
+// same rules as AddCustomer.
+func (u *IdentifyCustomerUseCaseImpl) Execute(command *commands.IdentifyCustomerCommand) (*entities.Customer, error) {
+	cpf, err := entities.NormalizeCPF(command.CPF)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := u.customerRepository.GetByCpf(cpf)
+	if err == nil {
+		return entity, nil
+	}
+	if !errors.Is(err, repositories.ErrCustomerNotFound) || !command.AutoRegister {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(command.Email)
+	if err := entities.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	entity = &entities.Customer{
+		Name:  strings.TrimSpace(command.Name),
+		Email: email,
+		CPF:   cpf,
+	}
+	if err := u.customerRepository.Add(entity); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,191 @@
+package identify_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/identify"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type IdentifyCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        identify.IdentifyCustomerUseCase
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = identify.NewIdentifyCustomerUseCaseImpl(suite.mockRepository)
+}
+
+func TestIdentifyCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(IdentifyCustomerUseCaseTestSuite))
+}
+
+// Feature: Identify Customer Use Case
+// Scenario: Customer identifies with CPF at the kiosk
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithRegisteredCPF_ShouldReturnCustomer() {
+	// GIVEN a registered customer
+	command := commands.NewIdentifyCustomerCommand("12345678909", true, "", "")
+	existing := &entities.Customer{ID: "customer-1", CPF: command.CPF}
+
+	suite.mockRepository.EXPECT().
+		GetByCpf(command.CPF).
+		Return(existing, nil).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the existing customer should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), existing, result)
+	// AND nothing should be registered
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add", mock.Anything)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithUnknownCPFAndAutoRegister_ShouldRegisterCustomer() {
+	// GIVEN an unknown CPF and auto registration enabled
+	command := commands.NewIdentifyCustomerCommand("12345678909", true, "John Doe", "john@example.com")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf(command.CPF).
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Add(&entities.Customer{Name: "John Doe", Email: "john@example.com", CPF: command.CPF}).
+		Run(func(customer *entities.Customer) { customer.ID = "generated-id" }).
+		Return(nil).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the newly registered customer should be returned with its generated ID
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "generated-id", result.ID)
+	assert.Equal(suite.T(), "John Doe", result.Name)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithUnknownCPFWithoutAutoRegister_ShouldReturnNotFound() {
+	// GIVEN an unknown CPF and auto registration disabled
+	command := commands.NewIdentifyCustomerCommand("52998224725", false, "", "")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf(command.CPF).
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN a not found error should be returned
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithRepositoryFailure_ShouldNotRegister() {
+	// GIVEN the lookup fails with a database error
+	command := commands.NewIdentifyCustomerCommand("12345678909", true, "John Doe", "john@example.com")
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf(command.CPF).
+		Return(nil, expectedError).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+	// AND registration should not be attempted
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add", mock.Anything)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithRegistrationFailure_ShouldReturnError() {
+	// GIVEN an unknown CPF whose registration fails
+	command := commands.NewIdentifyCustomerCommand("12345678909", true, "John Doe", "john@example.com")
+	expectedError := errors.New("database error")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf(command.CPF).
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Add(mock.Anything).
+		Return(expectedError).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the registration error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), result)
+}
+
+// Scenario: The CPF follows the same rules as a registration
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithPunctuatedCPF_ShouldLookUpAndRegisterBareCPF() {
+	// GIVEN an unknown punctuated CPF and auto registration enabled
+	command := commands.NewIdentifyCustomerCommand("123.456.789-09", true, "John Doe", "john@example.com")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf("12345678909").
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	suite.mockRepository.EXPECT().
+		Add(&entities.Customer{Name: "John Doe", Email: "john@example.com", CPF: "12345678909"}).
+		Return(nil).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the customer should be registered with the bare CPF
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "12345678909", result.CPF)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithInvalidCPF_ShouldBeRejected() {
+	// GIVEN a CPF with wrong check digits
+	command := commands.NewIdentifyCustomerCommand("12345678901", true, "John Doe", "john@example.com")
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the CPF should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidCPF)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *IdentifyCustomerUseCaseTestSuite) Test_CustomerIdentification_WithInvalidEmail_ShouldNotRegister() {
+	// GIVEN an unknown CPF to register with an email that is not an address
+	command := commands.NewIdentifyCustomerCommand("12345678909", true, "John Doe", "john@")
+
+	suite.mockRepository.EXPECT().
+		GetByCpf("12345678909").
+		Return(nil, repositories.ErrCustomerNotFound).
+		Once()
+
+	// WHEN the customer identifies
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the email should be rejected and nothing registered
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidEmail)
+	assert.Nil(suite.T(), result)
+	suite.mockRepository.AssertNotCalled(suite.T(), "Add", mock.Anything)
+}
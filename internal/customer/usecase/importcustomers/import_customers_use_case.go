@@ -0,0 +1,10 @@
+package importcustomers
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type ImportCustomersUseCase interface {
+	Execute(command *commands.ImportCustomersCommand) (*entities.ImportReport, error)
+}
@@ -0,0 +1,158 @@
+package importcustomers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ ImportCustomersUseCase = (*ImportCustomersUseCaseImpl)(nil)
+)
+
+var ErrTooManyRows = fmt.Errorf("an import is limited to %d rows", MaxRows)
+
+// MaxRows bounds a single import, larger files are split by the caller.
+const MaxRows = 10000
+
+// batchSize is how many valid rows are looked up and stored at a time.
+const batchSize = 100
+
+// Reasons reported for rows that were not created.
+const (
+	reasonRegistered = "cpf already registered"
+	reasonFailed     = "customer could not be stored, import the row again"
+)
+
+type ImportCustomersUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewImportCustomersUseCaseImpl(customerRepository repositories.CustomerRepository) *ImportCustomersUseCaseImpl {
+	return &ImportCustomersUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute validates every row, skips the CPFs already registered or repeated in the file and
+// stores the rest in batches. Only a file over MaxRows fails as a whole, every other problem is
+// reported on its row.
+func (u *ImportCustomersUseCaseImpl) Execute(command *commands.ImportCustomersCommand) (*entities.ImportReport, error) {
+	if len(command.Rows) > MaxRows {
+		return nil, ErrTooManyRows
+	}
+
+	report := &entities.ImportReport{Rows: make([]*entities.ImportRowResult, len(command.Rows))}
+	firstLines := make(map[string]int, len(command.Rows))
+	var pending []int
+	var customers []*entities.Customer
+	for i, row := range command.Rows {
+		customer, err := newCustomer(row)
+		if err != nil {
+			report.Rows[i] = &entities.ImportRowResult{Line: row.Line, Status: entities.ImportInvalid, Reason: err.Error()}
+			continue
+		}
+		if line, ok := firstLines[customer.CPF]; ok {
+			report.Rows[i] = &entities.ImportRowResult{
+				Line:   row.Line,
+				Status: entities.ImportDuplicate,
+				Reason: fmt.Sprintf("cpf repeated from line %d", line),
+			}
+			continue
+		}
+		firstLines[customer.CPF] = row.Line
+		pending = append(pending, i)
+		customers = append(customers, customer)
+	}
+
+	for start := 0; start < len(pending); start += batchSize {
+		end := min(start+batchSize, len(pending))
+		u.store(command.Rows, report, pending[start:end], customers[start:end])
+	}
+
+	for _, result := range report.Rows {
+		switch result.Status {
+		case entities.ImportCreated:
+			report.Created++
+		case entities.ImportDuplicate:
+			report.Duplicates++
+		case entities.ImportInvalid:
+			report.Invalid++
+		case entities.ImportFailed:
+			report.Failed++
+		}
+	}
+
+	return report, nil
+}
+
+// store adds the customers of the rows at indexes whose CPF is not registered yet.
+func (u *ImportCustomersUseCaseImpl) store(rows []*commands.ImportCustomerRow, report *entities.ImportReport, indexes []int, customers []*entities.Customer) {
+	cpfs := make([]string, len(customers))
+	for i, customer := range customers {
+		cpfs[i] = customer.CPF
+	}
+
+	registered, err := u.customerRepository.FindRegisteredCPFs(cpfs)
+	if err != nil {
+		log.Printf("Warning: failed to look up registered cpfs of an import: %v\n", err)
+		for _, index := range indexes {
+			report.Rows[index] = &entities.ImportRowResult{Line: rows[index].Line, Status: entities.ImportFailed, Reason: reasonFailed}
+		}
+		return
+	}
+
+	var added []int
+	var newCustomers []*entities.Customer
+	for i, customer := range customers {
+		index := indexes[i]
+		if registered[customer.CPF] {
+			report.Rows[index] = &entities.ImportRowResult{Line: rows[index].Line, Status: entities.ImportDuplicate, Reason: reasonRegistered}
+			continue
+		}
+		added = append(added, index)
+		newCustomers = append(newCustomers, customer)
+	}
+	if len(newCustomers) == 0 {
+		return
+	}
+
+	errs := u.customerRepository.AddBatch(newCustomers)
+	for i, index := range added {
+		if errors.Is(errs[i], repositories.ErrCustomerAlreadyExists) {
+			report.Rows[index] = &entities.ImportRowResult{Line: rows[index].Line, Status: entities.ImportDuplicate, Reason: reasonRegistered}
+			continue
+		}
+		if errs[i] != nil {
+			log.Printf("Warning: failed to import customer of line %d: %v\n", rows[index].Line, errs[i])
+			report.Rows[index] = &entities.ImportRowResult{Line: rows[index].Line, Status: entities.ImportFailed, Reason: reasonFailed}
+			continue
+		}
+		report.Rows[index] = &entities.ImportRowResult{Line: rows[index].Line, Status: entities.ImportCreated, CustomerID: newCustomers[i].ID}
+	}
+}
+
+// newCustomer applies the CPF and email rules to a row, keeping the CPF in its normalized form.
+func newCustomer(row *commands.ImportCustomerRow) (*entities.Customer, error) {
+	if row.Error != "" {
+		return nil, errors.New(row.Error)
+	}
+
+	cpf, err := entities.NormalizeCPF(row.CPF)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.TrimSpace(row.Email)
+	if err := entities.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+
+	return &entities.Customer{
+		Name:  strings.TrimSpace(row.Name),
+		Email: email,
+		CPF:   cpf,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package importcustomers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/importcustomers"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type ImportCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        importcustomers.ImportCustomersUseCase
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = importcustomers.NewImportCustomersUseCaseImpl(suite.mockRepository)
+}
+
+func TestImportCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportCustomersUseCaseTestSuite))
+}
+
+// storeWithIDs makes AddBatch succeed, naming the customers after their CPFs.
+func (suite *ImportCustomersUseCaseTestSuite) storeWithIDs() {
+	suite.mockRepository.EXPECT().AddBatch(mock.Anything).RunAndReturn(func(customers []*entities.Customer) []error {
+		for _, customer := range customers {
+			customer.ID = "id-" + customer.CPF
+		}
+		return make([]error, len(customers))
+	})
+}
+
+// Feature: Import Customers Use Case
+// Scenario: Every row of an import file gets a result
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithValidRows_ShouldCreateCustomers() {
+	// GIVEN two valid rows, one with a formatted CPF
+	rows := []*commands.ImportCustomerRow{
+		{Line: 2, Name: " Jane Doe ", Email: "jane@example.com", CPF: "529.982.247-25"},
+		{Line: 3, Name: "John Doe", CPF: "11144477735"},
+	}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs([]string{"52998224725", "11144477735"}).Return(map[string]bool{}, nil).Once()
+	suite.mockRepository.EXPECT().AddBatch(mock.MatchedBy(func(customers []*entities.Customer) bool {
+		return len(customers) == 2 && customers[0].CPF == "52998224725" && customers[0].Name == "Jane Doe"
+	})).RunAndReturn(func(customers []*entities.Customer) []error {
+		customers[0].ID, customers[1].ID = "customer-1", "customer-2"
+		return []error{nil, nil}
+	}).Once()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN both customers should be created with their IDs reported
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, report.Created)
+	assert.Equal(suite.T(), &entities.ImportRowResult{Line: 2, Status: entities.ImportCreated, CustomerID: "customer-1"}, report.Rows[0])
+	assert.Equal(suite.T(), &entities.ImportRowResult{Line: 3, Status: entities.ImportCreated, CustomerID: "customer-2"}, report.Rows[1])
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithInvalidRows_ShouldReportReasons() {
+	// GIVEN rows with a bad CPF, a bad email and an unreadable line
+	rows := []*commands.ImportCustomerRow{
+		{Line: 2, CPF: "12345678900"},
+		{Line: 3, CPF: "52998224725", Email: "not an email"},
+		{Line: 4, Error: "invalid JSON"},
+	}
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN every row should be invalid without touching the repository
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, report.Invalid)
+	assert.Equal(suite.T(), entities.ErrInvalidCPF.Error(), report.Rows[0].Reason)
+	assert.Equal(suite.T(), entities.ErrInvalidEmail.Error(), report.Rows[1].Reason)
+	assert.Equal(suite.T(), "invalid JSON", report.Rows[2].Reason)
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithDuplicates_ShouldSkipThem() {
+	// GIVEN a CPF repeated in the file and another one already registered
+	rows := []*commands.ImportCustomerRow{
+		{Line: 2, CPF: "52998224725"},
+		{Line: 3, CPF: "529.982.247-25"},
+		{Line: 4, CPF: "11144477735"},
+	}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs([]string{"52998224725", "11144477735"}).Return(map[string]bool{"11144477735": true}, nil).Once()
+	suite.storeWithIDs()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN only the first occurrence of the new CPF should be created
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), 2, report.Duplicates)
+	assert.Equal(suite.T(), "cpf repeated from line 2", report.Rows[1].Reason)
+	assert.Equal(suite.T(), "cpf already registered", report.Rows[2].Reason)
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithStorageFailure_ShouldReportFailedRows() {
+	// GIVEN the second customer cannot be stored
+	rows := []*commands.ImportCustomerRow{{Line: 2, CPF: "52998224725"}, {Line: 3, CPF: "11144477735"}}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs(mock.Anything).Return(map[string]bool{}, nil).Once()
+	suite.mockRepository.EXPECT().AddBatch(mock.Anything).Return([]error{nil, errors.New("unavailable")}).Once()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN the row should be reported as failed without the storage error
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), 1, report.Failed)
+	assert.Equal(suite.T(), entities.ImportFailed, report.Rows[1].Status)
+	assert.NotContains(suite.T(), report.Rows[1].Reason, "unavailable")
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithCPFRegisteredMeanwhile_ShouldReportDuplicate() {
+	// GIVEN the second CPF is registered between the lookup and the write
+	rows := []*commands.ImportCustomerRow{{Line: 2, CPF: "52998224725"}, {Line: 3, CPF: "11144477735"}}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs(mock.Anything).Return(map[string]bool{}, nil).Once()
+	suite.mockRepository.EXPECT().AddBatch(mock.Anything).Return([]error{nil, repositories.ErrCustomerAlreadyExists}).Once()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN the row should be reported as a duplicate rather than a failure
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Created)
+	assert.Equal(suite.T(), 1, report.Duplicates)
+	assert.Equal(suite.T(), &entities.ImportRowResult{Line: 3, Status: entities.ImportDuplicate, Reason: "cpf already registered"}, report.Rows[1])
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithLookupFailure_ShouldReportFailedRows() {
+	// GIVEN the registered CPFs cannot be looked up
+	rows := []*commands.ImportCustomerRow{{Line: 2, CPF: "52998224725"}}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs(mock.Anything).Return(nil, errors.New("unavailable")).Once()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN the row should fail without being stored
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, report.Failed)
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithManyRows_ShouldStoreInBatches() {
+	// GIVEN more valid rows than a single batch
+	rows := make([]*commands.ImportCustomerRow, 150)
+	for i := range rows {
+		rows[i] = &commands.ImportCustomerRow{Line: i + 2, CPF: validCPF(i)}
+	}
+	suite.mockRepository.EXPECT().FindRegisteredCPFs(mock.Anything).Return(map[string]bool{}, nil).Twice()
+	suite.storeWithIDs()
+
+	// WHEN importing them
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN every customer should be created
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 150, report.Created)
+	suite.mockRepository.AssertNumberOfCalls(suite.T(), "AddBatch", 2)
+}
+
+func (suite *ImportCustomersUseCaseTestSuite) Test_Import_WithTooManyRows_ShouldReturnError() {
+	// GIVEN a file over the row limit
+	rows := make([]*commands.ImportCustomerRow, importcustomers.MaxRows+1)
+
+	// WHEN importing it
+	report, err := suite.useCase.Execute(commands.NewImportCustomersCommand(rows))
+
+	// THEN the whole import should be rejected
+	assert.ErrorIs(suite.T(), err, importcustomers.ErrTooManyRows)
+	assert.Nil(suite.T(), report)
+}
+
+// validCPF builds a distinct CPF with valid check digits from n.
+func validCPF(n int) string {
+	digits := []byte{'1', '0', '0', '0', '0', '0', byte('0' + n/100%10), byte('0' + n/10%10), byte('0' + n%10)}
+	for len(digits) < 11 {
+		sum := 0
+		for i, digit := range digits {
+			sum += int(digit-'0') * (len(digits) + 1 - i)
+		}
+		check := sum * 10 % 11 % 10
+		digits = append(digits, byte('0'+check))
+	}
+	return string(digits)
+}
@@ -0,0 +1,10 @@
+package listcustomerhistory
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type ListCustomerHistoryUseCase interface {
+	Execute(command *commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error)
+}
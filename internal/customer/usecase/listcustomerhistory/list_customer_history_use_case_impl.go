@@ -0,0 +1,36 @@
+package listcustomerhistory
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ ListCustomerHistoryUseCase = (*ListCustomerHistoryUseCaseImpl)(nil)
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type ListCustomerHistoryUseCaseImpl struct {
+	customerHistoryRepository repositories.CustomerHistoryRepository
+}
+
+func NewListCustomerHistoryUseCaseImpl(customerHistoryRepository repositories.CustomerHistoryRepository) *ListCustomerHistoryUseCaseImpl {
+	return &ListCustomerHistoryUseCaseImpl{customerHistoryRepository: customerHistoryRepository}
+}
+
+func (u *ListCustomerHistoryUseCaseImpl) Execute(command *commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error) {
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return u.customerHistoryRepository.ListRevisions(command.CustomerID, limit, command.Cursor)
+}
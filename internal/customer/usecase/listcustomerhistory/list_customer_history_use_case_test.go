@@ -0,0 +1,66 @@
+package listcustomerhistory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/listcustomerhistory"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type ListCustomerHistoryUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerHistoryRepository
+	useCase        listcustomerhistory.ListCustomerHistoryUseCase
+}
+
+func (suite *ListCustomerHistoryUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerHistoryRepository(suite.T())
+	suite.useCase = listcustomerhistory.NewListCustomerHistoryUseCaseImpl(suite.mockRepository)
+}
+
+func TestListCustomerHistoryUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListCustomerHistoryUseCaseTestSuite))
+}
+
+// Feature: List Customer History Use Case
+// Scenario: Page sizes are bounded
+
+func (suite *ListCustomerHistoryUseCaseTestSuite) Test_ListHistory_WithoutLimit_ShouldUseDefaultPageSize() {
+	// GIVEN a request without a limit
+	page := &entities.CustomerHistoryPage{}
+	suite.mockRepository.EXPECT().ListRevisions("customer-1", listcustomerhistory.DefaultPageSize, "").Return(page, nil).Once()
+
+	// WHEN executing the use case
+	result, err := suite.useCase.Execute(commands.NewListCustomerHistoryCommand("customer-1", 0, ""))
+
+	// THEN the default page size should be used
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), page, result)
+}
+
+func (suite *ListCustomerHistoryUseCaseTestSuite) Test_ListHistory_WithLargeLimit_ShouldCapPageSize() {
+	// GIVEN a request above the maximum page size
+	suite.mockRepository.EXPECT().ListRevisions("customer-1", listcustomerhistory.MaxPageSize, "cursor").Return(&entities.CustomerHistoryPage{}, nil).Once()
+
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewListCustomerHistoryCommand("customer-1", 1000, "cursor"))
+
+	// THEN the page size should be capped
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ListCustomerHistoryUseCaseTestSuite) Test_ListHistory_WithInvalidCursor_ShouldReturnError() {
+	// GIVEN the repository rejects the cursor
+	suite.mockRepository.EXPECT().ListRevisions("customer-1", 10, "bad").Return(nil, repositories.ErrInvalidCursor).Once()
+
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewListCustomerHistoryCommand("customer-1", 10, "bad"))
+
+	// THEN the error should be returned
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+}
@@ -0,0 +1,11 @@
+package reindexcustomers
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type ReindexCustomersUseCase interface {
+	// Execute moves every stored customer to the current encryption key and blind indexes.
+	Execute(command *commands.ReindexCustomersCommand) (*entities.ReindexReport, error)
+}
@@ -0,0 +1,60 @@
+package reindexcustomers
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ ReindexCustomersUseCase = (*ReindexCustomersUseCaseImpl)(nil)
+)
+
+const (
+	DefaultSegments = 4
+	MaxSegments     = 16
+)
+
+type ReindexCustomersUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewReindexCustomersUseCaseImpl(customerRepository repositories.CustomerRepository) *ReindexCustomersUseCaseImpl {
+	return &ReindexCustomersUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute reindexes the segments in parallel. A failed segment does not stop the others, whose
+// customers are still worth moving, and running the reindex again resumes where it failed since
+// customers already under the current key are left alone.
+func (u *ReindexCustomersUseCaseImpl) Execute(command *commands.ReindexCustomersCommand) (*entities.ReindexReport, error) {
+	segments := command.Segments
+	if segments <= 0 {
+		segments = DefaultSegments
+	}
+	if segments > MaxSegments {
+		segments = MaxSegments
+	}
+
+	reports := make([]*entities.ReindexReport, segments)
+	errs := make([]error, segments)
+	var wg sync.WaitGroup
+	for segment := range segments {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reports[segment], errs[segment] = u.customerRepository.Reindex(segment, segments)
+		}()
+	}
+	wg.Wait()
+
+	report := &entities.ReindexReport{}
+	for _, segmentReport := range reports {
+		if segmentReport != nil {
+			report.Add(segmentReport)
+		}
+	}
+	return report, errors.Join(errs...)
+}
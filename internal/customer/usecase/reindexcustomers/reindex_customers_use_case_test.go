@@ -0,0 +1,89 @@
+package reindexcustomers_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/reindexcustomers"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type ReindexCustomersUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        reindexcustomers.ReindexCustomersUseCase
+}
+
+func (suite *ReindexCustomersUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = reindexcustomers.NewReindexCustomersUseCaseImpl(suite.mockRepository)
+}
+
+func TestReindexCustomersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ReindexCustomersUseCaseTestSuite))
+}
+
+// Feature: Reindex Customers Use Case
+// Scenario: Every segment of the table is reindexed in parallel
+
+func (suite *ReindexCustomersUseCaseTestSuite) Test_Reindex_ShouldSumTheReportsOfEverySegment() {
+	// GIVEN three segments, each with one plaintext customer and one conflict
+	suite.mockRepository.EXPECT().Reindex(mock.Anything, 3).
+		RunAndReturn(func(segment int, _ int) (*entities.ReindexReport, error) {
+			return &entities.ReindexReport{Scanned: 10, Encrypted: 1, Reencrypted: 2, Conflicts: []string{string(rune('a' + segment))}}, nil
+		}).Times(3)
+
+	// WHEN reindexing with three segments
+	report, err := suite.useCase.Execute(commands.NewReindexCustomersCommand(3))
+
+	// THEN the report should cover every segment
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 30, report.Scanned)
+	assert.Equal(suite.T(), 3, report.Encrypted)
+	assert.Equal(suite.T(), 6, report.Reencrypted)
+	sort.Strings(report.Conflicts)
+	assert.Equal(suite.T(), []string{"a", "b", "c"}, report.Conflicts)
+}
+
+func (suite *ReindexCustomersUseCaseTestSuite) Test_Reindex_WithoutSegments_ShouldUseDefault() {
+	// GIVEN empty segments
+	suite.mockRepository.EXPECT().Reindex(mock.Anything, reindexcustomers.DefaultSegments).
+		Return(&entities.ReindexReport{}, nil).Times(reindexcustomers.DefaultSegments)
+
+	// WHEN reindexing without choosing the segments
+	_, err := suite.useCase.Execute(commands.NewReindexCustomersCommand(0))
+
+	// THEN the default number of segments should be used
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ReindexCustomersUseCaseTestSuite) Test_Reindex_WithTooManySegments_ShouldCapThem() {
+	// GIVEN empty segments
+	suite.mockRepository.EXPECT().Reindex(mock.Anything, reindexcustomers.MaxSegments).
+		Return(&entities.ReindexReport{}, nil).Times(reindexcustomers.MaxSegments)
+
+	// WHEN reindexing with more segments than allowed
+	_, err := suite.useCase.Execute(commands.NewReindexCustomersCommand(100))
+
+	// THEN the segments should be capped
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ReindexCustomersUseCaseTestSuite) Test_Reindex_WhenASegmentFails_ShouldFinishTheOthers() {
+	// GIVEN the first segment fails
+	suite.mockRepository.EXPECT().Reindex(0, 2).Return(nil, errors.New("throttled")).Once()
+	suite.mockRepository.EXPECT().Reindex(1, 2).Return(&entities.ReindexReport{Scanned: 5, Encrypted: 5}, nil).Once()
+
+	// WHEN reindexing with two segments
+	report, err := suite.useCase.Execute(commands.NewReindexCustomersCommand(2))
+
+	// THEN the error should be returned along with what the other segment did
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), 5, report.Encrypted)
+}
@@ -0,0 +1,10 @@
+package updatecustomer
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+type UpdateCustomerUseCase interface {
+	Execute(command *commands.UpdateCustomerCommand) (*entities.Customer, error)
+}
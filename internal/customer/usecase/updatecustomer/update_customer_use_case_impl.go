@@ -0,0 +1,50 @@
+package updatecustomer
+
+import (
+	"strings"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+)
+
+var (
+	_ UpdateCustomerUseCase = (*UpdateCustomerUseCaseImpl)(nil)
+)
+
+type UpdateCustomerUseCaseImpl struct {
+	customerRepository repositories.CustomerRepository
+}
+
+func NewUpdateCustomerUseCaseImpl(customerRepository repositories.CustomerRepository) *UpdateCustomerUseCaseImpl {
+	return &UpdateCustomerUseCaseImpl{customerRepository: customerRepository}
+}
+
+// Execute changes the contact data of a customer. The CPF is the partition key and
+// is not editable; empty fields keep their current value. A new email follows the same
+// rule as AddCustomer.
+func (u *UpdateCustomerUseCaseImpl) Execute(command *commands.UpdateCustomerCommand) (*entities.Customer, error) {
+	email := strings.TrimSpace(command.Email)
+	if err := entities.ValidateEmail(email); err != nil {
+		return nil, err
+	}
+
+	customer, err := u.customerRepository.GetByID(command.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *customer
+	if command.Name != "" {
+		updated.Name = command.Name
+	}
+	if email != "" {
+		updated.Email = email
+	}
+
+	if err := u.customerRepository.Update(&updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
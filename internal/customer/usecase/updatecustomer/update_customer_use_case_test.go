@@ -0,0 +1,83 @@
+package updatecustomer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/updatecustomer"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/customer/domain/repositories"
+)
+
+type UpdateCustomerUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockCustomerRepository
+	useCase        updatecustomer.UpdateCustomerUseCase
+	customer       *entities.Customer
+}
+
+func (suite *UpdateCustomerUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockCustomerRepository(suite.T())
+	suite.useCase = updatecustomer.NewUpdateCustomerUseCaseImpl(suite.mockRepository)
+	suite.customer = &entities.Customer{
+		ID:    "customer-1",
+		CPF:   "12345678901",
+		Name:  "John Doe",
+		Email: "john@example.com",
+	}
+}
+
+func TestUpdateCustomerUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCustomerUseCaseTestSuite))
+}
+
+// Feature: Update Customer Use Case
+// Scenario: Customer changes contact data
+
+func (suite *UpdateCustomerUseCaseTestSuite) Test_UpdateCustomer_WithNewEmail_ShouldKeepOtherFields() {
+	// GIVEN a registered customer and a new email
+	command := commands.NewUpdateCustomerCommand("customer-1", "", "doe@example.com")
+
+	suite.mockRepository.EXPECT().GetByID("customer-1").Return(suite.customer, nil).Once()
+	suite.mockRepository.EXPECT().
+		Update(mock.MatchedBy(func(customer *entities.Customer) bool {
+			return customer.Email == "doe@example.com" && customer.Name == "John Doe" && customer.CPF == "12345678901"
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN the customer is updated
+	result, err := suite.useCase.Execute(command)
+
+	// THEN only the email should change
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "doe@example.com", result.Email)
+	assert.Equal(suite.T(), "John Doe", result.Name)
+}
+
+func (suite *UpdateCustomerUseCaseTestSuite) Test_UpdateCustomer_WithUnknownID_ShouldReturnNotFound() {
+	// GIVEN the customer does not exist
+	command := commands.NewUpdateCustomerCommand("missing", "Jane", "")
+
+	suite.mockRepository.EXPECT().GetByID("missing").Return(nil, repositories.ErrCustomerNotFound).Once()
+
+	// WHEN the customer is updated
+	result, err := suite.useCase.Execute(command)
+
+	// THEN not found should be returned without writing
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, repositories.ErrCustomerNotFound)
+}
+
+func (suite *UpdateCustomerUseCaseTestSuite) Test_UpdateCustomer_WithInvalidEmail_ShouldBeRejected() {
+	// WHEN updating a customer with an email that is not an address
+	result, err := suite.useCase.Execute(commands.NewUpdateCustomerCommand("customer-1", "", "doe@"))
+
+	// THEN the email should be rejected without reaching the repository
+	assert.ErrorIs(suite.T(), err, entities.ErrInvalidEmail)
+	assert.Nil(suite.T(), result)
+}
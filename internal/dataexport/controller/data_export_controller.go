@@ -0,0 +1,11 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type DataExportController interface {
+	Export(customerID string, actor audit.Actor) (*dto.DataExportResponseDto, error)
+	ExportHTML(customerID string, actor audit.Actor) ([]byte, error)
+}
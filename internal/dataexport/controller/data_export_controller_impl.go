@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+	dataExportPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/exportcustomerdata"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+var (
+	_ DataExportController = (*DataExportControllerImpl)(nil)
+)
+
+type DataExportControllerImpl struct {
+	presenter                 dataExportPresenter.DataExportPresenter
+	exportCustomerDataUseCase exportcustomerdata.ExportCustomerDataUseCase
+}
+
+func NewDataExportControllerImpl(
+	presenter dataExportPresenter.DataExportPresenter,
+	exportCustomerDataUseCase exportcustomerdata.ExportCustomerDataUseCase) *DataExportControllerImpl {
+	return &DataExportControllerImpl{
+		presenter:                 presenter,
+		exportCustomerDataUseCase: exportCustomerDataUseCase,
+	}
+}
+
+func (c *DataExportControllerImpl) Export(customerID string, actor audit.Actor) (*dto.DataExportResponseDto, error) {
+	command := commands.NewExportCustomerDataCommand(customerID)
+	command.Actor = actor
+	export, err := c.exportCustomerDataUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(export), nil
+}
+
+func (c *DataExportControllerImpl) ExportHTML(customerID string, actor audit.Actor) ([]byte, error) {
+	command := commands.NewExportCustomerDataCommand(customerID)
+	command.Actor = actor
+	export, err := c.exportCustomerDataUseCase.Execute(command)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentHTML(export)
+}
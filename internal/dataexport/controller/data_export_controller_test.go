@@ -0,0 +1,82 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/presenter"
+	mockExportCustomerData "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/usecase/exportcustomerdata"
+)
+
+type DataExportControllerTestSuite struct {
+	suite.Suite
+	mockPresenter                 *mockPresenter.MockDataExportPresenter
+	mockExportCustomerDataUseCase *mockExportCustomerData.MockExportCustomerDataUseCase
+	controller                    controller.DataExportController
+}
+
+func (suite *DataExportControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockDataExportPresenter(suite.T())
+	suite.mockExportCustomerDataUseCase = mockExportCustomerData.NewMockExportCustomerDataUseCase(suite.T())
+	suite.controller = controller.NewDataExportControllerImpl(suite.mockPresenter, suite.mockExportCustomerDataUseCase)
+}
+
+func TestDataExportControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(DataExportControllerTestSuite))
+}
+
+// Feature: Data Export Controller
+// Scenario: Exports are presented as JSON or HTML
+
+func (suite *DataExportControllerTestSuite) Test_Export_ShouldPresentExport() {
+	// GIVEN the export of a customer
+	export := &entities.DataExport{CustomerID: "customer-1"}
+	expectedDto := &dto.DataExportResponseDto{CustomerID: "customer-1"}
+	suite.mockExportCustomerDataUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ExportCustomerDataCommand) bool { return cmd.CustomerID == "customer-1" && cmd.Actor.ID == "staff-1" })).
+		Return(export, nil).
+		Once()
+	suite.mockPresenter.EXPECT().Present(export).Return(expectedDto).Once()
+
+	// WHEN exporting the data
+	result, err := suite.controller.Export("customer-1", audit.Actor{ID: "staff-1"})
+
+	// THEN the presented export should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *DataExportControllerTestSuite) Test_ExportHTML_ShouldRenderReport() {
+	// GIVEN the export of a customer
+	export := &entities.DataExport{CustomerID: "customer-1"}
+	suite.mockExportCustomerDataUseCase.EXPECT().Execute(mock.Anything).Return(export, nil).Once()
+	suite.mockPresenter.EXPECT().PresentHTML(export).Return([]byte("<html></html>"), nil).Once()
+
+	// WHEN exporting the report
+	result, err := suite.controller.ExportHTML("customer-1", audit.Actor{ID: "staff-1"})
+
+	// THEN the rendered page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []byte("<html></html>"), result)
+}
+
+func (suite *DataExportControllerTestSuite) Test_Export_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the export fails
+	expectedError := errors.New("query failed")
+	suite.mockExportCustomerDataUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN exporting the data
+	result, err := suite.controller.Export("customer-1", audit.Actor{ID: "staff-1"})
+
+	// THEN the error should be returned without presenting anything
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// DataExport is everything the service holds about a customer, one section per data-owning module.
+type DataExport struct {
+	CustomerID  string
+	GeneratedAt time.Time
+	Sections    []*Section
+}
+
+// Section is the data a module holds about the customer. Data must be serializable to JSON.
+type Section struct {
+	Name  string
+	Title string
+	Data  any
+}
@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"errors"
+)
+
+var (
+	ErrSubjectNotFound = errors.New("data subject not found")
+)
+
+// DataContributor is implemented by every module holding personal data, so a data export
+// covers all of it without the export knowing how each module stores its data.
+type DataContributor interface {
+	// Section is the stable key of the module data in the export, e.g. "profile".
+	Section() string
+	// Title is the heading of the section in the human-readable report.
+	Title() string
+	// Collect returns everything the module holds about the customer. The contributor owning the
+	// customer profile fails with ErrSubjectNotFound when the customer does not exist.
+	Collect(customerID string) (any, error)
+}
+
+// ContributorRegistry keeps the contributors in the order their sections appear in the export.
+type ContributorRegistry interface {
+	Register(contributor DataContributor)
+	Contributors() []DataContributor
+}
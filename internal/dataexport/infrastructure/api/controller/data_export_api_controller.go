@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	dataExportController "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	// Exports hold everything known about a customer, so only staff hand them over, after
+	// checking the identity of whoever asked for it.
+	exportDataRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+)
+
+type dataExportApiController struct {
+	controller dataExportController.DataExportController
+}
+
+func NewDataExportController(controller dataExportController.DataExportController) *dataExportApiController {
+	return &dataExportApiController{
+		controller: controller,
+	}
+}
+
+func (c *dataExportApiController) RegisterRoutes(r chi.Router) {
+	r.With(auth.Authorize(exportDataRule)).Get("/v1/customer/{id}/data-export", c.Export)
+}
+
+// @Summary     Export customer data
+// @Description Download everything held about a customer: profile, consents, loyalty ledger and orders. The html format is a readable report for the customer
+// @Tags        Data Export
+// @Produce     json
+// @Produce     html
+// @Param       id     path  string true  "Customer ID"
+// @Param       format query string false "json (default) or html"
+// @Success     200 {object} dto.DataExportResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     404 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/data-export [get]
+func (h *dataExportApiController) Export(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		export, err := h.controller.Export(customerID, audit.ActorFromRequest(r))
+		if err != nil {
+			writeDataExportError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", attachment(customerID, "json"))
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(export)
+	case "html":
+		page, err := h.controller.ExportHTML(customerID, audit.ActorFromRequest(r))
+		if err != nil {
+			writeDataExportError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Disposition", attachment(customerID, "html"))
+		w.WriteHeader(http.StatusOK)
+		w.Write(page)
+	default:
+		http.Error(w, `{"error":"Invalid format parameter"}`, http.StatusBadRequest)
+	}
+}
+
+// attachment names the downloaded file. Customer IDs are generated UUIDs, but the ID comes from
+// the URL, so it is quoted rather than trusted.
+func attachment(customerID string, extension string) string {
+	return fmt.Sprintf("attachment; filename=%q", "customer-"+customerID+"-data-export."+extension)
+}
+
+func writeDataExportError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, repositories.ErrSubjectNotFound):
+		http.Error(w, `{"error":"Customer not found"}`, http.StatusNotFound)
+	default:
+		rest.WriteError(w, err)
+	}
+}
@@ -0,0 +1,140 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type DataExportApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockDataExportController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *DataExportApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockDataExportController(suite.T())
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiController.NewDataExportController(suite.mockController).RegisterRoutes(suite.router)
+}
+
+func TestDataExportApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(DataExportApiControllerTestSuite))
+}
+
+func (suite *DataExportApiControllerTestSuite) request(path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+// Feature: Data Export API
+// Scenario: Staff download everything held about a customer
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_ShouldDownloadJSON() {
+	// GIVEN the export of a customer
+	suite.mockController.EXPECT().Export("customer-1", audit.Actor{ID: "staff-1", Roles: []string{"staff"}}).Return(&dto.DataExportResponseDto{CustomerID: "customer-1"}, nil).Once()
+
+	// WHEN requesting the export
+	w := suite.request("/v1/customer/customer-1/data-export")
+
+	// THEN a JSON attachment should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "application/json", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), `attachment; filename="customer-customer-1-data-export.json"`, w.Header().Get("Content-Disposition"))
+	var response dto.DataExportResponseDto
+	json.Unmarshal(w.Body.Bytes(), &response)
+	assert.Equal(suite.T(), "customer-1", response.CustomerID)
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_WithHTMLFormat_ShouldDownloadReport() {
+	// GIVEN the report of a customer
+	suite.mockController.EXPECT().ExportHTML("customer-1", mock.Anything).Return([]byte("<html></html>"), nil).Once()
+
+	// WHEN requesting the HTML report
+	w := suite.request("/v1/customer/customer-1/data-export?format=html")
+
+	// THEN an HTML attachment should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(suite.T(), `attachment; filename="customer-customer-1-data-export.html"`, w.Header().Get("Content-Disposition"))
+	assert.Equal(suite.T(), "<html></html>", w.Body.String())
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_WithUnknownFormat_ShouldReturnBadRequest() {
+	// GIVEN an unsupported format
+	// WHEN requesting the export
+	w := suite.request("/v1/customer/customer-1/data-export?format=pdf")
+
+	// THEN the request should be rejected
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_WithUnknownCustomer_ShouldReturnNotFound() {
+	// GIVEN a customer that does not exist
+	suite.mockController.EXPECT().Export("customer-1", mock.Anything).Return(nil, fmt.Errorf("collecting profile: %w", repositories.ErrSubjectNotFound)).Once()
+
+	// WHEN requesting the export
+	w := suite.request("/v1/customer/customer-1/data-export")
+
+	// THEN not found should be returned
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_WithControllerError_ShouldReturnInternalServerError() {
+	// GIVEN the export fails
+	suite.mockController.EXPECT().ExportHTML("customer-1", mock.Anything).Return(nil, errors.New("query failed")).Once()
+
+	// WHEN requesting the report
+	w := suite.request("/v1/customer/customer-1/data-export?format=html")
+
+	// THEN an internal error should be returned
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_AsKiosk_ShouldReturnForbidden() {
+	// GIVEN a kiosk, which may read customers but not export them
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}, Scopes: []string{auth.ScopeCustomersRead}}
+
+	// WHEN requesting the export
+	w := suite.request("/v1/customer/customer-1/data-export")
+
+	// THEN the request should be forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *DataExportApiControllerTestSuite) Test_Export_AsTheCustomer_ShouldReturnForbidden() {
+	// GIVEN the customer themselves, whose identity staff must check first
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN requesting the export
+	w := suite.request("/v1/customer/customer-1/data-export")
+
+	// THEN the request should be forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+type DataExportResponseDto struct {
+	CustomerID  string                 `json:"customer_id"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Sections    []DataExportSectionDto `json:"sections"`
+}
+
+// DataExportSectionDto is the data one module holds about the customer. The shape of Data is
+// owned by that module.
+type DataExportSectionDto struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Data  any    `json:"data"`
+}
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"log"
+
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/recordauditentry"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/exportcustomerdata"
+)
+
+var (
+	_ exportcustomerdata.ExportCustomerDataUseCase = (*AuditedExportCustomerDataUseCase)(nil)
+)
+
+// ActionExportData is recorded for every export of the data held about a customer.
+const ActionExportData = "customer.export_data"
+
+// AuditedExportCustomerDataUseCase records every export, including the failed ones, as an export
+// gathers everything the service holds about the customer. Failing to record an entry is logged
+// instead of failing the export.
+type AuditedExportCustomerDataUseCase struct {
+	next                    exportcustomerdata.ExportCustomerDataUseCase
+	recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase
+}
+
+func NewAuditedExportCustomerDataUseCase(next exportcustomerdata.ExportCustomerDataUseCase, recordAuditEntryUseCase recordauditentry.RecordAuditEntryUseCase) *AuditedExportCustomerDataUseCase {
+	return &AuditedExportCustomerDataUseCase{next: next, recordAuditEntryUseCase: recordAuditEntryUseCase}
+}
+
+func (u *AuditedExportCustomerDataUseCase) Execute(command *commands.ExportCustomerDataCommand) (*entities.DataExport, error) {
+	export, err := u.next.Execute(command)
+
+	entry := auditCommands.NewRecordAuditEntryCommand(command.Actor, ActionExportData, command.CustomerID, nil, err == nil)
+	if _, recordErr := u.recordAuditEntryUseCase.Execute(entry); recordErr != nil {
+		log.Printf("Warning: failed to record audit entry %s of customer %q by %s: %v\n", ActionExportData, command.CustomerID, command.Actor.ID, recordErr)
+	}
+
+	return export, err
+}
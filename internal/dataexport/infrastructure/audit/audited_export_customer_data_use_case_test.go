@@ -0,0 +1,88 @@
+package audit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	auditCommands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+	mockRecordAuditEntry "github.com/viniciuscluna/tc-fiap-customer/mocks/audit/usecase/recordauditentry"
+	mockExportCustomerData "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/usecase/exportcustomerdata"
+	auditpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+)
+
+type AuditedExportCustomerDataUseCaseTestSuite struct {
+	suite.Suite
+	mockNext   *mockExportCustomerData.MockExportCustomerDataUseCase
+	mockRecord *mockRecordAuditEntry.MockRecordAuditEntryUseCase
+	useCase    *audit.AuditedExportCustomerDataUseCase
+}
+
+func (suite *AuditedExportCustomerDataUseCaseTestSuite) SetupTest() {
+	suite.mockNext = mockExportCustomerData.NewMockExportCustomerDataUseCase(suite.T())
+	suite.mockRecord = mockRecordAuditEntry.NewMockRecordAuditEntryUseCase(suite.T())
+	suite.useCase = audit.NewAuditedExportCustomerDataUseCase(suite.mockNext, suite.mockRecord)
+}
+
+func TestAuditedExportCustomerDataUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditedExportCustomerDataUseCaseTestSuite))
+}
+
+// Feature: Data Export Audit
+// Scenario: Every export of the data held about a customer is recorded with its actor
+
+func (suite *AuditedExportCustomerDataUseCaseTestSuite) Test_Export_ShouldRecordExport() {
+	// GIVEN a staff member exporting the data of a customer
+	command := commands.NewExportCustomerDataCommand("customer-1")
+	command.Actor = auditpkg.Actor{ID: "staff-1", Roles: []string{"staff"}, RequestID: "request-1"}
+	export := &entities.DataExport{CustomerID: "customer-1"}
+	suite.mockNext.EXPECT().Execute(command).Return(export, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.Actor.ID == "staff-1" && cmd.Actor.RequestID == "request-1" &&
+			cmd.Action == audit.ActionExportData && cmd.CustomerID == "customer-1" && cmd.Changes == nil && cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN exporting the data
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the export should be returned and recorded
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), export, result)
+}
+
+func (suite *AuditedExportCustomerDataUseCaseTestSuite) Test_Export_WithError_ShouldRecordFailedExport() {
+	// GIVEN a customer that does not exist
+	command := commands.NewExportCustomerDataCommand("customer-404")
+	suite.mockNext.EXPECT().Execute(command).Return(nil, repositories.ErrSubjectNotFound).Once()
+	suite.mockRecord.EXPECT().Execute(mock.MatchedBy(func(cmd *auditCommands.RecordAuditEntryCommand) bool {
+		return cmd.CustomerID == "customer-404" && !cmd.Succeeded
+	})).Return(nil, nil).Once()
+
+	// WHEN exporting the data
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the error should be returned and the failed export recorded
+	assert.Nil(suite.T(), result)
+	assert.ErrorIs(suite.T(), err, repositories.ErrSubjectNotFound)
+}
+
+func (suite *AuditedExportCustomerDataUseCaseTestSuite) Test_Export_WithAuditFailure_ShouldStillReturnExport() {
+	// GIVEN the audit table is unavailable
+	command := commands.NewExportCustomerDataCommand("customer-1")
+	export := &entities.DataExport{CustomerID: "customer-1"}
+	suite.mockNext.EXPECT().Execute(command).Return(export, nil).Once()
+	suite.mockRecord.EXPECT().Execute(mock.Anything).Return(nil, errors.New("dynamodb error")).Once()
+
+	// WHEN exporting the data
+	result, err := suite.useCase.Execute(command)
+
+	// THEN the export should not fail because of the audit log
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), export, result)
+}
@@ -0,0 +1,46 @@
+package registry
+
+import (
+	"sync"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+var (
+	_ repositories.ContributorRegistry = (*ContributorRegistryImpl)(nil)
+)
+
+type ContributorRegistryImpl struct {
+	mu           sync.RWMutex
+	contributors []repositories.DataContributor
+}
+
+func NewContributorRegistryImpl(contributors ...repositories.DataContributor) *ContributorRegistryImpl {
+	registry := &ContributorRegistryImpl{}
+	for _, contributor := range contributors {
+		registry.Register(contributor)
+	}
+	return registry
+}
+
+// Register adds a contributor after the ones already registered. Registering a section twice
+// replaces the previous contributor in place.
+func (r *ContributorRegistryImpl) Register(contributor repositories.DataContributor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, registered := range r.contributors {
+		if registered.Section() == contributor.Section() {
+			r.contributors[i] = contributor
+			return
+		}
+	}
+	r.contributors = append(r.contributors, contributor)
+}
+
+func (r *ContributorRegistryImpl) Contributors() []repositories.DataContributor {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]repositories.DataContributor(nil), r.contributors...)
+}
@@ -0,0 +1,38 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/registry"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/domain/repositories"
+)
+
+func TestContributorRegistry_ShouldKeepRegistrationOrder(t *testing.T) {
+	profile := mockRepositories.NewMockDataContributor(t)
+	profile.EXPECT().Section().Return("profile").Maybe()
+	orders := mockRepositories.NewMockDataContributor(t)
+	orders.EXPECT().Section().Return("orders").Maybe()
+
+	contributors := registry.NewContributorRegistryImpl(profile, orders).Contributors()
+
+	assert.Len(t, contributors, 2)
+	assert.Same(t, profile, contributors[0])
+	assert.Same(t, orders, contributors[1])
+}
+
+func TestContributorRegistry_Register_ShouldReplaceSameSection(t *testing.T) {
+	profile := mockRepositories.NewMockDataContributor(t)
+	profile.EXPECT().Section().Return("profile").Maybe()
+	orders := mockRepositories.NewMockDataContributor(t)
+	orders.EXPECT().Section().Return("orders").Maybe()
+	newProfile := mockRepositories.NewMockDataContributor(t)
+	newProfile.EXPECT().Section().Return("profile").Maybe()
+	contributorRegistry := registry.NewContributorRegistryImpl(profile, orders)
+
+	contributorRegistry.Register(newProfile)
+
+	contributors := contributorRegistry.Contributors()
+	assert.Len(t, contributors, 2)
+	assert.Same(t, newProfile, contributors[0])
+}
@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+)
+
+type DataExportPresenter interface {
+	Present(export *entities.DataExport) *dto.DataExportResponseDto
+	// PresentHTML renders the export as a standalone HTML page meant to be read by the customer.
+	PresentHTML(export *entities.DataExport) ([]byte, error)
+}
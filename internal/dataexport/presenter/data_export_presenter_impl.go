@@ -0,0 +1,125 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"sort"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+)
+
+var (
+	_ DataExportPresenter = (*DataExportPresenterImpl)(nil)
+)
+
+// reportTemplate renders any section data generically: objects become key/value tables and
+// lists become numbered lists, so modules contribute data without writing HTML.
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"kind": valueKind,
+	"keys": sortedKeys,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Data export of customer {{.CustomerID}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin: 0.25em 0; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; text-align: left; vertical-align: top; }
+th { background: #f4f4f4; }
+.empty { color: #888; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>Data export</h1>
+<p>Customer <strong>{{.CustomerID}}</strong>, generated at {{.GeneratedAt.Format "2006-01-02 15:04:05 MST"}}.</p>
+{{range .Sections}}
+<section id="{{.Name}}">
+<h2>{{.Title}}</h2>
+{{template "value" .Data}}
+</section>
+{{end}}
+</body>
+</html>
+{{define "value"}}{{$kind := kind .}}{{if eq $kind "empty"}}<span class="empty">none</span>{{else if eq $kind "object"}}<table>{{$object := .}}{{range keys .}}<tr><th>{{.}}</th><td>{{template "value" index $object .}}</td></tr>{{end}}</table>{{else if eq $kind "list"}}<ol>{{range .}}<li>{{template "value" .}}</li>{{end}}</ol>{{else}}{{.}}{{end}}{{end}}`))
+
+type DataExportPresenterImpl struct {
+}
+
+func NewDataExportPresenterImpl() *DataExportPresenterImpl {
+	return &DataExportPresenterImpl{}
+}
+
+func (p *DataExportPresenterImpl) Present(export *entities.DataExport) *dto.DataExportResponseDto {
+	response := &dto.DataExportResponseDto{
+		CustomerID:  export.CustomerID,
+		GeneratedAt: export.GeneratedAt,
+		Sections:    make([]dto.DataExportSectionDto, 0, len(export.Sections)),
+	}
+	for _, section := range export.Sections {
+		response.Sections = append(response.Sections, dto.DataExportSectionDto{
+			Name:  section.Name,
+			Title: section.Title,
+			Data:  section.Data,
+		})
+	}
+	return response
+}
+
+func (p *DataExportPresenterImpl) PresentHTML(export *entities.DataExport) ([]byte, error) {
+	response := p.Present(export)
+
+	// Going through JSON shows the same field names as the JSON export and turns every
+	// section into maps, slices and scalars the template knows how to render.
+	for i, section := range response.Sections {
+		data, err := json.Marshal(section.Data)
+		if err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", section.Name, err)
+		}
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", section.Name, err)
+		}
+		response.Sections[i].Data = value
+	}
+
+	var page bytes.Buffer
+	if err := reportTemplate.Execute(&page, response); err != nil {
+		return nil, err
+	}
+	return page.Bytes(), nil
+}
+
+func valueKind(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "empty"
+	case string:
+		if v == "" {
+			return "empty"
+		}
+	case map[string]any:
+		if len(v) == 0 {
+			return "empty"
+		}
+		return "object"
+	case []any:
+		if len(v) == 0 {
+			return "empty"
+		}
+		return "list"
+	}
+	return "scalar"
+}
+
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
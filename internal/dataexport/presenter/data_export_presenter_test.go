@@ -0,0 +1,79 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/presenter"
+)
+
+type DataExportPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.DataExportPresenter
+	export    *entities.DataExport
+}
+
+func (suite *DataExportPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewDataExportPresenterImpl()
+	suite.export = &entities.DataExport{
+		CustomerID:  "customer-1",
+		GeneratedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		Sections: []*entities.Section{
+			{Name: "profile", Title: "Profile", Data: map[string]any{"name": "<script>alert(1)</script>", "email": ""}},
+			{Name: "orders", Title: "Orders", Data: []map[string]any{{"order_id": "order-1", "total": 35.5}}},
+			{Name: "consents", Title: "Consents", Data: []string{}},
+		},
+	}
+}
+
+func TestDataExportPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(DataExportPresenterTestSuite))
+}
+
+// Feature: Data Export Presentation
+// Scenario: Exports are downloaded as JSON or read as an HTML report
+
+func (suite *DataExportPresenterTestSuite) Test_Present_ShouldKeepSectionOrder() {
+	// GIVEN an export with three sections
+	// WHEN presenting it
+	result := suite.presenter.Present(suite.export)
+
+	// THEN every section should be kept with its data untouched
+	assert.Equal(suite.T(), "customer-1", result.CustomerID)
+	assert.Equal(suite.T(), suite.export.GeneratedAt, result.GeneratedAt)
+	assert.Len(suite.T(), result.Sections, 3)
+	assert.Equal(suite.T(), "profile", result.Sections[0].Name)
+	assert.Equal(suite.T(), suite.export.Sections[1].Data, result.Sections[1].Data)
+}
+
+func (suite *DataExportPresenterTestSuite) Test_PresentHTML_ShouldRenderEverySectionEscaped() {
+	// GIVEN an export holding markup typed by the customer
+	// WHEN rendering the report
+	page, err := suite.presenter.PresentHTML(suite.export)
+
+	// THEN every section should be readable and the markup escaped
+	assert.NoError(suite.T(), err)
+	html := string(page)
+	assert.Contains(suite.T(), html, "<h2>Profile</h2>")
+	assert.Contains(suite.T(), html, "<h2>Orders</h2>")
+	assert.Contains(suite.T(), html, "<th>order_id</th><td>order-1</td>")
+	assert.Contains(suite.T(), html, "<td>35.5</td>")
+	assert.Contains(suite.T(), html, "&lt;script&gt;")
+	assert.NotContains(suite.T(), html, "<script>")
+	assert.Contains(suite.T(), html, `<span class="empty">none</span>`)
+}
+
+func (suite *DataExportPresenterTestSuite) Test_PresentHTML_WithUnencodableData_ShouldReturnError() {
+	// GIVEN a section that cannot be turned into JSON
+	suite.export.Sections[0].Data = func() {}
+
+	// WHEN rendering the report
+	page, err := suite.presenter.PresentHTML(suite.export)
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), page)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,14 @@
+package commands
+
+import "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+type ExportCustomerDataCommand struct {
+	CustomerID string
+	Actor      audit.Actor
+}
+
+func NewExportCustomerDataCommand(customerID string) *ExportCustomerDataCommand {
+	return &ExportCustomerDataCommand{
+		CustomerID: customerID,
+	}
+}
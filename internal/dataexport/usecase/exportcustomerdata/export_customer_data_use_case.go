@@ -0,0 +1,10 @@
+package exportcustomerdata
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+)
+
+type ExportCustomerDataUseCase interface {
+	Execute(command *commands.ExportCustomerDataCommand) (*entities.DataExport, error)
+}
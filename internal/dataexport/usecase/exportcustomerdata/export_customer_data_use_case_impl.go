@@ -0,0 +1,44 @@
+package exportcustomerdata
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+)
+
+var (
+	_ ExportCustomerDataUseCase = (*ExportCustomerDataUseCaseImpl)(nil)
+)
+
+type ExportCustomerDataUseCaseImpl struct {
+	registry repositories.ContributorRegistry
+}
+
+func NewExportCustomerDataUseCaseImpl(registry repositories.ContributorRegistry) *ExportCustomerDataUseCaseImpl {
+	return &ExportCustomerDataUseCaseImpl{registry: registry}
+}
+
+// Execute collects the data of every registered module. Any failure aborts the export: handing
+// the customer an incomplete copy would look like a complete one.
+func (u *ExportCustomerDataUseCaseImpl) Execute(command *commands.ExportCustomerDataCommand) (*entities.DataExport, error) {
+	export := &entities.DataExport{
+		CustomerID:  command.CustomerID,
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	for _, contributor := range u.registry.Contributors() {
+		data, err := contributor.Collect(command.CustomerID)
+		if err != nil {
+			return nil, fmt.Errorf("collecting %s: %w", contributor.Section(), err)
+		}
+		export.Sections = append(export.Sections, &entities.Section{
+			Name:  contributor.Section(),
+			Title: contributor.Title(),
+			Data:  data,
+		})
+	}
+	return export, nil
+}
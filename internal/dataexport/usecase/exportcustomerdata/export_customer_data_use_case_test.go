@@ -0,0 +1,86 @@
+package exportcustomerdata_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/exportcustomerdata"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/dataexport/domain/repositories"
+)
+
+type ExportCustomerDataUseCaseTestSuite struct {
+	suite.Suite
+	mockRegistry *mockRepositories.MockContributorRegistry
+	mockProfile  *mockRepositories.MockDataContributor
+	mockOrders   *mockRepositories.MockDataContributor
+	useCase      exportcustomerdata.ExportCustomerDataUseCase
+}
+
+func (suite *ExportCustomerDataUseCaseTestSuite) SetupTest() {
+	suite.mockRegistry = mockRepositories.NewMockContributorRegistry(suite.T())
+	suite.mockProfile = mockRepositories.NewMockDataContributor(suite.T())
+	suite.mockOrders = mockRepositories.NewMockDataContributor(suite.T())
+	suite.mockRegistry.EXPECT().Contributors().Return([]repositories.DataContributor{suite.mockProfile, suite.mockOrders}).Maybe()
+	suite.mockProfile.EXPECT().Section().Return("profile").Maybe()
+	suite.mockProfile.EXPECT().Title().Return("Profile").Maybe()
+	suite.mockOrders.EXPECT().Section().Return("orders").Maybe()
+	suite.mockOrders.EXPECT().Title().Return("Orders").Maybe()
+	suite.useCase = exportcustomerdata.NewExportCustomerDataUseCaseImpl(suite.mockRegistry)
+}
+
+func TestExportCustomerDataUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportCustomerDataUseCaseTestSuite))
+}
+
+// Feature: Export Customer Data Use Case
+// Scenario: Every registered module contributes a section to the export
+
+func (suite *ExportCustomerDataUseCaseTestSuite) Test_ExportCustomerData_ShouldCollectEverySectionInOrder() {
+	// GIVEN two modules holding data about the customer
+	suite.mockProfile.EXPECT().Collect("customer-1").Return(map[string]string{"name": "Maria"}, nil).Once()
+	suite.mockOrders.EXPECT().Collect("customer-1").Return([]string{"order-1"}, nil).Once()
+
+	// WHEN executing the use case
+	export, err := suite.useCase.Execute(commands.NewExportCustomerDataCommand("customer-1"))
+
+	// THEN the sections should follow the registry order
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "customer-1", export.CustomerID)
+	assert.False(suite.T(), export.GeneratedAt.IsZero())
+	assert.Len(suite.T(), export.Sections, 2)
+	assert.Equal(suite.T(), "profile", export.Sections[0].Name)
+	assert.Equal(suite.T(), "Profile", export.Sections[0].Title)
+	assert.Equal(suite.T(), map[string]string{"name": "Maria"}, export.Sections[0].Data)
+	assert.Equal(suite.T(), "orders", export.Sections[1].Name)
+}
+
+func (suite *ExportCustomerDataUseCaseTestSuite) Test_ExportCustomerData_WithUnknownCustomer_ShouldReturnError() {
+	// GIVEN the profile module does not know the customer
+	suite.mockProfile.EXPECT().Collect("customer-1").Return(nil, repositories.ErrSubjectNotFound).Once()
+
+	// WHEN executing the use case
+	export, err := suite.useCase.Execute(commands.NewExportCustomerDataCommand("customer-1"))
+
+	// THEN the export should stop without asking the other modules
+	assert.Nil(suite.T(), export)
+	assert.ErrorIs(suite.T(), err, repositories.ErrSubjectNotFound)
+}
+
+func (suite *ExportCustomerDataUseCaseTestSuite) Test_ExportCustomerData_WithContributorError_ShouldNotReturnPartialExport() {
+	// GIVEN the orders cannot be read
+	expectedError := errors.New("query failed")
+	suite.mockProfile.EXPECT().Collect("customer-1").Return(map[string]string{}, nil).Once()
+	suite.mockOrders.EXPECT().Collect("customer-1").Return(nil, expectedError).Once()
+
+	// WHEN executing the use case
+	export, err := suite.useCase.Execute(commands.NewExportCustomerDataCommand("customer-1"))
+
+	// THEN no export should be returned
+	assert.Nil(suite.T(), export)
+	assert.ErrorIs(suite.T(), err, expectedError)
+	assert.Contains(suite.T(), err.Error(), "orders")
+}
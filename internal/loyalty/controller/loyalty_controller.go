@@ -0,0 +1,12 @@
+package controller
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+
+type LoyaltyController interface {
+	GetBalance(customerID string) (*dto.LoyaltyBalanceResponseDto, error)
+	ListEntries(customerID string, limit int, cursor string) (*dto.LedgerResponseDto, error)
+	Redeem(customerID string, request *dto.RedeemPointsRequestDto) (*dto.LedgerEntryResponseDto, error)
+	Adjust(customerID string, request *dto.AdjustPointsRequestDto) (*dto.LedgerEntryResponseDto, error)
+	ListRewards(customerID string) (*dto.RewardsCatalogResponseDto, error)
+	RedeemReward(customerID string, rewardID string, request *dto.RedeemRewardRequestDto) (*dto.LedgerEntryResponseDto, error)
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+	loyaltyPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/adjustpoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/expirepoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listentries"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listrewards"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeempoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeemreward"
+)
+
+var (
+	_ LoyaltyController = (*LoyaltyControllerImpl)(nil)
+)
+
+type LoyaltyControllerImpl struct {
+	presenter           loyaltyPresenter.LoyaltyPresenter
+	expirePointsUseCase expirepoints.ExpirePointsUseCase
+	listEntriesUseCase  listentries.ListEntriesUseCase
+	redeemPointsUseCase redeempoints.RedeemPointsUseCase
+	adjustPointsUseCase adjustpoints.AdjustPointsUseCase
+	listRewardsUseCase  listrewards.ListRewardsUseCase
+	redeemRewardUseCase redeemreward.RedeemRewardUseCase
+}
+
+func NewLoyaltyControllerImpl(
+	presenter loyaltyPresenter.LoyaltyPresenter,
+	expirePointsUseCase expirepoints.ExpirePointsUseCase,
+	listEntriesUseCase listentries.ListEntriesUseCase,
+	redeemPointsUseCase redeempoints.RedeemPointsUseCase,
+	adjustPointsUseCase adjustpoints.AdjustPointsUseCase,
+	listRewardsUseCase listrewards.ListRewardsUseCase,
+	redeemRewardUseCase redeemreward.RedeemRewardUseCase) *LoyaltyControllerImpl {
+	return &LoyaltyControllerImpl{
+		presenter:           presenter,
+		expirePointsUseCase: expirePointsUseCase,
+		listEntriesUseCase:  listEntriesUseCase,
+		redeemPointsUseCase: redeemPointsUseCase,
+		adjustPointsUseCase: adjustPointsUseCase,
+		listRewardsUseCase:  listRewardsUseCase,
+		redeemRewardUseCase: redeemRewardUseCase,
+	}
+}
+
+// GetBalance expires due points before reading the balance, so customers never see points
+// they can no longer spend.
+func (c *LoyaltyControllerImpl) GetBalance(customerID string) (*dto.LoyaltyBalanceResponseDto, error) {
+	balance, err := c.expirePointsUseCase.Execute(commands.NewExpirePointsCommand(customerID, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentBalance(balance), nil
+}
+
+func (c *LoyaltyControllerImpl) ListEntries(customerID string, limit int, cursor string) (*dto.LedgerResponseDto, error) {
+	page, err := c.listEntriesUseCase.Execute(commands.NewListLedgerEntriesCommand(customerID, limit, cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentEntries(page), nil
+}
+
+// Redeem expires due points first, so expired points cannot pay for an order.
+func (c *LoyaltyControllerImpl) Redeem(customerID string, request *dto.RedeemPointsRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	if _, err := c.expirePointsUseCase.Execute(commands.NewExpirePointsCommand(customerID, time.Now())); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.redeemPointsUseCase.Execute(commands.NewRedeemPointsCommand(customerID, request.Points, request.Reference, request.Reason))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentEntry(entry), nil
+}
+
+func (c *LoyaltyControllerImpl) Adjust(customerID string, request *dto.AdjustPointsRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	entry, err := c.adjustPointsUseCase.Execute(commands.NewAdjustPointsCommand(customerID, request.Points, request.Reason))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentEntry(entry), nil
+}
+
+func (c *LoyaltyControllerImpl) ListRewards(customerID string) (*dto.RewardsCatalogResponseDto, error) {
+	catalog, err := c.listRewardsUseCase.Execute(commands.NewListRewardsCommand(customerID))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentCatalog(catalog), nil
+}
+
+// RedeemReward expires due points first, so expired points cannot pay for a reward.
+func (c *LoyaltyControllerImpl) RedeemReward(customerID string, rewardID string, request *dto.RedeemRewardRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	if _, err := c.expirePointsUseCase.Execute(commands.NewExpirePointsCommand(customerID, time.Now())); err != nil {
+		return nil, err
+	}
+
+	entry, err := c.redeemRewardUseCase.Execute(commands.NewRedeemRewardCommand(customerID, rewardID, request.Reference))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.PresentEntry(entry), nil
+}
@@ -0,0 +1,196 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/presenter"
+	mockAdjustPoints "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/adjustpoints"
+	mockExpirePoints "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/expirepoints"
+	mockListEntries "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/listentries"
+	mockListRewards "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/listrewards"
+	mockRedeemPoints "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/redeempoints"
+	mockRedeemReward "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/redeemreward"
+)
+
+type LoyaltyControllerTestSuite struct {
+	suite.Suite
+	mockPresenter           *mockPresenter.MockLoyaltyPresenter
+	mockExpirePointsUseCase *mockExpirePoints.MockExpirePointsUseCase
+	mockListEntriesUseCase  *mockListEntries.MockListEntriesUseCase
+	mockRedeemPointsUseCase *mockRedeemPoints.MockRedeemPointsUseCase
+	mockAdjustPointsUseCase *mockAdjustPoints.MockAdjustPointsUseCase
+	mockListRewardsUseCase  *mockListRewards.MockListRewardsUseCase
+	mockRedeemRewardUseCase *mockRedeemReward.MockRedeemRewardUseCase
+	controller              controller.LoyaltyController
+}
+
+func (suite *LoyaltyControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockLoyaltyPresenter(suite.T())
+	suite.mockExpirePointsUseCase = mockExpirePoints.NewMockExpirePointsUseCase(suite.T())
+	suite.mockListEntriesUseCase = mockListEntries.NewMockListEntriesUseCase(suite.T())
+	suite.mockRedeemPointsUseCase = mockRedeemPoints.NewMockRedeemPointsUseCase(suite.T())
+	suite.mockAdjustPointsUseCase = mockAdjustPoints.NewMockAdjustPointsUseCase(suite.T())
+	suite.mockListRewardsUseCase = mockListRewards.NewMockListRewardsUseCase(suite.T())
+	suite.mockRedeemRewardUseCase = mockRedeemReward.NewMockRedeemRewardUseCase(suite.T())
+	suite.controller = controller.NewLoyaltyControllerImpl(
+		suite.mockPresenter,
+		suite.mockExpirePointsUseCase,
+		suite.mockListEntriesUseCase,
+		suite.mockRedeemPointsUseCase,
+		suite.mockAdjustPointsUseCase,
+		suite.mockListRewardsUseCase,
+		suite.mockRedeemRewardUseCase,
+	)
+}
+
+func TestLoyaltyControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyControllerTestSuite))
+}
+
+// Feature: Loyalty Controller
+// Scenario: Expired points are written off before balances are read or spent
+
+func (suite *LoyaltyControllerTestSuite) Test_GetBalance_ShouldExpireThenPresent() {
+	// GIVEN a customer balance after expiry
+	balance := &entities.Balance{CustomerID: "customer-1", Points: 80}
+	expectedDto := &dto.LoyaltyBalanceResponseDto{CustomerID: "customer-1", Points: 80}
+	suite.mockExpirePointsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ExpirePointsCommand) bool {
+			return cmd.CustomerID == "customer-1" && !cmd.Now.IsZero()
+		})).
+		Return(balance, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentBalance(balance).Return(expectedDto).Once()
+
+	// WHEN reading the balance
+	result, err := suite.controller.GetBalance("customer-1")
+
+	// THEN the presented balance should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_Redeem_ShouldExpireBeforeDebiting() {
+	// GIVEN a redemption request
+	entry := &entities.LedgerEntry{ID: "entry-1", Points: -50}
+	expectedDto := &dto.LedgerEntryResponseDto{ID: "entry-1", Points: -50}
+	expire := suite.mockExpirePointsUseCase.EXPECT().Execute(mock.Anything).Return(&entities.Balance{Points: 80}, nil).Once()
+	suite.mockRedeemPointsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RedeemPointsCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Points == 50 && cmd.Reference == "order-1"
+		})).
+		Return(entry, nil).
+		Once().
+		NotBefore(expire)
+	suite.mockPresenter.EXPECT().PresentEntry(entry).Return(expectedDto).Once()
+
+	// WHEN redeeming
+	result, err := suite.controller.Redeem("customer-1", &dto.RedeemPointsRequestDto{Points: 50, Reference: "order-1"})
+
+	// THEN the debit should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_Redeem_WithExpiryError_ShouldNotDebit() {
+	// GIVEN the expiry fails
+	expectedError := errors.New("query failed")
+	suite.mockExpirePointsUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN redeeming
+	result, err := suite.controller.Redeem("customer-1", &dto.RedeemPointsRequestDto{Points: 50, Reference: "order-1"})
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_ListEntries_ShouldPresentPage() {
+	// GIVEN a page of entries
+	page := &entities.LedgerPage{NextCursor: "next"}
+	expectedDto := &dto.LedgerResponseDto{NextCursor: "next"}
+	suite.mockListEntriesUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListLedgerEntriesCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Limit == 5 && cmd.Cursor == "cursor"
+		})).
+		Return(page, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentEntries(page).Return(expectedDto).Once()
+
+	// WHEN listing the entries
+	result, err := suite.controller.ListEntries("customer-1", 5, "cursor")
+
+	// THEN the presented page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_Adjust_ShouldPresentEntry() {
+	// GIVEN an adjustment request
+	entry := &entities.LedgerEntry{ID: "entry-1", Points: 20}
+	expectedDto := &dto.LedgerEntryResponseDto{ID: "entry-1", Points: 20}
+	suite.mockAdjustPointsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.AdjustPointsCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Points == 20 && cmd.Reason == "goodwill"
+		})).
+		Return(entry, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentEntry(entry).Return(expectedDto).Once()
+
+	// WHEN adjusting
+	result, err := suite.controller.Adjust("customer-1", &dto.AdjustPointsRequestDto{Points: 20, Reason: "goodwill"})
+
+	// THEN the adjustment should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_ListRewards_ShouldPresentCatalog() {
+	// GIVEN a catalog for the customer
+	catalog := []entities.CatalogEntry{{Reward: entities.Reward{ID: "dessert", Points: 300}, Eligible: true}}
+	expectedDto := &dto.RewardsCatalogResponseDto{Rewards: []dto.RewardResponseDto{{ID: "dessert", Points: 300, Eligible: true}}}
+	suite.mockListRewardsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListRewardsCommand) bool {
+			return cmd.CustomerID == "customer-1"
+		})).
+		Return(catalog, nil).
+		Once()
+	suite.mockPresenter.EXPECT().PresentCatalog(catalog).Return(expectedDto).Once()
+
+	// WHEN listing the rewards
+	result, err := suite.controller.ListRewards("customer-1")
+
+	// THEN the presented catalog should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *LoyaltyControllerTestSuite) Test_RedeemReward_ShouldExpireBeforeDebiting() {
+	// GIVEN a reward redemption request
+	entry := &entities.LedgerEntry{ID: "entry-1", Points: -300}
+	expectedDto := &dto.LedgerEntryResponseDto{ID: "entry-1", Points: -300}
+	expire := suite.mockExpirePointsUseCase.EXPECT().Execute(mock.Anything).Return(&entities.Balance{Points: 400}, nil).Once()
+	suite.mockRedeemRewardUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RedeemRewardCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.RewardID == "dessert" && cmd.Reference == "order-1"
+		})).
+		Return(entry, nil).
+		Once().
+		NotBefore(expire)
+	suite.mockPresenter.EXPECT().PresentEntry(entry).Return(expectedDto).Once()
+
+	// WHEN redeeming the reward
+	result, err := suite.controller.RedeemReward("customer-1", "dessert", &dto.RedeemRewardRequestDto{Reference: "order-1"})
+
+	// THEN the debit should be presented
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// Balance is the current points of a customer, kept next to the ledger and updated
+// in the same transaction as every entry.
+type Balance struct {
+	CustomerID string    `json:"customer_id" dynamodbav:"customer_id"`
+	Points     int       `json:"points" dynamodbav:"points"`
+	UpdatedAt  time.Time `json:"updated_at" dynamodbav:"updated_at"`
+}
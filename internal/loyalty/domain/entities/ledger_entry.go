@@ -0,0 +1,34 @@
+package entities
+
+import "time"
+
+type EntryType string
+
+const (
+	EntryEarn   EntryType = "earn"
+	EntryRedeem EntryType = "redeem"
+	EntryExpire EntryType = "expire"
+	EntryAdjust EntryType = "adjust"
+)
+
+// LedgerEntry is an immutable movement of loyalty points. Points are positive for
+// credits and negative for debits; BalanceAfter is the balance once the entry applied.
+type LedgerEntry struct {
+	CustomerID   string    `json:"customer_id" dynamodbav:"customer_id"`
+	ID           string    `json:"id" dynamodbav:"id"`
+	Type         EntryType `json:"type" dynamodbav:"type"`
+	Points       int       `json:"points" dynamodbav:"points"`
+	BalanceAfter int       `json:"balance_after" dynamodbav:"balance_after"`
+	// Reference ties the entry to what caused it, e.g. a payment or an order. At most one
+	// entry of each type may exist per reference, which makes event redeliveries harmless.
+	Reference string     `json:"reference,omitempty" dynamodbav:"reference,omitempty"`
+	Reason    string     `json:"reason,omitempty" dynamodbav:"reason,omitempty"`
+	CreatedAt time.Time  `json:"created_at" dynamodbav:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" dynamodbav:"expires_at,omitempty"`
+}
+
+// LedgerPage is one page of a customer ledger, newest first. NextCursor is empty on the last page.
+type LedgerPage struct {
+	Entries    []*LedgerEntry
+	NextCursor string
+}
@@ -0,0 +1,70 @@
+package entities
+
+import "time"
+
+// Tier is a loyalty level. A customer reaches it by earning at least MinPoints within the
+// rolling Window that ends now.
+type Tier struct {
+	Name      string
+	MinPoints int
+	Window    time.Duration
+	Benefits  []string
+}
+
+// Reward is an item of the rewards catalog, redeemed for Points by customers at MinTier or above.
+type Reward struct {
+	ID          string
+	Name        string
+	Description string
+	Points      int
+	MinTier     string
+}
+
+// LoyaltyRules holds the tiers, from the lowest to the highest threshold, and the rewards catalog.
+type LoyaltyRules struct {
+	Tiers   []Tier
+	Rewards []Reward
+}
+
+// Rank returns the position of a tier, higher is better, or -1 when the tier is unknown.
+func (r *LoyaltyRules) Rank(tier string) int {
+	for i, t := range r.Tiers {
+		if t.Name == tier {
+			return i
+		}
+	}
+	return -1
+}
+
+// Reward returns the catalog item with the given ID.
+func (r *LoyaltyRules) Reward(id string) (Reward, bool) {
+	for _, reward := range r.Rewards {
+		if reward.ID == id {
+			return reward, true
+		}
+	}
+	return Reward{}, false
+}
+
+// LongestWindow is how far back the ledger must be read to evaluate every tier.
+func (r *LoyaltyRules) LongestWindow() time.Duration {
+	var longest time.Duration
+	for _, t := range r.Tiers {
+		longest = max(longest, t.Window)
+	}
+	return longest
+}
+
+// CustomerTier is the tier last computed for a customer and the points that qualified for it.
+type CustomerTier struct {
+	CustomerID       string    `json:"customer_id" dynamodbav:"customer_id"`
+	Tier             string    `json:"tier" dynamodbav:"tier"`
+	QualifyingPoints int       `json:"qualifying_points" dynamodbav:"qualifying_points"`
+	EvaluatedAt      time.Time `json:"evaluated_at" dynamodbav:"evaluated_at"`
+}
+
+// CatalogEntry is a reward as offered to a customer, eligible when the customer tier allows it.
+type CatalogEntry struct {
+	Reward
+	Eligible bool
+}
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+)
+
+var (
+	ErrInsufficientPoints = errors.New("insufficient points")
+	ErrDuplicateEntry     = errors.New("entry already recorded for this reference")
+	ErrConcurrentUpdate   = errors.New("balance changed concurrently")
+	ErrInvalidCursor      = errors.New("invalid cursor")
+)
+
+type LoyaltyRepository interface {
+	// GetBalance returns the balance of a customer, zero when nothing was ever earned.
+	GetBalance(customerID string) (*entities.Balance, error)
+	// Append applies an entry to the balance and stores it. It fails with ErrInsufficientPoints
+	// when the balance would go negative and with ErrDuplicateEntry when an entry of the same
+	// type was already recorded for the reference. BalanceAfter is filled in on success.
+	Append(entry *entities.LedgerEntry) (*entities.Balance, error)
+	// ListEntries returns up to limit entries of a customer, newest first, starting after cursor.
+	ListEntries(customerID string, limit int, cursor string) (*entities.LedgerPage, error)
+	// ListEntriesSince returns every entry of a customer created at or after since, oldest first.
+	ListEntriesSince(customerID string, since time.Time) ([]*entities.LedgerEntry, error)
+	// ListCustomers returns up to limit IDs of customers with a balance, starting after cursor.
+	ListCustomers(limit int, cursor string) ([]string, string, error)
+	// GetTier returns the tier last computed for a customer, with an empty Tier when there is none.
+	GetTier(customerID string) (*entities.CustomerTier, error)
+	SaveTier(tier *entities.CustomerTier) error
+}
@@ -0,0 +1,8 @@
+package repositories
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+
+// LoyaltyRulesRepository provides the tier and rewards configuration currently in force.
+type LoyaltyRulesRepository interface {
+	Get() *entities.LoyaltyRules
+}
@@ -0,0 +1,319 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	loyaltyController "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/adjustpoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeempoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeemreward"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	// Customers and guests may only see their own points; the handler checks the token subject.
+	readLoyaltyRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin, auth.RoleCustomer, auth.RoleGuest},
+		Scopes: []string{auth.ScopeCustomersRead},
+	}
+	// Customers and guests may only redeem their own points; the handler checks the token subject.
+	redeemPointsRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin, auth.RoleCustomer, auth.RoleGuest},
+		Scopes: []string{auth.ScopeCustomersWrite},
+	}
+	adjustPointsRule = auth.Rule{
+		Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin},
+	}
+)
+
+type loyaltyApiController struct {
+	controller loyaltyController.LoyaltyController
+}
+
+func NewLoyaltyController(controller loyaltyController.LoyaltyController) *loyaltyApiController {
+	return &loyaltyApiController{
+		controller: controller,
+	}
+}
+
+func (c *loyaltyApiController) RegisterRoutes(r chi.Router) {
+	r.With(auth.Authorize(readLoyaltyRule)).Get("/v1/customer/{id}/loyalty", c.GetBalance)
+	r.With(auth.Authorize(readLoyaltyRule)).Get("/v1/customer/{id}/loyalty/entries", c.ListEntries)
+	r.With(auth.Authorize(redeemPointsRule)).Post("/v1/customer/{id}/loyalty/redeem", c.Redeem)
+	r.With(auth.Authorize(adjustPointsRule)).Post("/v1/customer/{id}/loyalty/adjust", c.Adjust)
+	r.With(auth.Authorize(readLoyaltyRule)).Get("/v1/customer/{id}/loyalty/rewards", c.ListRewards)
+	r.With(auth.Authorize(redeemPointsRule)).Post("/v1/customer/{id}/loyalty/rewards/{rewardId}/redeem", c.RedeemReward)
+}
+
+// @Summary     Get loyalty balance
+// @Description Get the loyalty points a customer can spend, after expiring points past their validity
+// @Tags        Loyalty
+// @Produce     json
+// @Param       id  path string true "Customer ID"
+// @Success     200 {object} dto.LoyaltyBalanceResponseDto
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/loyalty [get]
+func (h *loyaltyApiController) GetBalance(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnPoints(principal, customerID, auth.ScopeCustomersRead) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	balance, err := h.controller.GetBalance(customerID)
+
+	if err != nil {
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(balance)
+}
+
+// @Summary     List loyalty entries
+// @Description List the points ledger of a customer, newest first
+// @Tags        Loyalty
+// @Produce     json
+// @Param       id     path  string true  "Customer ID"
+// @Param       limit  query int    false "Page size (default 20, max 100)"
+// @Param       cursor query string false "Cursor returned by the previous page"
+// @Success     200 {object} dto.LedgerResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/loyalty/entries [get]
+func (h *loyaltyApiController) ListEntries(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnPoints(principal, customerID, auth.ScopeCustomersRead) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, `{"error":"Invalid limit parameter"}`, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	entries, err := h.controller.ListEntries(customerID, limit, r.URL.Query().Get("cursor"))
+
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidCursor) {
+			http.Error(w, `{"error":"Invalid cursor parameter"}`, http.StatusBadRequest)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(entries)
+}
+
+// @Summary     Redeem loyalty points
+// @Description Spend points at checkout. Each reference, usually the order ID, can be redeemed once
+// @Tags        Loyalty
+// @Accept      json
+// @Produce     json
+// @Param       id   path string true "Customer ID"
+// @Param       body body dto.RedeemPointsRequestDto true "Body"
+// @Success     201 {object} dto.LedgerEntryResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     409 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/loyalty/redeem [post]
+func (h *loyaltyApiController) Redeem(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnPoints(principal, customerID, auth.ScopeCustomersWrite) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	var redeemRequest dto.RedeemPointsRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&redeemRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	entry, err := h.controller.Redeem(customerID, &redeemRequest)
+
+	if err != nil {
+		writeLedgerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+// @Summary     Adjust loyalty points
+// @Description Credit (positive points) or debit (negative points) a customer by hand
+// @Tags        Loyalty
+// @Accept      json
+// @Produce     json
+// @Param       id   path string true "Customer ID"
+// @Param       body body dto.AdjustPointsRequestDto true "Body"
+// @Success     201 {object} dto.LedgerEntryResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     409 {object} map[string]string
+// @Security    BearerAuth
+// @Router      /v1/customer/{id}/loyalty/adjust [post]
+func (h *loyaltyApiController) Adjust(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	var adjustRequest dto.AdjustPointsRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&adjustRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	entry, err := h.controller.Adjust(customerID, &adjustRequest)
+
+	if err != nil {
+		writeLedgerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+// @Summary     List loyalty rewards
+// @Description List the rewards catalog, flagging the rewards the customer tier gives access to
+// @Tags        Loyalty
+// @Produce     json
+// @Param       id  path string true "Customer ID"
+// @Success     200 {object} dto.RewardsCatalogResponseDto
+// @Failure     401 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/loyalty/rewards [get]
+func (h *loyaltyApiController) ListRewards(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnPoints(principal, customerID, auth.ScopeCustomersRead) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	catalog, err := h.controller.ListRewards(customerID)
+
+	if err != nil {
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(catalog)
+}
+
+// @Summary     Redeem loyalty reward
+// @Description Spend the points of a catalog reward. Each reward can be redeemed once per reference, usually the order ID
+// @Tags        Loyalty
+// @Accept      json
+// @Produce     json
+// @Param       id       path string true "Customer ID"
+// @Param       rewardId path string true "Reward ID"
+// @Param       body     body dto.RedeemRewardRequestDto true "Body"
+// @Success     201 {object} dto.LedgerEntryResponseDto
+// @Failure     400 {object} map[string]string
+// @Failure     403 {object} map[string]string
+// @Failure     404 {object} map[string]string
+// @Failure     409 {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/loyalty/rewards/{rewardId}/redeem [post]
+func (h *loyaltyApiController) RedeemReward(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !actsOnOwnPoints(principal, customerID, auth.ScopeCustomersWrite) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	var redeemRequest dto.RedeemRewardRequestDto
+
+	if err := json.NewDecoder(r.Body).Decode(&redeemRequest); err != nil {
+		http.Error(w, `{"error":"Invalid request payload"}`, http.StatusBadRequest)
+		return
+	}
+
+	entry, err := h.controller.RedeemReward(customerID, chi.URLParam(r, "rewardId"), &redeemRequest)
+
+	if err != nil {
+		writeLedgerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+func writeLedgerError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, redeempoints.ErrInvalidRedemption):
+		http.Error(w, `{"error":"Points must be positive and a reference is required"}`, http.StatusBadRequest)
+	case errors.Is(err, adjustpoints.ErrInvalidAdjustment):
+		http.Error(w, `{"error":"Points must not be zero and a reason is required"}`, http.StatusBadRequest)
+	case errors.Is(err, redeemreward.ErrMissingReference):
+		http.Error(w, `{"error":"A reference is required"}`, http.StatusBadRequest)
+	case errors.Is(err, redeemreward.ErrRewardNotFound):
+		http.Error(w, `{"error":"Reward not found"}`, http.StatusNotFound)
+	case errors.Is(err, redeemreward.ErrTierTooLow):
+		http.Error(w, `{"error":"Reward requires a higher tier"}`, http.StatusConflict)
+	case errors.Is(err, repositories.ErrInsufficientPoints):
+		http.Error(w, `{"error":"Insufficient points"}`, http.StatusConflict)
+	case errors.Is(err, repositories.ErrDuplicateEntry):
+		http.Error(w, `{"error":"Reference already redeemed"}`, http.StatusConflict)
+	case errors.Is(err, repositories.ErrConcurrentUpdate):
+		http.Error(w, `{"error":"Balance changed, try again"}`, http.StatusConflict)
+	default:
+		rest.WriteError(w, err)
+	}
+}
+
+// actsOnOwnPoints lets staff-like roles and services with the scope act on any customer while
+// session tokens may only act on the customer they were issued to.
+func actsOnOwnPoints(principal *auth.Principal, customerID string, scope string) bool {
+	if principal.HasRole(auth.RoleKiosk) || principal.HasRole(auth.RoleStaff) || principal.HasRole(auth.RoleAdmin) || principal.HasScope(scope) {
+		return true
+	}
+	return principal.Subject == customerID
+}
@@ -0,0 +1,277 @@
+package controller_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeempoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeemreward"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type LoyaltyApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockLoyaltyController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *LoyaltyApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockLoyaltyController(suite.T())
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiController.NewLoyaltyController(suite.mockController).RegisterRoutes(suite.router)
+}
+
+func TestLoyaltyApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyApiControllerTestSuite))
+}
+
+func (suite *LoyaltyApiControllerTestSuite) post(path string, body any) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+	return w
+}
+
+// Feature: Loyalty REST API
+// Scenario: Customers check and spend their points at the kiosk
+
+func (suite *LoyaltyApiControllerTestSuite) Test_GetBalance_ShouldReturnBalance() {
+	// GIVEN a customer with points
+	suite.mockController.EXPECT().GetBalance("customer-1").Return(&dto.LoyaltyBalanceResponseDto{CustomerID: "customer-1", Points: 120}, nil).Once()
+
+	// WHEN a GET request is made to /v1/customer/customer-1/loyalty
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/loyalty", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the balance should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.LoyaltyBalanceResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), 120, response.Points)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_GetBalance_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer reads someone else's balance
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/loyalty", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_ListEntries_WithInvalidCursor_ShouldReturnBadRequest() {
+	// GIVEN a tampered cursor
+	suite.mockController.EXPECT().ListEntries("customer-1", 0, "bad").Return(nil, repositories.ErrInvalidCursor).Once()
+
+	// WHEN a GET request is made
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/loyalty/entries?cursor=bad", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_ListEntries_ShouldReturnPage() {
+	// GIVEN a ledger page
+	suite.mockController.EXPECT().
+		ListEntries("customer-1", 5, "").
+		Return(&dto.LedgerResponseDto{Entries: []dto.LedgerEntryResponseDto{{ID: "entry-1"}}, NextCursor: "next"}, nil).
+		Once()
+
+	// WHEN a GET request is made
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/loyalty/entries?limit=5", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the page should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.LedgerResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), "entry-1", response.Entries[0].ID)
+	assert.Equal(suite.T(), "next", response.NextCursor)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Redeem_WithOwnSessionToken_ShouldReturnCreated() {
+	// GIVEN a customer paying with points
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+	suite.mockController.EXPECT().
+		Redeem("customer-1", mock.MatchedBy(func(request *dto.RedeemPointsRequestDto) bool {
+			return request.Points == 50 && request.Reference == "order-1"
+		})).
+		Return(&dto.LedgerEntryResponseDto{ID: "entry-1", Points: -50, BalanceAfter: 70}, nil).
+		Once()
+
+	// WHEN redeeming
+	w := suite.post("/v1/customer/customer-1/loyalty/redeem", dto.RedeemPointsRequestDto{Points: 50, Reference: "order-1"})
+
+	// THEN the debit should be returned
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	var response dto.LedgerEntryResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), 70, response.BalanceAfter)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Redeem_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer spends someone else's points
+	w := suite.post("/v1/customer/customer-1/loyalty/redeem", dto.RedeemPointsRequestDto{Points: 50, Reference: "order-1"})
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Redeem_WithInsufficientPoints_ShouldReturnConflict() {
+	// GIVEN a balance lower than the redemption
+	suite.mockController.EXPECT().Redeem("customer-1", mock.Anything).Return(nil, repositories.ErrInsufficientPoints).Once()
+
+	// WHEN redeeming
+	w := suite.post("/v1/customer/customer-1/loyalty/redeem", dto.RedeemPointsRequestDto{Points: 500, Reference: "order-1"})
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Insufficient points")
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Redeem_WithRedeemedReference_ShouldReturnConflict() {
+	// GIVEN the order already paid with points
+	suite.mockController.EXPECT().Redeem("customer-1", mock.Anything).Return(nil, repositories.ErrDuplicateEntry).Once()
+
+	// WHEN redeeming again
+	w := suite.post("/v1/customer/customer-1/loyalty/redeem", dto.RedeemPointsRequestDto{Points: 50, Reference: "order-1"})
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Redeem_WithInvalidRequest_ShouldReturnBadRequest() {
+	// GIVEN a redemption without reference
+	suite.mockController.EXPECT().Redeem("customer-1", mock.Anything).Return(nil, redeempoints.ErrInvalidRedemption).Once()
+
+	// WHEN redeeming
+	w := suite.post("/v1/customer/customer-1/loyalty/redeem", dto.RedeemPointsRequestDto{Points: 50})
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Adjust_WithStaffToken_ShouldReturnCreated() {
+	// GIVEN a staff member
+	suite.principal = &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff}}
+	suite.mockController.EXPECT().Adjust("customer-1", mock.Anything).Return(&dto.LedgerEntryResponseDto{ID: "entry-1", Points: 20}, nil).Once()
+
+	// WHEN adjusting
+	w := suite.post("/v1/customer/customer-1/loyalty/adjust", dto.AdjustPointsRequestDto{Points: 20, Reason: "goodwill"})
+
+	// THEN the response status should be 201 Created
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_Adjust_WithKioskToken_ShouldReturnForbidden() {
+	// GIVEN a kiosk
+	// WHEN adjusting
+	w := suite.post("/v1/customer/customer-1/loyalty/adjust", dto.AdjustPointsRequestDto{Points: 20, Reason: "goodwill"})
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_ListRewards_ShouldReturnCatalog() {
+	// GIVEN a catalog with one reward
+	suite.mockController.EXPECT().ListRewards("customer-1").
+		Return(&dto.RewardsCatalogResponseDto{Rewards: []dto.RewardResponseDto{{ID: "dessert", Points: 300, Eligible: true}}}, nil).
+		Once()
+
+	// WHEN a GET request is made to /v1/customer/customer-1/loyalty/rewards
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/loyalty/rewards", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the catalog should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.RewardsCatalogResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Len(suite.T(), response.Rewards, 1)
+	assert.True(suite.T(), response.Rewards[0].Eligible)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_RedeemReward_WithOwnSessionToken_ShouldReturnCreated() {
+	// GIVEN a customer exchanging points for a reward
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+	suite.mockController.EXPECT().
+		RedeemReward("customer-1", "dessert", mock.MatchedBy(func(request *dto.RedeemRewardRequestDto) bool {
+			return request.Reference == "order-1"
+		})).
+		Return(&dto.LedgerEntryResponseDto{ID: "entry-1", Points: -300}, nil).
+		Once()
+
+	// WHEN redeeming the reward
+	w := suite.post("/v1/customer/customer-1/loyalty/rewards/dessert/redeem", dto.RedeemRewardRequestDto{Reference: "order-1"})
+
+	// THEN the debit should be returned
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_RedeemReward_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer redeems with someone else's points
+	w := suite.post("/v1/customer/customer-1/loyalty/rewards/dessert/redeem", dto.RedeemRewardRequestDto{Reference: "order-1"})
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_RedeemReward_WithUnknownReward_ShouldReturnNotFound() {
+	// GIVEN a reward missing from the catalog
+	suite.mockController.EXPECT().RedeemReward("customer-1", "yacht", mock.Anything).Return(nil, redeemreward.ErrRewardNotFound).Once()
+
+	// WHEN redeeming it
+	w := suite.post("/v1/customer/customer-1/loyalty/rewards/yacht/redeem", dto.RedeemRewardRequestDto{Reference: "order-1"})
+
+	// THEN the response status should be 404 Not Found
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+func (suite *LoyaltyApiControllerTestSuite) Test_RedeemReward_WithLowerTier_ShouldReturnConflict() {
+	// GIVEN a reward above the customer tier
+	suite.mockController.EXPECT().RedeemReward("customer-1", "combo", mock.Anything).Return(nil, redeemreward.ErrTierTooLow).Once()
+
+	// WHEN redeeming it
+	w := suite.post("/v1/customer/customer-1/loyalty/rewards/combo/redeem", dto.RedeemRewardRequestDto{Reference: "order-1"})
+
+	// THEN the response status should be 409 Conflict
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "higher tier")
+}
@@ -0,0 +1,7 @@
+package dto
+
+type AdjustPointsRequestDto struct {
+	// Points is positive to credit and negative to debit.
+	Points int    `json:"points"`
+	Reason string `json:"reason"`
+}
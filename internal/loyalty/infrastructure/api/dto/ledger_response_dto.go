@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type LedgerResponseDto struct {
+	Entries    []LedgerEntryResponseDto `json:"entries"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+type LedgerEntryResponseDto struct {
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	Points       int        `json:"points"`
+	BalanceAfter int        `json:"balance_after"`
+	Reference    string     `json:"reference,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+}
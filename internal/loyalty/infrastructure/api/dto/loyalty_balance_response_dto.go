@@ -0,0 +1,9 @@
+package dto
+
+import "time"
+
+type LoyaltyBalanceResponseDto struct {
+	CustomerID string    `json:"customer_id"`
+	Points     int       `json:"points"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
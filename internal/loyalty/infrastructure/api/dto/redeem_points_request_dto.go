@@ -0,0 +1,8 @@
+package dto
+
+type RedeemPointsRequestDto struct {
+	Points int `json:"points"`
+	// Reference identifies the checkout paying with the points, usually the order ID.
+	Reference string `json:"reference"`
+	Reason    string `json:"reason,omitempty"`
+}
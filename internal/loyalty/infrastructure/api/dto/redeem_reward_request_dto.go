@@ -0,0 +1,6 @@
+package dto
+
+type RedeemRewardRequestDto struct {
+	// Reference identifies the checkout the reward is added to, usually the order ID.
+	Reference string `json:"reference"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type RewardsCatalogResponseDto struct {
+	Rewards []RewardResponseDto `json:"rewards"`
+}
+
+type RewardResponseDto struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Points      int    `json:"points"`
+	MinTier     string `json:"min_tier,omitempty"`
+	// Eligible tells whether the customer tier gives access to the reward.
+	Eligible bool `json:"eligible"`
+}
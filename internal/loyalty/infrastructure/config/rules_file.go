@@ -0,0 +1,222 @@
+package config
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ repositories.LoyaltyRulesRepository = (*FileRulesRepository)(nil)
+)
+
+const DefaultReloadInterval = 30 * time.Second
+
+// defaultRules is used when LOYALTY_RULES_FILE is not set, so the service runs from an image
+// that ships no configuration files.
+//
+//go:embed default_rules.yaml
+var defaultRules []byte
+
+type rulesDocument struct {
+	Window string `yaml:"window"`
+	Tiers  []struct {
+		Name      string   `yaml:"name"`
+		MinPoints int      `yaml:"min_points"`
+		Window    string   `yaml:"window"`
+		Benefits  []string `yaml:"benefits"`
+	} `yaml:"tiers"`
+	Rewards []struct {
+		ID          string `yaml:"id"`
+		Name        string `yaml:"name"`
+		Description string `yaml:"description"`
+		Points      int    `yaml:"points"`
+		MinTier     string `yaml:"min_tier"`
+	} `yaml:"rewards"`
+}
+
+// FileRulesRepository serves the loyalty rules parsed from a YAML file. The file is polled and
+// reloaded when it changes; a file that fails validation is logged and the previous rules stay.
+type FileRulesRepository struct {
+	path     string
+	interval time.Duration
+	rules    atomic.Pointer[entities.LoyaltyRules]
+	modTime  time.Time
+
+	stop chan struct{}
+	done sync.WaitGroup
+}
+
+// NewFileRulesRepository loads the rules from path, or the built-in rules when path is empty.
+func NewFileRulesRepository(path string, interval time.Duration) (*FileRulesRepository, error) {
+	r := &FileRulesRepository{path: path, interval: interval}
+	if path == "" {
+		rules, err := ParseRules(defaultRules)
+		if err != nil {
+			return nil, err
+		}
+		r.rules.Store(rules)
+		return r, nil
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// NewFileRulesRepositoryFromEnv reads LOYALTY_RULES_FILE and LOYALTY_RULES_RELOAD_INTERVAL.
+func NewFileRulesRepositoryFromEnv() (*FileRulesRepository, error) {
+	interval := DefaultReloadInterval
+	if value := os.Getenv("LOYALTY_RULES_RELOAD_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid LOYALTY_RULES_RELOAD_INTERVAL: %q", value)
+		}
+		interval = parsed
+	}
+	return NewFileRulesRepository(os.Getenv("LOYALTY_RULES_FILE"), interval)
+}
+
+func (r *FileRulesRepository) Get() *entities.LoyaltyRules {
+	return r.rules.Load()
+}
+
+// Reload parses the file again and swaps the rules in when they are valid.
+func (r *FileRulesRepository) Reload() error {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to read loyalty rules: %w", err)
+	}
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to read loyalty rules: %w", err)
+	}
+	rules, err := ParseRules(content)
+	if err != nil {
+		return fmt.Errorf("invalid loyalty rules in %s: %w", r.path, err)
+	}
+	r.rules.Store(rules)
+	r.modTime = info.ModTime()
+	return nil
+}
+
+// Start polls the file for changes. It does nothing for the built-in rules or a zero interval.
+func (r *FileRulesRepository) Start() {
+	if r.path == "" || r.interval <= 0 {
+		return
+	}
+	r.stop = make(chan struct{})
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				r.reloadIfChanged()
+			}
+		}
+	}()
+}
+
+func (r *FileRulesRepository) Stop() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	r.done.Wait()
+}
+
+func (r *FileRulesRepository) reloadIfChanged() {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		log.Printf("Warning: failed to check loyalty rules: %v\n", err)
+		return
+	}
+	if info.ModTime().Equal(r.modTime) {
+		return
+	}
+	if err := r.Reload(); err != nil {
+		log.Printf("Warning: keeping previous loyalty rules: %v\n", err)
+		return
+	}
+	log.Printf("Loyalty rules reloaded from %s\n", r.path)
+}
+
+// ParseRules reads and validates a YAML rules document.
+func ParseRules(content []byte) (*entities.LoyaltyRules, error) {
+	var document rulesDocument
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Tiers) == 0 {
+		return nil, errors.New("at least one tier is required")
+	}
+
+	var defaultWindow time.Duration
+	if document.Window != "" {
+		window, err := time.ParseDuration(document.Window)
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("invalid window %q", document.Window)
+		}
+		defaultWindow = window
+	}
+
+	rules := &entities.LoyaltyRules{}
+	for i, t := range document.Tiers {
+		if t.Name == "" {
+			return nil, fmt.Errorf("tier %d has no name", i+1)
+		}
+		if rules.Rank(t.Name) >= 0 {
+			return nil, fmt.Errorf("tier %q is defined twice", t.Name)
+		}
+		if t.MinPoints < 0 || (i > 0 && t.MinPoints <= rules.Tiers[i-1].MinPoints) {
+			return nil, fmt.Errorf("tier %q must require more points than the tier below it", t.Name)
+		}
+		window := defaultWindow
+		if t.Window != "" {
+			parsed, err := time.ParseDuration(t.Window)
+			if err != nil || parsed <= 0 {
+				return nil, fmt.Errorf("invalid window %q for tier %q", t.Window, t.Name)
+			}
+			window = parsed
+		}
+		if window <= 0 {
+			return nil, fmt.Errorf("tier %q has no window", t.Name)
+		}
+		rules.Tiers = append(rules.Tiers, entities.Tier{Name: t.Name, MinPoints: t.MinPoints, Window: window, Benefits: t.Benefits})
+	}
+
+	for _, reward := range document.Rewards {
+		if reward.ID == "" || reward.Points <= 0 {
+			return nil, fmt.Errorf("reward %q needs an id and positive points", reward.Name)
+		}
+		if _, found := rules.Reward(reward.ID); found {
+			return nil, fmt.Errorf("reward %q is defined twice", reward.ID)
+		}
+		if reward.MinTier != "" && rules.Rank(reward.MinTier) < 0 {
+			return nil, fmt.Errorf("reward %q requires unknown tier %q", reward.ID, reward.MinTier)
+		}
+		rules.Rewards = append(rules.Rewards, entities.Reward{
+			ID:          reward.ID,
+			Name:        reward.Name,
+			Description: reward.Description,
+			Points:      reward.Points,
+			MinTier:     reward.MinTier,
+		})
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/config"
+)
+
+const validRules = `
+window: 720h
+tiers:
+  - name: bronze
+    min_points: 0
+  - name: gold
+    min_points: 500
+    window: 24h
+    benefits: [Fila prioritária]
+rewards:
+  - id: dessert
+    name: Sobremesa
+    points: 300
+    min_tier: gold
+`
+
+func TestParseRules_ShouldReadTiersAndRewards(t *testing.T) {
+	rules, err := config.ParseRules([]byte(validRules))
+
+	require.NoError(t, err)
+	require.Len(t, rules.Tiers, 2)
+	assert.Equal(t, 720*time.Hour, rules.Tiers[0].Window)
+	assert.Equal(t, 24*time.Hour, rules.Tiers[1].Window)
+	assert.Equal(t, []string{"Fila prioritária"}, rules.Tiers[1].Benefits)
+	assert.Equal(t, 720*time.Hour, rules.LongestWindow())
+	reward, found := rules.Reward("dessert")
+	assert.True(t, found)
+	assert.Equal(t, "gold", reward.MinTier)
+}
+
+func TestParseRules_WithInvalidDocument_ShouldReturnError(t *testing.T) {
+	documents := map[string]string{
+		"no tiers":         "window: 24h\n",
+		"no window":        "tiers:\n  - name: bronze\n",
+		"bad window":       "window: soon\ntiers:\n  - name: bronze\n",
+		"unordered tiers":  "window: 24h\ntiers:\n  - name: gold\n    min_points: 500\n  - name: silver\n    min_points: 100\n",
+		"duplicated tier":  "window: 24h\ntiers:\n  - name: gold\n  - name: gold\n    min_points: 1\n",
+		"free reward":      "window: 24h\ntiers:\n  - name: bronze\nrewards:\n  - id: dessert\n",
+		"duplicate reward": "window: 24h\ntiers:\n  - name: bronze\nrewards:\n  - id: a\n    points: 1\n  - id: a\n    points: 2\n",
+		"unknown tier":     "window: 24h\ntiers:\n  - name: bronze\nrewards:\n  - id: a\n    points: 1\n    min_tier: gold\n",
+		"not yaml":         "tiers: [",
+	}
+	for name, document := range documents {
+		t.Run(name, func(t *testing.T) {
+			_, err := config.ParseRules([]byte(document))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewFileRulesRepository_WithoutPath_ShouldUseBuiltInRules(t *testing.T) {
+	repository, err := config.NewFileRulesRepository("", time.Second)
+
+	require.NoError(t, err)
+	rules := repository.Get()
+	assert.Equal(t, "bronze", rules.Tiers[0].Name)
+	assert.NotEmpty(t, rules.Rewards)
+}
+
+func TestFileRulesRepository_Reload_ShouldKeepPreviousRulesWhenInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(validRules), 0o600))
+	repository, err := config.NewFileRulesRepository(path, time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("tiers: []\n"), 0o600))
+	assert.Error(t, repository.Reload())
+	assert.Len(t, repository.Get().Tiers, 2)
+
+	require.NoError(t, os.WriteFile(path, []byte("window: 24h\ntiers:\n  - name: bronze\n"), 0o600))
+	assert.NoError(t, repository.Reload())
+	assert.Len(t, repository.Get().Tiers, 1)
+}
+
+func TestNewFileRulesRepository_WithMissingFile_ShouldReturnError(t *testing.T) {
+	_, err := config.NewFileRulesRepository(filepath.Join(t.TempDir(), "missing.yaml"), time.Second)
+
+	assert.Error(t, err)
+}
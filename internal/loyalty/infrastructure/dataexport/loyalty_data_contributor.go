@@ -0,0 +1,62 @@
+package dataexport
+
+import (
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+)
+
+const ledgerPageSize = 100
+
+var (
+	_ dataExportRepositories.DataContributor = (*LoyaltyDataContributor)(nil)
+)
+
+// LoyaltyData is the loyalty section of a data export: the balance, the current tier and the
+// whole ledger, newest first.
+type LoyaltyData struct {
+	Balance *entities.Balance       `json:"balance"`
+	Tier    *entities.CustomerTier  `json:"tier"`
+	Ledger  []*entities.LedgerEntry `json:"ledger"`
+}
+
+type LoyaltyDataContributor struct {
+	loyaltyRepository repositories.LoyaltyRepository
+}
+
+func NewLoyaltyDataContributor(loyaltyRepository repositories.LoyaltyRepository) *LoyaltyDataContributor {
+	return &LoyaltyDataContributor{loyaltyRepository: loyaltyRepository}
+}
+
+func (c *LoyaltyDataContributor) Section() string {
+	return "loyalty"
+}
+
+func (c *LoyaltyDataContributor) Title() string {
+	return "Loyalty program"
+}
+
+func (c *LoyaltyDataContributor) Collect(customerID string) (any, error) {
+	balance, err := c.loyaltyRepository.GetBalance(customerID)
+	if err != nil {
+		return nil, err
+	}
+	tier, err := c.loyaltyRepository.GetTier(customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &LoyaltyData{Balance: balance, Tier: tier, Ledger: []*entities.LedgerEntry{}}
+	cursor := ""
+	for {
+		page, err := c.loyaltyRepository.ListEntries(customerID, ledgerPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		data.Ledger = append(data.Ledger, page.Entries...)
+		if page.NextCursor == "" {
+			return data, nil
+		}
+		cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,44 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/dataexport"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+func TestLoyaltyDataContributor_ShouldCollectBalanceTierAndLedger(t *testing.T) {
+	repository := mockRepositories.NewMockLoyaltyRepository(t)
+	balance := &entities.Balance{CustomerID: "customer-1", Points: 150}
+	tier := &entities.CustomerTier{CustomerID: "customer-1", Tier: "silver"}
+	repository.EXPECT().GetBalance("customer-1").Return(balance, nil).Once()
+	repository.EXPECT().GetTier("customer-1").Return(tier, nil).Once()
+	repository.EXPECT().ListEntries("customer-1", 100, "").
+		Return(&entities.LedgerPage{Entries: []*entities.LedgerEntry{{ID: "entry-2"}}, NextCursor: "next"}, nil).
+		Once()
+	repository.EXPECT().ListEntries("customer-1", 100, "next").
+		Return(&entities.LedgerPage{Entries: []*entities.LedgerEntry{{ID: "entry-1"}}}, nil).
+		Once()
+
+	data, err := dataexport.NewLoyaltyDataContributor(repository).Collect("customer-1")
+
+	assert.NoError(t, err)
+	loyalty := data.(*dataexport.LoyaltyData)
+	assert.Equal(t, balance, loyalty.Balance)
+	assert.Equal(t, tier, loyalty.Tier)
+	assert.Len(t, loyalty.Ledger, 2)
+}
+
+func TestLoyaltyDataContributor_WithBalanceError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockLoyaltyRepository(t)
+	expectedError := errors.New("get failed")
+	repository.EXPECT().GetBalance("customer-1").Return(nil, expectedError).Once()
+
+	data, err := dataexport.NewLoyaltyDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.Equal(t, expectedError, err)
+}
@@ -0,0 +1,148 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/persistence"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/recalculatetier"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/lease"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+const (
+	DefaultTierRecalculationInterval = time.Hour
+	customersPageSize                = 100
+	tierRecalculationLeaseName       = "tier-recalculation"
+)
+
+// TierRecalculationJob periodically recomputes the tier of every customer with a balance, so
+// tiers also drop when earned points leave the rolling window. Every replica runs the job, but
+// only the one holding the lease of the period recalculates.
+type TierRecalculationJob struct {
+	loyaltyRepository      repositories.LoyaltyRepository
+	recalculateTierUseCase recalculatetier.RecalculateTierUseCase
+	lease                  lease.Lease
+	holder                 string
+	interval               time.Duration
+	now                    func() time.Time
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+// NewTierRecalculationJob identifies the replica by its host name, the pod name in Kubernetes, when
+// taking the lease.
+func NewTierRecalculationJob(loyaltyRepository repositories.LoyaltyRepository, recalculateTierUseCase recalculatetier.RecalculateTierUseCase, lease lease.Lease, interval time.Duration) *TierRecalculationJob {
+	holder, err := os.Hostname()
+	if err != nil {
+		holder = uuid.NewString()
+	}
+	return &TierRecalculationJob{
+		loyaltyRepository:      loyaltyRepository,
+		recalculateTierUseCase: recalculateTierUseCase,
+		lease:                  lease,
+		holder:                 holder,
+		interval:               interval,
+		now:                    time.Now,
+	}
+}
+
+// NewTierRecalculationJobFromEnv reads LOYALTY_TIER_RECALC_INTERVAL; 0 disables the job.
+func NewTierRecalculationJobFromEnv(loyaltyRepository repositories.LoyaltyRepository, recalculateTierUseCase recalculatetier.RecalculateTierUseCase, db dynamodbpkg.Client) (*TierRecalculationJob, error) {
+	interval := DefaultTierRecalculationInterval
+	if value := os.Getenv("LOYALTY_TIER_RECALC_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid LOYALTY_TIER_RECALC_INTERVAL: %q", value)
+		}
+		interval = parsed
+	}
+	jobLease := persistence.NewJobLease(db, tierRecalculationLeaseName)
+	return NewTierRecalculationJob(loyaltyRepository, recalculateTierUseCase, jobLease, interval), nil
+}
+
+// Start runs the job right away and then on every interval until Stop is called, whenever this
+// replica gets the lease.
+func (j *TierRecalculationJob) Start() {
+	if j.interval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.done.Add(1)
+	go func() {
+		defer j.done.Done()
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			if _, updated, err := j.RunIfLeased(ctx); err != nil {
+				log.Printf("Warning: tier recalculation failed after %d customers: %v", updated, err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop cancels the job and waits for the customer being recalculated to finish.
+func (j *TierRecalculationJob) Stop() {
+	if j.cancel == nil {
+		return
+	}
+	j.cancel()
+	j.done.Wait()
+}
+
+// RunIfLeased runs the job when this replica gets the lease for the interval, so the tiers are
+// recalculated once per interval whatever the number of replicas, and tells whether it ran. The
+// replica holding the lease keeps it on its next run, while the others take over once it expires.
+func (j *TierRecalculationJob) RunIfLeased(ctx context.Context) (bool, int, error) {
+	acquired, err := j.lease.Acquire(ctx, j.holder, j.interval)
+	if err != nil {
+		return false, 0, err
+	}
+	if !acquired {
+		log.Println("Skipping tier recalculation, another replica holds the lease")
+		return false, 0, nil
+	}
+	updated, err := j.RunOnce(ctx)
+	return true, updated, err
+}
+
+// RunOnce recalculates the tier of every customer and returns how many were updated. A failure
+// on one customer is logged and skipped; only failing to list customers stops the run.
+func (j *TierRecalculationJob) RunOnce(ctx context.Context) (int, error) {
+	updated := 0
+	cursor := ""
+	for {
+		customerIDs, next, err := j.loyaltyRepository.ListCustomers(customersPageSize, cursor)
+		if err != nil {
+			return updated, err
+		}
+		for _, customerID := range customerIDs {
+			if ctx.Err() != nil {
+				return updated, nil
+			}
+			if _, err := j.recalculateTierUseCase.Execute(commands.NewRecalculateTierCommand(customerID, j.now())); err != nil {
+				log.Printf("Warning: failed to recalculate tier of customer %s: %v", customerID, err)
+				continue
+			}
+			updated++
+		}
+		if next == "" {
+			return updated, nil
+		}
+		cursor = next
+	}
+}
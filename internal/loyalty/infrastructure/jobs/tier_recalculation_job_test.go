@@ -0,0 +1,148 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/jobs"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+	mockRecalculateTier "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/recalculatetier"
+)
+
+// fakeLease grants the lease when acquired is set, failing with err otherwise.
+type fakeLease struct {
+	acquired bool
+	err      error
+	ttl      time.Duration
+}
+
+func (f *fakeLease) Acquire(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	f.ttl = ttl
+	return f.acquired, f.err
+}
+
+type TierRecalculationJobTestSuite struct {
+	suite.Suite
+	mockRepository             *mockRepositories.MockLoyaltyRepository
+	mockRecalculateTierUseCase *mockRecalculateTier.MockRecalculateTierUseCase
+	lease                      *fakeLease
+	job                        *jobs.TierRecalculationJob
+}
+
+func (suite *TierRecalculationJobTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.mockRecalculateTierUseCase = mockRecalculateTier.NewMockRecalculateTierUseCase(suite.T())
+	suite.lease = &fakeLease{acquired: true}
+	suite.job = jobs.NewTierRecalculationJob(suite.mockRepository, suite.mockRecalculateTierUseCase, suite.lease, time.Hour)
+}
+
+func TestTierRecalculationJobTestSuite(t *testing.T) {
+	suite.Run(t, new(TierRecalculationJobTestSuite))
+}
+
+// Feature: Tier Recalculation Job
+// Scenario: Every customer with a balance gets a fresh tier
+
+func (suite *TierRecalculationJobTestSuite) Test_RunOnce_ShouldRecalculateEveryPage() {
+	// GIVEN customers spread over two pages
+	suite.mockRepository.EXPECT().ListCustomers(100, "").Return([]string{"customer-1", "customer-2"}, "next", nil).Once()
+	suite.mockRepository.EXPECT().ListCustomers(100, "next").Return([]string{"customer-3"}, "", nil).Once()
+	for _, customerID := range []string{"customer-1", "customer-2", "customer-3"} {
+		suite.mockRecalculateTierUseCase.EXPECT().
+			Execute(mock.MatchedBy(func(cmd *commands.RecalculateTierCommand) bool {
+				return cmd.CustomerID == customerID && !cmd.Now.IsZero()
+			})).
+			Return(&entities.CustomerTier{CustomerID: customerID}, nil).
+			Once()
+	}
+
+	// WHEN running the job
+	updated, err := suite.job.RunOnce(context.Background())
+
+	// THEN every customer should be recalculated
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, updated)
+}
+
+func (suite *TierRecalculationJobTestSuite) Test_RunOnce_WithCustomerFailure_ShouldContinue() {
+	// GIVEN the first customer fails to recalculate
+	suite.mockRepository.EXPECT().ListCustomers(100, "").Return([]string{"customer-1", "customer-2"}, "", nil).Once()
+	suite.mockRecalculateTierUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecalculateTierCommand) bool { return cmd.CustomerID == "customer-1" })).
+		Return(nil, errors.New("query failed")).
+		Once()
+	suite.mockRecalculateTierUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecalculateTierCommand) bool { return cmd.CustomerID == "customer-2" })).
+		Return(&entities.CustomerTier{CustomerID: "customer-2"}, nil).
+		Once()
+
+	// WHEN running the job
+	updated, err := suite.job.RunOnce(context.Background())
+
+	// THEN the other customers should still be recalculated
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, updated)
+}
+
+func (suite *TierRecalculationJobTestSuite) Test_RunOnce_WithListError_ShouldReturnError() {
+	// GIVEN the customers cannot be listed
+	expectedError := errors.New("scan failed")
+	suite.mockRepository.EXPECT().ListCustomers(100, "").Return(nil, "", expectedError).Once()
+
+	// WHEN running the job
+	updated, err := suite.job.RunOnce(context.Background())
+
+	// THEN the error should be returned
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Equal(suite.T(), 0, updated)
+}
+
+// Scenario: A single replica recalculates the tiers each interval
+
+func (suite *TierRecalculationJobTestSuite) Test_RunIfLeased_WithLease_ShouldRunAndHoldItForTheInterval() {
+	// GIVEN this replica gets the lease
+	suite.mockRepository.EXPECT().ListCustomers(100, "").Return([]string{"customer-1"}, "", nil).Once()
+	suite.mockRecalculateTierUseCase.EXPECT().Execute(mock.Anything).Return(&entities.CustomerTier{CustomerID: "customer-1"}, nil).Once()
+
+	// WHEN the job is due
+	ran, updated, err := suite.job.RunIfLeased(context.Background())
+
+	// THEN it should run, holding the lease until the next run
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), ran)
+	assert.Equal(suite.T(), 1, updated)
+	assert.Equal(suite.T(), time.Hour, suite.lease.ttl)
+}
+
+func (suite *TierRecalculationJobTestSuite) Test_RunIfLeased_WhenAnotherReplicaHoldsTheLease_ShouldSkip() {
+	// GIVEN another replica holds the lease
+	suite.lease.acquired = false
+
+	// WHEN the job is due
+	ran, updated, err := suite.job.RunIfLeased(context.Background())
+
+	// THEN no customer should be recalculated
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), ran)
+	assert.Equal(suite.T(), 0, updated)
+}
+
+func (suite *TierRecalculationJobTestSuite) Test_RunIfLeased_WhenTheLeaseCannotBeAcquired_ShouldReturnError() {
+	// GIVEN the lease cannot be read
+	suite.lease.acquired = false
+	suite.lease.err = errors.New("throttled")
+
+	// WHEN the job is due
+	ran, _, err := suite.job.RunIfLeased(context.Background())
+
+	// THEN the error should be returned without running
+	assert.EqualError(suite.T(), err, "throttled")
+	assert.False(suite.T(), ran)
+}
@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+// PaymentConfirmedType is the event the payment service publishes when a payment is approved.
+const PaymentConfirmedType = "payment.confirmed"
+
+// paymentConfirmedPayload is the data of a payment.confirmed event.
+type paymentConfirmedPayload struct {
+	PaymentID   string    `json:"payment_id"`
+	OrderID     string    `json:"order_id"`
+	CustomerID  string    `json:"customer_id"`
+	Amount      float64   `json:"amount"`
+	ConfirmedAt time.Time `json:"confirmed_at"`
+}
+
+// PaymentEventHandler credits loyalty points from the events of the payment service.
+type PaymentEventHandler struct {
+	earnPointsUseCase earnpoints.EarnPointsUseCase
+}
+
+func NewPaymentEventHandler(earnPointsUseCase earnpoints.EarnPointsUseCase) *PaymentEventHandler {
+	return &PaymentEventHandler{earnPointsUseCase: earnPointsUseCase}
+}
+
+// Handle credits points for confirmed payments. Other payment events and anonymous payments are
+// ignored; payloads that cannot be read are rejected permanently so they go to the dead-letter queue.
+func (h *PaymentEventHandler) Handle(ctx context.Context, message messaging.Message) error {
+	if message.Type != PaymentConfirmedType {
+		return nil
+	}
+
+	var payload paymentConfirmedPayload
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return messaging.Permanent(fmt.Errorf("invalid %s payload: %w", message.Type, err))
+	}
+	if payload.CustomerID == "" {
+		return nil
+	}
+	if payload.PaymentID == "" {
+		payload.PaymentID = message.Subject
+	}
+	if payload.ConfirmedAt.IsZero() {
+		payload.ConfirmedAt = message.Time
+	}
+
+	command := commands.NewEarnPointsCommand(payload.CustomerID, payload.PaymentID, payload.OrderID, payload.Amount, payload.ConfirmedAt)
+	err := h.earnPointsUseCase.Execute(command)
+	if errors.Is(err, earnpoints.ErrInvalidPayment) {
+		return messaging.Permanent(err)
+	}
+	return err
+}
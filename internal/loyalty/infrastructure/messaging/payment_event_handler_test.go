@@ -0,0 +1,127 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	loyaltyMessaging "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/messaging"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+	mockEarnPoints "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/usecase/earnpoints"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+type PaymentEventHandlerTestSuite struct {
+	suite.Suite
+	mockEarnPointsUseCase *mockEarnPoints.MockEarnPointsUseCase
+	handler               *loyaltyMessaging.PaymentEventHandler
+}
+
+func (suite *PaymentEventHandlerTestSuite) SetupTest() {
+	suite.mockEarnPointsUseCase = mockEarnPoints.NewMockEarnPointsUseCase(suite.T())
+	suite.handler = loyaltyMessaging.NewPaymentEventHandler(suite.mockEarnPointsUseCase)
+}
+
+func TestPaymentEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(PaymentEventHandlerTestSuite))
+}
+
+// Feature: Payment Event Handler
+// Scenario: Confirmed payments earn loyalty points
+
+func (suite *PaymentEventHandlerTestSuite) Test_PaymentConfirmed_ShouldEarnPoints() {
+	// GIVEN a payment.confirmed event
+	message := messaging.Message{
+		ID:   "evt-1",
+		Type: loyaltyMessaging.PaymentConfirmedType,
+		Data: []byte(`{"payment_id":"payment-1","order_id":"order-1","customer_id":"customer-1","amount":45.9,"confirmed_at":"2025-01-01T12:00:00Z"}`),
+	}
+	suite.mockEarnPointsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.EarnPointsCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.PaymentID == "payment-1" && cmd.OrderID == "order-1" &&
+				cmd.Amount == 45.9 && cmd.ConfirmedAt.Equal(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN points should be earned
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PaymentEventHandlerTestSuite) Test_PaymentConfirmed_WithoutPaymentID_ShouldUseEventSubject() {
+	// GIVEN an event whose payload omits the payment ID and time
+	eventTime := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	message := messaging.Message{
+		Type:    loyaltyMessaging.PaymentConfirmedType,
+		Subject: "payment-9",
+		Time:    eventTime,
+		Data:    []byte(`{"customer_id":"customer-1","amount":10}`),
+	}
+	suite.mockEarnPointsUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.EarnPointsCommand) bool {
+			return cmd.PaymentID == "payment-9" && cmd.ConfirmedAt.Equal(eventTime)
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the envelope should fill the gaps
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PaymentEventHandlerTestSuite) Test_OtherEvents_ShouldBeIgnored() {
+	// GIVEN a payment.refused event and an anonymous payment
+	refused := messaging.Message{Type: "payment.refused", Data: []byte(`{}`)}
+	anonymous := messaging.Message{Type: loyaltyMessaging.PaymentConfirmedType, Data: []byte(`{"payment_id":"payment-1","amount":10}`)}
+
+	// WHEN handling them
+	// THEN nothing should be earned
+	assert.NoError(suite.T(), suite.handler.Handle(context.Background(), refused))
+	assert.NoError(suite.T(), suite.handler.Handle(context.Background(), anonymous))
+}
+
+func (suite *PaymentEventHandlerTestSuite) Test_InvalidPayload_ShouldFailPermanently() {
+	// GIVEN a payload that is not JSON
+	message := messaging.Message{Type: loyaltyMessaging.PaymentConfirmedType, Data: []byte(`not json`)}
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the failure should not be retried
+	assert.True(suite.T(), messaging.IsPermanent(err))
+}
+
+func (suite *PaymentEventHandlerTestSuite) Test_InvalidPayment_ShouldFailPermanently() {
+	// GIVEN the use case rejects the payment
+	message := messaging.Message{Type: loyaltyMessaging.PaymentConfirmedType, Data: []byte(`{"customer_id":"customer-1","amount":10}`)}
+	suite.mockEarnPointsUseCase.EXPECT().Execute(mock.Anything).Return(earnpoints.ErrInvalidPayment).Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the failure should not be retried
+	assert.True(suite.T(), messaging.IsPermanent(err))
+}
+
+func (suite *PaymentEventHandlerTestSuite) Test_TransientError_ShouldBeRetried() {
+	// GIVEN the ledger is unavailable
+	message := messaging.Message{Type: loyaltyMessaging.PaymentConfirmedType, Data: []byte(`{"payment_id":"payment-1","customer_id":"customer-1","amount":10}`)}
+	suite.mockEarnPointsUseCase.EXPECT().Execute(mock.Anything).Return(errors.New("throttled")).Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the error should be returned for redelivery
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), messaging.IsPermanent(err))
+}
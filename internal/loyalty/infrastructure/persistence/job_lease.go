@@ -0,0 +1,22 @@
+package persistence
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/lease"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+const (
+	// The leases of the loyalty jobs live in the loyalty table, each under a partition of its own
+	// that no customer ID takes, so they never show up among the customers.
+	jobLeasePrefix = "JOB#"
+	leaseSortKey   = "LEASE"
+)
+
+// NewJobLease returns the lease that lets a single replica at a time run the loyalty job named job.
+func NewJobLease(db dynamodbpkg.Client, job string) *lease.DynamoDBLease {
+	return lease.NewDynamoDBLease(db, dynamodbpkg.LoyaltyTableName, map[string]types.AttributeValue{
+		"customer_id": &types.AttributeValueMemberS{Value: jobLeasePrefix + job},
+		"sk":          &types.AttributeValueMemberS{Value: leaseSortKey},
+	})
+}
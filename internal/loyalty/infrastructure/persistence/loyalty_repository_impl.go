@@ -0,0 +1,405 @@
+package persistence
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.LoyaltyRepository = (*LoyaltyRepositoryImpl)(nil)
+)
+
+const (
+	conditionalCheckFailed = "ConditionalCheckFailed"
+
+	// The loyalty table keeps three kinds of items per customer: the balance, the ledger
+	// entries, sorted chronologically, and one marker per referenced entry.
+	balanceSortKey  = "BALANCE"
+	tierSortKey     = "TIER"
+	entryPrefix     = "ENTRY#"
+	referencePrefix = "REF#"
+	// entryUpperBound sorts after every entry sort key, as '~' follows the digits of the timestamps.
+	entryUpperBound = entryPrefix + "~"
+
+	// sortKeyLayout keeps a fixed width so entry sort keys order chronologically.
+	sortKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+	// maxAppendAttempts bounds the retries when the balance changes between read and write.
+	maxAppendAttempts = 3
+)
+
+// balanceRecord is the stored balance. Version is bumped on every write and checked by the
+// next one, so two entries applied concurrently cannot both compute from the same balance.
+type balanceRecord struct {
+	entities.Balance
+	SortKey string `dynamodbav:"sk"`
+	Version int    `dynamodbav:"version"`
+}
+
+type entryRecord struct {
+	entities.LedgerEntry
+	SortKey string `dynamodbav:"sk"`
+}
+
+type tierRecord struct {
+	entities.CustomerTier
+	SortKey string `dynamodbav:"sk"`
+}
+
+type referenceRecord struct {
+	CustomerID string `dynamodbav:"customer_id"`
+	SortKey    string `dynamodbav:"sk"`
+	EntryID    string `dynamodbav:"entry_id"`
+}
+
+type LoyaltyRepositoryImpl struct {
+	db dynamodbpkg.Client
+}
+
+func NewLoyaltyRepositoryImpl(db dynamodbpkg.Client) *LoyaltyRepositoryImpl {
+	return &LoyaltyRepositoryImpl{db: db}
+}
+
+func (r *LoyaltyRepositoryImpl) GetBalance(customerID string) (*entities.Balance, error) {
+	record, err := r.getBalance(customerID)
+	if err != nil {
+		return nil, err
+	}
+	return &record.Balance, nil
+}
+
+func (r *LoyaltyRepositoryImpl) Append(entry *entities.LedgerEntry) (*entities.Balance, error) {
+	if entry.ID == "" {
+		id, err := newEntryID()
+		if err != nil {
+			return nil, err
+		}
+		entry.ID = id
+	}
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now().UTC()
+	}
+
+	for attempt := 0; attempt < maxAppendAttempts; attempt++ {
+		current, err := r.getBalance(entry.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+
+		points := current.Points + entry.Points
+		if points < 0 {
+			return nil, repositories.ErrInsufficientPoints
+		}
+		entry.BalanceAfter = points
+
+		next := balanceRecord{
+			Balance: entities.Balance{
+				CustomerID: entry.CustomerID,
+				Points:     points,
+				UpdatedAt:  entry.CreatedAt,
+			},
+			SortKey: balanceSortKey,
+			Version: current.Version + 1,
+		}
+
+		writes, err := appendWrites(current.Version, next, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.db.TransactWriteItems(context.Background(), &dynamodb.TransactWriteItemsInput{TransactItems: writes})
+		if err == nil {
+			return &next.Balance, nil
+		}
+
+		var canceled *types.TransactionCanceledException
+		if !errors.As(err, &canceled) {
+			return nil, fmt.Errorf("failed to append ledger entry: %w", err)
+		}
+		if entry.Reference != "" && cancellationReason(canceled, 2) == conditionalCheckFailed {
+			return nil, repositories.ErrDuplicateEntry
+		}
+		if cancellationReason(canceled, 0) != conditionalCheckFailed {
+			return nil, fmt.Errorf("failed to append ledger entry: %w", err)
+		}
+	}
+
+	return nil, repositories.ErrConcurrentUpdate
+}
+
+func (r *LoyaltyRepositoryImpl) ListEntries(customerID string, limit int, cursor string) (*entities.LedgerPage, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.LoyaltyTableName),
+		KeyConditionExpression: aws.String("customer_id = :customer_id AND begins_with(sk, :prefix)"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":customer_id": &types.AttributeValueMemberS{Value: customerID},
+			":prefix":      &types.AttributeValueMemberS{Value: entryPrefix},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		lastKey, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: lastKey},
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ledger entries: %w", err)
+	}
+
+	var records []entryRecord
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ledger entries: %w", err)
+	}
+
+	page := &entities.LedgerPage{Entries: make([]*entities.LedgerEntry, 0, len(records))}
+	for i := range records {
+		page.Entries = append(page.Entries, &records[i].LedgerEntry)
+	}
+	if lastKey, ok := result.LastEvaluatedKey["sk"]; ok {
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(dynamodbpkg.StringValue(lastKey)))
+	}
+
+	return page, nil
+}
+
+func (r *LoyaltyRepositoryImpl) ListEntriesSince(customerID string, since time.Time) ([]*entities.LedgerEntry, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.LoyaltyTableName),
+		KeyConditionExpression: aws.String("customer_id = :customer_id AND sk BETWEEN :from AND :to"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":customer_id": &types.AttributeValueMemberS{Value: customerID},
+			":from":        &types.AttributeValueMemberS{Value: entryPrefix + since.UTC().Format(sortKeyLayout)},
+			":to":          &types.AttributeValueMemberS{Value: entryUpperBound},
+		},
+	}
+
+	var entries []*entities.LedgerEntry
+	for {
+		result, err := r.db.Query(context.Background(), input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query ledger entries: %w", err)
+		}
+
+		var records []entryRecord
+		if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal ledger entries: %w", err)
+		}
+		for i := range records {
+			entries = append(entries, &records[i].LedgerEntry)
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			return entries, nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// ListCustomers scans the balance items. The scan limit applies before the filter, so a page
+// may hold fewer customers than limit while more remain.
+func (r *LoyaltyRepositoryImpl) ListCustomers(limit int, cursor string) ([]string, string, error) {
+	input := &dynamodb.ScanInput{
+		TableName:            aws.String(dynamodbpkg.LoyaltyTableName),
+		FilterExpression:     aws.String("sk = :balance"),
+		ProjectionExpression: aws.String("customer_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":balance": &types.AttributeValueMemberS{Value: balanceSortKey},
+		},
+		Limit: aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		customerID, sortKey, found := strings.Cut(string(decoded), "\n")
+		if err != nil || !found {
+			return nil, "", repositories.ErrInvalidCursor
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: sortKey},
+		}
+	}
+
+	result, err := r.db.Scan(context.Background(), input)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to scan customers: %w", err)
+	}
+
+	customerIDs := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		customerIDs = append(customerIDs, dynamodbpkg.StringValue(item["customer_id"]))
+	}
+
+	next := ""
+	if len(result.LastEvaluatedKey) > 0 {
+		lastKey := dynamodbpkg.StringValue(result.LastEvaluatedKey["customer_id"]) + "\n" + dynamodbpkg.StringValue(result.LastEvaluatedKey["sk"])
+		next = base64.RawURLEncoding.EncodeToString([]byte(lastKey))
+	}
+
+	return customerIDs, next, nil
+}
+
+func (r *LoyaltyRepositoryImpl) GetTier(customerID string) (*entities.CustomerTier, error) {
+	result, err := r.db.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String(dynamodbpkg.LoyaltyTableName),
+		Key: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: tierSortKey},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tier: %w", err)
+	}
+
+	record := &tierRecord{CustomerTier: entities.CustomerTier{CustomerID: customerID}}
+	if result.Item == nil {
+		return &record.CustomerTier, nil
+	}
+	if err := attributevalue.UnmarshalMap(result.Item, record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tier: %w", err)
+	}
+	return &record.CustomerTier, nil
+}
+
+func (r *LoyaltyRepositoryImpl) SaveTier(tier *entities.CustomerTier) error {
+	av, err := attributevalue.MarshalMap(tierRecord{CustomerTier: *tier, SortKey: tierSortKey})
+	if err != nil {
+		return fmt.Errorf("failed to marshal tier: %w", err)
+	}
+
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName: aws.String(dynamodbpkg.LoyaltyTableName),
+		Item:      av,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save tier: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LoyaltyRepositoryImpl) getBalance(customerID string) (*balanceRecord, error) {
+	result, err := r.db.GetItem(context.Background(), &dynamodb.GetItemInput{
+		TableName: aws.String(dynamodbpkg.LoyaltyTableName),
+		Key: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: balanceSortKey},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	record := &balanceRecord{Balance: entities.Balance{CustomerID: customerID}}
+	if result.Item == nil {
+		return record, nil
+	}
+	if err := attributevalue.UnmarshalMap(result.Item, record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal balance: %w", err)
+	}
+	return record, nil
+}
+
+// appendWrites builds the transaction of an entry: the balance, conditioned on the version
+// that was read, the entry itself and, for referenced entries, the marker that keeps them unique.
+func appendWrites(version int, balance balanceRecord, entry *entities.LedgerEntry) ([]types.TransactWriteItem, error) {
+	balanceItem, err := attributevalue.MarshalMap(balance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal balance: %w", err)
+	}
+	entryItem, err := attributevalue.MarshalMap(entryRecord{LedgerEntry: *entry, SortKey: entrySortKey(entry)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+
+	balancePut := &types.Put{
+		TableName:           aws.String(dynamodbpkg.LoyaltyTableName),
+		Item:                balanceItem,
+		ConditionExpression: aws.String("attribute_not_exists(sk)"),
+	}
+	if version > 0 {
+		balancePut.ConditionExpression = aws.String("version = :version")
+		balancePut.ExpressionAttributeValues = map[string]types.AttributeValue{
+			":version": &types.AttributeValueMemberN{Value: strconv.Itoa(version)},
+		}
+	}
+
+	writes := []types.TransactWriteItem{
+		{Put: balancePut},
+		{Put: &types.Put{
+			TableName:           aws.String(dynamodbpkg.LoyaltyTableName),
+			Item:                entryItem,
+			ConditionExpression: aws.String("attribute_not_exists(sk)"),
+		}},
+	}
+
+	if entry.Reference != "" {
+		referenceItem, err := attributevalue.MarshalMap(referenceRecord{
+			CustomerID: entry.CustomerID,
+			SortKey:    referencePrefix + string(entry.Type) + "#" + entry.Reference,
+			EntryID:    entry.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal entry reference: %w", err)
+		}
+		writes = append(writes, types.TransactWriteItem{Put: &types.Put{
+			TableName:           aws.String(dynamodbpkg.LoyaltyTableName),
+			Item:                referenceItem,
+			ConditionExpression: aws.String("attribute_not_exists(sk)"),
+		}})
+	}
+
+	return writes, nil
+}
+
+func cancellationReason(canceled *types.TransactionCanceledException, index int) string {
+	if index >= len(canceled.CancellationReasons) {
+		return ""
+	}
+	return aws.ToString(canceled.CancellationReasons[index].Code)
+}
+
+func entrySortKey(entry *entities.LedgerEntry) string {
+	return entryPrefix + entry.CreatedAt.UTC().Format(sortKeyLayout) + "#" + entry.ID
+}
+
+func decodeCursor(cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), entryPrefix) {
+		return "", repositories.ErrInvalidCursor
+	}
+	return string(decoded), nil
+}
+
+func newEntryID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate entry id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,392 @@
+package persistence_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/persistence"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// Mock DynamoDB Client
+type MockDynamoDBClient struct {
+	mock.Mock
+	dynamodbpkg.Client
+}
+
+func (m *MockDynamoDBClient) GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.GetItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.TransactWriteItemsOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Scan(ctx context.Context, input *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.ScanOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+type LoyaltyRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     *MockDynamoDBClient
+	repository *persistence.LoyaltyRepositoryImpl
+}
+
+func (suite *LoyaltyRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	suite.repository = persistence.NewLoyaltyRepositoryImpl(suite.mockDB)
+}
+
+func TestLoyaltyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyRepositoryTestSuite))
+}
+
+func balanceItem(points string, version string) *dynamodb.GetItemOutput {
+	return &dynamodb.GetItemOutput{Item: map[string]types.AttributeValue{
+		"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+		"sk":          &types.AttributeValueMemberS{Value: "BALANCE"},
+		"points":      &types.AttributeValueMemberN{Value: points},
+		"version":     &types.AttributeValueMemberN{Value: version},
+	}}
+}
+
+func numberValue(av types.AttributeValue) string {
+	if n, ok := av.(*types.AttributeValueMemberN); ok {
+		return n.Value
+	}
+	return ""
+}
+
+func canceledAt(index int, size int) error {
+	reasons := make([]types.CancellationReason, size)
+	for i := range reasons {
+		reasons[i] = types.CancellationReason{Code: aws.String("None")}
+	}
+	reasons[index].Code = aws.String("ConditionalCheckFailed")
+	return &types.TransactionCanceledException{CancellationReasons: reasons}
+}
+
+// Feature: Loyalty Repository - Persistence Layer
+// Scenario: Entries and balance are written together and the balance never goes negative
+
+func (suite *LoyaltyRepositoryTestSuite) Test_GetBalance_WithoutBalanceItem_ShouldReturnZero() {
+	// GIVEN a customer that never earned points
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, nil).Once()
+
+	// WHEN reading the balance
+	balance, err := suite.repository.GetBalance("customer-1")
+
+	// THEN a zero balance should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "customer-1", balance.CustomerID)
+	assert.Equal(suite.T(), 0, balance.Points)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_FirstEntry_ShouldCreateBalanceEntryAndReference() {
+	// GIVEN a customer without balance earning 100 points
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["sk"]) == "BALANCE" && aws.ToBool(input.ConsistentRead)
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		items := input.TransactItems
+		return len(items) == 3 &&
+			aws.ToString(items[0].Put.ConditionExpression) == "attribute_not_exists(sk)" &&
+			numberValue(items[0].Put.Item["points"]) == "100" &&
+			numberValue(items[0].Put.Item["version"]) == "1" &&
+			dynamodbpkg.StringValue(items[1].Put.Item["sk"]) == "ENTRY#2025-01-01T12:00:00.000000Z#entry-1" &&
+			numberValue(items[1].Put.Item["balance_after"]) == "100" &&
+			dynamodbpkg.StringValue(items[2].Put.Item["sk"]) == "REF#earn#payment-1"
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN appending the entry
+	balance, err := suite.repository.Append(&entities.LedgerEntry{
+		CustomerID: "customer-1",
+		ID:         "entry-1",
+		Type:       entities.EntryEarn,
+		Points:     100,
+		Reference:  "payment-1",
+		CreatedAt:  time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	})
+
+	// THEN the balance should be created with the entry
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 100, balance.Points)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_Debit_ShouldBeConditionedOnVersionRead() {
+	// GIVEN a balance of 100 points at version 4
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("100", "4"), nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		put := input.TransactItems[0].Put
+		return len(input.TransactItems) == 2 &&
+			aws.ToString(put.ConditionExpression) == "version = :version" &&
+			numberValue(put.ExpressionAttributeValues[":version"]) == "4" &&
+			numberValue(put.Item["version"]) == "5" &&
+			numberValue(put.Item["points"]) == "60"
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN debiting 40 points without reference
+	entry := &entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryAdjust, Points: -40, Reason: "fix"}
+	balance, err := suite.repository.Append(entry)
+
+	// THEN the balance should be written only if unchanged since it was read
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 60, balance.Points)
+	assert.Equal(suite.T(), 60, entry.BalanceAfter)
+	assert.NotEmpty(suite.T(), entry.ID)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_WithInsufficientPoints_ShouldNotWrite() {
+	// GIVEN a balance of 30 points
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("30", "2"), nil).Once()
+
+	// WHEN redeeming 40 points
+	_, err := suite.repository.Append(&entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryRedeem, Points: -40, Reference: "order-1"})
+
+	// THEN the redemption should be refused without writing
+	assert.ErrorIs(suite.T(), err, repositories.ErrInsufficientPoints)
+	suite.mockDB.AssertNotCalled(suite.T(), "TransactWriteItems", mock.Anything)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_WithConcurrentWrite_ShouldRetryWithFreshBalance() {
+	// GIVEN the balance changes between the first read and write
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("100", "1"), nil).Once()
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("50", "2"), nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceledAt(0, 3)).Once()
+	suite.mockDB.On("TransactWriteItems", mock.MatchedBy(func(input *dynamodb.TransactWriteItemsInput) bool {
+		return numberValue(input.TransactItems[0].Put.Item["points"]) == "10"
+	})).Return(&dynamodb.TransactWriteItemsOutput{}, nil).Once()
+
+	// WHEN redeeming 40 points
+	balance, err := suite.repository.Append(&entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryRedeem, Points: -40, Reference: "order-1"})
+
+	// THEN the entry should be applied to the fresh balance
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 10, balance.Points)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_WithPersistentContention_ShouldReturnConcurrentUpdate() {
+	// GIVEN the balance keeps changing
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("100", "1"), nil)
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceledAt(0, 2))
+
+	// WHEN appending an entry
+	_, err := suite.repository.Append(&entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryAdjust, Points: 10, Reason: "fix"})
+
+	// THEN it should give up after a few attempts
+	assert.ErrorIs(suite.T(), err, repositories.ErrConcurrentUpdate)
+	suite.mockDB.AssertNumberOfCalls(suite.T(), "TransactWriteItems", 3)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_WithRecordedReference_ShouldReturnDuplicateEntry() {
+	// GIVEN the payment already earned points
+	suite.mockDB.On("GetItem", mock.Anything).Return(balanceItem("100", "1"), nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, canceledAt(2, 3)).Once()
+
+	// WHEN appending the same earn again
+	_, err := suite.repository.Append(&entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryEarn, Points: 100, Reference: "payment-1"})
+
+	// THEN a duplicate should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrDuplicateEntry)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_Append_WithDatabaseError_ShouldReturnError() {
+	// GIVEN the transaction fails
+	suite.mockDB.On("GetItem", mock.Anything).Return(&dynamodb.GetItemOutput{}, nil).Once()
+	suite.mockDB.On("TransactWriteItems", mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+	// WHEN appending an entry
+	_, err := suite.repository.Append(&entities.LedgerEntry{CustomerID: "customer-1", Type: entities.EntryAdjust, Points: 10})
+
+	// THEN the error should be returned
+	assert.ErrorContains(suite.T(), err, "throttled")
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_ListEntries_ShouldQueryEntriesNewestFirst() {
+	// GIVEN a ledger with more entries than the page
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("ENTRY#2025-02-01T00:00:00.000000Z#entry-2"))
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExpressionAttributeValues[":prefix"]) == "ENTRY#" &&
+			!aws.ToBool(input.ScanIndexForward) &&
+			aws.ToInt32(input.Limit) == 1 &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["sk"]) == "ENTRY#2025-02-01T00:00:00.000000Z#entry-2"
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: "ENTRY#2025-01-01T00:00:00.000000Z#entry-1"},
+			"id":          &types.AttributeValueMemberS{Value: "entry-1"},
+			"type":        &types.AttributeValueMemberS{Value: "earn"},
+			"points":      &types.AttributeValueMemberN{Value: "100"},
+		}},
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: "ENTRY#2025-01-01T00:00:00.000000Z#entry-1"},
+		},
+	}, nil).Once()
+
+	// WHEN listing a page after the cursor
+	page, err := suite.repository.ListEntries("customer-1", 1, cursor)
+
+	// THEN the entry and the next cursor should be returned
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Entries, 1)
+	assert.Equal(suite.T(), entities.EntryEarn, page.Entries[0].Type)
+	assert.Equal(suite.T(), base64.RawURLEncoding.EncodeToString([]byte("ENTRY#2025-01-01T00:00:00.000000Z#entry-1")), page.NextCursor)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_ListEntries_WithInvalidCursor_ShouldReturnInvalidCursor() {
+	// GIVEN a cursor that does not point to an entry
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("BALANCE"))
+
+	// WHEN listing
+	_, err := suite.repository.ListEntries("customer-1", 10, cursor)
+
+	// THEN the cursor should be rejected
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_ListEntriesSince_ShouldFollowEveryPage() {
+	// GIVEN entries split over two query pages
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExpressionAttributeValues[":from"]) == "ENTRY#2025-01-01T00:00:00.000000Z" &&
+			dynamodbpkg.StringValue(input.ExpressionAttributeValues[":to"]) == "ENTRY#~" &&
+			input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"id":     &types.AttributeValueMemberS{Value: "entry-1"},
+			"type":   &types.AttributeValueMemberS{Value: "earn"},
+			"points": &types.AttributeValueMemberN{Value: "100"},
+		}},
+		LastEvaluatedKey: map[string]types.AttributeValue{"sk": &types.AttributeValueMemberS{Value: "ENTRY#2025-02-01T00:00:00.000000Z#entry-1"}},
+	}, nil).Once()
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return input.ExclusiveStartKey != nil
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"id":     &types.AttributeValueMemberS{Value: "entry-2"},
+			"type":   &types.AttributeValueMemberS{Value: "redeem"},
+			"points": &types.AttributeValueMemberN{Value: "-40"},
+		}},
+	}, nil).Once()
+
+	// WHEN listing the entries since the start of the year
+	entries, err := suite.repository.ListEntriesSince("customer-1", since)
+
+	// THEN the entries of both pages should be returned oldest first
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 2)
+	assert.Equal(suite.T(), "entry-1", entries[0].ID)
+	assert.Equal(suite.T(), -40, entries[1].Points)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_ListCustomers_ShouldScanBalancesAndReturnCursor() {
+	// GIVEN a scan page that stops before the end of the table
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("customer-0\nBALANCE"))
+	suite.mockDB.On("Scan", mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+		return dynamodbpkg.StringValue(input.ExpressionAttributeValues[":balance"]) == "BALANCE" &&
+			aws.ToInt32(input.Limit) == 2 &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["customer_id"]) == "customer-0"
+	})).Return(&dynamodb.ScanOutput{
+		Items: []map[string]types.AttributeValue{
+			{"customer_id": &types.AttributeValueMemberS{Value: "customer-1"}},
+			{"customer_id": &types.AttributeValueMemberS{Value: "customer-2"}},
+		},
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-2"},
+			"sk":          &types.AttributeValueMemberS{Value: "BALANCE"},
+		},
+	}, nil).Once()
+
+	// WHEN listing customers after the cursor
+	customerIDs, next, err := suite.repository.ListCustomers(2, cursor)
+
+	// THEN the customers and the next cursor should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"customer-1", "customer-2"}, customerIDs)
+	assert.Equal(suite.T(), base64.RawURLEncoding.EncodeToString([]byte("customer-2\nBALANCE")), next)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_ListCustomers_WithInvalidCursor_ShouldReturnInvalidCursor() {
+	// GIVEN a cursor without a sort key
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("customer-0"))
+
+	// WHEN listing customers
+	_, _, err := suite.repository.ListCustomers(10, cursor)
+
+	// THEN the cursor should be rejected
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_GetTier_WithoutTierItem_ShouldReturnEmptyTier() {
+	// GIVEN a customer never evaluated
+	suite.mockDB.On("GetItem", mock.MatchedBy(func(input *dynamodb.GetItemInput) bool {
+		return dynamodbpkg.StringValue(input.Key["sk"]) == "TIER"
+	})).Return(&dynamodb.GetItemOutput{}, nil).Once()
+
+	// WHEN reading the tier
+	tier, err := suite.repository.GetTier("customer-1")
+
+	// THEN an empty tier should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "customer-1", tier.CustomerID)
+	assert.Empty(suite.T(), tier.Tier)
+}
+
+func (suite *LoyaltyRepositoryTestSuite) Test_SaveTier_ShouldPutTierItem() {
+	// GIVEN a recalculated tier
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return dynamodbpkg.StringValue(input.Item["sk"]) == "TIER" &&
+			dynamodbpkg.StringValue(input.Item["tier"]) == "gold" &&
+			numberValue(input.Item["qualifying_points"]) == "5000"
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN saving it
+	err := suite.repository.SaveTier(&entities.CustomerTier{CustomerID: "customer-1", Tier: "gold", QualifyingPoints: 5000, EvaluatedAt: time.Now()})
+
+	// THEN the tier item should be written
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
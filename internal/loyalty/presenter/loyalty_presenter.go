@@ -0,0 +1,13 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+)
+
+type LoyaltyPresenter interface {
+	PresentBalance(balance *entities.Balance) *dto.LoyaltyBalanceResponseDto
+	PresentEntry(entry *entities.LedgerEntry) *dto.LedgerEntryResponseDto
+	PresentEntries(page *entities.LedgerPage) *dto.LedgerResponseDto
+	PresentCatalog(catalog []entities.CatalogEntry) *dto.RewardsCatalogResponseDto
+}
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+)
+
+var (
+	_ LoyaltyPresenter = (*LoyaltyPresenterImpl)(nil)
+)
+
+type LoyaltyPresenterImpl struct {
+}
+
+func NewLoyaltyPresenterImpl() *LoyaltyPresenterImpl {
+	return &LoyaltyPresenterImpl{}
+}
+
+func (p *LoyaltyPresenterImpl) PresentBalance(balance *entities.Balance) *dto.LoyaltyBalanceResponseDto {
+	return &dto.LoyaltyBalanceResponseDto{
+		CustomerID: balance.CustomerID,
+		Points:     balance.Points,
+		UpdatedAt:  balance.UpdatedAt,
+	}
+}
+
+func (p *LoyaltyPresenterImpl) PresentEntry(entry *entities.LedgerEntry) *dto.LedgerEntryResponseDto {
+	return &dto.LedgerEntryResponseDto{
+		ID:           entry.ID,
+		Type:         string(entry.Type),
+		Points:       entry.Points,
+		BalanceAfter: entry.BalanceAfter,
+		Reference:    entry.Reference,
+		Reason:       entry.Reason,
+		CreatedAt:    entry.CreatedAt,
+		ExpiresAt:    entry.ExpiresAt,
+	}
+}
+
+func (p *LoyaltyPresenterImpl) PresentEntries(page *entities.LedgerPage) *dto.LedgerResponseDto {
+	response := &dto.LedgerResponseDto{
+		Entries:    make([]dto.LedgerEntryResponseDto, 0, len(page.Entries)),
+		NextCursor: page.NextCursor,
+	}
+	for _, entry := range page.Entries {
+		response.Entries = append(response.Entries, *p.PresentEntry(entry))
+	}
+	return response
+}
+
+func (p *LoyaltyPresenterImpl) PresentCatalog(catalog []entities.CatalogEntry) *dto.RewardsCatalogResponseDto {
+	response := &dto.RewardsCatalogResponseDto{Rewards: make([]dto.RewardResponseDto, 0, len(catalog))}
+	for _, entry := range catalog {
+		response.Rewards = append(response.Rewards, dto.RewardResponseDto{
+			ID:          entry.ID,
+			Name:        entry.Name,
+			Description: entry.Description,
+			Points:      entry.Points,
+			MinTier:     entry.MinTier,
+			Eligible:    entry.Eligible,
+		})
+	}
+	return response
+}
@@ -0,0 +1,92 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/presenter"
+)
+
+type LoyaltyPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.LoyaltyPresenter
+}
+
+func (suite *LoyaltyPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewLoyaltyPresenterImpl()
+}
+
+func TestLoyaltyPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(LoyaltyPresenterTestSuite))
+}
+
+// Feature: Loyalty Presenter
+// Scenario: Balance and ledger are mapped to response DTOs
+
+func (suite *LoyaltyPresenterTestSuite) Test_PresentBalance_ShouldMapFields() {
+	// GIVEN a balance
+	updatedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	balance := &entities.Balance{CustomerID: "customer-1", Points: 120, UpdatedAt: updatedAt}
+
+	// WHEN presenting it
+	result := suite.presenter.PresentBalance(balance)
+
+	// THEN the fields should be mapped
+	assert.Equal(suite.T(), "customer-1", result.CustomerID)
+	assert.Equal(suite.T(), 120, result.Points)
+	assert.Equal(suite.T(), updatedAt, result.UpdatedAt)
+}
+
+func (suite *LoyaltyPresenterTestSuite) Test_PresentEntries_ShouldMapEntriesAndCursor() {
+	// GIVEN a page with an earn entry
+	expiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	page := &entities.LedgerPage{
+		Entries: []*entities.LedgerEntry{{
+			ID: "entry-1", Type: entities.EntryEarn, Points: 100, BalanceAfter: 100,
+			Reference: "payment-1", Reason: "order order-1", ExpiresAt: &expiresAt,
+		}},
+		NextCursor: "next",
+	}
+
+	// WHEN presenting it
+	result := suite.presenter.PresentEntries(page)
+
+	// THEN the entries should be mapped
+	assert.Equal(suite.T(), "next", result.NextCursor)
+	assert.Len(suite.T(), result.Entries, 1)
+	assert.Equal(suite.T(), "earn", result.Entries[0].Type)
+	assert.Equal(suite.T(), 100, result.Entries[0].BalanceAfter)
+	assert.Equal(suite.T(), "payment-1", result.Entries[0].Reference)
+	assert.Equal(suite.T(), &expiresAt, result.Entries[0].ExpiresAt)
+}
+
+func (suite *LoyaltyPresenterTestSuite) Test_PresentEntries_WithEmptyPage_ShouldReturnEmptyList() {
+	// GIVEN an empty page
+	// WHEN presenting it
+	result := suite.presenter.PresentEntries(&entities.LedgerPage{})
+
+	// THEN an empty, non-nil list should be returned
+	assert.NotNil(suite.T(), result.Entries)
+	assert.Empty(suite.T(), result.Entries)
+}
+
+func (suite *LoyaltyPresenterTestSuite) Test_PresentCatalog_ShouldMapRewards() {
+	// GIVEN a catalog with a tier-restricted reward
+	catalog := []entities.CatalogEntry{{
+		Reward:   entities.Reward{ID: "combo", Name: "Combo completo", Description: "Lanche e bebida", Points: 1200, MinTier: "silver"},
+		Eligible: false,
+	}}
+
+	// WHEN presenting it
+	result := suite.presenter.PresentCatalog(catalog)
+
+	// THEN the rewards should be mapped with their eligibility
+	assert.Len(suite.T(), result.Rewards, 1)
+	assert.Equal(suite.T(), "combo", result.Rewards[0].ID)
+	assert.Equal(suite.T(), 1200, result.Rewards[0].Points)
+	assert.Equal(suite.T(), "silver", result.Rewards[0].MinTier)
+	assert.False(suite.T(), result.Rewards[0].Eligible)
+}
@@ -0,0 +1,10 @@
+package adjustpoints
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type AdjustPointsUseCase interface {
+	Execute(command *commands.AdjustPointsCommand) (*entities.LedgerEntry, error)
+}
@@ -0,0 +1,43 @@
+package adjustpoints
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ AdjustPointsUseCase = (*AdjustPointsUseCaseImpl)(nil)
+
+	ErrInvalidAdjustment = errors.New("an adjustment needs non-zero points and a reason")
+)
+
+type AdjustPointsUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+}
+
+func NewAdjustPointsUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository) *AdjustPointsUseCaseImpl {
+	return &AdjustPointsUseCaseImpl{loyaltyRepository: loyaltyRepository}
+}
+
+// Execute credits or debits points by hand, e.g. to compensate a customer. Adjusted points do
+// not expire, and a debit still cannot take the balance below zero.
+func (u *AdjustPointsUseCaseImpl) Execute(command *commands.AdjustPointsCommand) (*entities.LedgerEntry, error) {
+	if command.Points == 0 || command.Reason == "" {
+		return nil, ErrInvalidAdjustment
+	}
+
+	entry := &entities.LedgerEntry{
+		CustomerID: command.CustomerID,
+		Type:       entities.EntryAdjust,
+		Points:     command.Points,
+		Reason:     command.Reason,
+	}
+
+	if _, err := u.loyaltyRepository.Append(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,57 @@
+package adjustpoints_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/adjustpoints"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type AdjustPointsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockLoyaltyRepository
+	useCase        adjustpoints.AdjustPointsUseCase
+}
+
+func (suite *AdjustPointsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.useCase = adjustpoints.NewAdjustPointsUseCaseImpl(suite.mockRepository)
+}
+
+func TestAdjustPointsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AdjustPointsUseCaseTestSuite))
+}
+
+// Feature: Adjust Points Use Case
+// Scenario: Staff corrects a balance by hand
+
+func (suite *AdjustPointsUseCaseTestSuite) Test_AdjustPoints_ShouldAppendNonExpiringEntry() {
+	// GIVEN a compensation of 50 points
+	suite.mockRepository.EXPECT().
+		Append(mock.MatchedBy(func(entry *entities.LedgerEntry) bool {
+			return entry.Type == entities.EntryAdjust && entry.Points == 50 && entry.Reason == "late order" && entry.ExpiresAt == nil
+		})).
+		Return(&entities.Balance{Points: 50}, nil).
+		Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewAdjustPointsCommand("customer-1", 50, "late order"))
+
+	// THEN the adjustment should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 50, entry.Points)
+}
+
+func (suite *AdjustPointsUseCaseTestSuite) Test_AdjustPoints_WithoutReason_ShouldReturnInvalidAdjustment() {
+	// GIVEN an adjustment without reason
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewAdjustPointsCommand("customer-1", 50, ""))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, adjustpoints.ErrInvalidAdjustment)
+}
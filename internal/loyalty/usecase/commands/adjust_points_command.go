@@ -0,0 +1,15 @@
+package commands
+
+type AdjustPointsCommand struct {
+	CustomerID string
+	Points     int
+	Reason     string
+}
+
+func NewAdjustPointsCommand(customerID string, points int, reason string) *AdjustPointsCommand {
+	return &AdjustPointsCommand{
+		CustomerID: customerID,
+		Points:     points,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,21 @@
+package commands
+
+import "time"
+
+type EarnPointsCommand struct {
+	CustomerID  string
+	PaymentID   string
+	OrderID     string
+	Amount      float64
+	ConfirmedAt time.Time
+}
+
+func NewEarnPointsCommand(customerID string, paymentID string, orderID string, amount float64, confirmedAt time.Time) *EarnPointsCommand {
+	return &EarnPointsCommand{
+		CustomerID:  customerID,
+		PaymentID:   paymentID,
+		OrderID:     orderID,
+		Amount:      amount,
+		ConfirmedAt: confirmedAt,
+	}
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "time"
+
+type ExpirePointsCommand struct {
+	CustomerID string
+	Now        time.Time
+}
+
+func NewExpirePointsCommand(customerID string, now time.Time) *ExpirePointsCommand {
+	return &ExpirePointsCommand{
+		CustomerID: customerID,
+		Now:        now,
+	}
+}
@@ -0,0 +1,15 @@
+package commands
+
+type ListLedgerEntriesCommand struct {
+	CustomerID string
+	Limit      int
+	Cursor     string
+}
+
+func NewListLedgerEntriesCommand(customerID string, limit int, cursor string) *ListLedgerEntriesCommand {
+	return &ListLedgerEntriesCommand{
+		CustomerID: customerID,
+		Limit:      limit,
+		Cursor:     cursor,
+	}
+}
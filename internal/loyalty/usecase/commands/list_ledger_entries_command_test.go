@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+func TestNewListLedgerEntriesCommand(t *testing.T) {
+	// GIVEN a customer ID and a page request
+	// WHEN creating a new ListLedgerEntriesCommand
+	command := commands.NewListLedgerEntriesCommand("customer-1", 20, "cursor")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, 20, command.Limit)
+	assert.Equal(t, "cursor", command.Cursor)
+}
@@ -0,0 +1,11 @@
+package commands
+
+type ListRewardsCommand struct {
+	CustomerID string
+}
+
+func NewListRewardsCommand(customerID string) *ListRewardsCommand {
+	return &ListRewardsCommand{
+		CustomerID: customerID,
+	}
+}
@@ -0,0 +1,15 @@
+package commands
+
+import "time"
+
+type RecalculateTierCommand struct {
+	CustomerID string
+	Now        time.Time
+}
+
+func NewRecalculateTierCommand(customerID string, now time.Time) *RecalculateTierCommand {
+	return &RecalculateTierCommand{
+		CustomerID: customerID,
+		Now:        now,
+	}
+}
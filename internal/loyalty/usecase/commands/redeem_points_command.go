@@ -0,0 +1,17 @@
+package commands
+
+type RedeemPointsCommand struct {
+	CustomerID string
+	Points     int
+	Reference  string
+	Reason     string
+}
+
+func NewRedeemPointsCommand(customerID string, points int, reference string, reason string) *RedeemPointsCommand {
+	return &RedeemPointsCommand{
+		CustomerID: customerID,
+		Points:     points,
+		Reference:  reference,
+		Reason:     reason,
+	}
+}
@@ -0,0 +1,21 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+func TestNewRedeemPointsCommand(t *testing.T) {
+	// GIVEN a customer, the points to redeem and the order paying with them
+	// WHEN creating a new RedeemPointsCommand
+	command := commands.NewRedeemPointsCommand("customer-1", 150, "order-1", "checkout")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, 150, command.Points)
+	assert.Equal(t, "order-1", command.Reference)
+	assert.Equal(t, "checkout", command.Reason)
+}
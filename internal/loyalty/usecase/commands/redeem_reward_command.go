@@ -0,0 +1,15 @@
+package commands
+
+type RedeemRewardCommand struct {
+	CustomerID string
+	RewardID   string
+	Reference  string
+}
+
+func NewRedeemRewardCommand(customerID string, rewardID string, reference string) *RedeemRewardCommand {
+	return &RedeemRewardCommand{
+		CustomerID: customerID,
+		RewardID:   rewardID,
+		Reference:  reference,
+	}
+}
@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+func TestNewRedeemRewardCommand(t *testing.T) {
+	// GIVEN a customer, a catalog reward and the order it is added to
+	// WHEN creating a new RedeemRewardCommand
+	command := commands.NewRedeemRewardCommand("customer-1", "dessert", "order-1")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, "dessert", command.RewardID)
+	assert.Equal(t, "order-1", command.Reference)
+}
@@ -0,0 +1,9 @@
+package earnpoints
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type EarnPointsUseCase interface {
+	Execute(command *commands.EarnPointsCommand) error
+}
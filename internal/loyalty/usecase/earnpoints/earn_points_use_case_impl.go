@@ -0,0 +1,56 @@
+package earnpoints
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ EarnPointsUseCase = (*EarnPointsUseCaseImpl)(nil)
+
+	ErrInvalidPayment = errors.New("invalid payment")
+)
+
+type EarnPointsUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+	rules             EarningRules
+}
+
+func NewEarnPointsUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository, rules EarningRules) *EarnPointsUseCaseImpl {
+	return &EarnPointsUseCaseImpl{loyaltyRepository: loyaltyRepository, rules: rules}
+}
+
+// Execute credits the points of a confirmed payment. Payment events are delivered at least
+// once, so a payment that already earned points succeeds without changes.
+func (u *EarnPointsUseCaseImpl) Execute(command *commands.EarnPointsCommand) error {
+	if command.CustomerID == "" || command.PaymentID == "" || command.ConfirmedAt.IsZero() {
+		return ErrInvalidPayment
+	}
+
+	points := u.rules.Points(command.Amount)
+	if points == 0 {
+		return nil
+	}
+
+	expiresAt := command.ConfirmedAt.Add(u.rules.Validity)
+	entry := &entities.LedgerEntry{
+		CustomerID: command.CustomerID,
+		Type:       entities.EntryEarn,
+		Points:     points,
+		Reference:  command.PaymentID,
+		ExpiresAt:  &expiresAt,
+	}
+	if command.OrderID != "" {
+		entry.Reason = fmt.Sprintf("order %s", command.OrderID)
+	}
+
+	_, err := u.loyaltyRepository.Append(entry)
+	if errors.Is(err, repositories.ErrDuplicateEntry) {
+		return nil
+	}
+	return err
+}
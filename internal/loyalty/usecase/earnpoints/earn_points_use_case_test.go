@@ -0,0 +1,82 @@
+package earnpoints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type EarnPointsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockLoyaltyRepository
+	useCase        earnpoints.EarnPointsUseCase
+	confirmedAt    time.Time
+}
+
+func (suite *EarnPointsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.useCase = earnpoints.NewEarnPointsUseCaseImpl(suite.mockRepository, earnpoints.EarningRules{PointsPerUnit: 2, Validity: 24 * time.Hour})
+	suite.confirmedAt = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func TestEarnPointsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(EarnPointsUseCaseTestSuite))
+}
+
+// Feature: Earn Points Use Case
+// Scenario: Confirmed payments credit points once
+
+func (suite *EarnPointsUseCaseTestSuite) Test_EarnPoints_ShouldCreditPointsReferencingPayment() {
+	// GIVEN a confirmed payment of 45.90 at two points per unit
+	suite.mockRepository.EXPECT().
+		Append(mock.MatchedBy(func(entry *entities.LedgerEntry) bool {
+			return entry.CustomerID == "customer-1" && entry.Type == entities.EntryEarn && entry.Points == 91 &&
+				entry.Reference == "payment-1" && entry.Reason == "order order-1" &&
+				entry.ExpiresAt.Equal(suite.confirmedAt.Add(24*time.Hour))
+		})).
+		Return(&entities.Balance{Points: 91}, nil).
+		Once()
+
+	// WHEN executing the use case
+	err := suite.useCase.Execute(commands.NewEarnPointsCommand("customer-1", "payment-1", "order-1", 45.9, suite.confirmedAt))
+
+	// THEN the points should be credited with their expiry
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *EarnPointsUseCaseTestSuite) Test_EarnPoints_WithRedeliveredPayment_ShouldSucceed() {
+	// GIVEN the payment already earned points
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil, repositories.ErrDuplicateEntry).Once()
+
+	// WHEN executing the use case again
+	err := suite.useCase.Execute(commands.NewEarnPointsCommand("customer-1", "payment-1", "", 10, suite.confirmedAt))
+
+	// THEN it should succeed without crediting twice
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *EarnPointsUseCaseTestSuite) Test_EarnPoints_WithAmountBelowOnePoint_ShouldNotWrite() {
+	// GIVEN a payment too small to earn a point
+	// WHEN executing the use case
+	err := suite.useCase.Execute(commands.NewEarnPointsCommand("customer-1", "payment-1", "", 0.4, suite.confirmedAt))
+
+	// THEN nothing should be written
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *EarnPointsUseCaseTestSuite) Test_EarnPoints_WithoutPaymentID_ShouldReturnInvalidPayment() {
+	// GIVEN a payment without ID
+	// WHEN executing the use case
+	err := suite.useCase.Execute(commands.NewEarnPointsCommand("customer-1", "", "", 10, suite.confirmedAt))
+
+	// THEN the payment should be rejected
+	assert.ErrorIs(suite.T(), err, earnpoints.ErrInvalidPayment)
+}
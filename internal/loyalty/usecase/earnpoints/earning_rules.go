@@ -0,0 +1,52 @@
+package earnpoints
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultPointsPerUnit = 1
+	DefaultValidity      = 365 * 24 * time.Hour
+)
+
+// EarningRules turn a confirmed payment into points: PointsPerUnit points for each unit of
+// currency paid, rounded down, valid for Validity after the payment was confirmed.
+type EarningRules struct {
+	PointsPerUnit float64
+	Validity      time.Duration
+}
+
+// EarningRulesFromEnv reads LOYALTY_POINTS_PER_UNIT and LOYALTY_POINTS_VALIDITY, falling back to
+// one point per unit valid for a year.
+func EarningRulesFromEnv() (EarningRules, error) {
+	rules := EarningRules{PointsPerUnit: DefaultPointsPerUnit, Validity: DefaultValidity}
+
+	if value := os.Getenv("LOYALTY_POINTS_PER_UNIT"); value != "" {
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate < 0 {
+			return EarningRules{}, fmt.Errorf("invalid LOYALTY_POINTS_PER_UNIT: %q", value)
+		}
+		rules.PointsPerUnit = rate
+	}
+	if value := os.Getenv("LOYALTY_POINTS_VALIDITY"); value != "" {
+		validity, err := time.ParseDuration(value)
+		if err != nil || validity <= 0 {
+			return EarningRules{}, fmt.Errorf("invalid LOYALTY_POINTS_VALIDITY: %q", value)
+		}
+		rules.Validity = validity
+	}
+
+	return rules, nil
+}
+
+// Points returns the points earned for a payment of amount.
+func (r EarningRules) Points(amount float64) int {
+	if amount <= 0 {
+		return 0
+	}
+	// The epsilon keeps amounts such as 0.1*3 from rounding down to the wrong integer.
+	return int(amount*r.PointsPerUnit + 1e-9)
+}
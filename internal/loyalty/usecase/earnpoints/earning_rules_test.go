@@ -0,0 +1,59 @@
+package earnpoints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/earnpoints"
+)
+
+func TestEarningRulesFromEnv_WithoutVariables_ShouldUseDefaults(t *testing.T) {
+	// GIVEN no loyalty configuration
+	t.Setenv("LOYALTY_POINTS_PER_UNIT", "")
+	t.Setenv("LOYALTY_POINTS_VALIDITY", "")
+
+	// WHEN reading the rules
+	rules, err := earnpoints.EarningRulesFromEnv()
+
+	// THEN one point per unit valid for a year should be used
+	assert.NoError(t, err)
+	assert.Equal(t, float64(earnpoints.DefaultPointsPerUnit), rules.PointsPerUnit)
+	assert.Equal(t, earnpoints.DefaultValidity, rules.Validity)
+}
+
+func TestEarningRulesFromEnv_WithVariables_ShouldOverrideDefaults(t *testing.T) {
+	// GIVEN a custom rate and validity
+	t.Setenv("LOYALTY_POINTS_PER_UNIT", "1.5")
+	t.Setenv("LOYALTY_POINTS_VALIDITY", "720h")
+
+	// WHEN reading the rules
+	rules, err := earnpoints.EarningRulesFromEnv()
+
+	// THEN they should be used
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, rules.PointsPerUnit)
+	assert.Equal(t, 720*time.Hour, rules.Validity)
+}
+
+func TestEarningRulesFromEnv_WithInvalidValidity_ShouldFail(t *testing.T) {
+	// GIVEN a validity that is not a duration
+	t.Setenv("LOYALTY_POINTS_VALIDITY", "one year")
+
+	// WHEN reading the rules
+	_, err := earnpoints.EarningRulesFromEnv()
+
+	// THEN an error should be returned
+	assert.Error(t, err)
+}
+
+func TestEarningRules_Points_ShouldRoundDown(t *testing.T) {
+	// GIVEN one point per unit
+	rules := earnpoints.EarningRules{PointsPerUnit: 1}
+
+	// WHEN converting amounts
+	// THEN fractions should be dropped
+	assert.Equal(t, 45, rules.Points(45.9))
+	assert.Equal(t, 0, rules.Points(-10))
+	assert.Equal(t, 3, earnpoints.EarningRules{PointsPerUnit: 10}.Points(0.3))
+}
@@ -0,0 +1,10 @@
+package expirepoints
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type ExpirePointsUseCase interface {
+	Execute(command *commands.ExpirePointsCommand) (*entities.Balance, error)
+}
@@ -0,0 +1,79 @@
+package expirepoints
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ ExpirePointsUseCase = (*ExpirePointsUseCaseImpl)(nil)
+)
+
+// ledgerPageSize is the page size used to walk the whole ledger of a customer.
+const ledgerPageSize = 100
+
+type ExpirePointsUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+}
+
+func NewExpirePointsUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository) *ExpirePointsUseCaseImpl {
+	return &ExpirePointsUseCaseImpl{loyaltyRepository: loyaltyRepository}
+}
+
+// Execute writes an expire entry for the earned points that are past their expiry and were not
+// spent yet, then returns the balance. Debits consume the oldest earned points first, so the
+// expired points still available are those earned and expired minus everything debited so far.
+// Expiry runs lazily, whenever a balance is read or points are redeemed.
+func (u *ExpirePointsUseCaseImpl) Execute(command *commands.ExpirePointsCommand) (*entities.Balance, error) {
+	balance, err := u.loyaltyRepository.GetBalance(command.CustomerID)
+	if err != nil || balance.Points == 0 {
+		return balance, err
+	}
+
+	expired, debited := 0, 0
+	var through *entities.LedgerEntry
+	cursor := ""
+	for {
+		page, err := u.loyaltyRepository.ListEntries(command.CustomerID, ledgerPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range page.Entries {
+			switch {
+			case entry.Points < 0:
+				debited -= entry.Points
+			case entry.Type == entities.EntryEarn && entry.ExpiresAt != nil && !entry.ExpiresAt.After(command.Now):
+				expired += entry.Points
+				if through == nil || entry.CreatedAt.After(through.CreatedAt) {
+					through = entry
+				}
+			}
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	points := min(expired-debited, balance.Points)
+	if points <= 0 {
+		return balance, nil
+	}
+
+	// The reference is the newest expired earn, so concurrent runs that computed the same expiry
+	// write it once; a later expiry always covers a newer earn.
+	updated, err := u.loyaltyRepository.Append(&entities.LedgerEntry{
+		CustomerID: command.CustomerID,
+		Type:       entities.EntryExpire,
+		Points:     -points,
+		Reference:  through.ID,
+		Reason:     "points expired",
+	})
+	if errors.Is(err, repositories.ErrDuplicateEntry) || errors.Is(err, repositories.ErrInsufficientPoints) {
+		return u.loyaltyRepository.GetBalance(command.CustomerID)
+	}
+	return updated, err
+}
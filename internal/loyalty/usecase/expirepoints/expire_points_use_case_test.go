@@ -0,0 +1,120 @@
+package expirepoints_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/expirepoints"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type ExpirePointsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockLoyaltyRepository
+	useCase        expirepoints.ExpirePointsUseCase
+	now            time.Time
+}
+
+func (suite *ExpirePointsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.useCase = expirepoints.NewExpirePointsUseCaseImpl(suite.mockRepository)
+	suite.now = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestExpirePointsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ExpirePointsUseCaseTestSuite))
+}
+
+func (suite *ExpirePointsUseCaseTestSuite) earn(id string, points int, createdAt time.Time, expiresAt time.Time) *entities.LedgerEntry {
+	return &entities.LedgerEntry{ID: id, Type: entities.EntryEarn, Points: points, CreatedAt: createdAt, ExpiresAt: &expiresAt}
+}
+
+// Feature: Expire Points Use Case
+// Scenario: Earned points past their validity are written off, oldest first
+
+func (suite *ExpirePointsUseCaseTestSuite) Test_ExpirePoints_ShouldExpireUnspentExpiredPoints() {
+	// GIVEN 100 expired points, 30 of them already redeemed, and 50 points still valid
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockRepository.EXPECT().GetBalance("customer-1").Return(&entities.Balance{CustomerID: "customer-1", Points: 120}, nil).Once()
+	suite.mockRepository.EXPECT().ListEntries("customer-1", mock.Anything, "").Return(&entities.LedgerPage{
+		Entries: []*entities.LedgerEntry{
+			suite.earn("earn-2", 50, jan.AddDate(0, 3, 0), suite.now.AddDate(0, 1, 0)),
+			{ID: "redeem-1", Type: entities.EntryRedeem, Points: -30, CreatedAt: jan.AddDate(0, 1, 0)},
+		},
+		NextCursor: "next",
+	}, nil).Once()
+	suite.mockRepository.EXPECT().ListEntries("customer-1", mock.Anything, "next").Return(&entities.LedgerPage{
+		Entries: []*entities.LedgerEntry{suite.earn("earn-1", 100, jan, suite.now.Add(-time.Hour))},
+	}, nil).Once()
+	suite.mockRepository.EXPECT().
+		Append(mock.MatchedBy(func(entry *entities.LedgerEntry) bool {
+			return entry.Type == entities.EntryExpire && entry.Points == -70 && entry.Reference == "earn-1"
+		})).
+		Return(&entities.Balance{CustomerID: "customer-1", Points: 50}, nil).
+		Once()
+
+	// WHEN expiring points
+	balance, err := suite.useCase.Execute(commands.NewExpirePointsCommand("customer-1", suite.now))
+
+	// THEN the 70 unspent expired points should be written off
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 50, balance.Points)
+}
+
+func (suite *ExpirePointsUseCaseTestSuite) Test_ExpirePoints_WithExpiredPointsAlreadySpent_ShouldNotWrite() {
+	// GIVEN expired points that were fully redeemed before expiring
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	balance := &entities.Balance{CustomerID: "customer-1", Points: 50}
+	suite.mockRepository.EXPECT().GetBalance("customer-1").Return(balance, nil).Once()
+	suite.mockRepository.EXPECT().ListEntries("customer-1", mock.Anything, "").Return(&entities.LedgerPage{
+		Entries: []*entities.LedgerEntry{
+			suite.earn("earn-2", 50, jan.AddDate(0, 3, 0), suite.now.AddDate(0, 1, 0)),
+			{ID: "redeem-1", Type: entities.EntryRedeem, Points: -100, CreatedAt: jan.AddDate(0, 1, 0)},
+			suite.earn("earn-1", 100, jan, suite.now.Add(-time.Hour)),
+		},
+	}, nil).Once()
+
+	// WHEN expiring points
+	result, err := suite.useCase.Execute(commands.NewExpirePointsCommand("customer-1", suite.now))
+
+	// THEN the balance should be returned unchanged
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), balance, result)
+}
+
+func (suite *ExpirePointsUseCaseTestSuite) Test_ExpirePoints_WithEmptyBalance_ShouldNotReadLedger() {
+	// GIVEN a customer without points
+	balance := &entities.Balance{CustomerID: "customer-1"}
+	suite.mockRepository.EXPECT().GetBalance("customer-1").Return(balance, nil).Once()
+
+	// WHEN expiring points
+	result, err := suite.useCase.Execute(commands.NewExpirePointsCommand("customer-1", suite.now))
+
+	// THEN the balance should be returned as is
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), balance, result)
+}
+
+func (suite *ExpirePointsUseCaseTestSuite) Test_ExpirePoints_WithConcurrentExpiry_ShouldReturnCurrentBalance() {
+	// GIVEN another request expired the same points first
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.mockRepository.EXPECT().GetBalance("customer-1").Return(&entities.Balance{Points: 100}, nil).Once()
+	suite.mockRepository.EXPECT().ListEntries("customer-1", mock.Anything, "").Return(&entities.LedgerPage{
+		Entries: []*entities.LedgerEntry{suite.earn("earn-1", 100, jan, suite.now.Add(-time.Hour))},
+	}, nil).Once()
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil, repositories.ErrDuplicateEntry).Once()
+	suite.mockRepository.EXPECT().GetBalance("customer-1").Return(&entities.Balance{Points: 0}, nil).Once()
+
+	// WHEN expiring points
+	balance, err := suite.useCase.Execute(commands.NewExpirePointsCommand("customer-1", suite.now))
+
+	// THEN the balance after the other expiry should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, balance.Points)
+}
@@ -0,0 +1,10 @@
+package listentries
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type ListEntriesUseCase interface {
+	Execute(command *commands.ListLedgerEntriesCommand) (*entities.LedgerPage, error)
+}
@@ -0,0 +1,36 @@
+package listentries
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ ListEntriesUseCase = (*ListEntriesUseCaseImpl)(nil)
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+type ListEntriesUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+}
+
+func NewListEntriesUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository) *ListEntriesUseCaseImpl {
+	return &ListEntriesUseCaseImpl{loyaltyRepository: loyaltyRepository}
+}
+
+func (u *ListEntriesUseCaseImpl) Execute(command *commands.ListLedgerEntriesCommand) (*entities.LedgerPage, error) {
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return u.loyaltyRepository.ListEntries(command.CustomerID, limit, command.Cursor)
+}
@@ -0,0 +1,54 @@
+package listentries_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listentries"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type ListEntriesUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockLoyaltyRepository
+	useCase        listentries.ListEntriesUseCase
+}
+
+func (suite *ListEntriesUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.useCase = listentries.NewListEntriesUseCaseImpl(suite.mockRepository)
+}
+
+func TestListEntriesUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListEntriesUseCaseTestSuite))
+}
+
+// Feature: List Ledger Entries Use Case
+// Scenario: Page sizes are bounded
+
+func (suite *ListEntriesUseCaseTestSuite) Test_ListEntries_WithoutLimit_ShouldUseDefaultPageSize() {
+	// GIVEN no page size
+	page := &entities.LedgerPage{}
+	suite.mockRepository.EXPECT().ListEntries("customer-1", listentries.DefaultPageSize, "").Return(page, nil).Once()
+
+	// WHEN listing
+	result, err := suite.useCase.Execute(commands.NewListLedgerEntriesCommand("customer-1", 0, ""))
+
+	// THEN the default page size should be used
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), page, result)
+}
+
+func (suite *ListEntriesUseCaseTestSuite) Test_ListEntries_WithLargeLimit_ShouldCapPageSize() {
+	// GIVEN a page size above the maximum
+	suite.mockRepository.EXPECT().ListEntries("customer-1", listentries.MaxPageSize, "cursor").Return(&entities.LedgerPage{}, nil).Once()
+
+	// WHEN listing
+	_, err := suite.useCase.Execute(commands.NewListLedgerEntriesCommand("customer-1", 1000, "cursor"))
+
+	// THEN the page size should be capped
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,10 @@
+package listrewards
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type ListRewardsUseCase interface {
+	Execute(command *commands.ListRewardsCommand) ([]entities.CatalogEntry, error)
+}
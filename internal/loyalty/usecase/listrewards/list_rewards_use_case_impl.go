@@ -0,0 +1,40 @@
+package listrewards
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ ListRewardsUseCase = (*ListRewardsUseCaseImpl)(nil)
+)
+
+type ListRewardsUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+	rulesRepository   repositories.LoyaltyRulesRepository
+}
+
+func NewListRewardsUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository, rulesRepository repositories.LoyaltyRulesRepository) *ListRewardsUseCaseImpl {
+	return &ListRewardsUseCaseImpl{loyaltyRepository: loyaltyRepository, rulesRepository: rulesRepository}
+}
+
+// Execute returns the whole catalog, flagging the rewards the customer tier gives access to.
+func (u *ListRewardsUseCaseImpl) Execute(command *commands.ListRewardsCommand) ([]entities.CatalogEntry, error) {
+	rules := u.rulesRepository.Get()
+
+	tier, err := u.loyaltyRepository.GetTier(command.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make([]entities.CatalogEntry, 0, len(rules.Rewards))
+	for _, reward := range rules.Rewards {
+		catalog = append(catalog, entities.CatalogEntry{Reward: reward, Eligible: eligible(rules, tier.Tier, reward)})
+	}
+	return catalog, nil
+}
+
+func eligible(rules *entities.LoyaltyRules, tier string, reward entities.Reward) bool {
+	return reward.MinTier == "" || rules.Rank(tier) >= rules.Rank(reward.MinTier)
+}
@@ -0,0 +1,83 @@
+package listrewards_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/listrewards"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type ListRewardsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository      *mockRepositories.MockLoyaltyRepository
+	mockRulesRepository *mockRepositories.MockLoyaltyRulesRepository
+	useCase             listrewards.ListRewardsUseCase
+}
+
+func (suite *ListRewardsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.mockRulesRepository = mockRepositories.NewMockLoyaltyRulesRepository(suite.T())
+	suite.mockRulesRepository.EXPECT().Get().Return(&entities.LoyaltyRules{
+		Tiers: []entities.Tier{{Name: "bronze"}, {Name: "silver", MinPoints: 1000}, {Name: "gold", MinPoints: 5000}},
+		Rewards: []entities.Reward{
+			{ID: "dessert", Points: 300},
+			{ID: "combo", Points: 1200, MinTier: "silver"},
+			{ID: "lounge", Points: 2000, MinTier: "gold"},
+		},
+	}).Maybe()
+	suite.useCase = listrewards.NewListRewardsUseCaseImpl(suite.mockRepository, suite.mockRulesRepository)
+}
+
+func TestListRewardsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListRewardsUseCaseTestSuite))
+}
+
+// Feature: List Rewards Use Case
+// Scenario: Customers see which rewards their tier gives access to
+
+func (suite *ListRewardsUseCaseTestSuite) Test_ListRewards_ShouldFlagEligibleRewards() {
+	// GIVEN a silver customer
+	suite.mockRepository.EXPECT().GetTier("customer-1").Return(&entities.CustomerTier{CustomerID: "customer-1", Tier: "silver"}, nil).Once()
+
+	// WHEN executing the use case
+	catalog, err := suite.useCase.Execute(commands.NewListRewardsCommand("customer-1"))
+
+	// THEN rewards up to silver should be eligible
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), catalog, 3)
+	assert.True(suite.T(), catalog[0].Eligible)
+	assert.True(suite.T(), catalog[1].Eligible)
+	assert.False(suite.T(), catalog[2].Eligible)
+}
+
+func (suite *ListRewardsUseCaseTestSuite) Test_ListRewards_WithoutTier_ShouldOnlyFlagOpenRewards() {
+	// GIVEN a customer never evaluated
+	suite.mockRepository.EXPECT().GetTier("customer-1").Return(&entities.CustomerTier{CustomerID: "customer-1"}, nil).Once()
+
+	// WHEN executing the use case
+	catalog, err := suite.useCase.Execute(commands.NewListRewardsCommand("customer-1"))
+
+	// THEN only rewards without a minimum tier should be eligible
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), catalog[0].Eligible)
+	assert.False(suite.T(), catalog[1].Eligible)
+	assert.False(suite.T(), catalog[2].Eligible)
+}
+
+func (suite *ListRewardsUseCaseTestSuite) Test_ListRewards_WithRepositoryError_ShouldReturnError() {
+	// GIVEN the tier cannot be read
+	expectedError := errors.New("get failed")
+	suite.mockRepository.EXPECT().GetTier("customer-1").Return(nil, expectedError).Once()
+
+	// WHEN executing the use case
+	catalog, err := suite.useCase.Execute(commands.NewListRewardsCommand("customer-1"))
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), catalog)
+	assert.Equal(suite.T(), expectedError, err)
+}
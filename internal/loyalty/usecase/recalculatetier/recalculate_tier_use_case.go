@@ -0,0 +1,10 @@
+package recalculatetier
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type RecalculateTierUseCase interface {
+	Execute(command *commands.RecalculateTierCommand) (*entities.CustomerTier, error)
+}
@@ -0,0 +1,58 @@
+package recalculatetier
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ RecalculateTierUseCase = (*RecalculateTierUseCaseImpl)(nil)
+)
+
+type RecalculateTierUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+	rulesRepository   repositories.LoyaltyRulesRepository
+}
+
+func NewRecalculateTierUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository, rulesRepository repositories.LoyaltyRulesRepository) *RecalculateTierUseCaseImpl {
+	return &RecalculateTierUseCaseImpl{loyaltyRepository: loyaltyRepository, rulesRepository: rulesRepository}
+}
+
+// Execute assigns the highest tier whose threshold the customer earned within its window. Only
+// earned points qualify: redeeming does not demote a customer and adjustments do not promote one.
+func (u *RecalculateTierUseCaseImpl) Execute(command *commands.RecalculateTierCommand) (*entities.CustomerTier, error) {
+	rules := u.rulesRepository.Get()
+
+	entries, err := u.loyaltyRepository.ListEntriesSince(command.CustomerID, command.Now.Add(-rules.LongestWindow()))
+	if err != nil {
+		return nil, err
+	}
+
+	tier := &entities.CustomerTier{CustomerID: command.CustomerID, EvaluatedAt: command.Now}
+	for i := len(rules.Tiers) - 1; i >= 0; i-- {
+		earned := earnedSince(entries, command.Now.Add(-rules.Tiers[i].Window))
+		if earned >= rules.Tiers[i].MinPoints {
+			tier.Tier = rules.Tiers[i].Name
+			tier.QualifyingPoints = earned
+			break
+		}
+	}
+
+	if err := u.loyaltyRepository.SaveTier(tier); err != nil {
+		return nil, err
+	}
+	return tier, nil
+}
+
+func earnedSince(entries []*entities.LedgerEntry, since time.Time) int {
+	earned := 0
+	for _, entry := range entries {
+		if entry.Type == entities.EntryEarn && !entry.CreatedAt.Before(since) {
+			earned += entry.Points
+		}
+	}
+	return earned
+}
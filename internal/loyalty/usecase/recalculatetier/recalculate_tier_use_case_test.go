@@ -0,0 +1,110 @@
+package recalculatetier_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/recalculatetier"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+var now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type RecalculateTierUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository      *mockRepositories.MockLoyaltyRepository
+	mockRulesRepository *mockRepositories.MockLoyaltyRulesRepository
+	useCase             recalculatetier.RecalculateTierUseCase
+}
+
+func (suite *RecalculateTierUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.mockRulesRepository = mockRepositories.NewMockLoyaltyRulesRepository(suite.T())
+	suite.mockRulesRepository.EXPECT().Get().Return(&entities.LoyaltyRules{
+		Tiers: []entities.Tier{
+			{Name: "bronze", MinPoints: 0, Window: 365 * 24 * time.Hour},
+			{Name: "silver", MinPoints: 1000, Window: 365 * 24 * time.Hour},
+			{Name: "gold", MinPoints: 5000, Window: 90 * 24 * time.Hour},
+		},
+	}).Maybe()
+	suite.useCase = recalculatetier.NewRecalculateTierUseCaseImpl(suite.mockRepository, suite.mockRulesRepository)
+}
+
+func TestRecalculateTierUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RecalculateTierUseCaseTestSuite))
+}
+
+// Feature: Recalculate Tier Use Case
+// Scenario: Tiers follow the points earned within each tier window
+
+func (suite *RecalculateTierUseCaseTestSuite) Test_RecalculateTier_ShouldAssignHighestReachedTier() {
+	// GIVEN 6000 points earned, of which only 2000 within the gold window
+	entries := []*entities.LedgerEntry{
+		{Type: entities.EntryEarn, Points: 4000, CreatedAt: now.AddDate(0, -6, 0)},
+		{Type: entities.EntryEarn, Points: 2000, CreatedAt: now.AddDate(0, 0, -10)},
+		{Type: entities.EntryRedeem, Points: -1500, CreatedAt: now.AddDate(0, 0, -5)},
+	}
+	suite.mockRepository.EXPECT().ListEntriesSince("customer-1", now.Add(-365*24*time.Hour)).Return(entries, nil).Once()
+	suite.mockRepository.EXPECT().
+		SaveTier(mock.MatchedBy(func(tier *entities.CustomerTier) bool {
+			return tier.CustomerID == "customer-1" && tier.Tier == "silver" && tier.QualifyingPoints == 6000 && tier.EvaluatedAt.Equal(now)
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN executing the use case
+	tier, err := suite.useCase.Execute(commands.NewRecalculateTierCommand("customer-1", now))
+
+	// THEN the customer should be silver, redemptions not counting against the tier
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "silver", tier.Tier)
+	assert.Equal(suite.T(), 6000, tier.QualifyingPoints)
+}
+
+func (suite *RecalculateTierUseCaseTestSuite) Test_RecalculateTier_WithinGoldWindow_ShouldAssignGold() {
+	// GIVEN 5000 points earned last month
+	entries := []*entities.LedgerEntry{{Type: entities.EntryEarn, Points: 5000, CreatedAt: now.AddDate(0, -1, 0)}}
+	suite.mockRepository.EXPECT().ListEntriesSince("customer-1", mock.Anything).Return(entries, nil).Once()
+	suite.mockRepository.EXPECT().SaveTier(mock.Anything).Return(nil).Once()
+
+	// WHEN executing the use case
+	tier, err := suite.useCase.Execute(commands.NewRecalculateTierCommand("customer-1", now))
+
+	// THEN the customer should be gold
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "gold", tier.Tier)
+}
+
+func (suite *RecalculateTierUseCaseTestSuite) Test_RecalculateTier_WithAdjustmentsOnly_ShouldAssignLowestTier() {
+	// GIVEN a customer credited only through adjustments
+	entries := []*entities.LedgerEntry{{Type: entities.EntryAdjust, Points: 3000, CreatedAt: now.AddDate(0, 0, -1)}}
+	suite.mockRepository.EXPECT().ListEntriesSince("customer-1", mock.Anything).Return(entries, nil).Once()
+	suite.mockRepository.EXPECT().SaveTier(mock.Anything).Return(nil).Once()
+
+	// WHEN executing the use case
+	tier, err := suite.useCase.Execute(commands.NewRecalculateTierCommand("customer-1", now))
+
+	// THEN the adjustment should not promote the customer
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "bronze", tier.Tier)
+	assert.Equal(suite.T(), 0, tier.QualifyingPoints)
+}
+
+func (suite *RecalculateTierUseCaseTestSuite) Test_RecalculateTier_WithQueryError_ShouldNotSave() {
+	// GIVEN the ledger cannot be read
+	expectedError := errors.New("query failed")
+	suite.mockRepository.EXPECT().ListEntriesSince("customer-1", mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN executing the use case
+	tier, err := suite.useCase.Execute(commands.NewRecalculateTierCommand("customer-1", now))
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), tier)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,10 @@
+package redeempoints
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type RedeemPointsUseCase interface {
+	Execute(command *commands.RedeemPointsCommand) (*entities.LedgerEntry, error)
+}
@@ -0,0 +1,44 @@
+package redeempoints
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ RedeemPointsUseCase = (*RedeemPointsUseCaseImpl)(nil)
+
+	ErrInvalidRedemption = errors.New("points must be positive and the redemption must reference an order")
+)
+
+type RedeemPointsUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+}
+
+func NewRedeemPointsUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository) *RedeemPointsUseCaseImpl {
+	return &RedeemPointsUseCaseImpl{loyaltyRepository: loyaltyRepository}
+}
+
+// Execute debits points at checkout. The reference, usually the order ID, can only be redeemed
+// once, so a retried checkout fails with repositories.ErrDuplicateEntry instead of paying twice.
+func (u *RedeemPointsUseCaseImpl) Execute(command *commands.RedeemPointsCommand) (*entities.LedgerEntry, error) {
+	if command.Points <= 0 || command.Reference == "" {
+		return nil, ErrInvalidRedemption
+	}
+
+	entry := &entities.LedgerEntry{
+		CustomerID: command.CustomerID,
+		Type:       entities.EntryRedeem,
+		Points:     -command.Points,
+		Reference:  command.Reference,
+		Reason:     command.Reason,
+	}
+
+	if _, err := u.loyaltyRepository.Append(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
@@ -0,0 +1,83 @@
+package redeempoints_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeempoints"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type RedeemPointsUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockLoyaltyRepository
+	useCase        redeempoints.RedeemPointsUseCase
+}
+
+func (suite *RedeemPointsUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.useCase = redeempoints.NewRedeemPointsUseCaseImpl(suite.mockRepository)
+}
+
+func TestRedeemPointsUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RedeemPointsUseCaseTestSuite))
+}
+
+// Feature: Redeem Points Use Case
+// Scenario: Customers pay with points at checkout
+
+func (suite *RedeemPointsUseCaseTestSuite) Test_RedeemPoints_ShouldDebitPoints() {
+	// GIVEN a customer paying an order with 100 points
+	suite.mockRepository.EXPECT().
+		Append(mock.MatchedBy(func(entry *entities.LedgerEntry) bool {
+			return entry.Type == entities.EntryRedeem && entry.Points == -100 && entry.Reference == "order-1"
+		})).
+		RunAndReturn(func(entry *entities.LedgerEntry) (*entities.Balance, error) {
+			entry.BalanceAfter = 20
+			return &entities.Balance{Points: 20}, nil
+		}).
+		Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemPointsCommand("customer-1", 100, "order-1", "checkout"))
+
+	// THEN the debit should be returned with the remaining balance
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), -100, entry.Points)
+	assert.Equal(suite.T(), 20, entry.BalanceAfter)
+}
+
+func (suite *RedeemPointsUseCaseTestSuite) Test_RedeemPoints_WithInsufficientPoints_ShouldReturnError() {
+	// GIVEN a balance lower than the redemption
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil, repositories.ErrInsufficientPoints).Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemPointsCommand("customer-1", 100, "order-1", ""))
+
+	// THEN the redemption should be refused
+	assert.Nil(suite.T(), entry)
+	assert.ErrorIs(suite.T(), err, repositories.ErrInsufficientPoints)
+}
+
+func (suite *RedeemPointsUseCaseTestSuite) Test_RedeemPoints_WithoutReference_ShouldReturnInvalidRedemption() {
+	// GIVEN a redemption without an order
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewRedeemPointsCommand("customer-1", 100, "", ""))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, redeempoints.ErrInvalidRedemption)
+}
+
+func (suite *RedeemPointsUseCaseTestSuite) Test_RedeemPoints_WithNonPositivePoints_ShouldReturnInvalidRedemption() {
+	// GIVEN a negative redemption
+	// WHEN executing the use case
+	_, err := suite.useCase.Execute(commands.NewRedeemPointsCommand("customer-1", -5, "order-1", ""))
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, redeempoints.ErrInvalidRedemption)
+}
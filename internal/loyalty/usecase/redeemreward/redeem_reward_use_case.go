@@ -0,0 +1,10 @@
+package redeemreward
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+type RedeemRewardUseCase interface {
+	Execute(command *commands.RedeemRewardCommand) (*entities.LedgerEntry, error)
+}
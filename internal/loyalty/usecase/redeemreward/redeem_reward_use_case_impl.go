@@ -0,0 +1,64 @@
+package redeemreward
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+)
+
+var (
+	_ RedeemRewardUseCase = (*RedeemRewardUseCaseImpl)(nil)
+
+	ErrRewardNotFound   = errors.New("reward not found")
+	ErrTierTooLow       = errors.New("reward requires a higher tier")
+	ErrMissingReference = errors.New("the redemption must reference an order")
+)
+
+type RedeemRewardUseCaseImpl struct {
+	loyaltyRepository repositories.LoyaltyRepository
+	rulesRepository   repositories.LoyaltyRulesRepository
+}
+
+func NewRedeemRewardUseCaseImpl(loyaltyRepository repositories.LoyaltyRepository, rulesRepository repositories.LoyaltyRulesRepository) *RedeemRewardUseCaseImpl {
+	return &RedeemRewardUseCaseImpl{loyaltyRepository: loyaltyRepository, rulesRepository: rulesRepository}
+}
+
+// Execute debits the price of a catalog reward. A reward can be redeemed once per reference,
+// so the same order may include several different rewards but never the same one twice.
+func (u *RedeemRewardUseCaseImpl) Execute(command *commands.RedeemRewardCommand) (*entities.LedgerEntry, error) {
+	if command.Reference == "" {
+		return nil, ErrMissingReference
+	}
+
+	rules := u.rulesRepository.Get()
+	reward, found := rules.Reward(command.RewardID)
+	if !found {
+		return nil, ErrRewardNotFound
+	}
+
+	if reward.MinTier != "" {
+		tier, err := u.loyaltyRepository.GetTier(command.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		if rules.Rank(tier.Tier) < rules.Rank(reward.MinTier) {
+			return nil, ErrTierTooLow
+		}
+	}
+
+	entry := &entities.LedgerEntry{
+		CustomerID: command.CustomerID,
+		Type:       entities.EntryRedeem,
+		Points:     -reward.Points,
+		Reference:  fmt.Sprintf("reward:%s:%s", reward.ID, command.Reference),
+		Reason:     fmt.Sprintf("reward %s", reward.Name),
+	}
+
+	if _, err := u.loyaltyRepository.Append(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
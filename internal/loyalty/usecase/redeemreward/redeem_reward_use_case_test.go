@@ -0,0 +1,116 @@
+package redeemreward_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/redeemreward"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/loyalty/domain/repositories"
+)
+
+type RedeemRewardUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository      *mockRepositories.MockLoyaltyRepository
+	mockRulesRepository *mockRepositories.MockLoyaltyRulesRepository
+	useCase             redeemreward.RedeemRewardUseCase
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockLoyaltyRepository(suite.T())
+	suite.mockRulesRepository = mockRepositories.NewMockLoyaltyRulesRepository(suite.T())
+	suite.mockRulesRepository.EXPECT().Get().Return(&entities.LoyaltyRules{
+		Tiers: []entities.Tier{{Name: "bronze"}, {Name: "silver", MinPoints: 1000}},
+		Rewards: []entities.Reward{
+			{ID: "dessert", Name: "Sobremesa", Points: 300},
+			{ID: "combo", Name: "Combo completo", Points: 1200, MinTier: "silver"},
+		},
+	}).Maybe()
+	suite.useCase = redeemreward.NewRedeemRewardUseCaseImpl(suite.mockRepository, suite.mockRulesRepository)
+}
+
+func TestRedeemRewardUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RedeemRewardUseCaseTestSuite))
+}
+
+// Feature: Redeem Reward Use Case
+// Scenario: Customers exchange points for catalog rewards
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_ShouldDebitRewardPrice() {
+	// GIVEN a reward open to every tier
+	suite.mockRepository.EXPECT().
+		Append(mock.MatchedBy(func(entry *entities.LedgerEntry) bool {
+			return entry.Type == entities.EntryRedeem && entry.Points == -300 && entry.Reference == "reward:dessert:order-1"
+		})).
+		Return(&entities.Balance{Points: 100}, nil).
+		Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "dessert", "order-1"))
+
+	// THEN the reward price should be debited without looking at the tier
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), -300, entry.Points)
+	assert.Equal(suite.T(), "reward Sobremesa", entry.Reason)
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_WithEnoughTier_ShouldDebit() {
+	// GIVEN a silver customer and a silver reward
+	suite.mockRepository.EXPECT().GetTier("customer-1").Return(&entities.CustomerTier{Tier: "silver"}, nil).Once()
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(&entities.Balance{Points: 0}, nil).Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "combo", "order-1"))
+
+	// THEN the reward should be redeemed
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), -1200, entry.Points)
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_WithLowerTier_ShouldReturnError() {
+	// GIVEN a bronze customer and a silver reward
+	suite.mockRepository.EXPECT().GetTier("customer-1").Return(&entities.CustomerTier{Tier: "bronze"}, nil).Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "combo", "order-1"))
+
+	// THEN the redemption should be refused
+	assert.Nil(suite.T(), entry)
+	assert.ErrorIs(suite.T(), err, redeemreward.ErrTierTooLow)
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_WithUnknownReward_ShouldReturnError() {
+	// GIVEN a reward missing from the catalog
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "yacht", "order-1"))
+
+	// THEN the reward should not be found
+	assert.Nil(suite.T(), entry)
+	assert.ErrorIs(suite.T(), err, redeemreward.ErrRewardNotFound)
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_WithoutReference_ShouldReturnError() {
+	// GIVEN a redemption without an order
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "dessert", ""))
+
+	// THEN the reference should be required
+	assert.Nil(suite.T(), entry)
+	assert.ErrorIs(suite.T(), err, redeemreward.ErrMissingReference)
+}
+
+func (suite *RedeemRewardUseCaseTestSuite) Test_RedeemReward_WithInsufficientPoints_ShouldReturnError() {
+	// GIVEN a balance lower than the reward
+	suite.mockRepository.EXPECT().Append(mock.Anything).Return(nil, repositories.ErrInsufficientPoints).Once()
+
+	// WHEN executing the use case
+	entry, err := suite.useCase.Execute(commands.NewRedeemRewardCommand("customer-1", "dessert", "order-1"))
+
+	// THEN the repository error should be returned
+	assert.Nil(suite.T(), entry)
+	assert.ErrorIs(suite.T(), err, repositories.ErrInsufficientPoints)
+}
@@ -0,0 +1,7 @@
+package controller
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+
+type OrderHistoryController interface {
+	ListByCustomer(customerID string, limit int, cursor string) (*dto.OrderHistoryResponseDto, error)
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+	orderHistoryPresenter "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/presenter"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/listorders"
+)
+
+var (
+	_ OrderHistoryController = (*OrderHistoryControllerImpl)(nil)
+)
+
+type OrderHistoryControllerImpl struct {
+	presenter         orderHistoryPresenter.OrderHistoryPresenter
+	listOrdersUseCase listorders.ListOrdersUseCase
+}
+
+func NewOrderHistoryControllerImpl(
+	presenter orderHistoryPresenter.OrderHistoryPresenter,
+	listOrdersUseCase listorders.ListOrdersUseCase) *OrderHistoryControllerImpl {
+	return &OrderHistoryControllerImpl{
+		presenter:         presenter,
+		listOrdersUseCase: listOrdersUseCase,
+	}
+}
+
+func (c *OrderHistoryControllerImpl) ListByCustomer(customerID string, limit int, cursor string) (*dto.OrderHistoryResponseDto, error) {
+	page, err := c.listOrdersUseCase.Execute(commands.NewListCustomerOrdersCommand(customerID, limit, cursor))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.presenter.Present(page), nil
+}
@@ -0,0 +1,70 @@
+package controller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	mockPresenter "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/presenter"
+	mockListOrders "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/usecase/listorders"
+)
+
+type OrderHistoryControllerTestSuite struct {
+	suite.Suite
+	mockPresenter         *mockPresenter.MockOrderHistoryPresenter
+	mockListOrdersUseCase *mockListOrders.MockListOrdersUseCase
+	controller            controller.OrderHistoryController
+}
+
+func (suite *OrderHistoryControllerTestSuite) SetupTest() {
+	suite.mockPresenter = mockPresenter.NewMockOrderHistoryPresenter(suite.T())
+	suite.mockListOrdersUseCase = mockListOrders.NewMockListOrdersUseCase(suite.T())
+	suite.controller = controller.NewOrderHistoryControllerImpl(suite.mockPresenter, suite.mockListOrdersUseCase)
+}
+
+func TestOrderHistoryControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHistoryControllerTestSuite))
+}
+
+// Feature: Order History Controller
+// Scenario: List and present a page of orders
+
+func (suite *OrderHistoryControllerTestSuite) Test_OrderListing_ShouldPresentPage() {
+	// GIVEN a page of orders
+	page := &entities.OrderPage{NextCursor: "next"}
+	expectedDto := &dto.OrderHistoryResponseDto{NextCursor: "next"}
+
+	suite.mockListOrdersUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.ListCustomerOrdersCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.Limit == 5 && cmd.Cursor == "cursor"
+		})).
+		Return(page, nil).
+		Once()
+	suite.mockPresenter.EXPECT().Present(page).Return(expectedDto).Once()
+
+	// WHEN listing the orders
+	result, err := suite.controller.ListByCustomer("customer-1", 5, "cursor")
+
+	// THEN the presented page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedDto, result)
+}
+
+func (suite *OrderHistoryControllerTestSuite) Test_OrderListing_WithUseCaseError_ShouldReturnError() {
+	// GIVEN the use case fails
+	expectedError := errors.New("query failed")
+	suite.mockListOrdersUseCase.EXPECT().Execute(mock.Anything).Return(nil, expectedError).Once()
+
+	// WHEN listing the orders
+	result, err := suite.controller.ListByCustomer("customer-1", 0, "")
+
+	// THEN the error should be returned
+	assert.Nil(suite.T(), result)
+	assert.Equal(suite.T(), expectedError, err)
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// OrderSummary is the compact copy of a completed order kept in the customer history.
+type OrderSummary struct {
+	CustomerID  string      `json:"customer_id" dynamodbav:"customer_id"`
+	OrderID     string      `json:"order_id" dynamodbav:"order_id"`
+	CompletedAt time.Time   `json:"completed_at" dynamodbav:"completed_at"`
+	Total       float64     `json:"total" dynamodbav:"total"`
+	Items       []OrderItem `json:"items" dynamodbav:"items"`
+}
+
+type OrderItem struct {
+	Name     string `json:"name" dynamodbav:"name"`
+	Quantity int    `json:"quantity" dynamodbav:"quantity"`
+}
+
+// OrderPage is one page of a customer history, newest first. NextCursor is empty on the last page.
+type OrderPage struct {
+	Orders     []*OrderSummary
+	NextCursor string
+}
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+)
+
+var (
+	ErrOrderAlreadyRecorded = errors.New("order already recorded")
+	ErrInvalidCursor        = errors.New("invalid cursor")
+)
+
+type OrderHistoryRepository interface {
+	// Add stores an order once; recording the same order again fails with ErrOrderAlreadyRecorded.
+	Add(order *entities.OrderSummary) error
+	// ListByCustomer returns up to limit orders of a customer, newest first, starting after cursor.
+	ListByCustomer(customerID string, limit int, cursor string) (*entities.OrderPage, error)
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	orderHistoryController "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+var (
+	// Customers and guests may only read their own history; the handler checks the token subject.
+	readOrdersRule = auth.Rule{
+		Roles:  []auth.Role{auth.RoleKiosk, auth.RoleStaff, auth.RoleAdmin, auth.RoleCustomer, auth.RoleGuest},
+		Scopes: []string{auth.ScopeCustomersRead},
+	}
+)
+
+type orderHistoryApiController struct {
+	controller orderHistoryController.OrderHistoryController
+}
+
+func NewOrderHistoryController(controller orderHistoryController.OrderHistoryController) *orderHistoryApiController {
+	return &orderHistoryApiController{
+		controller: controller,
+	}
+}
+
+func (c *orderHistoryApiController) RegisterRoutes(r chi.Router) {
+	r.With(auth.Authorize(readOrdersRule)).Get("/v1/customer/{id}/orders", c.List)
+}
+
+// @Summary     List customer orders
+// @Description List the completed orders of a customer, newest first
+// @Tags        Customer
+// @Produce     json
+// @Param       id     path  string true  "Customer ID"
+// @Param       limit  query int    false "Page size (default 10, max 50)"
+// @Param       cursor query string false "Cursor returned by the previous page"
+// @Success     200  {object} dto.OrderHistoryResponseDto
+// @Failure     400  {object} map[string]string
+// @Failure     401  {object} map[string]string
+// @Failure     403  {object} map[string]string
+// @Security    BearerAuth
+// @Security    ApiKeyAuth
+// @Router      /v1/customer/{id}/orders [get]
+func (h *orderHistoryApiController) List(w http.ResponseWriter, r *http.Request) {
+	customerID := chi.URLParam(r, "id")
+
+	principal, _ := auth.PrincipalFromContext(r.Context())
+	if !readsOwnHistory(principal, customerID) {
+		http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+		return
+	}
+
+	limit := 0
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			http.Error(w, `{"error":"Invalid limit parameter"}`, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	history, err := h.controller.ListByCustomer(customerID, limit, r.URL.Query().Get("cursor"))
+
+	if err != nil {
+		if errors.Is(err, repositories.ErrInvalidCursor) {
+			http.Error(w, `{"error":"Invalid cursor parameter"}`, http.StatusBadRequest)
+			return
+		}
+		rest.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+// readsOwnHistory lets staff-like roles and services read any history while session tokens
+// may only read the history of the customer they were issued to.
+func readsOwnHistory(principal *auth.Principal, customerID string) bool {
+	if principal.HasRole(auth.RoleKiosk) || principal.HasRole(auth.RoleStaff) || principal.HasRole(auth.RoleAdmin) || principal.HasScope(auth.ScopeCustomersRead) {
+		return true
+	}
+	return principal.Subject == customerID
+}
@@ -0,0 +1,117 @@
+package controller_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	apiController "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+	mockController "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/controller"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+type OrderHistoryApiControllerTestSuite struct {
+	suite.Suite
+	mockController *mockController.MockOrderHistoryController
+	router         *chi.Mux
+	principal      *auth.Principal
+}
+
+func (suite *OrderHistoryApiControllerTestSuite) SetupTest() {
+	suite.mockController = mockController.NewMockOrderHistoryController(suite.T())
+	suite.principal = &auth.Principal{Subject: "kiosk-1", Roles: []auth.Role{auth.RoleKiosk}}
+	suite.router = chi.NewRouter()
+	suite.router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if suite.principal != nil {
+				r = r.WithContext(auth.ContextWithPrincipal(r.Context(), suite.principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+	apiController.NewOrderHistoryController(suite.mockController).RegisterRoutes(suite.router)
+}
+
+func TestOrderHistoryApiControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHistoryApiControllerTestSuite))
+}
+
+// Feature: Order History REST API
+// Scenario: Kiosk shows what the customer ordered last time
+
+func (suite *OrderHistoryApiControllerTestSuite) Test_OrderListing_ViaGetEndpoint_ShouldReturnPage() {
+	// GIVEN a customer with orders
+	suite.mockController.EXPECT().
+		ListByCustomer("customer-1", 5, "cursor").
+		Return(&dto.OrderHistoryResponseDto{Orders: []dto.OrderSummaryResponseDto{{OrderID: "order-1"}}, NextCursor: "next"}, nil).
+		Once()
+
+	// WHEN a GET request is made to /v1/customer/customer-1/orders
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/orders?limit=5&cursor=cursor", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the page should be returned
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response dto.OrderHistoryResponseDto
+	json.NewDecoder(w.Body).Decode(&response)
+	assert.Equal(suite.T(), "order-1", response.Orders[0].OrderID)
+	assert.Equal(suite.T(), "next", response.NextCursor)
+}
+
+func (suite *OrderHistoryApiControllerTestSuite) Test_OrderListing_WithInvalidLimit_ShouldReturnBadRequest() {
+	// GIVEN a negative page size
+	// WHEN a GET request is made
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/orders?limit=-1", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *OrderHistoryApiControllerTestSuite) Test_OrderListing_WithInvalidCursor_ShouldReturnBadRequest() {
+	// GIVEN a tampered cursor
+	suite.mockController.EXPECT().ListByCustomer("customer-1", 0, "bad").Return(nil, repositories.ErrInvalidCursor).Once()
+
+	// WHEN a GET request is made
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/orders?cursor=bad", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 400 Bad Request
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+func (suite *OrderHistoryApiControllerTestSuite) Test_OrderListing_WithOwnSessionToken_ShouldBeAllowed() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-1", Roles: []auth.Role{auth.RoleCustomer}}
+	suite.mockController.EXPECT().ListByCustomer("customer-1", 0, "").Return(&dto.OrderHistoryResponseDto{}, nil).Once()
+
+	// WHEN the customer reads their own history
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/orders", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 200 OK
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+func (suite *OrderHistoryApiControllerTestSuite) Test_OrderListing_WithOtherCustomerSessionToken_ShouldReturnForbidden() {
+	// GIVEN a customer session
+	suite.principal = &auth.Principal{Subject: "customer-2", Roles: []auth.Role{auth.RoleCustomer}}
+
+	// WHEN the customer reads someone else's history
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer/customer-1/orders", nil)
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	// THEN the response status should be 403 Forbidden
+	assert.Equal(suite.T(), http.StatusForbidden, w.Code)
+}
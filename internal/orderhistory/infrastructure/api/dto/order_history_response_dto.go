@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type OrderHistoryResponseDto struct {
+	Orders     []OrderSummaryResponseDto `json:"orders"`
+	NextCursor string                    `json:"next_cursor,omitempty"`
+}
+
+type OrderSummaryResponseDto struct {
+	OrderID     string                 `json:"order_id"`
+	CompletedAt time.Time              `json:"completed_at"`
+	Total       float64                `json:"total"`
+	Items       []OrderItemResponseDto `json:"items"`
+}
+
+type OrderItemResponseDto struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
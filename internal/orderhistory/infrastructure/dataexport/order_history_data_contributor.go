@@ -0,0 +1,47 @@
+package dataexport
+
+import (
+	dataExportRepositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+)
+
+const ordersPageSize = 100
+
+var (
+	_ dataExportRepositories.DataContributor = (*OrderHistoryDataContributor)(nil)
+)
+
+// OrderHistoryDataContributor adds the orders projected from the order events to data exports,
+// newest first.
+type OrderHistoryDataContributor struct {
+	orderHistoryRepository repositories.OrderHistoryRepository
+}
+
+func NewOrderHistoryDataContributor(orderHistoryRepository repositories.OrderHistoryRepository) *OrderHistoryDataContributor {
+	return &OrderHistoryDataContributor{orderHistoryRepository: orderHistoryRepository}
+}
+
+func (c *OrderHistoryDataContributor) Section() string {
+	return "orders"
+}
+
+func (c *OrderHistoryDataContributor) Title() string {
+	return "Orders"
+}
+
+func (c *OrderHistoryDataContributor) Collect(customerID string) (any, error) {
+	orders := []*entities.OrderSummary{}
+	cursor := ""
+	for {
+		page, err := c.orderHistoryRepository.ListByCustomer(customerID, ordersPageSize, cursor)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, page.Orders...)
+		if page.NextCursor == "" {
+			return orders, nil
+		}
+		cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,50 @@
+package dataexport_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/dataexport"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/domain/repositories"
+)
+
+func TestOrderHistoryDataContributor_ShouldCollectEveryPage(t *testing.T) {
+	repository := mockRepositories.NewMockOrderHistoryRepository(t)
+	repository.EXPECT().ListByCustomer("customer-1", 100, "").
+		Return(&entities.OrderPage{Orders: []*entities.OrderSummary{{OrderID: "order-2"}}, NextCursor: "next"}, nil).
+		Once()
+	repository.EXPECT().ListByCustomer("customer-1", 100, "next").
+		Return(&entities.OrderPage{Orders: []*entities.OrderSummary{{OrderID: "order-1"}}}, nil).
+		Once()
+
+	data, err := dataexport.NewOrderHistoryDataContributor(repository).Collect("customer-1")
+
+	assert.NoError(t, err)
+	orders := data.([]*entities.OrderSummary)
+	assert.Len(t, orders, 2)
+	assert.Equal(t, "order-1", orders[1].OrderID)
+}
+
+func TestOrderHistoryDataContributor_WithoutOrders_ShouldReturnEmptyList(t *testing.T) {
+	repository := mockRepositories.NewMockOrderHistoryRepository(t)
+	repository.EXPECT().ListByCustomer("customer-1", 100, "").Return(&entities.OrderPage{}, nil).Once()
+
+	data, err := dataexport.NewOrderHistoryDataContributor(repository).Collect("customer-1")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, data)
+	assert.Empty(t, data)
+}
+
+func TestOrderHistoryDataContributor_WithRepositoryError_ShouldReturnError(t *testing.T) {
+	repository := mockRepositories.NewMockOrderHistoryRepository(t)
+	expectedError := errors.New("query failed")
+	repository.EXPECT().ListByCustomer("customer-1", 100, "").Return(nil, expectedError).Once()
+
+	data, err := dataexport.NewOrderHistoryDataContributor(repository).Collect("customer-1")
+
+	assert.Nil(t, data)
+	assert.Equal(t, expectedError, err)
+}
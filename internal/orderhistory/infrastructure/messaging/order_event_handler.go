@@ -0,0 +1,72 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/recordorder"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+// OrderCompletedType is the event the order service publishes when an order is delivered.
+const OrderCompletedType = "order.completed"
+
+// orderCompletedPayload is the data of an order.completed event.
+type orderCompletedPayload struct {
+	OrderID     string    `json:"order_id"`
+	CustomerID  string    `json:"customer_id"`
+	CompletedAt time.Time `json:"completed_at"`
+	Total       float64   `json:"total"`
+	Items       []struct {
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	} `json:"items"`
+}
+
+// OrderEventHandler feeds the order history from the events of the order service.
+type OrderEventHandler struct {
+	recordOrderUseCase recordorder.RecordOrderUseCase
+}
+
+func NewOrderEventHandler(recordOrderUseCase recordorder.RecordOrderUseCase) *OrderEventHandler {
+	return &OrderEventHandler{recordOrderUseCase: recordOrderUseCase}
+}
+
+// Handle records completed orders. Other order events and anonymous orders are ignored;
+// payloads that cannot be read are rejected permanently so they go to the dead-letter queue.
+func (h *OrderEventHandler) Handle(ctx context.Context, message messaging.Message) error {
+	if message.Type != OrderCompletedType {
+		return nil
+	}
+
+	var payload orderCompletedPayload
+	if err := json.Unmarshal(message.Data, &payload); err != nil {
+		return messaging.Permanent(fmt.Errorf("invalid %s payload: %w", message.Type, err))
+	}
+	if payload.CustomerID == "" {
+		return nil
+	}
+	if payload.OrderID == "" {
+		payload.OrderID = message.Subject
+	}
+	if payload.CompletedAt.IsZero() {
+		payload.CompletedAt = message.Time
+	}
+
+	items := make([]entities.OrderItem, 0, len(payload.Items))
+	for _, item := range payload.Items {
+		items = append(items, entities.OrderItem{Name: item.Name, Quantity: item.Quantity})
+	}
+
+	command := commands.NewRecordOrderCommand(payload.CustomerID, payload.OrderID, payload.CompletedAt, payload.Total, items)
+	err := h.recordOrderUseCase.Execute(command)
+	if errors.Is(err, recordorder.ErrInvalidOrder) {
+		return messaging.Permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	orderMessaging "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/messaging"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	mockRecordOrder "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/usecase/recordorder"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+type OrderEventHandlerTestSuite struct {
+	suite.Suite
+	mockRecordOrderUseCase *mockRecordOrder.MockRecordOrderUseCase
+	handler                *orderMessaging.OrderEventHandler
+}
+
+func (suite *OrderEventHandlerTestSuite) SetupTest() {
+	suite.mockRecordOrderUseCase = mockRecordOrder.NewMockRecordOrderUseCase(suite.T())
+	suite.handler = orderMessaging.NewOrderEventHandler(suite.mockRecordOrderUseCase)
+}
+
+func TestOrderEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderEventHandlerTestSuite))
+}
+
+// Feature: Order Event Handler
+// Scenario: Completed orders feed the customer history
+
+func (suite *OrderEventHandlerTestSuite) Test_OrderCompleted_ShouldRecordOrder() {
+	// GIVEN an order.completed event
+	message := messaging.Message{
+		ID:   "evt-1",
+		Type: orderMessaging.OrderCompletedType,
+		Data: []byte(`{"order_id":"order-1","customer_id":"customer-1","completed_at":"2025-01-01T12:00:00Z","total":45.9,"items":[{"name":"X-Burger","quantity":2}]}`),
+	}
+	suite.mockRecordOrderUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecordOrderCommand) bool {
+			return cmd.CustomerID == "customer-1" && cmd.OrderID == "order-1" && cmd.Total == 45.9 &&
+				cmd.CompletedAt.Equal(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)) &&
+				len(cmd.Items) == 1 && cmd.Items[0].Quantity == 2
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the order should be recorded
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderEventHandlerTestSuite) Test_OrderCompleted_WithoutCompletionTime_ShouldUseEventTime() {
+	// GIVEN an event whose payload omits the completion time
+	eventTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	message := messaging.Message{ID: "evt-1", Type: orderMessaging.OrderCompletedType, Subject: "order-1", Time: eventTime, Data: []byte(`{"customer_id":"customer-1"}`)}
+	suite.mockRecordOrderUseCase.EXPECT().
+		Execute(mock.MatchedBy(func(cmd *commands.RecordOrderCommand) bool {
+			return cmd.OrderID == "order-1" && cmd.CompletedAt.Equal(eventTime)
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the envelope subject and time should be used
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderEventHandlerTestSuite) Test_OtherOrderEvents_ShouldBeIgnored() {
+	// GIVEN an order.created event
+	message := messaging.Message{ID: "evt-1", Type: "order.created", Data: []byte(`{}`)}
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN nothing should be recorded
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderEventHandlerTestSuite) Test_AnonymousOrder_ShouldBeIgnored() {
+	// GIVEN an order without customer
+	message := messaging.Message{ID: "evt-1", Type: orderMessaging.OrderCompletedType, Data: []byte(`{"order_id":"order-1"}`)}
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN nothing should be recorded
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OrderEventHandlerTestSuite) Test_MalformedPayload_ShouldFailPermanently() {
+	// GIVEN a payload that is not an order
+	message := messaging.Message{ID: "evt-1", Type: orderMessaging.OrderCompletedType, Data: []byte(`[]`)}
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN it should go to the dead-letter queue without retries
+	assert.True(suite.T(), messaging.IsPermanent(err))
+}
+
+func (suite *OrderEventHandlerTestSuite) Test_RecordFailure_ShouldBeRetried() {
+	// GIVEN the history cannot be written
+	message := messaging.Message{ID: "evt-1", Type: orderMessaging.OrderCompletedType, Data: []byte(`{"order_id":"order-1","customer_id":"customer-1","completed_at":"2025-01-01T12:00:00Z"}`)}
+	suite.mockRecordOrderUseCase.EXPECT().Execute(mock.Anything).Return(errors.New("throttled")).Once()
+
+	// WHEN handling it
+	err := suite.handler.Handle(context.Background(), message)
+
+	// THEN the error should be retryable
+	assert.Error(suite.T(), err)
+	assert.False(suite.T(), messaging.IsPermanent(err))
+}
@@ -0,0 +1,118 @@
+package persistence
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+var (
+	_ repositories.OrderHistoryRepository = (*OrderHistoryRepositoryImpl)(nil)
+)
+
+// sortKeyLayout keeps a fixed width so sort keys order chronologically.
+const sortKeyLayout = "2006-01-02T15:04:05.000Z"
+
+// orderRecord is the stored item. The sort key puts the completion time before the
+// order ID, so a query returns the history in chronological order while a
+// redelivered event still maps to the same item.
+type orderRecord struct {
+	entities.OrderSummary
+	SortKey string `dynamodbav:"sk"`
+}
+
+type OrderHistoryRepositoryImpl struct {
+	db dynamodbpkg.Client
+}
+
+func NewOrderHistoryRepositoryImpl(db dynamodbpkg.Client) *OrderHistoryRepositoryImpl {
+	return &OrderHistoryRepositoryImpl{db: db}
+}
+
+func (r *OrderHistoryRepositoryImpl) Add(order *entities.OrderSummary) error {
+	av, err := attributevalue.MarshalMap(orderRecord{OrderSummary: *order, SortKey: sortKey(order)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal order: %w", err)
+	}
+
+	_, err = r.db.PutItem(context.Background(), &dynamodb.PutItemInput{
+		TableName:           aws.String(dynamodbpkg.OrderHistoryTableName),
+		Item:                av,
+		ConditionExpression: aws.String("attribute_not_exists(sk)"),
+	})
+
+	var conditionFailed *types.ConditionalCheckFailedException
+	if errors.As(err, &conditionFailed) {
+		return repositories.ErrOrderAlreadyRecorded
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add order: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderHistoryRepositoryImpl) ListByCustomer(customerID string, limit int, cursor string) (*entities.OrderPage, error) {
+	input := &dynamodb.QueryInput{
+		TableName:              aws.String(dynamodbpkg.OrderHistoryTableName),
+		KeyConditionExpression: aws.String("customer_id = :customer_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":customer_id": &types.AttributeValueMemberS{Value: customerID},
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int32(int32(limit)),
+	}
+
+	if cursor != "" {
+		lastKey, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: customerID},
+			"sk":          &types.AttributeValueMemberS{Value: lastKey},
+		}
+	}
+
+	result, err := r.db.Query(context.Background(), input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orders: %w", err)
+	}
+
+	var records []orderRecord
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &records); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal orders: %w", err)
+	}
+
+	page := &entities.OrderPage{Orders: make([]*entities.OrderSummary, 0, len(records))}
+	for i := range records {
+		page.Orders = append(page.Orders, &records[i].OrderSummary)
+	}
+	if lastKey, ok := result.LastEvaluatedKey["sk"]; ok {
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(dynamodbpkg.StringValue(lastKey)))
+	}
+
+	return page, nil
+}
+
+func sortKey(order *entities.OrderSummary) string {
+	return order.CompletedAt.UTC().Format(sortKeyLayout) + "#" + order.OrderID
+}
+
+func decodeCursor(cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.Contains(string(decoded), "#") {
+		return "", repositories.ErrInvalidCursor
+	}
+	return string(decoded), nil
+}
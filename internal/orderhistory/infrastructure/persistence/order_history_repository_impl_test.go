@@ -0,0 +1,165 @@
+package persistence_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/persistence"
+	dynamodbpkg "github.com/viniciuscluna/tc-fiap-customer/pkg/storage/dynamodb"
+)
+
+// Mock DynamoDB Client
+type MockDynamoDBClient struct {
+	mock.Mock
+	dynamodbpkg.Client
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+type OrderHistoryRepositoryTestSuite struct {
+	suite.Suite
+	mockDB     *MockDynamoDBClient
+	repository *persistence.OrderHistoryRepositoryImpl
+	order      *entities.OrderSummary
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) SetupTest() {
+	suite.mockDB = new(MockDynamoDBClient)
+	suite.repository = persistence.NewOrderHistoryRepositoryImpl(suite.mockDB)
+	suite.order = &entities.OrderSummary{
+		CustomerID:  "customer-1",
+		OrderID:     "order-1",
+		CompletedAt: time.Date(2025, 1, 1, 12, 30, 0, 0, time.UTC),
+		Total:       45.9,
+		Items:       []entities.OrderItem{{Name: "X-Burger", Quantity: 2}},
+	}
+}
+
+func TestOrderHistoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHistoryRepositoryTestSuite))
+}
+
+// Feature: Order History Repository - Persistence Layer
+// Scenario: Store orders once and page through them newest first
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderPersistence_ShouldStoreWithChronologicalSortKey() {
+	// GIVEN a completed order
+	suite.mockDB.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return dynamodbpkg.StringValue(input.Item["customer_id"]) == "customer-1" &&
+			dynamodbpkg.StringValue(input.Item["sk"]) == "2025-01-01T12:30:00.000Z#order-1" &&
+			aws.ToString(input.ConditionExpression) == "attribute_not_exists(sk)"
+	})).Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	// WHEN adding the order
+	err := suite.repository.Add(suite.order)
+
+	// THEN it should be stored only if absent
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderPersistence_WithRecordedOrder_ShouldReturnAlreadyRecorded() {
+	// GIVEN the order is already stored
+	suite.mockDB.On("PutItem", mock.Anything).
+		Return(nil, &types.ConditionalCheckFailedException{}).Once()
+
+	// WHEN adding it again
+	err := suite.repository.Add(suite.order)
+
+	// THEN the duplicate should be reported
+	assert.ErrorIs(suite.T(), err, repositories.ErrOrderAlreadyRecorded)
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderPersistence_WithDynamoDBError_ShouldReturnError() {
+	// GIVEN DynamoDB fails
+	suite.mockDB.On("PutItem", mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+	// WHEN adding the order
+	err := suite.repository.Add(suite.order)
+
+	// THEN the error should be returned
+	assert.Error(suite.T(), err)
+	assert.NotErrorIs(suite.T(), err, repositories.ErrOrderAlreadyRecorded)
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderListing_ShouldQueryNewestFirstAndReturnCursor() {
+	// GIVEN a customer with more orders than the page size
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return !aws.ToBool(input.ScanIndexForward) && aws.ToInt32(input.Limit) == 1 && input.ExclusiveStartKey == nil
+	})).Return(&dynamodb.QueryOutput{
+		Items: []map[string]types.AttributeValue{{
+			"customer_id":  &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":           &types.AttributeValueMemberS{Value: "2025-01-01T12:30:00.000Z#order-1"},
+			"order_id":     &types.AttributeValueMemberS{Value: "order-1"},
+			"completed_at": &types.AttributeValueMemberS{Value: "2025-01-01T12:30:00Z"},
+			"total":        &types.AttributeValueMemberN{Value: "45.9"},
+		}},
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"customer_id": &types.AttributeValueMemberS{Value: "customer-1"},
+			"sk":          &types.AttributeValueMemberS{Value: "2025-01-01T12:30:00.000Z#order-1"},
+		},
+	}, nil).Once()
+
+	// WHEN listing the first page
+	page, err := suite.repository.ListByCustomer("customer-1", 1, "")
+
+	// THEN the order and a cursor to the next page should be returned
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), page.Orders, 1)
+	assert.Equal(suite.T(), "order-1", page.Orders[0].OrderID)
+	assert.Equal(suite.T(), 45.9, page.Orders[0].Total)
+	assert.NotEmpty(suite.T(), page.NextCursor)
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderListing_WithCursor_ShouldContinueAfterLastKey() {
+	// GIVEN the cursor of a previous page
+	cursor := base64.RawURLEncoding.EncodeToString([]byte("2025-01-01T12:30:00.000Z#order-1"))
+	suite.mockDB.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return dynamodbpkg.StringValue(input.ExclusiveStartKey["sk"]) == "2025-01-01T12:30:00.000Z#order-1" &&
+			dynamodbpkg.StringValue(input.ExclusiveStartKey["customer_id"]) == "customer-1"
+	})).Return(&dynamodb.QueryOutput{}, nil).Once()
+
+	// WHEN listing the next page
+	page, err := suite.repository.ListByCustomer("customer-1", 10, cursor)
+
+	// THEN the query should start after the cursor and the last page has no cursor
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), page.Orders)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *OrderHistoryRepositoryTestSuite) Test_OrderListing_WithMalformedCursor_ShouldReturnInvalidCursor() {
+	// GIVEN a tampered cursor
+	// WHEN listing
+	page, err := suite.repository.ListByCustomer("customer-1", 10, "not a cursor!")
+
+	// THEN the cursor should be rejected without querying
+	assert.Nil(suite.T(), page)
+	assert.ErrorIs(suite.T(), err, repositories.ErrInvalidCursor)
+	suite.mockDB.AssertNotCalled(suite.T(), "Query", mock.Anything)
+}
@@ -0,0 +1,10 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+)
+
+type OrderHistoryPresenter interface {
+	Present(page *entities.OrderPage) *dto.OrderHistoryResponseDto
+}
@@ -0,0 +1,37 @@
+package presenter
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+)
+
+var (
+	_ OrderHistoryPresenter = (*OrderHistoryPresenterImpl)(nil)
+)
+
+type OrderHistoryPresenterImpl struct {
+}
+
+func NewOrderHistoryPresenterImpl() *OrderHistoryPresenterImpl {
+	return &OrderHistoryPresenterImpl{}
+}
+
+func (p *OrderHistoryPresenterImpl) Present(page *entities.OrderPage) *dto.OrderHistoryResponseDto {
+	response := &dto.OrderHistoryResponseDto{
+		Orders:     make([]dto.OrderSummaryResponseDto, 0, len(page.Orders)),
+		NextCursor: page.NextCursor,
+	}
+	for _, order := range page.Orders {
+		items := make([]dto.OrderItemResponseDto, 0, len(order.Items))
+		for _, item := range order.Items {
+			items = append(items, dto.OrderItemResponseDto{Name: item.Name, Quantity: item.Quantity})
+		}
+		response.Orders = append(response.Orders, dto.OrderSummaryResponseDto{
+			OrderID:     order.OrderID,
+			CompletedAt: order.CompletedAt,
+			Total:       order.Total,
+			Items:       items,
+		})
+	}
+	return response
+}
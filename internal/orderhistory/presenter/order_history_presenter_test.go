@@ -0,0 +1,64 @@
+package presenter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/presenter"
+)
+
+type OrderHistoryPresenterTestSuite struct {
+	suite.Suite
+	presenter presenter.OrderHistoryPresenter
+}
+
+func (suite *OrderHistoryPresenterTestSuite) SetupTest() {
+	suite.presenter = presenter.NewOrderHistoryPresenterImpl()
+}
+
+func TestOrderHistoryPresenterTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderHistoryPresenterTestSuite))
+}
+
+// Feature: Order History Presentation
+// Scenario: Transform a page of orders to the response DTO
+
+func (suite *OrderHistoryPresenterTestSuite) Test_OrderHistoryPresentation_ShouldMapOrdersAndCursor() {
+	// GIVEN a page with one order
+	completedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	page := &entities.OrderPage{
+		Orders: []*entities.OrderSummary{{
+			CustomerID:  "customer-1",
+			OrderID:     "order-1",
+			CompletedAt: completedAt,
+			Total:       45.9,
+			Items:       []entities.OrderItem{{Name: "X-Burger", Quantity: 2}},
+		}},
+		NextCursor: "next",
+	}
+
+	// WHEN the presenter transforms it
+	result := suite.presenter.Present(page)
+
+	// THEN the orders and the cursor should be mapped
+	assert.Equal(suite.T(), "next", result.NextCursor)
+	assert.Len(suite.T(), result.Orders, 1)
+	assert.Equal(suite.T(), "order-1", result.Orders[0].OrderID)
+	assert.Equal(suite.T(), completedAt, result.Orders[0].CompletedAt)
+	assert.Equal(suite.T(), 45.9, result.Orders[0].Total)
+	assert.Equal(suite.T(), "X-Burger", result.Orders[0].Items[0].Name)
+	assert.Equal(suite.T(), 2, result.Orders[0].Items[0].Quantity)
+}
+
+func (suite *OrderHistoryPresenterTestSuite) Test_OrderHistoryPresentation_WithEmptyPage_ShouldReturnEmptyList() {
+	// GIVEN a customer without orders
+	// WHEN the presenter transforms the page
+	result := suite.presenter.Present(&entities.OrderPage{})
+
+	// THEN an empty list should be returned instead of null
+	assert.NotNil(suite.T(), result.Orders)
+	assert.Empty(suite.T(), result.Orders)
+}
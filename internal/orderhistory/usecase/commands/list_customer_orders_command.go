@@ -0,0 +1,15 @@
+package commands
+
+type ListCustomerOrdersCommand struct {
+	CustomerID string
+	Limit      int
+	Cursor     string
+}
+
+func NewListCustomerOrdersCommand(customerID string, limit int, cursor string) *ListCustomerOrdersCommand {
+	return &ListCustomerOrdersCommand{
+		CustomerID: customerID,
+		Limit:      limit,
+		Cursor:     cursor,
+	}
+}
@@ -0,0 +1,20 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+func TestNewListCustomerOrdersCommand(t *testing.T) {
+	// GIVEN a customer ID and a page request
+	// WHEN creating a new ListCustomerOrdersCommand
+	command := commands.NewListCustomerOrdersCommand("customer-1", 10, "cursor")
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, 10, command.Limit)
+	assert.Equal(t, "cursor", command.Cursor)
+}
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+)
+
+type RecordOrderCommand struct {
+	CustomerID  string
+	OrderID     string
+	CompletedAt time.Time
+	Total       float64
+	Items       []entities.OrderItem
+}
+
+func NewRecordOrderCommand(customerID string, orderID string, completedAt time.Time, total float64, items []entities.OrderItem) *RecordOrderCommand {
+	return &RecordOrderCommand{
+		CustomerID:  customerID,
+		OrderID:     orderID,
+		CompletedAt: completedAt,
+		Total:       total,
+		Items:       items,
+	}
+}
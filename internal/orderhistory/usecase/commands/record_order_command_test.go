@@ -0,0 +1,27 @@
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+func TestNewRecordOrderCommand(t *testing.T) {
+	// GIVEN a completed order
+	completedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	items := []entities.OrderItem{{Name: "X-Burger", Quantity: 2}}
+
+	// WHEN creating a new RecordOrderCommand
+	command := commands.NewRecordOrderCommand("customer-1", "order-1", completedAt, 45.9, items)
+
+	// THEN the command should be created with the correct values
+	assert.NotNil(t, command)
+	assert.Equal(t, "customer-1", command.CustomerID)
+	assert.Equal(t, "order-1", command.OrderID)
+	assert.Equal(t, completedAt, command.CompletedAt)
+	assert.Equal(t, 45.9, command.Total)
+	assert.Equal(t, items, command.Items)
+}
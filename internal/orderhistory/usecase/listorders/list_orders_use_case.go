@@ -0,0 +1,10 @@
+package listorders
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+type ListOrdersUseCase interface {
+	Execute(command *commands.ListCustomerOrdersCommand) (*entities.OrderPage, error)
+}
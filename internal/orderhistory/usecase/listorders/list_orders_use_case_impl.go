@@ -0,0 +1,36 @@
+package listorders
+
+import (
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+var (
+	_ ListOrdersUseCase = (*ListOrdersUseCaseImpl)(nil)
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 50
+)
+
+type ListOrdersUseCaseImpl struct {
+	orderHistoryRepository repositories.OrderHistoryRepository
+}
+
+func NewListOrdersUseCaseImpl(orderHistoryRepository repositories.OrderHistoryRepository) *ListOrdersUseCaseImpl {
+	return &ListOrdersUseCaseImpl{orderHistoryRepository: orderHistoryRepository}
+}
+
+func (u *ListOrdersUseCaseImpl) Execute(command *commands.ListCustomerOrdersCommand) (*entities.OrderPage, error) {
+	limit := command.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return u.orderHistoryRepository.ListByCustomer(command.CustomerID, limit, command.Cursor)
+}
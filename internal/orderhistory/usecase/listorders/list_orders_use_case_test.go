@@ -0,0 +1,54 @@
+package listorders_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/listorders"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/domain/repositories"
+)
+
+type ListOrdersUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockOrderHistoryRepository
+	useCase        listorders.ListOrdersUseCase
+}
+
+func (suite *ListOrdersUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockOrderHistoryRepository(suite.T())
+	suite.useCase = listorders.NewListOrdersUseCaseImpl(suite.mockRepository)
+}
+
+func TestListOrdersUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ListOrdersUseCaseTestSuite))
+}
+
+// Feature: List Orders Use Case
+// Scenario: Page sizes are bounded
+
+func (suite *ListOrdersUseCaseTestSuite) Test_ListOrders_WithoutLimit_ShouldUseDefaultPageSize() {
+	// GIVEN no page size
+	page := &entities.OrderPage{}
+	suite.mockRepository.EXPECT().ListByCustomer("customer-1", listorders.DefaultPageSize, "").Return(page, nil).Once()
+
+	// WHEN listing
+	result, err := suite.useCase.Execute(commands.NewListCustomerOrdersCommand("customer-1", 0, ""))
+
+	// THEN the default page size should be used
+	assert.NoError(suite.T(), err)
+	assert.Same(suite.T(), page, result)
+}
+
+func (suite *ListOrdersUseCaseTestSuite) Test_ListOrders_WithLargeLimit_ShouldCapPageSize() {
+	// GIVEN a page size above the maximum
+	suite.mockRepository.EXPECT().ListByCustomer("customer-1", listorders.MaxPageSize, "cursor").Return(&entities.OrderPage{}, nil).Once()
+
+	// WHEN listing
+	_, err := suite.useCase.Execute(commands.NewListCustomerOrdersCommand("customer-1", 1000, "cursor"))
+
+	// THEN the page size should be capped
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,7 @@
+package recordorder
+
+import "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+
+type RecordOrderUseCase interface {
+	Execute(command *commands.RecordOrderCommand) error
+}
@@ -0,0 +1,45 @@
+package recordorder
+
+import (
+	"errors"
+
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+var (
+	_ RecordOrderUseCase = (*RecordOrderUseCaseImpl)(nil)
+
+	ErrInvalidOrder = errors.New("invalid order")
+)
+
+type RecordOrderUseCaseImpl struct {
+	orderHistoryRepository repositories.OrderHistoryRepository
+}
+
+func NewRecordOrderUseCaseImpl(orderHistoryRepository repositories.OrderHistoryRepository) *RecordOrderUseCaseImpl {
+	return &RecordOrderUseCaseImpl{orderHistoryRepository: orderHistoryRepository}
+}
+
+// Execute adds a completed order to the customer history. Order events are delivered
+// at least once, so recording an order that is already there succeeds without changes.
+func (u *RecordOrderUseCaseImpl) Execute(command *commands.RecordOrderCommand) error {
+	if command.CustomerID == "" || command.OrderID == "" || command.CompletedAt.IsZero() {
+		return ErrInvalidOrder
+	}
+
+	order := &entities.OrderSummary{
+		CustomerID:  command.CustomerID,
+		OrderID:     command.OrderID,
+		CompletedAt: command.CompletedAt,
+		Total:       command.Total,
+		Items:       command.Items,
+	}
+
+	err := u.orderHistoryRepository.Add(order)
+	if errors.Is(err, repositories.ErrOrderAlreadyRecorded) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package recordorder_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/repositories"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/recordorder"
+	mockRepositories "github.com/viniciuscluna/tc-fiap-customer/mocks/orderhistory/domain/repositories"
+)
+
+type RecordOrderUseCaseTestSuite struct {
+	suite.Suite
+	mockRepository *mockRepositories.MockOrderHistoryRepository
+	useCase        recordorder.RecordOrderUseCase
+	command        *commands.RecordOrderCommand
+}
+
+func (suite *RecordOrderUseCaseTestSuite) SetupTest() {
+	suite.mockRepository = mockRepositories.NewMockOrderHistoryRepository(suite.T())
+	suite.useCase = recordorder.NewRecordOrderUseCaseImpl(suite.mockRepository)
+	suite.command = commands.NewRecordOrderCommand("customer-1", "order-1", time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), 45.9,
+		[]entities.OrderItem{{Name: "X-Burger", Quantity: 2}})
+}
+
+func TestRecordOrderUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(RecordOrderUseCaseTestSuite))
+}
+
+// Feature: Record Order Use Case
+// Scenario: Completed orders are added to the customer history once
+
+func (suite *RecordOrderUseCaseTestSuite) Test_RecordOrder_ShouldAddSummary() {
+	// GIVEN a completed order
+	suite.mockRepository.EXPECT().
+		Add(mock.MatchedBy(func(order *entities.OrderSummary) bool {
+			return order.CustomerID == "customer-1" && order.OrderID == "order-1" && len(order.Items) == 1
+		})).
+		Return(nil).
+		Once()
+
+	// WHEN recording it
+	err := suite.useCase.Execute(suite.command)
+
+	// THEN it should be stored
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RecordOrderUseCaseTestSuite) Test_RecordOrder_WithRedeliveredEvent_ShouldSucceedWithoutChanges() {
+	// GIVEN the order was already recorded
+	suite.mockRepository.EXPECT().Add(mock.Anything).Return(repositories.ErrOrderAlreadyRecorded).Once()
+
+	// WHEN recording it again
+	err := suite.useCase.Execute(suite.command)
+
+	// THEN the redelivery should be acknowledged
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *RecordOrderUseCaseTestSuite) Test_RecordOrder_WithRepositoryError_ShouldReturnError() {
+	// GIVEN the repository fails
+	expectedError := errors.New("throttled")
+	suite.mockRepository.EXPECT().Add(mock.Anything).Return(expectedError).Once()
+
+	// WHEN recording the order
+	err := suite.useCase.Execute(suite.command)
+
+	// THEN the error should be returned so the message is retried
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *RecordOrderUseCaseTestSuite) Test_RecordOrder_WithoutOrderID_ShouldReturnInvalidOrder() {
+	// GIVEN an order without ID
+	suite.command.OrderID = ""
+
+	// WHEN recording it
+	err := suite.useCase.Execute(suite.command)
+
+	// THEN it should be rejected
+	assert.ErrorIs(suite.T(), err, recordorder.ErrInvalidOrder)
+}
@@ -0,0 +1,186 @@
+package seed
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"time"
+
+	consentEntities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	customerEntities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+)
+
+const (
+	DefaultSeed = 42
+	// LookBack is how far before Options.Now seeded orders go.
+	LookBack = 180 * 24 * time.Hour
+	// MaxOrders is the most orders a seeded customer has.
+	MaxOrders = 8
+	// PolicyVersion is the privacy policy version seeded consents were given under.
+	PolicyVersion = "2025-01"
+)
+
+// consentSources are the channels a seeded customer answers consent through.
+var consentSources = []consentEntities.Source{consentEntities.SourceKiosk, consentEntities.SourceApp, consentEntities.SourceWeb}
+
+// Options decide what Generate produces.
+type Options struct {
+	// Count is how many customers to generate.
+	Count int
+	// Seed makes the output reproducible: the same seed and Now always give the same customers.
+	Seed uint64
+	// Now anchors the dates of the orders, which fall in the LookBack before it.
+	Now time.Time
+	// Orders generates completed orders, which earn loyalty points once loaded.
+	Orders bool
+	// Consents generates answers to the consent purposes.
+	Consents bool
+}
+
+// Customer is a generated customer with the data of the other subsystems that belongs to them.
+type Customer struct {
+	Name     string
+	Email    string
+	CPF      string
+	Orders   []Order
+	Consents []Consent
+}
+
+// Order is a completed and paid order, oldest first in Customer.Orders.
+type Order struct {
+	ID          string
+	PaymentID   string
+	CompletedAt time.Time
+	Total       float64
+	Items       []OrderItem
+}
+
+type OrderItem struct {
+	Name     string
+	Quantity int
+}
+
+// Consent is the answer of a customer to one purpose.
+type Consent struct {
+	Purpose       consentEntities.Purpose
+	Granted       bool
+	Source        consentEntities.Source
+	PolicyVersion string
+}
+
+// Generate produces options.Count customers with valid and unique CPFs and emails. Each customer
+// draws from its own random source, so the first customers of a larger run are the ones of a
+// smaller run with the same seed, and order and payment IDs stay the same between runs, which
+// lets loading them again skip what is already there.
+func Generate(options Options) []*Customer {
+	customers := make([]*Customer, 0, max(options.Count, 0))
+	cpfs := map[string]bool{}
+	emails := map[string]bool{}
+
+	for i := range options.Count {
+		random := rand.New(rand.NewPCG(options.Seed, uint64(i)))
+
+		first := firstNames[random.IntN(len(firstNames))]
+		last := surnames[random.IntN(len(surnames))]
+		name := first + " " + last
+		if random.IntN(2) == 0 {
+			middle := surnames[random.IntN(len(surnames))]
+			if middle != last {
+				name = first + " " + middle + " " + last
+			}
+		}
+
+		customer := &Customer{
+			Name:  name,
+			CPF:   uniqueCPF(random, cpfs),
+			Email: uniqueEmail(first, last, emailDomains[random.IntN(len(emailDomains))], emails),
+		}
+		if options.Orders {
+			customer.Orders = generateOrders(random, options, i)
+		}
+		if options.Consents {
+			customer.Consents = generateConsents(random)
+		}
+		customers = append(customers, customer)
+	}
+	return customers
+}
+
+// uniqueCPF draws CPFs until one is valid and not taken yet.
+func uniqueCPF(random *rand.Rand, taken map[string]bool) string {
+	for {
+		var base strings.Builder
+		for range 9 {
+			base.WriteByte(byte('0' + random.IntN(10)))
+		}
+		cpf := customerEntities.CompleteCPF(base.String())
+		if _, err := customerEntities.NormalizeCPF(cpf); err != nil || taken[cpf] {
+			continue
+		}
+		taken[cpf] = true
+		return cpf
+	}
+}
+
+// uniqueEmail builds first.last@domain, numbering the namesakes that come after the first.
+func uniqueEmail(first string, last string, domain string, taken map[string]bool) string {
+	local := asciiLower(first) + "." + asciiLower(last)
+	email := local + "@" + domain
+	for n := 2; taken[email]; n++ {
+		email = fmt.Sprintf("%s%d@%s", local, n, domain)
+	}
+	taken[email] = true
+	return email
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e",
+	"í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u",
+	"ç", "c",
+)
+
+func asciiLower(name string) string {
+	return accents.Replace(strings.ToLower(name))
+}
+
+func generateOrders(random *rand.Rand, options Options, customer int) []Order {
+	orders := make([]Order, random.IntN(MaxOrders+1))
+	for n := range orders {
+		order := Order{
+			ID:          fmt.Sprintf("seed-%d-%04d-%02d", options.Seed, customer, n),
+			PaymentID:   fmt.Sprintf("seed-payment-%d-%04d-%02d", options.Seed, customer, n),
+			CompletedAt: options.Now.Add(-time.Duration(random.Int64N(int64(LookBack)))).Truncate(time.Minute),
+		}
+		for _, index := range random.Perm(len(menu))[:1+random.IntN(3)] {
+			quantity := 1 + random.IntN(2)
+			order.Items = append(order.Items, OrderItem{Name: menu[index].Name, Quantity: quantity})
+			order.Total += menu[index].Price * float64(quantity)
+		}
+		order.Total = math.Round(order.Total*100) / 100
+		orders[n] = order
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].CompletedAt.Before(orders[j].CompletedAt) })
+	return orders
+}
+
+// generateConsents answers some of the purposes, mostly granting them, and leaves the others unanswered.
+func generateConsents(random *rand.Rand) []Consent {
+	var consents []Consent
+	for _, purpose := range consentEntities.Purposes {
+		if random.IntN(3) == 0 {
+			continue
+		}
+		consents = append(consents, Consent{
+			Purpose:       purpose,
+			Granted:       random.IntN(4) != 0,
+			Source:        consentSources[random.IntN(len(consentSources))],
+			PolicyVersion: PolicyVersion,
+		})
+	}
+	return consents
+}
@@ -0,0 +1,132 @@
+package seed_test
+
+import (
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	consentEntities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	customerEntities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	"github.com/viniciuscluna/tc-fiap-customer/internal/seed"
+)
+
+type GeneratorTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func (suite *GeneratorTestSuite) SetupTest() {
+	suite.now = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func TestGeneratorTestSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorTestSuite))
+}
+
+func (suite *GeneratorTestSuite) options(count int) seed.Options {
+	return seed.Options{Count: count, Seed: seed.DefaultSeed, Now: suite.now, Orders: true, Consents: true}
+}
+
+// Feature: Seed Data Generator
+// Scenario: Generated customers are valid and unique
+
+func (suite *GeneratorTestSuite) Test_Generate_ShouldProduceValidAndUniqueCustomers() {
+	// GIVEN enough customers for names to repeat
+	options := suite.options(500)
+
+	// WHEN generating them
+	customers := seed.Generate(options)
+
+	// THEN every CPF should be valid and every CPF and email unique
+	assert.Len(suite.T(), customers, 500)
+	cpfs := map[string]bool{}
+	emails := map[string]bool{}
+	for _, customer := range customers {
+		normalized, err := customerEntities.NormalizeCPF(customer.CPF)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), customer.CPF, normalized)
+		assert.NoError(suite.T(), customerEntities.ValidateEmail(customer.Email))
+		_, err = mail.ParseAddress(customer.Email)
+		assert.NoError(suite.T(), err)
+		assert.NotEmpty(suite.T(), customer.Name)
+
+		assert.False(suite.T(), cpfs[customer.CPF], "duplicate CPF %s", customer.CPF)
+		assert.False(suite.T(), emails[customer.Email], "duplicate email %s", customer.Email)
+		cpfs[customer.CPF] = true
+		emails[customer.Email] = true
+	}
+}
+
+// Scenario: The same seed gives the same customers
+
+func (suite *GeneratorTestSuite) Test_Generate_ShouldBeReproducible() {
+	// GIVEN two runs with the same seed, the second one larger
+	first := seed.Generate(suite.options(20))
+	second := seed.Generate(suite.options(30))
+
+	// THEN the first customers of the larger run should be the ones of the smaller run
+	assert.Equal(suite.T(), first, second[:20])
+}
+
+func (suite *GeneratorTestSuite) Test_Generate_ShouldDependOnTheSeed() {
+	// GIVEN two runs with different seeds
+	options := suite.options(5)
+	first := seed.Generate(options)
+	options.Seed++
+	second := seed.Generate(options)
+
+	// THEN the customers should differ
+	assert.NotEqual(suite.T(), first[0].CPF, second[0].CPF)
+}
+
+// Scenario: Orders and consents are generated only when asked for
+
+func (suite *GeneratorTestSuite) Test_Generate_ShouldSkipOrdersAndConsentsByDefault() {
+	// WHEN generating without orders or consents
+	customers := seed.Generate(seed.Options{Count: 10, Seed: seed.DefaultSeed, Now: suite.now})
+
+	// THEN no customer should have them
+	for _, customer := range customers {
+		assert.Empty(suite.T(), customer.Orders)
+		assert.Empty(suite.T(), customer.Consents)
+	}
+}
+
+func (suite *GeneratorTestSuite) Test_Generate_ShouldProducePlausibleOrdersAndConsents() {
+	// WHEN generating with orders and consents
+	customers := seed.Generate(suite.options(50))
+
+	// THEN orders should be paid within the look back, oldest first, with unique IDs
+	orderIDs := map[string]bool{}
+	orders, consents := 0, 0
+	for _, customer := range customers {
+		for i, order := range customer.Orders {
+			assert.False(suite.T(), orderIDs[order.ID])
+			orderIDs[order.ID] = true
+			assert.NotEmpty(suite.T(), order.PaymentID)
+			assert.NotEmpty(suite.T(), order.Items)
+			assert.Greater(suite.T(), order.Total, 0.0)
+			assert.False(suite.T(), order.CompletedAt.After(suite.now))
+			assert.False(suite.T(), order.CompletedAt.Before(suite.now.Add(-seed.LookBack)))
+			if i > 0 {
+				assert.False(suite.T(), order.CompletedAt.Before(customer.Orders[i-1].CompletedAt))
+			}
+		}
+		orders += len(customer.Orders)
+
+		// AND consents should answer valid purposes at most once each
+		purposes := map[consentEntities.Purpose]bool{}
+		for _, consent := range customer.Consents {
+			assert.True(suite.T(), consent.Purpose.Valid())
+			assert.True(suite.T(), consent.Source.Valid())
+			assert.Equal(suite.T(), seed.PolicyVersion, consent.PolicyVersion)
+			assert.False(suite.T(), purposes[consent.Purpose])
+			purposes[consent.Purpose] = true
+		}
+		consents += len(customer.Consents)
+	}
+	assert.Greater(suite.T(), orders, 0)
+	assert.Greater(suite.T(), consents, 0)
+}
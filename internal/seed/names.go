@@ -0,0 +1,39 @@
+package seed
+
+// firstNames and surnames are among the most common in Brazil, so generated customers look like
+// the ones the kiosk sees.
+var firstNames = []string{
+	"Maria", "Ana", "Francisca", "Antônia", "Adriana", "Juliana", "Márcia", "Fernanda", "Patrícia", "Aline",
+	"Camila", "Bruna", "Letícia", "Beatriz", "Larissa", "Gabriela", "Luana", "Mariana", "Vitória", "Júlia",
+	"José", "João", "Antônio", "Francisco", "Carlos", "Paulo", "Pedro", "Lucas", "Luiz", "Marcos",
+	"Luís", "Gabriel", "Rafael", "Daniel", "Marcelo", "Bruno", "Eduardo", "Felipe", "Rodrigo", "Gustavo",
+	"Matheus", "Thiago", "Leonardo", "Guilherme", "Vinícius", "André", "Diego", "Fábio", "Caio", "Heitor",
+}
+
+var surnames = []string{
+	"Silva", "Santos", "Oliveira", "Souza", "Rodrigues", "Ferreira", "Alves", "Pereira", "Lima", "Gomes",
+	"Costa", "Ribeiro", "Martins", "Carvalho", "Almeida", "Lopes", "Soares", "Fernandes", "Vieira", "Barbosa",
+	"Rocha", "Dias", "Nascimento", "Andrade", "Moreira", "Nunes", "Marques", "Machado", "Mendes", "Freitas",
+	"Cardoso", "Ramos", "Gonçalves", "Santana", "Teixeira", "Araújo", "Cavalcanti", "Monteiro", "Moura", "Correia",
+}
+
+// emailDomains are reserved for documentation, so seeded addresses never reach a real mailbox.
+var emailDomains = []string{"example.com", "example.org", "example.net"}
+
+// menu is what seeded orders are made of, with the unit price of each item.
+var menu = []menuItem{
+	{Name: "X-Burger", Price: 24.90},
+	{Name: "X-Salada", Price: 27.90},
+	{Name: "X-Bacon", Price: 31.90},
+	{Name: "Batata Frita", Price: 12.50},
+	{Name: "Onion Rings", Price: 14.90},
+	{Name: "Refrigerante", Price: 7.00},
+	{Name: "Suco Natural", Price: 9.50},
+	{Name: "Milk-shake", Price: 16.90},
+	{Name: "Sorvete", Price: 8.90},
+}
+
+type menuItem struct {
+	Name  string
+	Price float64
+}
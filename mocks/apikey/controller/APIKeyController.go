@@ -0,0 +1,209 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+)
+
+// MockAPIKeyController is an autogenerated mock type for the APIKeyController type
+type MockAPIKeyController struct {
+	mock.Mock
+}
+
+type MockAPIKeyController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyController) EXPECT() *MockAPIKeyController_Expecter {
+	return &MockAPIKeyController_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: request
+func (_m *MockAPIKeyController) Create(request *dto.CreateAPIKeyRequestDto) (*dto.APIKeySecretResponseDto, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *dto.APIKeySecretResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dto.CreateAPIKeyRequestDto) (*dto.APIKeySecretResponseDto, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.CreateAPIKeyRequestDto) *dto.APIKeySecretResponseDto); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.APIKeySecretResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.CreateAPIKeyRequestDto) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyController_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockAPIKeyController_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request *dto.CreateAPIKeyRequestDto
+func (_e *MockAPIKeyController_Expecter) Create(request interface{}) *MockAPIKeyController_Create_Call {
+	return &MockAPIKeyController_Create_Call{Call: _e.mock.On("Create", request)}
+}
+
+func (_c *MockAPIKeyController_Create_Call) Run(run func(request *dto.CreateAPIKeyRequestDto)) *MockAPIKeyController_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.CreateAPIKeyRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyController_Create_Call) Return(_a0 *dto.APIKeySecretResponseDto, _a1 error) *MockAPIKeyController_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyController_Create_Call) RunAndReturn(run func(*dto.CreateAPIKeyRequestDto) (*dto.APIKeySecretResponseDto, error)) *MockAPIKeyController_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: id
+func (_m *MockAPIKeyController) Revoke(id string) (*dto.APIKeyResponseDto, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 *dto.APIKeyResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dto.APIKeyResponseDto, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dto.APIKeyResponseDto); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.APIKeyResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyController_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyController_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - id string
+func (_e *MockAPIKeyController_Expecter) Revoke(id interface{}) *MockAPIKeyController_Revoke_Call {
+	return &MockAPIKeyController_Revoke_Call{Call: _e.mock.On("Revoke", id)}
+}
+
+func (_c *MockAPIKeyController_Revoke_Call) Run(run func(id string)) *MockAPIKeyController_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyController_Revoke_Call) Return(_a0 *dto.APIKeyResponseDto, _a1 error) *MockAPIKeyController_Revoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyController_Revoke_Call) RunAndReturn(run func(string) (*dto.APIKeyResponseDto, error)) *MockAPIKeyController_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields: id
+func (_m *MockAPIKeyController) Rotate(id string) (*dto.APIKeySecretResponseDto, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 *dto.APIKeySecretResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dto.APIKeySecretResponseDto, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dto.APIKeySecretResponseDto); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.APIKeySecretResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyController_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockAPIKeyController_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - id string
+func (_e *MockAPIKeyController_Expecter) Rotate(id interface{}) *MockAPIKeyController_Rotate_Call {
+	return &MockAPIKeyController_Rotate_Call{Call: _e.mock.On("Rotate", id)}
+}
+
+func (_c *MockAPIKeyController_Rotate_Call) Run(run func(id string)) *MockAPIKeyController_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyController_Rotate_Call) Return(_a0 *dto.APIKeySecretResponseDto, _a1 error) *MockAPIKeyController_Rotate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyController_Rotate_Call) RunAndReturn(run func(string) (*dto.APIKeySecretResponseDto, error)) *MockAPIKeyController_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyController creates a new instance of MockAPIKeyController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyController {
+	mock := &MockAPIKeyController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,260 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+
+	time "time"
+)
+
+// MockAPIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+type MockAPIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepository_Expecter {
+	return &MockAPIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: apiKey
+func (_m *MockAPIKeyRepository) Add(apiKey *entities.APIKey) error {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.APIKey) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyRepository_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockAPIKeyRepository_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - apiKey *entities.APIKey
+func (_e *MockAPIKeyRepository_Expecter) Add(apiKey interface{}) *MockAPIKeyRepository_Add_Call {
+	return &MockAPIKeyRepository_Add_Call{Call: _e.mock.On("Add", apiKey)}
+}
+
+func (_c *MockAPIKeyRepository_Add_Call) Run(run func(apiKey *entities.APIKey)) *MockAPIKeyRepository_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.APIKey))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Add_Call) Return(_a0 error) *MockAPIKeyRepository_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Add_Call) RunAndReturn(run func(*entities.APIKey) error) *MockAPIKeyRepository_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: id
+func (_m *MockAPIKeyRepository) GetByID(id string) (*entities.APIKey, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entities.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.APIKey, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.APIKey); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockAPIKeyRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockAPIKeyRepository_Expecter) GetByID(id interface{}) *MockAPIKeyRepository_GetByID_Call {
+	return &MockAPIKeyRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) Run(run func(id string)) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) Return(_a0 *entities.APIKey, _a1 error) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetByID_Call) RunAndReturn(run func(string) (*entities.APIKey, error)) *MockAPIKeyRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: id, revokedAt
+func (_m *MockAPIKeyRepository) Revoke(id string, revokedAt time.Time) (*entities.APIKey, error) {
+	ret := _m.Called(id, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 *entities.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*entities.APIKey, error)); ok {
+		return rf(id, revokedAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *entities.APIKey); ok {
+		r0 = rf(id, revokedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(id, revokedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockAPIKeyRepository_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - id string
+//   - revokedAt time.Time
+func (_e *MockAPIKeyRepository_Expecter) Revoke(id interface{}, revokedAt interface{}) *MockAPIKeyRepository_Revoke_Call {
+	return &MockAPIKeyRepository_Revoke_Call{Call: _e.mock.On("Revoke", id, revokedAt)}
+}
+
+func (_c *MockAPIKeyRepository_Revoke_Call) Run(run func(id string, revokedAt time.Time)) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Revoke_Call) Return(_a0 *entities.APIKey, _a1 error) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Revoke_Call) RunAndReturn(run func(string, time.Time) (*entities.APIKey, error)) *MockAPIKeyRepository_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function with given fields: id, keyHash, rotatedAt
+func (_m *MockAPIKeyRepository) Rotate(id string, keyHash string, rotatedAt time.Time) (*entities.APIKey, error) {
+	ret := _m.Called(id, keyHash, rotatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 *entities.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) (*entities.APIKey, error)); ok {
+		return rf(id, keyHash, rotatedAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) *entities.APIKey); ok {
+		r0 = rf(id, keyHash, rotatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(id, keyHash, rotatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockAPIKeyRepository_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - id string
+//   - keyHash string
+//   - rotatedAt time.Time
+func (_e *MockAPIKeyRepository_Expecter) Rotate(id interface{}, keyHash interface{}, rotatedAt interface{}) *MockAPIKeyRepository_Rotate_Call {
+	return &MockAPIKeyRepository_Rotate_Call{Call: _e.mock.On("Rotate", id, keyHash, rotatedAt)}
+}
+
+func (_c *MockAPIKeyRepository_Rotate_Call) Run(run func(id string, keyHash string, rotatedAt time.Time)) *MockAPIKeyRepository_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Rotate_Call) Return(_a0 *entities.APIKey, _a1 error) *MockAPIKeyRepository_Rotate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_Rotate_Call) RunAndReturn(run func(string, string, time.Time) (*entities.APIKey, error)) *MockAPIKeyRepository_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyRepository creates a new instance of MockAPIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
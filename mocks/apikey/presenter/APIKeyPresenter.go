@@ -0,0 +1,134 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyPresenter is an autogenerated mock type for the APIKeyPresenter type
+type MockAPIKeyPresenter struct {
+	mock.Mock
+}
+
+type MockAPIKeyPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyPresenter) EXPECT() *MockAPIKeyPresenter_Expecter {
+	return &MockAPIKeyPresenter_Expecter{mock: &_m.Mock}
+}
+
+// Present provides a mock function with given fields: apiKey
+func (_m *MockAPIKeyPresenter) Present(apiKey *entities.APIKey) *dto.APIKeyResponseDto {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Present")
+	}
+
+	var r0 *dto.APIKeyResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.APIKey) *dto.APIKeyResponseDto); ok {
+		r0 = rf(apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.APIKeyResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockAPIKeyPresenter_Present_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Present'
+type MockAPIKeyPresenter_Present_Call struct {
+	*mock.Call
+}
+
+// Present is a helper method to define mock.On call
+//   - apiKey *entities.APIKey
+func (_e *MockAPIKeyPresenter_Expecter) Present(apiKey interface{}) *MockAPIKeyPresenter_Present_Call {
+	return &MockAPIKeyPresenter_Present_Call{Call: _e.mock.On("Present", apiKey)}
+}
+
+func (_c *MockAPIKeyPresenter_Present_Call) Run(run func(apiKey *entities.APIKey)) *MockAPIKeyPresenter_Present_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.APIKey))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyPresenter_Present_Call) Return(_a0 *dto.APIKeyResponseDto) *MockAPIKeyPresenter_Present_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyPresenter_Present_Call) RunAndReturn(run func(*entities.APIKey) *dto.APIKeyResponseDto) *MockAPIKeyPresenter_Present_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentSecret provides a mock function with given fields: apiKey, key
+func (_m *MockAPIKeyPresenter) PresentSecret(apiKey *entities.APIKey, key string) *dto.APIKeySecretResponseDto {
+	ret := _m.Called(apiKey, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentSecret")
+	}
+
+	var r0 *dto.APIKeySecretResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.APIKey, string) *dto.APIKeySecretResponseDto); ok {
+		r0 = rf(apiKey, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.APIKeySecretResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockAPIKeyPresenter_PresentSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentSecret'
+type MockAPIKeyPresenter_PresentSecret_Call struct {
+	*mock.Call
+}
+
+// PresentSecret is a helper method to define mock.On call
+//   - apiKey *entities.APIKey
+//   - key string
+func (_e *MockAPIKeyPresenter_Expecter) PresentSecret(apiKey interface{}, key interface{}) *MockAPIKeyPresenter_PresentSecret_Call {
+	return &MockAPIKeyPresenter_PresentSecret_Call{Call: _e.mock.On("PresentSecret", apiKey, key)}
+}
+
+func (_c *MockAPIKeyPresenter_PresentSecret_Call) Run(run func(apiKey *entities.APIKey, key string)) *MockAPIKeyPresenter_PresentSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.APIKey), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyPresenter_PresentSecret_Call) Return(_a0 *dto.APIKeySecretResponseDto) *MockAPIKeyPresenter_PresentSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyPresenter_PresentSecret_Call) RunAndReturn(run func(*entities.APIKey, string) *dto.APIKeySecretResponseDto) *MockAPIKeyPresenter_PresentSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyPresenter creates a new instance of MockAPIKeyPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyPresenter {
+	mock := &MockAPIKeyPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,103 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCreateAPIKeyUseCase is an autogenerated mock type for the CreateAPIKeyUseCase type
+type MockCreateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+type MockCreateAPIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCreateAPIKeyUseCase) EXPECT() *MockCreateAPIKeyUseCase_Expecter {
+	return &MockCreateAPIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockCreateAPIKeyUseCase) Execute(command *commands.CreateAPIKeyCommand) (*entities.APIKey, string, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.APIKey
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*commands.CreateAPIKeyCommand) (*entities.APIKey, string, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.CreateAPIKeyCommand) *entities.APIKey); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.CreateAPIKeyCommand) string); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(*commands.CreateAPIKeyCommand) error); ok {
+		r2 = rf(command)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCreateAPIKeyUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockCreateAPIKeyUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.CreateAPIKeyCommand
+func (_e *MockCreateAPIKeyUseCase_Expecter) Execute(command interface{}) *MockCreateAPIKeyUseCase_Execute_Call {
+	return &MockCreateAPIKeyUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockCreateAPIKeyUseCase_Execute_Call) Run(run func(command *commands.CreateAPIKeyCommand)) *MockCreateAPIKeyUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.CreateAPIKeyCommand))
+	})
+	return _c
+}
+
+func (_c *MockCreateAPIKeyUseCase_Execute_Call) Return(_a0 *entities.APIKey, _a1 string, _a2 error) *MockCreateAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCreateAPIKeyUseCase_Execute_Call) RunAndReturn(run func(*commands.CreateAPIKeyCommand) (*entities.APIKey, string, error)) *MockCreateAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCreateAPIKeyUseCase creates a new instance of MockCreateAPIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCreateAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCreateAPIKeyUseCase {
+	mock := &MockCreateAPIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRotateAPIKeyUseCase is an autogenerated mock type for the RotateAPIKeyUseCase type
+type MockRotateAPIKeyUseCase struct {
+	mock.Mock
+}
+
+type MockRotateAPIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRotateAPIKeyUseCase) EXPECT() *MockRotateAPIKeyUseCase_Expecter {
+	return &MockRotateAPIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockRotateAPIKeyUseCase) Execute(command *commands.RotateAPIKeyCommand) (*entities.APIKey, string, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.APIKey
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*commands.RotateAPIKeyCommand) (*entities.APIKey, string, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.RotateAPIKeyCommand) *entities.APIKey); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.RotateAPIKeyCommand) string); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(*commands.RotateAPIKeyCommand) error); ok {
+		r2 = rf(command)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRotateAPIKeyUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRotateAPIKeyUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.RotateAPIKeyCommand
+func (_e *MockRotateAPIKeyUseCase_Expecter) Execute(command interface{}) *MockRotateAPIKeyUseCase_Execute_Call {
+	return &MockRotateAPIKeyUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockRotateAPIKeyUseCase_Execute_Call) Run(run func(command *commands.RotateAPIKeyCommand)) *MockRotateAPIKeyUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.RotateAPIKeyCommand))
+	})
+	return _c
+}
+
+func (_c *MockRotateAPIKeyUseCase_Execute_Call) Return(_a0 *entities.APIKey, _a1 string, _a2 error) *MockRotateAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRotateAPIKeyUseCase_Execute_Call) RunAndReturn(run func(*commands.RotateAPIKeyCommand) (*entities.APIKey, string, error)) *MockRotateAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRotateAPIKeyUseCase creates a new instance of MockRotateAPIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRotateAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRotateAPIKeyUseCase {
+	mock := &MockRotateAPIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/apikey/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockVerifyAPIKeyUseCase is an autogenerated mock type for the VerifyAPIKeyUseCase type
+type MockVerifyAPIKeyUseCase struct {
+	mock.Mock
+}
+
+type MockVerifyAPIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVerifyAPIKeyUseCase) EXPECT() *MockVerifyAPIKeyUseCase_Expecter {
+	return &MockVerifyAPIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockVerifyAPIKeyUseCase) Execute(command *commands.VerifyAPIKeyCommand) (*entities.APIKey, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.VerifyAPIKeyCommand) (*entities.APIKey, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.VerifyAPIKeyCommand) *entities.APIKey); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.VerifyAPIKeyCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVerifyAPIKeyUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockVerifyAPIKeyUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.VerifyAPIKeyCommand
+func (_e *MockVerifyAPIKeyUseCase_Expecter) Execute(command interface{}) *MockVerifyAPIKeyUseCase_Execute_Call {
+	return &MockVerifyAPIKeyUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockVerifyAPIKeyUseCase_Execute_Call) Run(run func(command *commands.VerifyAPIKeyCommand)) *MockVerifyAPIKeyUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.VerifyAPIKeyCommand))
+	})
+	return _c
+}
+
+func (_c *MockVerifyAPIKeyUseCase_Execute_Call) Return(_a0 *entities.APIKey, _a1 error) *MockVerifyAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVerifyAPIKeyUseCase_Execute_Call) RunAndReturn(run func(*commands.VerifyAPIKeyCommand) (*entities.APIKey, error)) *MockVerifyAPIKeyUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVerifyAPIKeyUseCase creates a new instance of MockVerifyAPIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVerifyAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVerifyAPIKeyUseCase {
+	mock := &MockVerifyAPIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+)
+
+// MockAuditController is an autogenerated mock type for the AuditController type
+type MockAuditController struct {
+	mock.Mock
+}
+
+type MockAuditController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditController) EXPECT() *MockAuditController_Expecter {
+	return &MockAuditController_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function with given fields: customerID, actor, limit, cursor
+func (_m *MockAuditController) List(customerID string, actor string, limit int, cursor string) (*dto.AuditEntriesResponseDto, error) {
+	ret := _m.Called(customerID, actor, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *dto.AuditEntriesResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, string) (*dto.AuditEntriesResponseDto, error)); ok {
+		return rf(customerID, actor, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, string) *dto.AuditEntriesResponseDto); ok {
+		r0 = rf(customerID, actor, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AuditEntriesResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, string) error); ok {
+		r1 = rf(customerID, actor, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuditController_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditController_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - customerID string
+//   - actor string
+//   - limit int
+//   - cursor string
+func (_e *MockAuditController_Expecter) List(customerID interface{}, actor interface{}, limit interface{}, cursor interface{}) *MockAuditController_List_Call {
+	return &MockAuditController_List_Call{Call: _e.mock.On("List", customerID, actor, limit, cursor)}
+}
+
+func (_c *MockAuditController_List_Call) Run(run func(customerID string, actor string, limit int, cursor string)) *MockAuditController_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuditController_List_Call) Return(_a0 *dto.AuditEntriesResponseDto, _a1 error) *MockAuditController_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuditController_List_Call) RunAndReturn(run func(string, string, int, string) (*dto.AuditEntriesResponseDto, error)) *MockAuditController_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditController creates a new instance of MockAuditController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditController {
+	mock := &MockAuditController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/audit/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuditPresenter is an autogenerated mock type for the AuditPresenter type
+type MockAuditPresenter struct {
+	mock.Mock
+}
+
+type MockAuditPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditPresenter) EXPECT() *MockAuditPresenter_Expecter {
+	return &MockAuditPresenter_Expecter{mock: &_m.Mock}
+}
+
+// Present provides a mock function with given fields: page
+func (_m *MockAuditPresenter) Present(page *entities.AuditPage) *dto.AuditEntriesResponseDto {
+	ret := _m.Called(page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Present")
+	}
+
+	var r0 *dto.AuditEntriesResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.AuditPage) *dto.AuditEntriesResponseDto); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.AuditEntriesResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockAuditPresenter_Present_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Present'
+type MockAuditPresenter_Present_Call struct {
+	*mock.Call
+}
+
+// Present is a helper method to define mock.On call
+//   - page *entities.AuditPage
+func (_e *MockAuditPresenter_Expecter) Present(page interface{}) *MockAuditPresenter_Present_Call {
+	return &MockAuditPresenter_Present_Call{Call: _e.mock.On("Present", page)}
+}
+
+func (_c *MockAuditPresenter_Present_Call) Run(run func(page *entities.AuditPage)) *MockAuditPresenter_Present_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.AuditPage))
+	})
+	return _c
+}
+
+func (_c *MockAuditPresenter_Present_Call) Return(_a0 *dto.AuditEntriesResponseDto) *MockAuditPresenter_Present_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuditPresenter_Present_Call) RunAndReturn(run func(*entities.AuditPage) *dto.AuditEntriesResponseDto) *MockAuditPresenter_Present_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditPresenter creates a new instance of MockAuditPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditPresenter {
+	mock := &MockAuditPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListAuditEntriesUseCase is an autogenerated mock type for the ListAuditEntriesUseCase type
+type MockListAuditEntriesUseCase struct {
+	mock.Mock
+}
+
+type MockListAuditEntriesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListAuditEntriesUseCase) EXPECT() *MockListAuditEntriesUseCase_Expecter {
+	return &MockListAuditEntriesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListAuditEntriesUseCase) Execute(command *commands.ListAuditEntriesCommand) (*entities.AuditPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.AuditPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListAuditEntriesCommand) (*entities.AuditPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListAuditEntriesCommand) *entities.AuditPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListAuditEntriesCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListAuditEntriesUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListAuditEntriesUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListAuditEntriesCommand
+func (_e *MockListAuditEntriesUseCase_Expecter) Execute(command interface{}) *MockListAuditEntriesUseCase_Execute_Call {
+	return &MockListAuditEntriesUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListAuditEntriesUseCase_Execute_Call) Run(run func(command *commands.ListAuditEntriesCommand)) *MockListAuditEntriesUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListAuditEntriesCommand))
+	})
+	return _c
+}
+
+func (_c *MockListAuditEntriesUseCase_Execute_Call) Return(_a0 *entities.AuditPage, _a1 error) *MockListAuditEntriesUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListAuditEntriesUseCase_Execute_Call) RunAndReturn(run func(*commands.ListAuditEntriesCommand) (*entities.AuditPage, error)) *MockListAuditEntriesUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListAuditEntriesUseCase creates a new instance of MockListAuditEntriesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListAuditEntriesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListAuditEntriesUseCase {
+	mock := &MockListAuditEntriesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
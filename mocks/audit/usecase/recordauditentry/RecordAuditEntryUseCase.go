@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/audit/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/audit/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecordAuditEntryUseCase is an autogenerated mock type for the RecordAuditEntryUseCase type
+type MockRecordAuditEntryUseCase struct {
+	mock.Mock
+}
+
+type MockRecordAuditEntryUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordAuditEntryUseCase) EXPECT() *MockRecordAuditEntryUseCase_Expecter {
+	return &MockRecordAuditEntryUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockRecordAuditEntryUseCase) Execute(command *commands.RecordAuditEntryCommand) (*entities.AuditEntry, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.RecordAuditEntryCommand) (*entities.AuditEntry, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.RecordAuditEntryCommand) *entities.AuditEntry); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.RecordAuditEntryCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRecordAuditEntryUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRecordAuditEntryUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.RecordAuditEntryCommand
+func (_e *MockRecordAuditEntryUseCase_Expecter) Execute(command interface{}) *MockRecordAuditEntryUseCase_Execute_Call {
+	return &MockRecordAuditEntryUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockRecordAuditEntryUseCase_Execute_Call) Run(run func(command *commands.RecordAuditEntryCommand)) *MockRecordAuditEntryUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.RecordAuditEntryCommand))
+	})
+	return _c
+}
+
+func (_c *MockRecordAuditEntryUseCase_Execute_Call) Return(_a0 *entities.AuditEntry, _a1 error) *MockRecordAuditEntryUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRecordAuditEntryUseCase_Execute_Call) RunAndReturn(run func(*commands.RecordAuditEntryCommand) (*entities.AuditEntry, error)) *MockRecordAuditEntryUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordAuditEntryUseCase creates a new instance of MockRecordAuditEntryUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordAuditEntryUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordAuditEntryUseCase {
+	mock := &MockRecordAuditEntryUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
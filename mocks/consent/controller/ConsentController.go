@@ -0,0 +1,338 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	audit "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConsentController is an autogenerated mock type for the ConsentController type
+type MockConsentController struct {
+	mock.Mock
+}
+
+type MockConsentController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConsentController) EXPECT() *MockConsentController_Expecter {
+	return &MockConsentController_Expecter{mock: &_m.Mock}
+}
+
+// GetConsents provides a mock function with given fields: customerID
+func (_m *MockConsentController) GetConsents(customerID string) (*dto.ConsentsResponseDto, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsents")
+	}
+
+	var r0 *dto.ConsentsResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dto.ConsentsResponseDto, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dto.ConsentsResponseDto); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentsResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentController_GetConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsents'
+type MockConsentController_GetConsents_Call struct {
+	*mock.Call
+}
+
+// GetConsents is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockConsentController_Expecter) GetConsents(customerID interface{}) *MockConsentController_GetConsents_Call {
+	return &MockConsentController_GetConsents_Call{Call: _e.mock.On("GetConsents", customerID)}
+}
+
+func (_c *MockConsentController_GetConsents_Call) Run(run func(customerID string)) *MockConsentController_GetConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentController_GetConsents_Call) Return(_a0 *dto.ConsentsResponseDto, _a1 error) *MockConsentController_GetConsents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentController_GetConsents_Call) RunAndReturn(run func(string) (*dto.ConsentsResponseDto, error)) *MockConsentController_GetConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Grant provides a mock function with given fields: customerID, purpose, request, actor
+func (_m *MockConsentController) Grant(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error) {
+	ret := _m.Called(customerID, purpose, request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Grant")
+	}
+
+	var r0 *dto.ConsentRecordResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *dto.ConsentRequestDto, audit.Actor) (*dto.ConsentRecordResponseDto, error)); ok {
+		return rf(customerID, purpose, request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *dto.ConsentRequestDto, audit.Actor) *dto.ConsentRecordResponseDto); ok {
+		r0 = rf(customerID, purpose, request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentRecordResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *dto.ConsentRequestDto, audit.Actor) error); ok {
+		r1 = rf(customerID, purpose, request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentController_Grant_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Grant'
+type MockConsentController_Grant_Call struct {
+	*mock.Call
+}
+
+// Grant is a helper method to define mock.On call
+//   - customerID string
+//   - purpose string
+//   - request *dto.ConsentRequestDto
+//   - actor audit.Actor
+func (_e *MockConsentController_Expecter) Grant(customerID interface{}, purpose interface{}, request interface{}, actor interface{}) *MockConsentController_Grant_Call {
+	return &MockConsentController_Grant_Call{Call: _e.mock.On("Grant", customerID, purpose, request, actor)}
+}
+
+func (_c *MockConsentController_Grant_Call) Run(run func(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor)) *MockConsentController_Grant_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(*dto.ConsentRequestDto), args[3].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockConsentController_Grant_Call) Return(_a0 *dto.ConsentRecordResponseDto, _a1 error) *MockConsentController_Grant_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentController_Grant_Call) RunAndReturn(run func(string, string, *dto.ConsentRequestDto, audit.Actor) (*dto.ConsentRecordResponseDto, error)) *MockConsentController_Grant_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHistory provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockConsentController) ListHistory(customerID string, limit int, cursor string) (*dto.ConsentHistoryResponseDto, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 *dto.ConsentHistoryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*dto.ConsentHistoryResponseDto, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *dto.ConsentHistoryResponseDto); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentHistoryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentController_ListHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistory'
+type MockConsentController_ListHistory_Call struct {
+	*mock.Call
+}
+
+// ListHistory is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockConsentController_Expecter) ListHistory(customerID interface{}, limit interface{}, cursor interface{}) *MockConsentController_ListHistory_Call {
+	return &MockConsentController_ListHistory_Call{Call: _e.mock.On("ListHistory", customerID, limit, cursor)}
+}
+
+func (_c *MockConsentController_ListHistory_Call) Run(run func(customerID string, limit int, cursor string)) *MockConsentController_ListHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentController_ListHistory_Call) Return(_a0 *dto.ConsentHistoryResponseDto, _a1 error) *MockConsentController_ListHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentController_ListHistory_Call) RunAndReturn(run func(string, int, string) (*dto.ConsentHistoryResponseDto, error)) *MockConsentController_ListHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOptedIn provides a mock function with given fields: purpose, limit, cursor
+func (_m *MockConsentController) ListOptedIn(purpose string, limit int, cursor string) (*dto.OptedInResponseDto, error) {
+	ret := _m.Called(purpose, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOptedIn")
+	}
+
+	var r0 *dto.OptedInResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*dto.OptedInResponseDto, error)); ok {
+		return rf(purpose, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *dto.OptedInResponseDto); ok {
+		r0 = rf(purpose, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OptedInResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(purpose, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentController_ListOptedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOptedIn'
+type MockConsentController_ListOptedIn_Call struct {
+	*mock.Call
+}
+
+// ListOptedIn is a helper method to define mock.On call
+//   - purpose string
+//   - limit int
+//   - cursor string
+func (_e *MockConsentController_Expecter) ListOptedIn(purpose interface{}, limit interface{}, cursor interface{}) *MockConsentController_ListOptedIn_Call {
+	return &MockConsentController_ListOptedIn_Call{Call: _e.mock.On("ListOptedIn", purpose, limit, cursor)}
+}
+
+func (_c *MockConsentController_ListOptedIn_Call) Run(run func(purpose string, limit int, cursor string)) *MockConsentController_ListOptedIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentController_ListOptedIn_Call) Return(_a0 *dto.OptedInResponseDto, _a1 error) *MockConsentController_ListOptedIn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentController_ListOptedIn_Call) RunAndReturn(run func(string, int, string) (*dto.OptedInResponseDto, error)) *MockConsentController_ListOptedIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: customerID, purpose, request, actor
+func (_m *MockConsentController) Revoke(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor) (*dto.ConsentRecordResponseDto, error) {
+	ret := _m.Called(customerID, purpose, request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 *dto.ConsentRecordResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *dto.ConsentRequestDto, audit.Actor) (*dto.ConsentRecordResponseDto, error)); ok {
+		return rf(customerID, purpose, request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *dto.ConsentRequestDto, audit.Actor) *dto.ConsentRecordResponseDto); ok {
+		r0 = rf(customerID, purpose, request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentRecordResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *dto.ConsentRequestDto, audit.Actor) error); ok {
+		r1 = rf(customerID, purpose, request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentController_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockConsentController_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - customerID string
+//   - purpose string
+//   - request *dto.ConsentRequestDto
+//   - actor audit.Actor
+func (_e *MockConsentController_Expecter) Revoke(customerID interface{}, purpose interface{}, request interface{}, actor interface{}) *MockConsentController_Revoke_Call {
+	return &MockConsentController_Revoke_Call{Call: _e.mock.On("Revoke", customerID, purpose, request, actor)}
+}
+
+func (_c *MockConsentController_Revoke_Call) Run(run func(customerID string, purpose string, request *dto.ConsentRequestDto, actor audit.Actor)) *MockConsentController_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(*dto.ConsentRequestDto), args[3].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockConsentController_Revoke_Call) Return(_a0 *dto.ConsentRecordResponseDto, _a1 error) *MockConsentController_Revoke_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentController_Revoke_Call) RunAndReturn(run func(string, string, *dto.ConsentRequestDto, audit.Actor) (*dto.ConsentRecordResponseDto, error)) *MockConsentController_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConsentController creates a new instance of MockConsentController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConsentController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConsentController {
+	mock := &MockConsentController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
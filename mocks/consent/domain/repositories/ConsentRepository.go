@@ -0,0 +1,259 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+)
+
+// MockConsentRepository is an autogenerated mock type for the ConsentRepository type
+type MockConsentRepository struct {
+	mock.Mock
+}
+
+type MockConsentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConsentRepository) EXPECT() *MockConsentRepository_Expecter {
+	return &MockConsentRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetCurrent provides a mock function with given fields: customerID
+func (_m *MockConsentRepository) GetCurrent(customerID string) ([]*entities.ConsentRecord, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrent")
+	}
+
+	var r0 []*entities.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.ConsentRecord, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.ConsentRecord); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentRepository_GetCurrent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrent'
+type MockConsentRepository_GetCurrent_Call struct {
+	*mock.Call
+}
+
+// GetCurrent is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockConsentRepository_Expecter) GetCurrent(customerID interface{}) *MockConsentRepository_GetCurrent_Call {
+	return &MockConsentRepository_GetCurrent_Call{Call: _e.mock.On("GetCurrent", customerID)}
+}
+
+func (_c *MockConsentRepository_GetCurrent_Call) Run(run func(customerID string)) *MockConsentRepository_GetCurrent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentRepository_GetCurrent_Call) Return(_a0 []*entities.ConsentRecord, _a1 error) *MockConsentRepository_GetCurrent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentRepository_GetCurrent_Call) RunAndReturn(run func(string) ([]*entities.ConsentRecord, error)) *MockConsentRepository_GetCurrent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHistory provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockConsentRepository) ListHistory(customerID string, limit int, cursor string) (*entities.ConsentHistoryPage, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 *entities.ConsentHistoryPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*entities.ConsentHistoryPage, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *entities.ConsentHistoryPage); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsentHistoryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentRepository_ListHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistory'
+type MockConsentRepository_ListHistory_Call struct {
+	*mock.Call
+}
+
+// ListHistory is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockConsentRepository_Expecter) ListHistory(customerID interface{}, limit interface{}, cursor interface{}) *MockConsentRepository_ListHistory_Call {
+	return &MockConsentRepository_ListHistory_Call{Call: _e.mock.On("ListHistory", customerID, limit, cursor)}
+}
+
+func (_c *MockConsentRepository_ListHistory_Call) Run(run func(customerID string, limit int, cursor string)) *MockConsentRepository_ListHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentRepository_ListHistory_Call) Return(_a0 *entities.ConsentHistoryPage, _a1 error) *MockConsentRepository_ListHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentRepository_ListHistory_Call) RunAndReturn(run func(string, int, string) (*entities.ConsentHistoryPage, error)) *MockConsentRepository_ListHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOptedIn provides a mock function with given fields: purpose, limit, cursor
+func (_m *MockConsentRepository) ListOptedIn(purpose entities.Purpose, limit int, cursor string) (*entities.OptedInPage, error) {
+	ret := _m.Called(purpose, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOptedIn")
+	}
+
+	var r0 *entities.OptedInPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(entities.Purpose, int, string) (*entities.OptedInPage, error)); ok {
+		return rf(purpose, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(entities.Purpose, int, string) *entities.OptedInPage); ok {
+		r0 = rf(purpose, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptedInPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(entities.Purpose, int, string) error); ok {
+		r1 = rf(purpose, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockConsentRepository_ListOptedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOptedIn'
+type MockConsentRepository_ListOptedIn_Call struct {
+	*mock.Call
+}
+
+// ListOptedIn is a helper method to define mock.On call
+//   - purpose entities.Purpose
+//   - limit int
+//   - cursor string
+func (_e *MockConsentRepository_Expecter) ListOptedIn(purpose interface{}, limit interface{}, cursor interface{}) *MockConsentRepository_ListOptedIn_Call {
+	return &MockConsentRepository_ListOptedIn_Call{Call: _e.mock.On("ListOptedIn", purpose, limit, cursor)}
+}
+
+func (_c *MockConsentRepository_ListOptedIn_Call) Run(run func(purpose entities.Purpose, limit int, cursor string)) *MockConsentRepository_ListOptedIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entities.Purpose), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockConsentRepository_ListOptedIn_Call) Return(_a0 *entities.OptedInPage, _a1 error) *MockConsentRepository_ListOptedIn_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockConsentRepository_ListOptedIn_Call) RunAndReturn(run func(entities.Purpose, int, string) (*entities.OptedInPage, error)) *MockConsentRepository_ListOptedIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: record
+func (_m *MockConsentRepository) Record(record *entities.ConsentRecord) error {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.ConsentRecord) error); ok {
+		r0 = rf(record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockConsentRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockConsentRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - record *entities.ConsentRecord
+func (_e *MockConsentRepository_Expecter) Record(record interface{}) *MockConsentRepository_Record_Call {
+	return &MockConsentRepository_Record_Call{Call: _e.mock.On("Record", record)}
+}
+
+func (_c *MockConsentRepository_Record_Call) Run(run func(record *entities.ConsentRecord)) *MockConsentRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.ConsentRecord))
+	})
+	return _c
+}
+
+func (_c *MockConsentRepository_Record_Call) Return(_a0 error) *MockConsentRepository_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsentRepository_Record_Call) RunAndReturn(run func(*entities.ConsentRecord) error) *MockConsentRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConsentRepository creates a new instance of MockConsentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConsentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConsentRepository {
+	mock := &MockConsentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
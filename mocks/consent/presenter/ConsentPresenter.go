@@ -0,0 +1,231 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/consent/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockConsentPresenter is an autogenerated mock type for the ConsentPresenter type
+type MockConsentPresenter struct {
+	mock.Mock
+}
+
+type MockConsentPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockConsentPresenter) EXPECT() *MockConsentPresenter_Expecter {
+	return &MockConsentPresenter_Expecter{mock: &_m.Mock}
+}
+
+// PresentConsents provides a mock function with given fields: customerID, consents
+func (_m *MockConsentPresenter) PresentConsents(customerID string, consents []*entities.ConsentRecord) *dto.ConsentsResponseDto {
+	ret := _m.Called(customerID, consents)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentConsents")
+	}
+
+	var r0 *dto.ConsentsResponseDto
+	if rf, ok := ret.Get(0).(func(string, []*entities.ConsentRecord) *dto.ConsentsResponseDto); ok {
+		r0 = rf(customerID, consents)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentsResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockConsentPresenter_PresentConsents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentConsents'
+type MockConsentPresenter_PresentConsents_Call struct {
+	*mock.Call
+}
+
+// PresentConsents is a helper method to define mock.On call
+//   - customerID string
+//   - consents []*entities.ConsentRecord
+func (_e *MockConsentPresenter_Expecter) PresentConsents(customerID interface{}, consents interface{}) *MockConsentPresenter_PresentConsents_Call {
+	return &MockConsentPresenter_PresentConsents_Call{Call: _e.mock.On("PresentConsents", customerID, consents)}
+}
+
+func (_c *MockConsentPresenter_PresentConsents_Call) Run(run func(customerID string, consents []*entities.ConsentRecord)) *MockConsentPresenter_PresentConsents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]*entities.ConsentRecord))
+	})
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentConsents_Call) Return(_a0 *dto.ConsentsResponseDto) *MockConsentPresenter_PresentConsents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentConsents_Call) RunAndReturn(run func(string, []*entities.ConsentRecord) *dto.ConsentsResponseDto) *MockConsentPresenter_PresentConsents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentHistory provides a mock function with given fields: page
+func (_m *MockConsentPresenter) PresentHistory(page *entities.ConsentHistoryPage) *dto.ConsentHistoryResponseDto {
+	ret := _m.Called(page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentHistory")
+	}
+
+	var r0 *dto.ConsentHistoryResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.ConsentHistoryPage) *dto.ConsentHistoryResponseDto); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentHistoryResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockConsentPresenter_PresentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentHistory'
+type MockConsentPresenter_PresentHistory_Call struct {
+	*mock.Call
+}
+
+// PresentHistory is a helper method to define mock.On call
+//   - page *entities.ConsentHistoryPage
+func (_e *MockConsentPresenter_Expecter) PresentHistory(page interface{}) *MockConsentPresenter_PresentHistory_Call {
+	return &MockConsentPresenter_PresentHistory_Call{Call: _e.mock.On("PresentHistory", page)}
+}
+
+func (_c *MockConsentPresenter_PresentHistory_Call) Run(run func(page *entities.ConsentHistoryPage)) *MockConsentPresenter_PresentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.ConsentHistoryPage))
+	})
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentHistory_Call) Return(_a0 *dto.ConsentHistoryResponseDto) *MockConsentPresenter_PresentHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentHistory_Call) RunAndReturn(run func(*entities.ConsentHistoryPage) *dto.ConsentHistoryResponseDto) *MockConsentPresenter_PresentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentOptedIn provides a mock function with given fields: purpose, page
+func (_m *MockConsentPresenter) PresentOptedIn(purpose entities.Purpose, page *entities.OptedInPage) *dto.OptedInResponseDto {
+	ret := _m.Called(purpose, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentOptedIn")
+	}
+
+	var r0 *dto.OptedInResponseDto
+	if rf, ok := ret.Get(0).(func(entities.Purpose, *entities.OptedInPage) *dto.OptedInResponseDto); ok {
+		r0 = rf(purpose, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OptedInResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockConsentPresenter_PresentOptedIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentOptedIn'
+type MockConsentPresenter_PresentOptedIn_Call struct {
+	*mock.Call
+}
+
+// PresentOptedIn is a helper method to define mock.On call
+//   - purpose entities.Purpose
+//   - page *entities.OptedInPage
+func (_e *MockConsentPresenter_Expecter) PresentOptedIn(purpose interface{}, page interface{}) *MockConsentPresenter_PresentOptedIn_Call {
+	return &MockConsentPresenter_PresentOptedIn_Call{Call: _e.mock.On("PresentOptedIn", purpose, page)}
+}
+
+func (_c *MockConsentPresenter_PresentOptedIn_Call) Run(run func(purpose entities.Purpose, page *entities.OptedInPage)) *MockConsentPresenter_PresentOptedIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entities.Purpose), args[1].(*entities.OptedInPage))
+	})
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentOptedIn_Call) Return(_a0 *dto.OptedInResponseDto) *MockConsentPresenter_PresentOptedIn_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentOptedIn_Call) RunAndReturn(run func(entities.Purpose, *entities.OptedInPage) *dto.OptedInResponseDto) *MockConsentPresenter_PresentOptedIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentRecord provides a mock function with given fields: record
+func (_m *MockConsentPresenter) PresentRecord(record *entities.ConsentRecord) *dto.ConsentRecordResponseDto {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentRecord")
+	}
+
+	var r0 *dto.ConsentRecordResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.ConsentRecord) *dto.ConsentRecordResponseDto); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ConsentRecordResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockConsentPresenter_PresentRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentRecord'
+type MockConsentPresenter_PresentRecord_Call struct {
+	*mock.Call
+}
+
+// PresentRecord is a helper method to define mock.On call
+//   - record *entities.ConsentRecord
+func (_e *MockConsentPresenter_Expecter) PresentRecord(record interface{}) *MockConsentPresenter_PresentRecord_Call {
+	return &MockConsentPresenter_PresentRecord_Call{Call: _e.mock.On("PresentRecord", record)}
+}
+
+func (_c *MockConsentPresenter_PresentRecord_Call) Run(run func(record *entities.ConsentRecord)) *MockConsentPresenter_PresentRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.ConsentRecord))
+	})
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentRecord_Call) Return(_a0 *dto.ConsentRecordResponseDto) *MockConsentPresenter_PresentRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockConsentPresenter_PresentRecord_Call) RunAndReturn(run func(*entities.ConsentRecord) *dto.ConsentRecordResponseDto) *MockConsentPresenter_PresentRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockConsentPresenter creates a new instance of MockConsentPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockConsentPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockConsentPresenter {
+	mock := &MockConsentPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetConsentsUseCase is an autogenerated mock type for the GetConsentsUseCase type
+type MockGetConsentsUseCase struct {
+	mock.Mock
+}
+
+type MockGetConsentsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetConsentsUseCase) EXPECT() *MockGetConsentsUseCase_Expecter {
+	return &MockGetConsentsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockGetConsentsUseCase) Execute(command *commands.GetConsentsCommand) ([]*entities.ConsentRecord, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []*entities.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.GetConsentsCommand) ([]*entities.ConsentRecord, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.GetConsentsCommand) []*entities.ConsentRecord); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.GetConsentsCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetConsentsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetConsentsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.GetConsentsCommand
+func (_e *MockGetConsentsUseCase_Expecter) Execute(command interface{}) *MockGetConsentsUseCase_Execute_Call {
+	return &MockGetConsentsUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockGetConsentsUseCase_Execute_Call) Run(run func(command *commands.GetConsentsCommand)) *MockGetConsentsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.GetConsentsCommand))
+	})
+	return _c
+}
+
+func (_c *MockGetConsentsUseCase_Execute_Call) Return(_a0 []*entities.ConsentRecord, _a1 error) *MockGetConsentsUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetConsentsUseCase_Execute_Call) RunAndReturn(run func(*commands.GetConsentsCommand) ([]*entities.ConsentRecord, error)) *MockGetConsentsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetConsentsUseCase creates a new instance of MockGetConsentsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetConsentsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetConsentsUseCase {
+	mock := &MockGetConsentsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListConsentHistoryUseCase is an autogenerated mock type for the ListConsentHistoryUseCase type
+type MockListConsentHistoryUseCase struct {
+	mock.Mock
+}
+
+type MockListConsentHistoryUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListConsentHistoryUseCase) EXPECT() *MockListConsentHistoryUseCase_Expecter {
+	return &MockListConsentHistoryUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListConsentHistoryUseCase) Execute(command *commands.ListConsentHistoryCommand) (*entities.ConsentHistoryPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.ConsentHistoryPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListConsentHistoryCommand) (*entities.ConsentHistoryPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListConsentHistoryCommand) *entities.ConsentHistoryPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsentHistoryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListConsentHistoryCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListConsentHistoryUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListConsentHistoryUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListConsentHistoryCommand
+func (_e *MockListConsentHistoryUseCase_Expecter) Execute(command interface{}) *MockListConsentHistoryUseCase_Execute_Call {
+	return &MockListConsentHistoryUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListConsentHistoryUseCase_Execute_Call) Run(run func(command *commands.ListConsentHistoryCommand)) *MockListConsentHistoryUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListConsentHistoryCommand))
+	})
+	return _c
+}
+
+func (_c *MockListConsentHistoryUseCase_Execute_Call) Return(_a0 *entities.ConsentHistoryPage, _a1 error) *MockListConsentHistoryUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListConsentHistoryUseCase_Execute_Call) RunAndReturn(run func(*commands.ListConsentHistoryCommand) (*entities.ConsentHistoryPage, error)) *MockListConsentHistoryUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListConsentHistoryUseCase creates a new instance of MockListConsentHistoryUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListConsentHistoryUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListConsentHistoryUseCase {
+	mock := &MockListConsentHistoryUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
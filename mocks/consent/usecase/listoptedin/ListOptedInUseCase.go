@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListOptedInUseCase is an autogenerated mock type for the ListOptedInUseCase type
+type MockListOptedInUseCase struct {
+	mock.Mock
+}
+
+type MockListOptedInUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListOptedInUseCase) EXPECT() *MockListOptedInUseCase_Expecter {
+	return &MockListOptedInUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListOptedInUseCase) Execute(command *commands.ListOptedInCommand) (*entities.OptedInPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.OptedInPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListOptedInCommand) (*entities.OptedInPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListOptedInCommand) *entities.OptedInPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OptedInPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListOptedInCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListOptedInUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListOptedInUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListOptedInCommand
+func (_e *MockListOptedInUseCase_Expecter) Execute(command interface{}) *MockListOptedInUseCase_Execute_Call {
+	return &MockListOptedInUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListOptedInUseCase_Execute_Call) Run(run func(command *commands.ListOptedInCommand)) *MockListOptedInUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListOptedInCommand))
+	})
+	return _c
+}
+
+func (_c *MockListOptedInUseCase_Execute_Call) Return(_a0 *entities.OptedInPage, _a1 error) *MockListOptedInUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListOptedInUseCase_Execute_Call) RunAndReturn(run func(*commands.ListOptedInCommand) (*entities.OptedInPage, error)) *MockListOptedInUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListOptedInUseCase creates a new instance of MockListOptedInUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListOptedInUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListOptedInUseCase {
+	mock := &MockListOptedInUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
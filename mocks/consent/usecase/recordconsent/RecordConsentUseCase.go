@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/consent/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/consent/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRecordConsentUseCase is an autogenerated mock type for the RecordConsentUseCase type
+type MockRecordConsentUseCase struct {
+	mock.Mock
+}
+
+type MockRecordConsentUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordConsentUseCase) EXPECT() *MockRecordConsentUseCase_Expecter {
+	return &MockRecordConsentUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockRecordConsentUseCase) Execute(command *commands.RecordConsentCommand) (*entities.ConsentRecord, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.ConsentRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.RecordConsentCommand) (*entities.ConsentRecord, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.RecordConsentCommand) *entities.ConsentRecord); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ConsentRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.RecordConsentCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRecordConsentUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRecordConsentUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.RecordConsentCommand
+func (_e *MockRecordConsentUseCase_Expecter) Execute(command interface{}) *MockRecordConsentUseCase_Execute_Call {
+	return &MockRecordConsentUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockRecordConsentUseCase_Execute_Call) Run(run func(command *commands.RecordConsentCommand)) *MockRecordConsentUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.RecordConsentCommand))
+	})
+	return _c
+}
+
+func (_c *MockRecordConsentUseCase_Execute_Call) Return(_a0 *entities.ConsentRecord, _a1 error) *MockRecordConsentUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRecordConsentUseCase_Execute_Call) RunAndReturn(run func(*commands.RecordConsentCommand) (*entities.ConsentRecord, error)) *MockRecordConsentUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordConsentUseCase creates a new instance of MockRecordConsentUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordConsentUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordConsentUseCase {
+	mock := &MockRecordConsentUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
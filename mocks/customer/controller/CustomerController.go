@@ -3,8 +3,15 @@
 package mocks
 
 import (
-	mock "github.com/stretchr/testify/mock"
+	audit "github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+
 	dto "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
+
+	exportcustomers "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockCustomerController is an autogenerated mock type for the CustomerController type
@@ -20,17 +27,17 @@ func (_m *MockCustomerController) EXPECT() *MockCustomerController_Expecter {
 	return &MockCustomerController_Expecter{mock: &_m.Mock}
 }
 
-// Add provides a mock function with given fields: customer
-func (_m *MockCustomerController) Add(customer *dto.AddCustomerRequestDto) error {
-	ret := _m.Called(customer)
+// Add provides a mock function with given fields: customer, actor
+func (_m *MockCustomerController) Add(customer *dto.AddCustomerRequestDto, actor audit.Actor) error {
+	ret := _m.Called(customer, actor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Add")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*dto.AddCustomerRequestDto) error); ok {
-		r0 = rf(customer)
+	if rf, ok := ret.Get(0).(func(*dto.AddCustomerRequestDto, audit.Actor) error); ok {
+		r0 = rf(customer, actor)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,13 +52,14 @@ type MockCustomerController_Add_Call struct {
 
 // Add is a helper method to define mock.On call
 //   - customer *dto.AddCustomerRequestDto
-func (_e *MockCustomerController_Expecter) Add(customer interface{}) *MockCustomerController_Add_Call {
-	return &MockCustomerController_Add_Call{Call: _e.mock.On("Add", customer)}
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Add(customer interface{}, actor interface{}) *MockCustomerController_Add_Call {
+	return &MockCustomerController_Add_Call{Call: _e.mock.On("Add", customer, actor)}
 }
 
-func (_c *MockCustomerController_Add_Call) Run(run func(customer *dto.AddCustomerRequestDto)) *MockCustomerController_Add_Call {
+func (_c *MockCustomerController_Add_Call) Run(run func(customer *dto.AddCustomerRequestDto, actor audit.Actor)) *MockCustomerController_Add_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*dto.AddCustomerRequestDto))
+		run(args[0].(*dto.AddCustomerRequestDto), args[1].(audit.Actor))
 	})
 	return _c
 }
@@ -61,14 +69,299 @@ func (_c *MockCustomerController_Add_Call) Return(_a0 error) *MockCustomerContro
 	return _c
 }
 
-func (_c *MockCustomerController_Add_Call) RunAndReturn(run func(*dto.AddCustomerRequestDto) error) *MockCustomerController_Add_Call {
+func (_c *MockCustomerController_Add_Call) RunAndReturn(run func(*dto.AddCustomerRequestDto, audit.Actor) error) *MockCustomerController_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddGuest provides a mock function with given fields: request, actor
+func (_m *MockCustomerController) AddGuest(request *dto.AddGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	ret := _m.Called(request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGuest")
+	}
+
+	var r0 *dto.CustomerSessionResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dto.AddGuestRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)); ok {
+		return rf(request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.AddGuestRequestDto, audit.Actor) *dto.CustomerSessionResponseDto); ok {
+		r0 = rf(request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerSessionResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.AddGuestRequestDto, audit.Actor) error); ok {
+		r1 = rf(request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_AddGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGuest'
+type MockCustomerController_AddGuest_Call struct {
+	*mock.Call
+}
+
+// AddGuest is a helper method to define mock.On call
+//   - request *dto.AddGuestRequestDto
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) AddGuest(request interface{}, actor interface{}) *MockCustomerController_AddGuest_Call {
+	return &MockCustomerController_AddGuest_Call{Call: _e.mock.On("AddGuest", request, actor)}
+}
+
+func (_c *MockCustomerController_AddGuest_Call) Run(run func(request *dto.AddGuestRequestDto, actor audit.Actor)) *MockCustomerController_AddGuest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.AddGuestRequestDto), args[1].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_AddGuest_Call) Return(_a0 *dto.CustomerSessionResponseDto, _a1 error) *MockCustomerController_AddGuest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_AddGuest_Call) RunAndReturn(run func(*dto.AddGuestRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)) *MockCustomerController_AddGuest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimGuest provides a mock function with given fields: customerID, request, actor
+func (_m *MockCustomerController) ClaimGuest(customerID string, request *dto.ClaimGuestRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	ret := _m.Called(customerID, request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimGuest")
+	}
+
+	var r0 *dto.CustomerSessionResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.ClaimGuestRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)); ok {
+		return rf(customerID, request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.ClaimGuestRequestDto, audit.Actor) *dto.CustomerSessionResponseDto); ok {
+		r0 = rf(customerID, request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerSessionResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.ClaimGuestRequestDto, audit.Actor) error); ok {
+		r1 = rf(customerID, request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_ClaimGuest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimGuest'
+type MockCustomerController_ClaimGuest_Call struct {
+	*mock.Call
+}
+
+// ClaimGuest is a helper method to define mock.On call
+//   - customerID string
+//   - request *dto.ClaimGuestRequestDto
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) ClaimGuest(customerID interface{}, request interface{}, actor interface{}) *MockCustomerController_ClaimGuest_Call {
+	return &MockCustomerController_ClaimGuest_Call{Call: _e.mock.On("ClaimGuest", customerID, request, actor)}
+}
+
+func (_c *MockCustomerController_ClaimGuest_Call) Run(run func(customerID string, request *dto.ClaimGuestRequestDto, actor audit.Actor)) *MockCustomerController_ClaimGuest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.ClaimGuestRequestDto), args[2].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_ClaimGuest_Call) Return(_a0 *dto.CustomerSessionResponseDto, _a1 error) *MockCustomerController_ClaimGuest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_ClaimGuest_Call) RunAndReturn(run func(string, *dto.ClaimGuestRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)) *MockCustomerController_ClaimGuest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Erase provides a mock function with given fields: customerID, actor
+func (_m *MockCustomerController) Erase(customerID string, actor audit.Actor) error {
+	ret := _m.Called(customerID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Erase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, audit.Actor) error); ok {
+		r0 = rf(customerID, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerController_Erase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Erase'
+type MockCustomerController_Erase_Call struct {
+	*mock.Call
+}
+
+// Erase is a helper method to define mock.On call
+//   - customerID string
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Erase(customerID interface{}, actor interface{}) *MockCustomerController_Erase_Call {
+	return &MockCustomerController_Erase_Call{Call: _e.mock.On("Erase", customerID, actor)}
+}
+
+func (_c *MockCustomerController_Erase_Call) Run(run func(customerID string, actor audit.Actor)) *MockCustomerController_Erase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Erase_Call) Return(_a0 error) *MockCustomerController_Erase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerController_Erase_Call) RunAndReturn(run func(string, audit.Actor) error) *MockCustomerController_Erase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: writer, segments, maskPII, actor
+func (_m *MockCustomerController) Export(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor) (int, error) {
+	ret := _m.Called(writer, segments, maskPII, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(exportcustomers.CustomerWriter, int, bool, audit.Actor) (int, error)); ok {
+		return rf(writer, segments, maskPII, actor)
+	}
+	if rf, ok := ret.Get(0).(func(exportcustomers.CustomerWriter, int, bool, audit.Actor) int); ok {
+		r0 = rf(writer, segments, maskPII, actor)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(exportcustomers.CustomerWriter, int, bool, audit.Actor) error); ok {
+		r1 = rf(writer, segments, maskPII, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type MockCustomerController_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - writer exportcustomers.CustomerWriter
+//   - segments int
+//   - maskPII bool
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Export(writer interface{}, segments interface{}, maskPII interface{}, actor interface{}) *MockCustomerController_Export_Call {
+	return &MockCustomerController_Export_Call{Call: _e.mock.On("Export", writer, segments, maskPII, actor)}
+}
+
+func (_c *MockCustomerController_Export_Call) Run(run func(writer exportcustomers.CustomerWriter, segments int, maskPII bool, actor audit.Actor)) *MockCustomerController_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(exportcustomers.CustomerWriter), args[1].(int), args[2].(bool), args[3].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Export_Call) Return(_a0 int, _a1 error) *MockCustomerController_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_Export_Call) RunAndReturn(run func(exportcustomers.CustomerWriter, int, bool, audit.Actor) (int, error)) *MockCustomerController_Export_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByCpf provides a mock function with given fields: cpf
-func (_m *MockCustomerController) GetByCpf(cpf string) (*dto.GetCustomerResponseDto, error) {
-	ret := _m.Called(cpf)
+// GetAsOf provides a mock function with given fields: customerID, asOf, actor
+func (_m *MockCustomerController) GetAsOf(customerID string, asOf time.Time, actor audit.Actor) (*dto.CustomerRevisionResponseDto, error) {
+	ret := _m.Called(customerID, asOf, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsOf")
+	}
+
+	var r0 *dto.CustomerRevisionResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, audit.Actor) (*dto.CustomerRevisionResponseDto, error)); ok {
+		return rf(customerID, asOf, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, audit.Actor) *dto.CustomerRevisionResponseDto); ok {
+		r0 = rf(customerID, asOf, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerRevisionResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, audit.Actor) error); ok {
+		r1 = rf(customerID, asOf, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_GetAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAsOf'
+type MockCustomerController_GetAsOf_Call struct {
+	*mock.Call
+}
+
+// GetAsOf is a helper method to define mock.On call
+//   - customerID string
+//   - asOf time.Time
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) GetAsOf(customerID interface{}, asOf interface{}, actor interface{}) *MockCustomerController_GetAsOf_Call {
+	return &MockCustomerController_GetAsOf_Call{Call: _e.mock.On("GetAsOf", customerID, asOf, actor)}
+}
+
+func (_c *MockCustomerController_GetAsOf_Call) Run(run func(customerID string, asOf time.Time, actor audit.Actor)) *MockCustomerController_GetAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time), args[2].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_GetAsOf_Call) Return(_a0 *dto.CustomerRevisionResponseDto, _a1 error) *MockCustomerController_GetAsOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_GetAsOf_Call) RunAndReturn(run func(string, time.Time, audit.Actor) (*dto.CustomerRevisionResponseDto, error)) *MockCustomerController_GetAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByCpf provides a mock function with given fields: cpf, actor
+func (_m *MockCustomerController) GetByCpf(cpf string, actor audit.Actor) (*dto.GetCustomerResponseDto, error) {
+	ret := _m.Called(cpf, actor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByCpf")
@@ -76,19 +369,19 @@ func (_m *MockCustomerController) GetByCpf(cpf string) (*dto.GetCustomerResponse
 
 	var r0 *dto.GetCustomerResponseDto
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*dto.GetCustomerResponseDto, error)); ok {
-		return rf(cpf)
+	if rf, ok := ret.Get(0).(func(string, audit.Actor) (*dto.GetCustomerResponseDto, error)); ok {
+		return rf(cpf, actor)
 	}
-	if rf, ok := ret.Get(0).(func(string) *dto.GetCustomerResponseDto); ok {
-		r0 = rf(cpf)
+	if rf, ok := ret.Get(0).(func(string, audit.Actor) *dto.GetCustomerResponseDto); ok {
+		r0 = rf(cpf, actor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*dto.GetCustomerResponseDto)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(cpf)
+	if rf, ok := ret.Get(1).(func(string, audit.Actor) error); ok {
+		r1 = rf(cpf, actor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -103,13 +396,14 @@ type MockCustomerController_GetByCpf_Call struct {
 
 // GetByCpf is a helper method to define mock.On call
 //   - cpf string
-func (_e *MockCustomerController_Expecter) GetByCpf(cpf interface{}) *MockCustomerController_GetByCpf_Call {
-	return &MockCustomerController_GetByCpf_Call{Call: _e.mock.On("GetByCpf", cpf)}
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) GetByCpf(cpf interface{}, actor interface{}) *MockCustomerController_GetByCpf_Call {
+	return &MockCustomerController_GetByCpf_Call{Call: _e.mock.On("GetByCpf", cpf, actor)}
 }
 
-func (_c *MockCustomerController_GetByCpf_Call) Run(run func(cpf string)) *MockCustomerController_GetByCpf_Call {
+func (_c *MockCustomerController_GetByCpf_Call) Run(run func(cpf string, actor audit.Actor)) *MockCustomerController_GetByCpf_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(audit.Actor))
 	})
 	return _c
 }
@@ -119,7 +413,305 @@ func (_c *MockCustomerController_GetByCpf_Call) Return(_a0 *dto.GetCustomerRespo
 	return _c
 }
 
-func (_c *MockCustomerController_GetByCpf_Call) RunAndReturn(run func(string) (*dto.GetCustomerResponseDto, error)) *MockCustomerController_GetByCpf_Call {
+func (_c *MockCustomerController_GetByCpf_Call) RunAndReturn(run func(string, audit.Actor) (*dto.GetCustomerResponseDto, error)) *MockCustomerController_GetByCpf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Identify provides a mock function with given fields: request, actor
+func (_m *MockCustomerController) Identify(request *dto.IdentifyCustomerRequestDto, actor audit.Actor) (*dto.CustomerSessionResponseDto, error) {
+	ret := _m.Called(request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Identify")
+	}
+
+	var r0 *dto.CustomerSessionResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dto.IdentifyCustomerRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)); ok {
+		return rf(request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(*dto.IdentifyCustomerRequestDto, audit.Actor) *dto.CustomerSessionResponseDto); ok {
+		r0 = rf(request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerSessionResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dto.IdentifyCustomerRequestDto, audit.Actor) error); ok {
+		r1 = rf(request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_Identify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Identify'
+type MockCustomerController_Identify_Call struct {
+	*mock.Call
+}
+
+// Identify is a helper method to define mock.On call
+//   - request *dto.IdentifyCustomerRequestDto
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Identify(request interface{}, actor interface{}) *MockCustomerController_Identify_Call {
+	return &MockCustomerController_Identify_Call{Call: _e.mock.On("Identify", request, actor)}
+}
+
+func (_c *MockCustomerController_Identify_Call) Run(run func(request *dto.IdentifyCustomerRequestDto, actor audit.Actor)) *MockCustomerController_Identify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*dto.IdentifyCustomerRequestDto), args[1].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Identify_Call) Return(_a0 *dto.CustomerSessionResponseDto, _a1 error) *MockCustomerController_Identify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_Identify_Call) RunAndReturn(run func(*dto.IdentifyCustomerRequestDto, audit.Actor) (*dto.CustomerSessionResponseDto, error)) *MockCustomerController_Identify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: rows, actor
+func (_m *MockCustomerController) Import(rows []dto.ImportCustomerRowDto, actor audit.Actor) (*dto.ImportCustomersResponseDto, error) {
+	ret := _m.Called(rows, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *dto.ImportCustomersResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]dto.ImportCustomerRowDto, audit.Actor) (*dto.ImportCustomersResponseDto, error)); ok {
+		return rf(rows, actor)
+	}
+	if rf, ok := ret.Get(0).(func([]dto.ImportCustomerRowDto, audit.Actor) *dto.ImportCustomersResponseDto); ok {
+		r0 = rf(rows, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ImportCustomersResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]dto.ImportCustomerRowDto, audit.Actor) error); ok {
+		r1 = rf(rows, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockCustomerController_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - rows []dto.ImportCustomerRowDto
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Import(rows interface{}, actor interface{}) *MockCustomerController_Import_Call {
+	return &MockCustomerController_Import_Call{Call: _e.mock.On("Import", rows, actor)}
+}
+
+func (_c *MockCustomerController_Import_Call) Run(run func(rows []dto.ImportCustomerRowDto, actor audit.Actor)) *MockCustomerController_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]dto.ImportCustomerRowDto), args[1].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Import_Call) Return(_a0 *dto.ImportCustomersResponseDto, _a1 error) *MockCustomerController_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_Import_Call) RunAndReturn(run func([]dto.ImportCustomerRowDto, audit.Actor) (*dto.ImportCustomersResponseDto, error)) *MockCustomerController_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHistory provides a mock function with given fields: customerID, limit, cursor, actor
+func (_m *MockCustomerController) ListHistory(customerID string, limit int, cursor string, actor audit.Actor) (*dto.CustomerHistoryResponseDto, error) {
+	ret := _m.Called(customerID, limit, cursor, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 *dto.CustomerHistoryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string, audit.Actor) (*dto.CustomerHistoryResponseDto, error)); ok {
+		return rf(customerID, limit, cursor, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string, audit.Actor) *dto.CustomerHistoryResponseDto); ok {
+		r0 = rf(customerID, limit, cursor, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerHistoryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string, audit.Actor) error); ok {
+		r1 = rf(customerID, limit, cursor, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_ListHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistory'
+type MockCustomerController_ListHistory_Call struct {
+	*mock.Call
+}
+
+// ListHistory is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) ListHistory(customerID interface{}, limit interface{}, cursor interface{}, actor interface{}) *MockCustomerController_ListHistory_Call {
+	return &MockCustomerController_ListHistory_Call{Call: _e.mock.On("ListHistory", customerID, limit, cursor, actor)}
+}
+
+func (_c *MockCustomerController_ListHistory_Call) Run(run func(customerID string, limit int, cursor string, actor audit.Actor)) *MockCustomerController_ListHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string), args[3].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_ListHistory_Call) Return(_a0 *dto.CustomerHistoryResponseDto, _a1 error) *MockCustomerController_ListHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_ListHistory_Call) RunAndReturn(run func(string, int, string, audit.Actor) (*dto.CustomerHistoryResponseDto, error)) *MockCustomerController_ListHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reindex provides a mock function with given fields: segments, actor
+func (_m *MockCustomerController) Reindex(segments int, actor audit.Actor) (*dto.ReindexCustomersResponseDto, error) {
+	ret := _m.Called(segments, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reindex")
+	}
+
+	var r0 *dto.ReindexCustomersResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, audit.Actor) (*dto.ReindexCustomersResponseDto, error)); ok {
+		return rf(segments, actor)
+	}
+	if rf, ok := ret.Get(0).(func(int, audit.Actor) *dto.ReindexCustomersResponseDto); ok {
+		r0 = rf(segments, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ReindexCustomersResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, audit.Actor) error); ok {
+		r1 = rf(segments, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_Reindex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reindex'
+type MockCustomerController_Reindex_Call struct {
+	*mock.Call
+}
+
+// Reindex is a helper method to define mock.On call
+//   - segments int
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Reindex(segments interface{}, actor interface{}) *MockCustomerController_Reindex_Call {
+	return &MockCustomerController_Reindex_Call{Call: _e.mock.On("Reindex", segments, actor)}
+}
+
+func (_c *MockCustomerController_Reindex_Call) Run(run func(segments int, actor audit.Actor)) *MockCustomerController_Reindex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Reindex_Call) Return(_a0 *dto.ReindexCustomersResponseDto, _a1 error) *MockCustomerController_Reindex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_Reindex_Call) RunAndReturn(run func(int, audit.Actor) (*dto.ReindexCustomersResponseDto, error)) *MockCustomerController_Reindex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: customerID, request, actor
+func (_m *MockCustomerController) Update(customerID string, request *dto.UpdateCustomerRequestDto, actor audit.Actor) (*dto.GetCustomerResponseDto, error) {
+	ret := _m.Called(customerID, request, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *dto.GetCustomerResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.UpdateCustomerRequestDto, audit.Actor) (*dto.GetCustomerResponseDto, error)); ok {
+		return rf(customerID, request, actor)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.UpdateCustomerRequestDto, audit.Actor) *dto.GetCustomerResponseDto); ok {
+		r0 = rf(customerID, request, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.GetCustomerResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.UpdateCustomerRequestDto, audit.Actor) error); ok {
+		r1 = rf(customerID, request, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerController_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCustomerController_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - customerID string
+//   - request *dto.UpdateCustomerRequestDto
+//   - actor audit.Actor
+func (_e *MockCustomerController_Expecter) Update(customerID interface{}, request interface{}, actor interface{}) *MockCustomerController_Update_Call {
+	return &MockCustomerController_Update_Call{Call: _e.mock.On("Update", customerID, request, actor)}
+}
+
+func (_c *MockCustomerController_Update_Call) Run(run func(customerID string, request *dto.UpdateCustomerRequestDto, actor audit.Actor)) *MockCustomerController_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.UpdateCustomerRequestDto), args[2].(audit.Actor))
+	})
+	return _c
+}
+
+func (_c *MockCustomerController_Update_Call) Return(_a0 *dto.GetCustomerResponseDto, _a1 error) *MockCustomerController_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerController_Update_Call) RunAndReturn(run func(string, *dto.UpdateCustomerRequestDto, audit.Actor) (*dto.GetCustomerResponseDto, error)) *MockCustomerController_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
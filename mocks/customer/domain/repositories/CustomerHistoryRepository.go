@@ -0,0 +1,202 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+
+	time "time"
+)
+
+// MockCustomerHistoryRepository is an autogenerated mock type for the CustomerHistoryRepository type
+type MockCustomerHistoryRepository struct {
+	mock.Mock
+}
+
+type MockCustomerHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomerHistoryRepository) EXPECT() *MockCustomerHistoryRepository_Expecter {
+	return &MockCustomerHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetAsOf provides a mock function with given fields: customerID, asOf
+func (_m *MockCustomerHistoryRepository) GetAsOf(customerID string, asOf time.Time) (*entities.CustomerRevision, error) {
+	ret := _m.Called(customerID, asOf)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAsOf")
+	}
+
+	var r0 *entities.CustomerRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*entities.CustomerRevision, error)); ok {
+		return rf(customerID, asOf)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *entities.CustomerRevision); ok {
+		r0 = rf(customerID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CustomerRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(customerID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerHistoryRepository_GetAsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAsOf'
+type MockCustomerHistoryRepository_GetAsOf_Call struct {
+	*mock.Call
+}
+
+// GetAsOf is a helper method to define mock.On call
+//   - customerID string
+//   - asOf time.Time
+func (_e *MockCustomerHistoryRepository_Expecter) GetAsOf(customerID interface{}, asOf interface{}) *MockCustomerHistoryRepository_GetAsOf_Call {
+	return &MockCustomerHistoryRepository_GetAsOf_Call{Call: _e.mock.On("GetAsOf", customerID, asOf)}
+}
+
+func (_c *MockCustomerHistoryRepository_GetAsOf_Call) Run(run func(customerID string, asOf time.Time)) *MockCustomerHistoryRepository_GetAsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_GetAsOf_Call) Return(_a0 *entities.CustomerRevision, _a1 error) *MockCustomerHistoryRepository_GetAsOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_GetAsOf_Call) RunAndReturn(run func(string, time.Time) (*entities.CustomerRevision, error)) *MockCustomerHistoryRepository_GetAsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockCustomerHistoryRepository) ListRevisions(customerID string, limit int, cursor string) (*entities.CustomerHistoryPage, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 *entities.CustomerHistoryPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*entities.CustomerHistoryPage, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *entities.CustomerHistoryPage); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CustomerHistoryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerHistoryRepository_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type MockCustomerHistoryRepository_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockCustomerHistoryRepository_Expecter) ListRevisions(customerID interface{}, limit interface{}, cursor interface{}) *MockCustomerHistoryRepository_ListRevisions_Call {
+	return &MockCustomerHistoryRepository_ListRevisions_Call{Call: _e.mock.On("ListRevisions", customerID, limit, cursor)}
+}
+
+func (_c *MockCustomerHistoryRepository_ListRevisions_Call) Run(run func(customerID string, limit int, cursor string)) *MockCustomerHistoryRepository_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_ListRevisions_Call) Return(_a0 *entities.CustomerHistoryPage, _a1 error) *MockCustomerHistoryRepository_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_ListRevisions_Call) RunAndReturn(run func(string, int, string) (*entities.CustomerHistoryPage, error)) *MockCustomerHistoryRepository_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function with given fields: customerID
+func (_m *MockCustomerHistoryRepository) Purge(customerID string) error {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(customerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerHistoryRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockCustomerHistoryRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockCustomerHistoryRepository_Expecter) Purge(customerID interface{}) *MockCustomerHistoryRepository_Purge_Call {
+	return &MockCustomerHistoryRepository_Purge_Call{Call: _e.mock.On("Purge", customerID)}
+}
+
+func (_c *MockCustomerHistoryRepository_Purge_Call) Run(run func(customerID string)) *MockCustomerHistoryRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_Purge_Call) Return(_a0 error) *MockCustomerHistoryRepository_Purge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerHistoryRepository_Purge_Call) RunAndReturn(run func(string) error) *MockCustomerHistoryRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomerHistoryRepository creates a new instance of MockCustomerHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomerHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomerHistoryRepository {
+	mock := &MockCustomerHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -66,6 +66,262 @@ func (_c *MockCustomerRepository_Add_Call) RunAndReturn(run func(*entities.Custo
 	return _c
 }
 
+// AddBatch provides a mock function with given fields: customers
+func (_m *MockCustomerRepository) AddBatch(customers []*entities.Customer) []error {
+	ret := _m.Called(customers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddBatch")
+	}
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func([]*entities.Customer) []error); ok {
+		r0 = rf(customers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerRepository_AddBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddBatch'
+type MockCustomerRepository_AddBatch_Call struct {
+	*mock.Call
+}
+
+// AddBatch is a helper method to define mock.On call
+//   - customers []*entities.Customer
+func (_e *MockCustomerRepository_Expecter) AddBatch(customers interface{}) *MockCustomerRepository_AddBatch_Call {
+	return &MockCustomerRepository_AddBatch_Call{Call: _e.mock.On("AddBatch", customers)}
+}
+
+func (_c *MockCustomerRepository_AddBatch_Call) Run(run func(customers []*entities.Customer)) *MockCustomerRepository_AddBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*entities.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_AddBatch_Call) Return(_a0 []error) *MockCustomerRepository_AddBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerRepository_AddBatch_Call) RunAndReturn(run func([]*entities.Customer) []error) *MockCustomerRepository_AddBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Claim provides a mock function with given fields: customer
+func (_m *MockCustomerRepository) Claim(customer *entities.Customer) error {
+	ret := _m.Called(customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Customer) error); ok {
+		r0 = rf(customer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerRepository_Claim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Claim'
+type MockCustomerRepository_Claim_Call struct {
+	*mock.Call
+}
+
+// Claim is a helper method to define mock.On call
+//   - customer *entities.Customer
+func (_e *MockCustomerRepository_Expecter) Claim(customer interface{}) *MockCustomerRepository_Claim_Call {
+	return &MockCustomerRepository_Claim_Call{Call: _e.mock.On("Claim", customer)}
+}
+
+func (_c *MockCustomerRepository_Claim_Call) Run(run func(customer *entities.Customer)) *MockCustomerRepository_Claim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_Claim_Call) Return(_a0 error) *MockCustomerRepository_Claim_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerRepository_Claim_Call) RunAndReturn(run func(*entities.Customer) error) *MockCustomerRepository_Claim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Erase provides a mock function with given fields: customer
+func (_m *MockCustomerRepository) Erase(customer *entities.Customer) error {
+	ret := _m.Called(customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Erase")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Customer) error); ok {
+		r0 = rf(customer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerRepository_Erase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Erase'
+type MockCustomerRepository_Erase_Call struct {
+	*mock.Call
+}
+
+// Erase is a helper method to define mock.On call
+//   - customer *entities.Customer
+func (_e *MockCustomerRepository_Expecter) Erase(customer interface{}) *MockCustomerRepository_Erase_Call {
+	return &MockCustomerRepository_Erase_Call{Call: _e.mock.On("Erase", customer)}
+}
+
+func (_c *MockCustomerRepository_Erase_Call) Run(run func(customer *entities.Customer)) *MockCustomerRepository_Erase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_Erase_Call) Return(_a0 error) *MockCustomerRepository_Erase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerRepository_Erase_Call) RunAndReturn(run func(*entities.Customer) error) *MockCustomerRepository_Erase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByEmail provides a mock function with given fields: email
+func (_m *MockCustomerRepository) FindByEmail(email string) ([]*entities.Customer, error) {
+	ret := _m.Called(email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByEmail")
+	}
+
+	var r0 []*entities.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*entities.Customer, error)); ok {
+		return rf(email)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*entities.Customer); ok {
+		r0 = rf(email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerRepository_FindByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByEmail'
+type MockCustomerRepository_FindByEmail_Call struct {
+	*mock.Call
+}
+
+// FindByEmail is a helper method to define mock.On call
+//   - email string
+func (_e *MockCustomerRepository_Expecter) FindByEmail(email interface{}) *MockCustomerRepository_FindByEmail_Call {
+	return &MockCustomerRepository_FindByEmail_Call{Call: _e.mock.On("FindByEmail", email)}
+}
+
+func (_c *MockCustomerRepository_FindByEmail_Call) Run(run func(email string)) *MockCustomerRepository_FindByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_FindByEmail_Call) Return(_a0 []*entities.Customer, _a1 error) *MockCustomerRepository_FindByEmail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerRepository_FindByEmail_Call) RunAndReturn(run func(string) ([]*entities.Customer, error)) *MockCustomerRepository_FindByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRegisteredCPFs provides a mock function with given fields: cpfs
+func (_m *MockCustomerRepository) FindRegisteredCPFs(cpfs []string) (map[string]bool, error) {
+	ret := _m.Called(cpfs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRegisteredCPFs")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]bool, error)); ok {
+		return rf(cpfs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]bool); ok {
+		r0 = rf(cpfs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(cpfs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerRepository_FindRegisteredCPFs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRegisteredCPFs'
+type MockCustomerRepository_FindRegisteredCPFs_Call struct {
+	*mock.Call
+}
+
+// FindRegisteredCPFs is a helper method to define mock.On call
+//   - cpfs []string
+func (_e *MockCustomerRepository_Expecter) FindRegisteredCPFs(cpfs interface{}) *MockCustomerRepository_FindRegisteredCPFs_Call {
+	return &MockCustomerRepository_FindRegisteredCPFs_Call{Call: _e.mock.On("FindRegisteredCPFs", cpfs)}
+}
+
+func (_c *MockCustomerRepository_FindRegisteredCPFs_Call) Run(run func(cpfs []string)) *MockCustomerRepository_FindRegisteredCPFs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_FindRegisteredCPFs_Call) Return(_a0 map[string]bool, _a1 error) *MockCustomerRepository_FindRegisteredCPFs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerRepository_FindRegisteredCPFs_Call) RunAndReturn(run func([]string) (map[string]bool, error)) *MockCustomerRepository_FindRegisteredCPFs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByCpf provides a mock function with given fields: cpf
 func (_m *MockCustomerRepository) GetByCpf(cpf string) (*entities.Customer, error) {
 	ret := _m.Called(cpf)
@@ -124,6 +380,217 @@ func (_c *MockCustomerRepository_GetByCpf_Call) RunAndReturn(run func(string) (*
 	return _c
 }
 
+// GetByID provides a mock function with given fields: id
+func (_m *MockCustomerRepository) GetByID(id string) (*entities.Customer, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entities.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Customer, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Customer); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockCustomerRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockCustomerRepository_Expecter) GetByID(id interface{}) *MockCustomerRepository_GetByID_Call {
+	return &MockCustomerRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockCustomerRepository_GetByID_Call) Run(run func(id string)) *MockCustomerRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_GetByID_Call) Return(_a0 *entities.Customer, _a1 error) *MockCustomerRepository_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerRepository_GetByID_Call) RunAndReturn(run func(string) (*entities.Customer, error)) *MockCustomerRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reindex provides a mock function with given fields: segment, totalSegments
+func (_m *MockCustomerRepository) Reindex(segment int, totalSegments int) (*entities.ReindexReport, error) {
+	ret := _m.Called(segment, totalSegments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reindex")
+	}
+
+	var r0 *entities.ReindexReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*entities.ReindexReport, error)); ok {
+		return rf(segment, totalSegments)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *entities.ReindexReport); ok {
+		r0 = rf(segment, totalSegments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReindexReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(segment, totalSegments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerRepository_Reindex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reindex'
+type MockCustomerRepository_Reindex_Call struct {
+	*mock.Call
+}
+
+// Reindex is a helper method to define mock.On call
+//   - segment int
+//   - totalSegments int
+func (_e *MockCustomerRepository_Expecter) Reindex(segment interface{}, totalSegments interface{}) *MockCustomerRepository_Reindex_Call {
+	return &MockCustomerRepository_Reindex_Call{Call: _e.mock.On("Reindex", segment, totalSegments)}
+}
+
+func (_c *MockCustomerRepository_Reindex_Call) Run(run func(segment int, totalSegments int)) *MockCustomerRepository_Reindex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_Reindex_Call) Return(_a0 *entities.ReindexReport, _a1 error) *MockCustomerRepository_Reindex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerRepository_Reindex_Call) RunAndReturn(run func(int, int) (*entities.ReindexReport, error)) *MockCustomerRepository_Reindex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scan provides a mock function with given fields: segment, totalSegments, visit
+func (_m *MockCustomerRepository) Scan(segment int, totalSegments int, visit func(*entities.Customer) error) error {
+	ret := _m.Called(segment, totalSegments, visit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, func(*entities.Customer) error) error); ok {
+		r0 = rf(segment, totalSegments, visit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerRepository_Scan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scan'
+type MockCustomerRepository_Scan_Call struct {
+	*mock.Call
+}
+
+// Scan is a helper method to define mock.On call
+//   - segment int
+//   - totalSegments int
+//   - visit func(*entities.Customer) error
+func (_e *MockCustomerRepository_Expecter) Scan(segment interface{}, totalSegments interface{}, visit interface{}) *MockCustomerRepository_Scan_Call {
+	return &MockCustomerRepository_Scan_Call{Call: _e.mock.On("Scan", segment, totalSegments, visit)}
+}
+
+func (_c *MockCustomerRepository_Scan_Call) Run(run func(segment int, totalSegments int, visit func(*entities.Customer) error)) *MockCustomerRepository_Scan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(func(*entities.Customer) error))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_Scan_Call) Return(_a0 error) *MockCustomerRepository_Scan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerRepository_Scan_Call) RunAndReturn(run func(int, int, func(*entities.Customer) error) error) *MockCustomerRepository_Scan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: customer
+func (_m *MockCustomerRepository) Update(customer *entities.Customer) error {
+	ret := _m.Called(customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Customer) error); ok {
+		r0 = rf(customer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockCustomerRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - customer *entities.Customer
+func (_e *MockCustomerRepository_Expecter) Update(customer interface{}) *MockCustomerRepository_Update_Call {
+	return &MockCustomerRepository_Update_Call{Call: _e.mock.On("Update", customer)}
+}
+
+func (_c *MockCustomerRepository_Update_Call) Run(run func(customer *entities.Customer)) *MockCustomerRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCustomerRepository_Update_Call) Return(_a0 error) *MockCustomerRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerRepository_Update_Call) RunAndReturn(run func(*entities.Customer) error) *MockCustomerRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCustomerRepository creates a new instance of MockCustomerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCustomerRepository(t interface {
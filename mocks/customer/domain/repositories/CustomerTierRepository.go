@@ -0,0 +1,88 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockCustomerTierRepository is an autogenerated mock type for the CustomerTierRepository type
+type MockCustomerTierRepository struct {
+	mock.Mock
+}
+
+type MockCustomerTierRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomerTierRepository) EXPECT() *MockCustomerTierRepository_Expecter {
+	return &MockCustomerTierRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetTier provides a mock function with given fields: customerID
+func (_m *MockCustomerTierRepository) GetTier(customerID string) (string, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTier")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(customerID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCustomerTierRepository_GetTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTier'
+type MockCustomerTierRepository_GetTier_Call struct {
+	*mock.Call
+}
+
+// GetTier is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockCustomerTierRepository_Expecter) GetTier(customerID interface{}) *MockCustomerTierRepository_GetTier_Call {
+	return &MockCustomerTierRepository_GetTier_Call{Call: _e.mock.On("GetTier", customerID)}
+}
+
+func (_c *MockCustomerTierRepository_GetTier_Call) Run(run func(customerID string)) *MockCustomerTierRepository_GetTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCustomerTierRepository_GetTier_Call) Return(_a0 string, _a1 error) *MockCustomerTierRepository_GetTier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCustomerTierRepository_GetTier_Call) RunAndReturn(run func(string) (string, error)) *MockCustomerTierRepository_GetTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomerTierRepository creates a new instance of MockCustomerTierRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomerTierRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomerTierRepository {
+	mock := &MockCustomerTierRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
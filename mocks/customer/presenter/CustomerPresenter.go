@@ -7,6 +7,8 @@ import (
 	dto "github.com/viniciuscluna/tc-fiap-customer/internal/customer/infrastructure/api/dto"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockCustomerPresenter is an autogenerated mock type for the CustomerPresenter type
@@ -70,6 +72,248 @@ func (_c *MockCustomerPresenter_Present_Call) RunAndReturn(run func(*entities.Cu
 	return _c
 }
 
+// PresentHistory provides a mock function with given fields: page
+func (_m *MockCustomerPresenter) PresentHistory(page *entities.CustomerHistoryPage) *dto.CustomerHistoryResponseDto {
+	ret := _m.Called(page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentHistory")
+	}
+
+	var r0 *dto.CustomerHistoryResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.CustomerHistoryPage) *dto.CustomerHistoryResponseDto); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerHistoryResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerPresenter_PresentHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentHistory'
+type MockCustomerPresenter_PresentHistory_Call struct {
+	*mock.Call
+}
+
+// PresentHistory is a helper method to define mock.On call
+//   - page *entities.CustomerHistoryPage
+func (_e *MockCustomerPresenter_Expecter) PresentHistory(page interface{}) *MockCustomerPresenter_PresentHistory_Call {
+	return &MockCustomerPresenter_PresentHistory_Call{Call: _e.mock.On("PresentHistory", page)}
+}
+
+func (_c *MockCustomerPresenter_PresentHistory_Call) Run(run func(page *entities.CustomerHistoryPage)) *MockCustomerPresenter_PresentHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.CustomerHistoryPage))
+	})
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentHistory_Call) Return(_a0 *dto.CustomerHistoryResponseDto) *MockCustomerPresenter_PresentHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentHistory_Call) RunAndReturn(run func(*entities.CustomerHistoryPage) *dto.CustomerHistoryResponseDto) *MockCustomerPresenter_PresentHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentImport provides a mock function with given fields: report
+func (_m *MockCustomerPresenter) PresentImport(report *entities.ImportReport) *dto.ImportCustomersResponseDto {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentImport")
+	}
+
+	var r0 *dto.ImportCustomersResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.ImportReport) *dto.ImportCustomersResponseDto); ok {
+		r0 = rf(report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ImportCustomersResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerPresenter_PresentImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentImport'
+type MockCustomerPresenter_PresentImport_Call struct {
+	*mock.Call
+}
+
+// PresentImport is a helper method to define mock.On call
+//   - report *entities.ImportReport
+func (_e *MockCustomerPresenter_Expecter) PresentImport(report interface{}) *MockCustomerPresenter_PresentImport_Call {
+	return &MockCustomerPresenter_PresentImport_Call{Call: _e.mock.On("PresentImport", report)}
+}
+
+func (_c *MockCustomerPresenter_PresentImport_Call) Run(run func(report *entities.ImportReport)) *MockCustomerPresenter_PresentImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.ImportReport))
+	})
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentImport_Call) Return(_a0 *dto.ImportCustomersResponseDto) *MockCustomerPresenter_PresentImport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentImport_Call) RunAndReturn(run func(*entities.ImportReport) *dto.ImportCustomersResponseDto) *MockCustomerPresenter_PresentImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentReindex provides a mock function with given fields: report
+func (_m *MockCustomerPresenter) PresentReindex(report *entities.ReindexReport) *dto.ReindexCustomersResponseDto {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentReindex")
+	}
+
+	var r0 *dto.ReindexCustomersResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.ReindexReport) *dto.ReindexCustomersResponseDto); ok {
+		r0 = rf(report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.ReindexCustomersResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerPresenter_PresentReindex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentReindex'
+type MockCustomerPresenter_PresentReindex_Call struct {
+	*mock.Call
+}
+
+// PresentReindex is a helper method to define mock.On call
+//   - report *entities.ReindexReport
+func (_e *MockCustomerPresenter_Expecter) PresentReindex(report interface{}) *MockCustomerPresenter_PresentReindex_Call {
+	return &MockCustomerPresenter_PresentReindex_Call{Call: _e.mock.On("PresentReindex", report)}
+}
+
+func (_c *MockCustomerPresenter_PresentReindex_Call) Run(run func(report *entities.ReindexReport)) *MockCustomerPresenter_PresentReindex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.ReindexReport))
+	})
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentReindex_Call) Return(_a0 *dto.ReindexCustomersResponseDto) *MockCustomerPresenter_PresentReindex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentReindex_Call) RunAndReturn(run func(*entities.ReindexReport) *dto.ReindexCustomersResponseDto) *MockCustomerPresenter_PresentReindex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentRevision provides a mock function with given fields: revision
+func (_m *MockCustomerPresenter) PresentRevision(revision *entities.CustomerRevision) *dto.CustomerRevisionResponseDto {
+	ret := _m.Called(revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentRevision")
+	}
+
+	var r0 *dto.CustomerRevisionResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.CustomerRevision) *dto.CustomerRevisionResponseDto); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerRevisionResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerPresenter_PresentRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentRevision'
+type MockCustomerPresenter_PresentRevision_Call struct {
+	*mock.Call
+}
+
+// PresentRevision is a helper method to define mock.On call
+//   - revision *entities.CustomerRevision
+func (_e *MockCustomerPresenter_Expecter) PresentRevision(revision interface{}) *MockCustomerPresenter_PresentRevision_Call {
+	return &MockCustomerPresenter_PresentRevision_Call{Call: _e.mock.On("PresentRevision", revision)}
+}
+
+func (_c *MockCustomerPresenter_PresentRevision_Call) Run(run func(revision *entities.CustomerRevision)) *MockCustomerPresenter_PresentRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.CustomerRevision))
+	})
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentRevision_Call) Return(_a0 *dto.CustomerRevisionResponseDto) *MockCustomerPresenter_PresentRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentRevision_Call) RunAndReturn(run func(*entities.CustomerRevision) *dto.CustomerRevisionResponseDto) *MockCustomerPresenter_PresentRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentSession provides a mock function with given fields: customer, token, expiresAt
+func (_m *MockCustomerPresenter) PresentSession(customer *entities.Customer, token string, expiresAt time.Time) *dto.CustomerSessionResponseDto {
+	ret := _m.Called(customer, token, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentSession")
+	}
+
+	var r0 *dto.CustomerSessionResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.Customer, string, time.Time) *dto.CustomerSessionResponseDto); ok {
+		r0 = rf(customer, token, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.CustomerSessionResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockCustomerPresenter_PresentSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentSession'
+type MockCustomerPresenter_PresentSession_Call struct {
+	*mock.Call
+}
+
+// PresentSession is a helper method to define mock.On call
+//   - customer *entities.Customer
+//   - token string
+//   - expiresAt time.Time
+func (_e *MockCustomerPresenter_Expecter) PresentSession(customer interface{}, token interface{}, expiresAt interface{}) *MockCustomerPresenter_PresentSession_Call {
+	return &MockCustomerPresenter_PresentSession_Call{Call: _e.mock.On("PresentSession", customer, token, expiresAt)}
+}
+
+func (_c *MockCustomerPresenter_PresentSession_Call) Run(run func(customer *entities.Customer, token string, expiresAt time.Time)) *MockCustomerPresenter_PresentSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Customer), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentSession_Call) Return(_a0 *dto.CustomerSessionResponseDto) *MockCustomerPresenter_PresentSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerPresenter_PresentSession_Call) RunAndReturn(run func(*entities.Customer, string, time.Time) *dto.CustomerSessionResponseDto) *MockCustomerPresenter_PresentSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCustomerPresenter creates a new instance of MockCustomerPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCustomerPresenter(t interface {
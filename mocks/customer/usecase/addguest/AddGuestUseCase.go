@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAddGuestUseCase is an autogenerated mock type for the AddGuestUseCase type
+type MockAddGuestUseCase struct {
+	mock.Mock
+}
+
+type MockAddGuestUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAddGuestUseCase) EXPECT() *MockAddGuestUseCase_Expecter {
+	return &MockAddGuestUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockAddGuestUseCase) Execute(command *commands.AddGuestCommand) (*entities.Customer, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.AddGuestCommand) (*entities.Customer, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.AddGuestCommand) *entities.Customer); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.AddGuestCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAddGuestUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAddGuestUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.AddGuestCommand
+func (_e *MockAddGuestUseCase_Expecter) Execute(command interface{}) *MockAddGuestUseCase_Execute_Call {
+	return &MockAddGuestUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockAddGuestUseCase_Execute_Call) Run(run func(command *commands.AddGuestCommand)) *MockAddGuestUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.AddGuestCommand))
+	})
+	return _c
+}
+
+func (_c *MockAddGuestUseCase_Execute_Call) Return(_a0 *entities.Customer, _a1 error) *MockAddGuestUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAddGuestUseCase_Execute_Call) RunAndReturn(run func(*commands.AddGuestCommand) (*entities.Customer, error)) *MockAddGuestUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAddGuestUseCase creates a new instance of MockAddGuestUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAddGuestUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAddGuestUseCase {
+	mock := &MockAddGuestUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEraseCustomerUseCase is an autogenerated mock type for the EraseCustomerUseCase type
+type MockEraseCustomerUseCase struct {
+	mock.Mock
+}
+
+type MockEraseCustomerUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEraseCustomerUseCase) EXPECT() *MockEraseCustomerUseCase_Expecter {
+	return &MockEraseCustomerUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockEraseCustomerUseCase) Execute(command *commands.EraseCustomerCommand) error {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*commands.EraseCustomerCommand) error); ok {
+		r0 = rf(command)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEraseCustomerUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockEraseCustomerUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.EraseCustomerCommand
+func (_e *MockEraseCustomerUseCase_Expecter) Execute(command interface{}) *MockEraseCustomerUseCase_Execute_Call {
+	return &MockEraseCustomerUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockEraseCustomerUseCase_Execute_Call) Run(run func(command *commands.EraseCustomerCommand)) *MockEraseCustomerUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.EraseCustomerCommand))
+	})
+	return _c
+}
+
+func (_c *MockEraseCustomerUseCase_Execute_Call) Return(_a0 error) *MockEraseCustomerUseCase_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEraseCustomerUseCase_Execute_Call) RunAndReturn(run func(*commands.EraseCustomerCommand) error) *MockEraseCustomerUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEraseCustomerUseCase creates a new instance of MockEraseCustomerUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEraseCustomerUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEraseCustomerUseCase {
+	mock := &MockEraseCustomerUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockCustomerWriter is an autogenerated mock type for the CustomerWriter type
+type MockCustomerWriter struct {
+	mock.Mock
+}
+
+type MockCustomerWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCustomerWriter) EXPECT() *MockCustomerWriter_Expecter {
+	return &MockCustomerWriter_Expecter{mock: &_m.Mock}
+}
+
+// Write provides a mock function with given fields: customer
+func (_m *MockCustomerWriter) Write(customer *entities.Customer) error {
+	ret := _m.Called(customer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.Customer) error); ok {
+		r0 = rf(customer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCustomerWriter_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type MockCustomerWriter_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - customer *entities.Customer
+func (_e *MockCustomerWriter_Expecter) Write(customer interface{}) *MockCustomerWriter_Write_Call {
+	return &MockCustomerWriter_Write_Call{Call: _e.mock.On("Write", customer)}
+}
+
+func (_c *MockCustomerWriter_Write_Call) Run(run func(customer *entities.Customer)) *MockCustomerWriter_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Customer))
+	})
+	return _c
+}
+
+func (_c *MockCustomerWriter_Write_Call) Return(_a0 error) *MockCustomerWriter_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCustomerWriter_Write_Call) RunAndReturn(run func(*entities.Customer) error) *MockCustomerWriter_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCustomerWriter creates a new instance of MockCustomerWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCustomerWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCustomerWriter {
+	mock := &MockCustomerWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+	exportcustomers "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/exportcustomers"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportCustomersUseCase is an autogenerated mock type for the ExportCustomersUseCase type
+type MockExportCustomersUseCase struct {
+	mock.Mock
+}
+
+type MockExportCustomersUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportCustomersUseCase) EXPECT() *MockExportCustomersUseCase_Expecter {
+	return &MockExportCustomersUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command, writer
+func (_m *MockExportCustomersUseCase) Execute(command *commands.ExportCustomersCommand, writer exportcustomers.CustomerWriter) (int, error) {
+	ret := _m.Called(command, writer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ExportCustomersCommand, exportcustomers.CustomerWriter) (int, error)); ok {
+		return rf(command, writer)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ExportCustomersCommand, exportcustomers.CustomerWriter) int); ok {
+		r0 = rf(command, writer)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ExportCustomersCommand, exportcustomers.CustomerWriter) error); ok {
+		r1 = rf(command, writer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportCustomersUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExportCustomersUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ExportCustomersCommand
+//   - writer exportcustomers.CustomerWriter
+func (_e *MockExportCustomersUseCase_Expecter) Execute(command interface{}, writer interface{}) *MockExportCustomersUseCase_Execute_Call {
+	return &MockExportCustomersUseCase_Execute_Call{Call: _e.mock.On("Execute", command, writer)}
+}
+
+func (_c *MockExportCustomersUseCase_Execute_Call) Run(run func(command *commands.ExportCustomersCommand, writer exportcustomers.CustomerWriter)) *MockExportCustomersUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ExportCustomersCommand), args[1].(exportcustomers.CustomerWriter))
+	})
+	return _c
+}
+
+func (_c *MockExportCustomersUseCase_Execute_Call) Return(_a0 int, _a1 error) *MockExportCustomersUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportCustomersUseCase_Execute_Call) RunAndReturn(run func(*commands.ExportCustomersCommand, exportcustomers.CustomerWriter) (int, error)) *MockExportCustomersUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportCustomersUseCase creates a new instance of MockExportCustomersUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportCustomersUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportCustomersUseCase {
+	mock := &MockExportCustomersUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockGetCustomerAsOfUseCase is an autogenerated mock type for the GetCustomerAsOfUseCase type
+type MockGetCustomerAsOfUseCase struct {
+	mock.Mock
+}
+
+type MockGetCustomerAsOfUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetCustomerAsOfUseCase) EXPECT() *MockGetCustomerAsOfUseCase_Expecter {
+	return &MockGetCustomerAsOfUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockGetCustomerAsOfUseCase) Execute(command *commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.CustomerRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.GetCustomerAsOfCommand) *entities.CustomerRevision); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CustomerRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.GetCustomerAsOfCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGetCustomerAsOfUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetCustomerAsOfUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.GetCustomerAsOfCommand
+func (_e *MockGetCustomerAsOfUseCase_Expecter) Execute(command interface{}) *MockGetCustomerAsOfUseCase_Execute_Call {
+	return &MockGetCustomerAsOfUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockGetCustomerAsOfUseCase_Execute_Call) Run(run func(command *commands.GetCustomerAsOfCommand)) *MockGetCustomerAsOfUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.GetCustomerAsOfCommand))
+	})
+	return _c
+}
+
+func (_c *MockGetCustomerAsOfUseCase_Execute_Call) Return(_a0 *entities.CustomerRevision, _a1 error) *MockGetCustomerAsOfUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGetCustomerAsOfUseCase_Execute_Call) RunAndReturn(run func(*commands.GetCustomerAsOfCommand) (*entities.CustomerRevision, error)) *MockGetCustomerAsOfUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockGetCustomerAsOfUseCase creates a new instance of MockGetCustomerAsOfUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetCustomerAsOfUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetCustomerAsOfUseCase {
+	mock := &MockGetCustomerAsOfUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
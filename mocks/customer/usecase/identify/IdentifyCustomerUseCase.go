@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockIdentifyCustomerUseCase is an autogenerated mock type for the IdentifyCustomerUseCase type
+type MockIdentifyCustomerUseCase struct {
+	mock.Mock
+}
+
+type MockIdentifyCustomerUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdentifyCustomerUseCase) EXPECT() *MockIdentifyCustomerUseCase_Expecter {
+	return &MockIdentifyCustomerUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockIdentifyCustomerUseCase) Execute(command *commands.IdentifyCustomerCommand) (*entities.Customer, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.IdentifyCustomerCommand) (*entities.Customer, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.IdentifyCustomerCommand) *entities.Customer); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.IdentifyCustomerCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockIdentifyCustomerUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockIdentifyCustomerUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.IdentifyCustomerCommand
+func (_e *MockIdentifyCustomerUseCase_Expecter) Execute(command interface{}) *MockIdentifyCustomerUseCase_Execute_Call {
+	return &MockIdentifyCustomerUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockIdentifyCustomerUseCase_Execute_Call) Run(run func(command *commands.IdentifyCustomerCommand)) *MockIdentifyCustomerUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.IdentifyCustomerCommand))
+	})
+	return _c
+}
+
+func (_c *MockIdentifyCustomerUseCase_Execute_Call) Return(_a0 *entities.Customer, _a1 error) *MockIdentifyCustomerUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIdentifyCustomerUseCase_Execute_Call) RunAndReturn(run func(*commands.IdentifyCustomerCommand) (*entities.Customer, error)) *MockIdentifyCustomerUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdentifyCustomerUseCase creates a new instance of MockIdentifyCustomerUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdentifyCustomerUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdentifyCustomerUseCase {
+	mock := &MockIdentifyCustomerUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockImportCustomersUseCase is an autogenerated mock type for the ImportCustomersUseCase type
+type MockImportCustomersUseCase struct {
+	mock.Mock
+}
+
+type MockImportCustomersUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockImportCustomersUseCase) EXPECT() *MockImportCustomersUseCase_Expecter {
+	return &MockImportCustomersUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockImportCustomersUseCase) Execute(command *commands.ImportCustomersCommand) (*entities.ImportReport, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ImportCustomersCommand) (*entities.ImportReport, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ImportCustomersCommand) *entities.ImportReport); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ImportCustomersCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockImportCustomersUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockImportCustomersUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ImportCustomersCommand
+func (_e *MockImportCustomersUseCase_Expecter) Execute(command interface{}) *MockImportCustomersUseCase_Execute_Call {
+	return &MockImportCustomersUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockImportCustomersUseCase_Execute_Call) Run(run func(command *commands.ImportCustomersCommand)) *MockImportCustomersUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ImportCustomersCommand))
+	})
+	return _c
+}
+
+func (_c *MockImportCustomersUseCase_Execute_Call) Return(_a0 *entities.ImportReport, _a1 error) *MockImportCustomersUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockImportCustomersUseCase_Execute_Call) RunAndReturn(run func(*commands.ImportCustomersCommand) (*entities.ImportReport, error)) *MockImportCustomersUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockImportCustomersUseCase creates a new instance of MockImportCustomersUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockImportCustomersUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockImportCustomersUseCase {
+	mock := &MockImportCustomersUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
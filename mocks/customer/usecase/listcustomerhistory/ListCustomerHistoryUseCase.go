@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListCustomerHistoryUseCase is an autogenerated mock type for the ListCustomerHistoryUseCase type
+type MockListCustomerHistoryUseCase struct {
+	mock.Mock
+}
+
+type MockListCustomerHistoryUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListCustomerHistoryUseCase) EXPECT() *MockListCustomerHistoryUseCase_Expecter {
+	return &MockListCustomerHistoryUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListCustomerHistoryUseCase) Execute(command *commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.CustomerHistoryPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListCustomerHistoryCommand) *entities.CustomerHistoryPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CustomerHistoryPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListCustomerHistoryCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListCustomerHistoryUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListCustomerHistoryUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListCustomerHistoryCommand
+func (_e *MockListCustomerHistoryUseCase_Expecter) Execute(command interface{}) *MockListCustomerHistoryUseCase_Execute_Call {
+	return &MockListCustomerHistoryUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListCustomerHistoryUseCase_Execute_Call) Run(run func(command *commands.ListCustomerHistoryCommand)) *MockListCustomerHistoryUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListCustomerHistoryCommand))
+	})
+	return _c
+}
+
+func (_c *MockListCustomerHistoryUseCase_Execute_Call) Return(_a0 *entities.CustomerHistoryPage, _a1 error) *MockListCustomerHistoryUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListCustomerHistoryUseCase_Execute_Call) RunAndReturn(run func(*commands.ListCustomerHistoryCommand) (*entities.CustomerHistoryPage, error)) *MockListCustomerHistoryUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListCustomerHistoryUseCase creates a new instance of MockListCustomerHistoryUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListCustomerHistoryUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListCustomerHistoryUseCase {
+	mock := &MockListCustomerHistoryUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
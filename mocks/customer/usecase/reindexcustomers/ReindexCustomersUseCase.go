@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/customer/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/customer/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockReindexCustomersUseCase is an autogenerated mock type for the ReindexCustomersUseCase type
+type MockReindexCustomersUseCase struct {
+	mock.Mock
+}
+
+type MockReindexCustomersUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReindexCustomersUseCase) EXPECT() *MockReindexCustomersUseCase_Expecter {
+	return &MockReindexCustomersUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockReindexCustomersUseCase) Execute(command *commands.ReindexCustomersCommand) (*entities.ReindexReport, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.ReindexReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ReindexCustomersCommand) (*entities.ReindexReport, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ReindexCustomersCommand) *entities.ReindexReport); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReindexReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ReindexCustomersCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReindexCustomersUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockReindexCustomersUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ReindexCustomersCommand
+func (_e *MockReindexCustomersUseCase_Expecter) Execute(command interface{}) *MockReindexCustomersUseCase_Execute_Call {
+	return &MockReindexCustomersUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockReindexCustomersUseCase_Execute_Call) Run(run func(command *commands.ReindexCustomersCommand)) *MockReindexCustomersUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ReindexCustomersCommand))
+	})
+	return _c
+}
+
+func (_c *MockReindexCustomersUseCase_Execute_Call) Return(_a0 *entities.ReindexReport, _a1 error) *MockReindexCustomersUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReindexCustomersUseCase_Execute_Call) RunAndReturn(run func(*commands.ReindexCustomersCommand) (*entities.ReindexReport, error)) *MockReindexCustomersUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReindexCustomersUseCase creates a new instance of MockReindexCustomersUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReindexCustomersUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReindexCustomersUseCase {
+	mock := &MockReindexCustomersUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
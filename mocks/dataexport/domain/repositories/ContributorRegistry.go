@@ -0,0 +1,115 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	repositories "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/repositories"
+)
+
+// MockContributorRegistry is an autogenerated mock type for the ContributorRegistry type
+type MockContributorRegistry struct {
+	mock.Mock
+}
+
+type MockContributorRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockContributorRegistry) EXPECT() *MockContributorRegistry_Expecter {
+	return &MockContributorRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Contributors provides a mock function with no fields
+func (_m *MockContributorRegistry) Contributors() []repositories.DataContributor {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Contributors")
+	}
+
+	var r0 []repositories.DataContributor
+	if rf, ok := ret.Get(0).(func() []repositories.DataContributor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.DataContributor)
+		}
+	}
+
+	return r0
+}
+
+// MockContributorRegistry_Contributors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Contributors'
+type MockContributorRegistry_Contributors_Call struct {
+	*mock.Call
+}
+
+// Contributors is a helper method to define mock.On call
+func (_e *MockContributorRegistry_Expecter) Contributors() *MockContributorRegistry_Contributors_Call {
+	return &MockContributorRegistry_Contributors_Call{Call: _e.mock.On("Contributors")}
+}
+
+func (_c *MockContributorRegistry_Contributors_Call) Run(run func()) *MockContributorRegistry_Contributors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockContributorRegistry_Contributors_Call) Return(_a0 []repositories.DataContributor) *MockContributorRegistry_Contributors_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContributorRegistry_Contributors_Call) RunAndReturn(run func() []repositories.DataContributor) *MockContributorRegistry_Contributors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: contributor
+func (_m *MockContributorRegistry) Register(contributor repositories.DataContributor) {
+	_m.Called(contributor)
+}
+
+// MockContributorRegistry_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockContributorRegistry_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - contributor repositories.DataContributor
+func (_e *MockContributorRegistry_Expecter) Register(contributor interface{}) *MockContributorRegistry_Register_Call {
+	return &MockContributorRegistry_Register_Call{Call: _e.mock.On("Register", contributor)}
+}
+
+func (_c *MockContributorRegistry_Register_Call) Run(run func(contributor repositories.DataContributor)) *MockContributorRegistry_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(repositories.DataContributor))
+	})
+	return _c
+}
+
+func (_c *MockContributorRegistry_Register_Call) Return() *MockContributorRegistry_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockContributorRegistry_Register_Call) RunAndReturn(run func(repositories.DataContributor)) *MockContributorRegistry_Register_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockContributorRegistry creates a new instance of MockContributorRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockContributorRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockContributorRegistry {
+	mock := &MockContributorRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
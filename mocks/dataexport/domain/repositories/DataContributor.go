@@ -0,0 +1,180 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MockDataContributor is an autogenerated mock type for the DataContributor type
+type MockDataContributor struct {
+	mock.Mock
+}
+
+type MockDataContributor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataContributor) EXPECT() *MockDataContributor_Expecter {
+	return &MockDataContributor_Expecter{mock: &_m.Mock}
+}
+
+// Collect provides a mock function with given fields: customerID
+func (_m *MockDataContributor) Collect(customerID string) (interface{}, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collect")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataContributor_Collect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collect'
+type MockDataContributor_Collect_Call struct {
+	*mock.Call
+}
+
+// Collect is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockDataContributor_Expecter) Collect(customerID interface{}) *MockDataContributor_Collect_Call {
+	return &MockDataContributor_Collect_Call{Call: _e.mock.On("Collect", customerID)}
+}
+
+func (_c *MockDataContributor_Collect_Call) Run(run func(customerID string)) *MockDataContributor_Collect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockDataContributor_Collect_Call) Return(_a0 interface{}, _a1 error) *MockDataContributor_Collect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataContributor_Collect_Call) RunAndReturn(run func(string) (interface{}, error)) *MockDataContributor_Collect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Section provides a mock function with no fields
+func (_m *MockDataContributor) Section() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Section")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockDataContributor_Section_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Section'
+type MockDataContributor_Section_Call struct {
+	*mock.Call
+}
+
+// Section is a helper method to define mock.On call
+func (_e *MockDataContributor_Expecter) Section() *MockDataContributor_Section_Call {
+	return &MockDataContributor_Section_Call{Call: _e.mock.On("Section")}
+}
+
+func (_c *MockDataContributor_Section_Call) Run(run func()) *MockDataContributor_Section_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDataContributor_Section_Call) Return(_a0 string) *MockDataContributor_Section_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDataContributor_Section_Call) RunAndReturn(run func() string) *MockDataContributor_Section_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Title provides a mock function with no fields
+func (_m *MockDataContributor) Title() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Title")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockDataContributor_Title_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Title'
+type MockDataContributor_Title_Call struct {
+	*mock.Call
+}
+
+// Title is a helper method to define mock.On call
+func (_e *MockDataContributor_Expecter) Title() *MockDataContributor_Title_Call {
+	return &MockDataContributor_Title_Call{Call: _e.mock.On("Title")}
+}
+
+func (_c *MockDataContributor_Title_Call) Run(run func()) *MockDataContributor_Title_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDataContributor_Title_Call) Return(_a0 string) *MockDataContributor_Title_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDataContributor_Title_Call) RunAndReturn(run func() string) *MockDataContributor_Title_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataContributor creates a new instance of MockDataContributor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataContributor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataContributor {
+	mock := &MockDataContributor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
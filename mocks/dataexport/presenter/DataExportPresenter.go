@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockDataExportPresenter is an autogenerated mock type for the DataExportPresenter type
+type MockDataExportPresenter struct {
+	mock.Mock
+}
+
+type MockDataExportPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDataExportPresenter) EXPECT() *MockDataExportPresenter_Expecter {
+	return &MockDataExportPresenter_Expecter{mock: &_m.Mock}
+}
+
+// Present provides a mock function with given fields: export
+func (_m *MockDataExportPresenter) Present(export *entities.DataExport) *dto.DataExportResponseDto {
+	ret := _m.Called(export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Present")
+	}
+
+	var r0 *dto.DataExportResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.DataExport) *dto.DataExportResponseDto); ok {
+		r0 = rf(export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.DataExportResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockDataExportPresenter_Present_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Present'
+type MockDataExportPresenter_Present_Call struct {
+	*mock.Call
+}
+
+// Present is a helper method to define mock.On call
+//   - export *entities.DataExport
+func (_e *MockDataExportPresenter_Expecter) Present(export interface{}) *MockDataExportPresenter_Present_Call {
+	return &MockDataExportPresenter_Present_Call{Call: _e.mock.On("Present", export)}
+}
+
+func (_c *MockDataExportPresenter_Present_Call) Run(run func(export *entities.DataExport)) *MockDataExportPresenter_Present_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.DataExport))
+	})
+	return _c
+}
+
+func (_c *MockDataExportPresenter_Present_Call) Return(_a0 *dto.DataExportResponseDto) *MockDataExportPresenter_Present_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDataExportPresenter_Present_Call) RunAndReturn(run func(*entities.DataExport) *dto.DataExportResponseDto) *MockDataExportPresenter_Present_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentHTML provides a mock function with given fields: export
+func (_m *MockDataExportPresenter) PresentHTML(export *entities.DataExport) ([]byte, error) {
+	ret := _m.Called(export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentHTML")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.DataExport) ([]byte, error)); ok {
+		return rf(export)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.DataExport) []byte); ok {
+		r0 = rf(export)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.DataExport) error); ok {
+		r1 = rf(export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataExportPresenter_PresentHTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentHTML'
+type MockDataExportPresenter_PresentHTML_Call struct {
+	*mock.Call
+}
+
+// PresentHTML is a helper method to define mock.On call
+//   - export *entities.DataExport
+func (_e *MockDataExportPresenter_Expecter) PresentHTML(export interface{}) *MockDataExportPresenter_PresentHTML_Call {
+	return &MockDataExportPresenter_PresentHTML_Call{Call: _e.mock.On("PresentHTML", export)}
+}
+
+func (_c *MockDataExportPresenter_PresentHTML_Call) Run(run func(export *entities.DataExport)) *MockDataExportPresenter_PresentHTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.DataExport))
+	})
+	return _c
+}
+
+func (_c *MockDataExportPresenter_PresentHTML_Call) Return(_a0 []byte, _a1 error) *MockDataExportPresenter_PresentHTML_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataExportPresenter_PresentHTML_Call) RunAndReturn(run func(*entities.DataExport) ([]byte, error)) *MockDataExportPresenter_PresentHTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDataExportPresenter creates a new instance of MockDataExportPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDataExportPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDataExportPresenter {
+	mock := &MockDataExportPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
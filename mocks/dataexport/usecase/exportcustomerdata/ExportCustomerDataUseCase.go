@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/dataexport/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExportCustomerDataUseCase is an autogenerated mock type for the ExportCustomerDataUseCase type
+type MockExportCustomerDataUseCase struct {
+	mock.Mock
+}
+
+type MockExportCustomerDataUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportCustomerDataUseCase) EXPECT() *MockExportCustomerDataUseCase_Expecter {
+	return &MockExportCustomerDataUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockExportCustomerDataUseCase) Execute(command *commands.ExportCustomerDataCommand) (*entities.DataExport, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.DataExport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ExportCustomerDataCommand) (*entities.DataExport, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ExportCustomerDataCommand) *entities.DataExport); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.DataExport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ExportCustomerDataCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExportCustomerDataUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExportCustomerDataUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ExportCustomerDataCommand
+func (_e *MockExportCustomerDataUseCase_Expecter) Execute(command interface{}) *MockExportCustomerDataUseCase_Execute_Call {
+	return &MockExportCustomerDataUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockExportCustomerDataUseCase_Execute_Call) Run(run func(command *commands.ExportCustomerDataCommand)) *MockExportCustomerDataUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ExportCustomerDataCommand))
+	})
+	return _c
+}
+
+func (_c *MockExportCustomerDataUseCase_Execute_Call) Return(_a0 *entities.DataExport, _a1 error) *MockExportCustomerDataUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExportCustomerDataUseCase_Execute_Call) RunAndReturn(run func(*commands.ExportCustomerDataCommand) (*entities.DataExport, error)) *MockExportCustomerDataUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportCustomerDataUseCase creates a new instance of MockExportCustomerDataUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportCustomerDataUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportCustomerDataUseCase {
+	mock := &MockExportCustomerDataUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,389 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+)
+
+// MockLoyaltyController is an autogenerated mock type for the LoyaltyController type
+type MockLoyaltyController struct {
+	mock.Mock
+}
+
+type MockLoyaltyController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoyaltyController) EXPECT() *MockLoyaltyController_Expecter {
+	return &MockLoyaltyController_Expecter{mock: &_m.Mock}
+}
+
+// Adjust provides a mock function with given fields: customerID, request
+func (_m *MockLoyaltyController) Adjust(customerID string, request *dto.AdjustPointsRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	ret := _m.Called(customerID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Adjust")
+	}
+
+	var r0 *dto.LedgerEntryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.AdjustPointsRequestDto) (*dto.LedgerEntryResponseDto, error)); ok {
+		return rf(customerID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.AdjustPointsRequestDto) *dto.LedgerEntryResponseDto); ok {
+		r0 = rf(customerID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerEntryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.AdjustPointsRequestDto) error); ok {
+		r1 = rf(customerID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_Adjust_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Adjust'
+type MockLoyaltyController_Adjust_Call struct {
+	*mock.Call
+}
+
+// Adjust is a helper method to define mock.On call
+//   - customerID string
+//   - request *dto.AdjustPointsRequestDto
+func (_e *MockLoyaltyController_Expecter) Adjust(customerID interface{}, request interface{}) *MockLoyaltyController_Adjust_Call {
+	return &MockLoyaltyController_Adjust_Call{Call: _e.mock.On("Adjust", customerID, request)}
+}
+
+func (_c *MockLoyaltyController_Adjust_Call) Run(run func(customerID string, request *dto.AdjustPointsRequestDto)) *MockLoyaltyController_Adjust_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.AdjustPointsRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_Adjust_Call) Return(_a0 *dto.LedgerEntryResponseDto, _a1 error) *MockLoyaltyController_Adjust_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_Adjust_Call) RunAndReturn(run func(string, *dto.AdjustPointsRequestDto) (*dto.LedgerEntryResponseDto, error)) *MockLoyaltyController_Adjust_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalance provides a mock function with given fields: customerID
+func (_m *MockLoyaltyController) GetBalance(customerID string) (*dto.LoyaltyBalanceResponseDto, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 *dto.LoyaltyBalanceResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dto.LoyaltyBalanceResponseDto, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dto.LoyaltyBalanceResponseDto); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LoyaltyBalanceResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type MockLoyaltyController_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockLoyaltyController_Expecter) GetBalance(customerID interface{}) *MockLoyaltyController_GetBalance_Call {
+	return &MockLoyaltyController_GetBalance_Call{Call: _e.mock.On("GetBalance", customerID)}
+}
+
+func (_c *MockLoyaltyController_GetBalance_Call) Run(run func(customerID string)) *MockLoyaltyController_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_GetBalance_Call) Return(_a0 *dto.LoyaltyBalanceResponseDto, _a1 error) *MockLoyaltyController_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_GetBalance_Call) RunAndReturn(run func(string) (*dto.LoyaltyBalanceResponseDto, error)) *MockLoyaltyController_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockLoyaltyController) ListEntries(customerID string, limit int, cursor string) (*dto.LedgerResponseDto, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntries")
+	}
+
+	var r0 *dto.LedgerResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*dto.LedgerResponseDto, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *dto.LedgerResponseDto); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type MockLoyaltyController_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockLoyaltyController_Expecter) ListEntries(customerID interface{}, limit interface{}, cursor interface{}) *MockLoyaltyController_ListEntries_Call {
+	return &MockLoyaltyController_ListEntries_Call{Call: _e.mock.On("ListEntries", customerID, limit, cursor)}
+}
+
+func (_c *MockLoyaltyController_ListEntries_Call) Run(run func(customerID string, limit int, cursor string)) *MockLoyaltyController_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_ListEntries_Call) Return(_a0 *dto.LedgerResponseDto, _a1 error) *MockLoyaltyController_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_ListEntries_Call) RunAndReturn(run func(string, int, string) (*dto.LedgerResponseDto, error)) *MockLoyaltyController_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRewards provides a mock function with given fields: customerID
+func (_m *MockLoyaltyController) ListRewards(customerID string) (*dto.RewardsCatalogResponseDto, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRewards")
+	}
+
+	var r0 *dto.RewardsCatalogResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dto.RewardsCatalogResponseDto, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dto.RewardsCatalogResponseDto); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RewardsCatalogResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_ListRewards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRewards'
+type MockLoyaltyController_ListRewards_Call struct {
+	*mock.Call
+}
+
+// ListRewards is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockLoyaltyController_Expecter) ListRewards(customerID interface{}) *MockLoyaltyController_ListRewards_Call {
+	return &MockLoyaltyController_ListRewards_Call{Call: _e.mock.On("ListRewards", customerID)}
+}
+
+func (_c *MockLoyaltyController_ListRewards_Call) Run(run func(customerID string)) *MockLoyaltyController_ListRewards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_ListRewards_Call) Return(_a0 *dto.RewardsCatalogResponseDto, _a1 error) *MockLoyaltyController_ListRewards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_ListRewards_Call) RunAndReturn(run func(string) (*dto.RewardsCatalogResponseDto, error)) *MockLoyaltyController_ListRewards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Redeem provides a mock function with given fields: customerID, request
+func (_m *MockLoyaltyController) Redeem(customerID string, request *dto.RedeemPointsRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	ret := _m.Called(customerID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeem")
+	}
+
+	var r0 *dto.LedgerEntryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *dto.RedeemPointsRequestDto) (*dto.LedgerEntryResponseDto, error)); ok {
+		return rf(customerID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, *dto.RedeemPointsRequestDto) *dto.LedgerEntryResponseDto); ok {
+		r0 = rf(customerID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerEntryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *dto.RedeemPointsRequestDto) error); ok {
+		r1 = rf(customerID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_Redeem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeem'
+type MockLoyaltyController_Redeem_Call struct {
+	*mock.Call
+}
+
+// Redeem is a helper method to define mock.On call
+//   - customerID string
+//   - request *dto.RedeemPointsRequestDto
+func (_e *MockLoyaltyController_Expecter) Redeem(customerID interface{}, request interface{}) *MockLoyaltyController_Redeem_Call {
+	return &MockLoyaltyController_Redeem_Call{Call: _e.mock.On("Redeem", customerID, request)}
+}
+
+func (_c *MockLoyaltyController_Redeem_Call) Run(run func(customerID string, request *dto.RedeemPointsRequestDto)) *MockLoyaltyController_Redeem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*dto.RedeemPointsRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_Redeem_Call) Return(_a0 *dto.LedgerEntryResponseDto, _a1 error) *MockLoyaltyController_Redeem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_Redeem_Call) RunAndReturn(run func(string, *dto.RedeemPointsRequestDto) (*dto.LedgerEntryResponseDto, error)) *MockLoyaltyController_Redeem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemReward provides a mock function with given fields: customerID, rewardID, request
+func (_m *MockLoyaltyController) RedeemReward(customerID string, rewardID string, request *dto.RedeemRewardRequestDto) (*dto.LedgerEntryResponseDto, error) {
+	ret := _m.Called(customerID, rewardID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemReward")
+	}
+
+	var r0 *dto.LedgerEntryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *dto.RedeemRewardRequestDto) (*dto.LedgerEntryResponseDto, error)); ok {
+		return rf(customerID, rewardID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *dto.RedeemRewardRequestDto) *dto.LedgerEntryResponseDto); ok {
+		r0 = rf(customerID, rewardID, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerEntryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *dto.RedeemRewardRequestDto) error); ok {
+		r1 = rf(customerID, rewardID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyController_RedeemReward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemReward'
+type MockLoyaltyController_RedeemReward_Call struct {
+	*mock.Call
+}
+
+// RedeemReward is a helper method to define mock.On call
+//   - customerID string
+//   - rewardID string
+//   - request *dto.RedeemRewardRequestDto
+func (_e *MockLoyaltyController_Expecter) RedeemReward(customerID interface{}, rewardID interface{}, request interface{}) *MockLoyaltyController_RedeemReward_Call {
+	return &MockLoyaltyController_RedeemReward_Call{Call: _e.mock.On("RedeemReward", customerID, rewardID, request)}
+}
+
+func (_c *MockLoyaltyController_RedeemReward_Call) Run(run func(customerID string, rewardID string, request *dto.RedeemRewardRequestDto)) *MockLoyaltyController_RedeemReward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(*dto.RedeemRewardRequestDto))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyController_RedeemReward_Call) Return(_a0 *dto.LedgerEntryResponseDto, _a1 error) *MockLoyaltyController_RedeemReward_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyController_RedeemReward_Call) RunAndReturn(run func(string, string, *dto.RedeemRewardRequestDto) (*dto.LedgerEntryResponseDto, error)) *MockLoyaltyController_RedeemReward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoyaltyController creates a new instance of MockLoyaltyController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoyaltyController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoyaltyController {
+	mock := &MockLoyaltyController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
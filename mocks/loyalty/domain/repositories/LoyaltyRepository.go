@@ -0,0 +1,442 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+
+	time "time"
+)
+
+// MockLoyaltyRepository is an autogenerated mock type for the LoyaltyRepository type
+type MockLoyaltyRepository struct {
+	mock.Mock
+}
+
+type MockLoyaltyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoyaltyRepository) EXPECT() *MockLoyaltyRepository_Expecter {
+	return &MockLoyaltyRepository_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function with given fields: entry
+func (_m *MockLoyaltyRepository) Append(entry *entities.LedgerEntry) (*entities.Balance, error) {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 *entities.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.LedgerEntry) (*entities.Balance, error)); ok {
+		return rf(entry)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.LedgerEntry) *entities.Balance); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Balance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.LedgerEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyRepository_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type MockLoyaltyRepository_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - entry *entities.LedgerEntry
+func (_e *MockLoyaltyRepository_Expecter) Append(entry interface{}) *MockLoyaltyRepository_Append_Call {
+	return &MockLoyaltyRepository_Append_Call{Call: _e.mock.On("Append", entry)}
+}
+
+func (_c *MockLoyaltyRepository_Append_Call) Run(run func(entry *entities.LedgerEntry)) *MockLoyaltyRepository_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.LedgerEntry))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_Append_Call) Return(_a0 *entities.Balance, _a1 error) *MockLoyaltyRepository_Append_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_Append_Call) RunAndReturn(run func(*entities.LedgerEntry) (*entities.Balance, error)) *MockLoyaltyRepository_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalance provides a mock function with given fields: customerID
+func (_m *MockLoyaltyRepository) GetBalance(customerID string) (*entities.Balance, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 *entities.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.Balance, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.Balance); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Balance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyRepository_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type MockLoyaltyRepository_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockLoyaltyRepository_Expecter) GetBalance(customerID interface{}) *MockLoyaltyRepository_GetBalance_Call {
+	return &MockLoyaltyRepository_GetBalance_Call{Call: _e.mock.On("GetBalance", customerID)}
+}
+
+func (_c *MockLoyaltyRepository_GetBalance_Call) Run(run func(customerID string)) *MockLoyaltyRepository_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_GetBalance_Call) Return(_a0 *entities.Balance, _a1 error) *MockLoyaltyRepository_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_GetBalance_Call) RunAndReturn(run func(string) (*entities.Balance, error)) *MockLoyaltyRepository_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTier provides a mock function with given fields: customerID
+func (_m *MockLoyaltyRepository) GetTier(customerID string) (*entities.CustomerTier, error) {
+	ret := _m.Called(customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTier")
+	}
+
+	var r0 *entities.CustomerTier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entities.CustomerTier, error)); ok {
+		return rf(customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entities.CustomerTier); ok {
+		r0 = rf(customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.CustomerTier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyRepository_GetTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTier'
+type MockLoyaltyRepository_GetTier_Call struct {
+	*mock.Call
+}
+
+// GetTier is a helper method to define mock.On call
+//   - customerID string
+func (_e *MockLoyaltyRepository_Expecter) GetTier(customerID interface{}) *MockLoyaltyRepository_GetTier_Call {
+	return &MockLoyaltyRepository_GetTier_Call{Call: _e.mock.On("GetTier", customerID)}
+}
+
+func (_c *MockLoyaltyRepository_GetTier_Call) Run(run func(customerID string)) *MockLoyaltyRepository_GetTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_GetTier_Call) Return(_a0 *entities.CustomerTier, _a1 error) *MockLoyaltyRepository_GetTier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_GetTier_Call) RunAndReturn(run func(string) (*entities.CustomerTier, error)) *MockLoyaltyRepository_GetTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCustomers provides a mock function with given fields: limit, cursor
+func (_m *MockLoyaltyRepository) ListCustomers(limit int, cursor string) ([]string, string, error) {
+	ret := _m.Called(limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomers")
+	}
+
+	var r0 []string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int, string) ([]string, string, error)); ok {
+		return rf(limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(int, string) []string); ok {
+		r0 = rf(limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string) string); ok {
+		r1 = rf(limit, cursor)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(int, string) error); ok {
+		r2 = rf(limit, cursor)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockLoyaltyRepository_ListCustomers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCustomers'
+type MockLoyaltyRepository_ListCustomers_Call struct {
+	*mock.Call
+}
+
+// ListCustomers is a helper method to define mock.On call
+//   - limit int
+//   - cursor string
+func (_e *MockLoyaltyRepository_Expecter) ListCustomers(limit interface{}, cursor interface{}) *MockLoyaltyRepository_ListCustomers_Call {
+	return &MockLoyaltyRepository_ListCustomers_Call{Call: _e.mock.On("ListCustomers", limit, cursor)}
+}
+
+func (_c *MockLoyaltyRepository_ListCustomers_Call) Run(run func(limit int, cursor string)) *MockLoyaltyRepository_ListCustomers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListCustomers_Call) Return(_a0 []string, _a1 string, _a2 error) *MockLoyaltyRepository_ListCustomers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListCustomers_Call) RunAndReturn(run func(int, string) ([]string, string, error)) *MockLoyaltyRepository_ListCustomers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntries provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockLoyaltyRepository) ListEntries(customerID string, limit int, cursor string) (*entities.LedgerPage, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntries")
+	}
+
+	var r0 *entities.LedgerPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*entities.LedgerPage, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *entities.LedgerPage); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LedgerPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyRepository_ListEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntries'
+type MockLoyaltyRepository_ListEntries_Call struct {
+	*mock.Call
+}
+
+// ListEntries is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockLoyaltyRepository_Expecter) ListEntries(customerID interface{}, limit interface{}, cursor interface{}) *MockLoyaltyRepository_ListEntries_Call {
+	return &MockLoyaltyRepository_ListEntries_Call{Call: _e.mock.On("ListEntries", customerID, limit, cursor)}
+}
+
+func (_c *MockLoyaltyRepository_ListEntries_Call) Run(run func(customerID string, limit int, cursor string)) *MockLoyaltyRepository_ListEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListEntries_Call) Return(_a0 *entities.LedgerPage, _a1 error) *MockLoyaltyRepository_ListEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListEntries_Call) RunAndReturn(run func(string, int, string) (*entities.LedgerPage, error)) *MockLoyaltyRepository_ListEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntriesSince provides a mock function with given fields: customerID, since
+func (_m *MockLoyaltyRepository) ListEntriesSince(customerID string, since time.Time) ([]*entities.LedgerEntry, error) {
+	ret := _m.Called(customerID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntriesSince")
+	}
+
+	var r0 []*entities.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*entities.LedgerEntry, error)); ok {
+		return rf(customerID, since)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*entities.LedgerEntry); ok {
+		r0 = rf(customerID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(customerID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoyaltyRepository_ListEntriesSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntriesSince'
+type MockLoyaltyRepository_ListEntriesSince_Call struct {
+	*mock.Call
+}
+
+// ListEntriesSince is a helper method to define mock.On call
+//   - customerID string
+//   - since time.Time
+func (_e *MockLoyaltyRepository_Expecter) ListEntriesSince(customerID interface{}, since interface{}) *MockLoyaltyRepository_ListEntriesSince_Call {
+	return &MockLoyaltyRepository_ListEntriesSince_Call{Call: _e.mock.On("ListEntriesSince", customerID, since)}
+}
+
+func (_c *MockLoyaltyRepository_ListEntriesSince_Call) Run(run func(customerID string, since time.Time)) *MockLoyaltyRepository_ListEntriesSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListEntriesSince_Call) Return(_a0 []*entities.LedgerEntry, _a1 error) *MockLoyaltyRepository_ListEntriesSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_ListEntriesSince_Call) RunAndReturn(run func(string, time.Time) ([]*entities.LedgerEntry, error)) *MockLoyaltyRepository_ListEntriesSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveTier provides a mock function with given fields: tier
+func (_m *MockLoyaltyRepository) SaveTier(tier *entities.CustomerTier) error {
+	ret := _m.Called(tier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveTier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.CustomerTier) error); ok {
+		r0 = rf(tier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLoyaltyRepository_SaveTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveTier'
+type MockLoyaltyRepository_SaveTier_Call struct {
+	*mock.Call
+}
+
+// SaveTier is a helper method to define mock.On call
+//   - tier *entities.CustomerTier
+func (_e *MockLoyaltyRepository_Expecter) SaveTier(tier interface{}) *MockLoyaltyRepository_SaveTier_Call {
+	return &MockLoyaltyRepository_SaveTier_Call{Call: _e.mock.On("SaveTier", tier)}
+}
+
+func (_c *MockLoyaltyRepository_SaveTier_Call) Run(run func(tier *entities.CustomerTier)) *MockLoyaltyRepository_SaveTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.CustomerTier))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_SaveTier_Call) Return(_a0 error) *MockLoyaltyRepository_SaveTier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyRepository_SaveTier_Call) RunAndReturn(run func(*entities.CustomerTier) error) *MockLoyaltyRepository_SaveTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoyaltyRepository creates a new instance of MockLoyaltyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoyaltyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoyaltyRepository {
+	mock := &MockLoyaltyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
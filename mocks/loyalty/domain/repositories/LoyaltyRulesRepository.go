@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+)
+
+// MockLoyaltyRulesRepository is an autogenerated mock type for the LoyaltyRulesRepository type
+type MockLoyaltyRulesRepository struct {
+	mock.Mock
+}
+
+type MockLoyaltyRulesRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoyaltyRulesRepository) EXPECT() *MockLoyaltyRulesRepository_Expecter {
+	return &MockLoyaltyRulesRepository_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with no fields
+func (_m *MockLoyaltyRulesRepository) Get() *entities.LoyaltyRules {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *entities.LoyaltyRules
+	if rf, ok := ret.Get(0).(func() *entities.LoyaltyRules); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoyaltyRules)
+		}
+	}
+
+	return r0
+}
+
+// MockLoyaltyRulesRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockLoyaltyRulesRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+func (_e *MockLoyaltyRulesRepository_Expecter) Get() *MockLoyaltyRulesRepository_Get_Call {
+	return &MockLoyaltyRulesRepository_Get_Call{Call: _e.mock.On("Get")}
+}
+
+func (_c *MockLoyaltyRulesRepository_Get_Call) Run(run func()) *MockLoyaltyRulesRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyRulesRepository_Get_Call) Return(_a0 *entities.LoyaltyRules) *MockLoyaltyRulesRepository_Get_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyRulesRepository_Get_Call) RunAndReturn(run func() *entities.LoyaltyRules) *MockLoyaltyRulesRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoyaltyRulesRepository creates a new instance of MockLoyaltyRulesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoyaltyRulesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoyaltyRulesRepository {
+	mock := &MockLoyaltyRulesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
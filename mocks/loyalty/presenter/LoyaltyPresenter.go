@@ -0,0 +1,229 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLoyaltyPresenter is an autogenerated mock type for the LoyaltyPresenter type
+type MockLoyaltyPresenter struct {
+	mock.Mock
+}
+
+type MockLoyaltyPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLoyaltyPresenter) EXPECT() *MockLoyaltyPresenter_Expecter {
+	return &MockLoyaltyPresenter_Expecter{mock: &_m.Mock}
+}
+
+// PresentBalance provides a mock function with given fields: balance
+func (_m *MockLoyaltyPresenter) PresentBalance(balance *entities.Balance) *dto.LoyaltyBalanceResponseDto {
+	ret := _m.Called(balance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentBalance")
+	}
+
+	var r0 *dto.LoyaltyBalanceResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.Balance) *dto.LoyaltyBalanceResponseDto); ok {
+		r0 = rf(balance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LoyaltyBalanceResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockLoyaltyPresenter_PresentBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentBalance'
+type MockLoyaltyPresenter_PresentBalance_Call struct {
+	*mock.Call
+}
+
+// PresentBalance is a helper method to define mock.On call
+//   - balance *entities.Balance
+func (_e *MockLoyaltyPresenter_Expecter) PresentBalance(balance interface{}) *MockLoyaltyPresenter_PresentBalance_Call {
+	return &MockLoyaltyPresenter_PresentBalance_Call{Call: _e.mock.On("PresentBalance", balance)}
+}
+
+func (_c *MockLoyaltyPresenter_PresentBalance_Call) Run(run func(balance *entities.Balance)) *MockLoyaltyPresenter_PresentBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.Balance))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentBalance_Call) Return(_a0 *dto.LoyaltyBalanceResponseDto) *MockLoyaltyPresenter_PresentBalance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentBalance_Call) RunAndReturn(run func(*entities.Balance) *dto.LoyaltyBalanceResponseDto) *MockLoyaltyPresenter_PresentBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentCatalog provides a mock function with given fields: catalog
+func (_m *MockLoyaltyPresenter) PresentCatalog(catalog []entities.CatalogEntry) *dto.RewardsCatalogResponseDto {
+	ret := _m.Called(catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentCatalog")
+	}
+
+	var r0 *dto.RewardsCatalogResponseDto
+	if rf, ok := ret.Get(0).(func([]entities.CatalogEntry) *dto.RewardsCatalogResponseDto); ok {
+		r0 = rf(catalog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.RewardsCatalogResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockLoyaltyPresenter_PresentCatalog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentCatalog'
+type MockLoyaltyPresenter_PresentCatalog_Call struct {
+	*mock.Call
+}
+
+// PresentCatalog is a helper method to define mock.On call
+//   - catalog []entities.CatalogEntry
+func (_e *MockLoyaltyPresenter_Expecter) PresentCatalog(catalog interface{}) *MockLoyaltyPresenter_PresentCatalog_Call {
+	return &MockLoyaltyPresenter_PresentCatalog_Call{Call: _e.mock.On("PresentCatalog", catalog)}
+}
+
+func (_c *MockLoyaltyPresenter_PresentCatalog_Call) Run(run func(catalog []entities.CatalogEntry)) *MockLoyaltyPresenter_PresentCatalog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]entities.CatalogEntry))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentCatalog_Call) Return(_a0 *dto.RewardsCatalogResponseDto) *MockLoyaltyPresenter_PresentCatalog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentCatalog_Call) RunAndReturn(run func([]entities.CatalogEntry) *dto.RewardsCatalogResponseDto) *MockLoyaltyPresenter_PresentCatalog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentEntries provides a mock function with given fields: page
+func (_m *MockLoyaltyPresenter) PresentEntries(page *entities.LedgerPage) *dto.LedgerResponseDto {
+	ret := _m.Called(page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentEntries")
+	}
+
+	var r0 *dto.LedgerResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.LedgerPage) *dto.LedgerResponseDto); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockLoyaltyPresenter_PresentEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentEntries'
+type MockLoyaltyPresenter_PresentEntries_Call struct {
+	*mock.Call
+}
+
+// PresentEntries is a helper method to define mock.On call
+//   - page *entities.LedgerPage
+func (_e *MockLoyaltyPresenter_Expecter) PresentEntries(page interface{}) *MockLoyaltyPresenter_PresentEntries_Call {
+	return &MockLoyaltyPresenter_PresentEntries_Call{Call: _e.mock.On("PresentEntries", page)}
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntries_Call) Run(run func(page *entities.LedgerPage)) *MockLoyaltyPresenter_PresentEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.LedgerPage))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntries_Call) Return(_a0 *dto.LedgerResponseDto) *MockLoyaltyPresenter_PresentEntries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntries_Call) RunAndReturn(run func(*entities.LedgerPage) *dto.LedgerResponseDto) *MockLoyaltyPresenter_PresentEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PresentEntry provides a mock function with given fields: entry
+func (_m *MockLoyaltyPresenter) PresentEntry(entry *entities.LedgerEntry) *dto.LedgerEntryResponseDto {
+	ret := _m.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PresentEntry")
+	}
+
+	var r0 *dto.LedgerEntryResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.LedgerEntry) *dto.LedgerEntryResponseDto); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.LedgerEntryResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockLoyaltyPresenter_PresentEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PresentEntry'
+type MockLoyaltyPresenter_PresentEntry_Call struct {
+	*mock.Call
+}
+
+// PresentEntry is a helper method to define mock.On call
+//   - entry *entities.LedgerEntry
+func (_e *MockLoyaltyPresenter_Expecter) PresentEntry(entry interface{}) *MockLoyaltyPresenter_PresentEntry_Call {
+	return &MockLoyaltyPresenter_PresentEntry_Call{Call: _e.mock.On("PresentEntry", entry)}
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntry_Call) Run(run func(entry *entities.LedgerEntry)) *MockLoyaltyPresenter_PresentEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.LedgerEntry))
+	})
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntry_Call) Return(_a0 *dto.LedgerEntryResponseDto) *MockLoyaltyPresenter_PresentEntry_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLoyaltyPresenter_PresentEntry_Call) RunAndReturn(run func(*entities.LedgerEntry) *dto.LedgerEntryResponseDto) *MockLoyaltyPresenter_PresentEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLoyaltyPresenter creates a new instance of MockLoyaltyPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLoyaltyPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLoyaltyPresenter {
+	mock := &MockLoyaltyPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
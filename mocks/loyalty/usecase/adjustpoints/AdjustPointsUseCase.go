@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAdjustPointsUseCase is an autogenerated mock type for the AdjustPointsUseCase type
+type MockAdjustPointsUseCase struct {
+	mock.Mock
+}
+
+type MockAdjustPointsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdjustPointsUseCase) EXPECT() *MockAdjustPointsUseCase_Expecter {
+	return &MockAdjustPointsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockAdjustPointsUseCase) Execute(command *commands.AdjustPointsCommand) (*entities.LedgerEntry, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.AdjustPointsCommand) (*entities.LedgerEntry, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.AdjustPointsCommand) *entities.LedgerEntry); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.AdjustPointsCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdjustPointsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockAdjustPointsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.AdjustPointsCommand
+func (_e *MockAdjustPointsUseCase_Expecter) Execute(command interface{}) *MockAdjustPointsUseCase_Execute_Call {
+	return &MockAdjustPointsUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockAdjustPointsUseCase_Execute_Call) Run(run func(command *commands.AdjustPointsCommand)) *MockAdjustPointsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.AdjustPointsCommand))
+	})
+	return _c
+}
+
+func (_c *MockAdjustPointsUseCase_Execute_Call) Return(_a0 *entities.LedgerEntry, _a1 error) *MockAdjustPointsUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdjustPointsUseCase_Execute_Call) RunAndReturn(run func(*commands.AdjustPointsCommand) (*entities.LedgerEntry, error)) *MockAdjustPointsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdjustPointsUseCase creates a new instance of MockAdjustPointsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdjustPointsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdjustPointsUseCase {
+	mock := &MockAdjustPointsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
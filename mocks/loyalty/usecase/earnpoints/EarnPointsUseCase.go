@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockEarnPointsUseCase is an autogenerated mock type for the EarnPointsUseCase type
+type MockEarnPointsUseCase struct {
+	mock.Mock
+}
+
+type MockEarnPointsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEarnPointsUseCase) EXPECT() *MockEarnPointsUseCase_Expecter {
+	return &MockEarnPointsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockEarnPointsUseCase) Execute(command *commands.EarnPointsCommand) error {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*commands.EarnPointsCommand) error); ok {
+		r0 = rf(command)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEarnPointsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockEarnPointsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.EarnPointsCommand
+func (_e *MockEarnPointsUseCase_Expecter) Execute(command interface{}) *MockEarnPointsUseCase_Execute_Call {
+	return &MockEarnPointsUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockEarnPointsUseCase_Execute_Call) Run(run func(command *commands.EarnPointsCommand)) *MockEarnPointsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.EarnPointsCommand))
+	})
+	return _c
+}
+
+func (_c *MockEarnPointsUseCase_Execute_Call) Return(_a0 error) *MockEarnPointsUseCase_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEarnPointsUseCase_Execute_Call) RunAndReturn(run func(*commands.EarnPointsCommand) error) *MockEarnPointsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEarnPointsUseCase creates a new instance of MockEarnPointsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEarnPointsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEarnPointsUseCase {
+	mock := &MockEarnPointsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
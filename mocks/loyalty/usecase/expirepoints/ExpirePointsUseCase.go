@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockExpirePointsUseCase is an autogenerated mock type for the ExpirePointsUseCase type
+type MockExpirePointsUseCase struct {
+	mock.Mock
+}
+
+type MockExpirePointsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpirePointsUseCase) EXPECT() *MockExpirePointsUseCase_Expecter {
+	return &MockExpirePointsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockExpirePointsUseCase) Execute(command *commands.ExpirePointsCommand) (*entities.Balance, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.Balance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ExpirePointsCommand) (*entities.Balance, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ExpirePointsCommand) *entities.Balance); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Balance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ExpirePointsCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpirePointsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockExpirePointsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ExpirePointsCommand
+func (_e *MockExpirePointsUseCase_Expecter) Execute(command interface{}) *MockExpirePointsUseCase_Execute_Call {
+	return &MockExpirePointsUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockExpirePointsUseCase_Execute_Call) Run(run func(command *commands.ExpirePointsCommand)) *MockExpirePointsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ExpirePointsCommand))
+	})
+	return _c
+}
+
+func (_c *MockExpirePointsUseCase_Execute_Call) Return(_a0 *entities.Balance, _a1 error) *MockExpirePointsUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpirePointsUseCase_Execute_Call) RunAndReturn(run func(*commands.ExpirePointsCommand) (*entities.Balance, error)) *MockExpirePointsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpirePointsUseCase creates a new instance of MockExpirePointsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpirePointsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpirePointsUseCase {
+	mock := &MockExpirePointsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListEntriesUseCase is an autogenerated mock type for the ListEntriesUseCase type
+type MockListEntriesUseCase struct {
+	mock.Mock
+}
+
+type MockListEntriesUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListEntriesUseCase) EXPECT() *MockListEntriesUseCase_Expecter {
+	return &MockListEntriesUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListEntriesUseCase) Execute(command *commands.ListLedgerEntriesCommand) (*entities.LedgerPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.LedgerPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListLedgerEntriesCommand) (*entities.LedgerPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListLedgerEntriesCommand) *entities.LedgerPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LedgerPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListLedgerEntriesCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListEntriesUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListEntriesUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListLedgerEntriesCommand
+func (_e *MockListEntriesUseCase_Expecter) Execute(command interface{}) *MockListEntriesUseCase_Execute_Call {
+	return &MockListEntriesUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListEntriesUseCase_Execute_Call) Run(run func(command *commands.ListLedgerEntriesCommand)) *MockListEntriesUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListLedgerEntriesCommand))
+	})
+	return _c
+}
+
+func (_c *MockListEntriesUseCase_Execute_Call) Return(_a0 *entities.LedgerPage, _a1 error) *MockListEntriesUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListEntriesUseCase_Execute_Call) RunAndReturn(run func(*commands.ListLedgerEntriesCommand) (*entities.LedgerPage, error)) *MockListEntriesUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListEntriesUseCase creates a new instance of MockListEntriesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListEntriesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListEntriesUseCase {
+	mock := &MockListEntriesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListRewardsUseCase is an autogenerated mock type for the ListRewardsUseCase type
+type MockListRewardsUseCase struct {
+	mock.Mock
+}
+
+type MockListRewardsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListRewardsUseCase) EXPECT() *MockListRewardsUseCase_Expecter {
+	return &MockListRewardsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListRewardsUseCase) Execute(command *commands.ListRewardsCommand) ([]entities.CatalogEntry, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []entities.CatalogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListRewardsCommand) ([]entities.CatalogEntry, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListRewardsCommand) []entities.CatalogEntry); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.CatalogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListRewardsCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListRewardsUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListRewardsUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListRewardsCommand
+func (_e *MockListRewardsUseCase_Expecter) Execute(command interface{}) *MockListRewardsUseCase_Execute_Call {
+	return &MockListRewardsUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListRewardsUseCase_Execute_Call) Run(run func(command *commands.ListRewardsCommand)) *MockListRewardsUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListRewardsCommand))
+	})
+	return _c
+}
+
+func (_c *MockListRewardsUseCase_Execute_Call) Return(_a0 []entities.CatalogEntry, _a1 error) *MockListRewardsUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListRewardsUseCase_Execute_Call) RunAndReturn(run func(*commands.ListRewardsCommand) ([]entities.CatalogEntry, error)) *MockListRewardsUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListRewardsUseCase creates a new instance of MockListRewardsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListRewardsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListRewardsUseCase {
+	mock := &MockListRewardsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
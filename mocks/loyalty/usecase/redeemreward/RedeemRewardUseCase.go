@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/loyalty/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockRedeemRewardUseCase is an autogenerated mock type for the RedeemRewardUseCase type
+type MockRedeemRewardUseCase struct {
+	mock.Mock
+}
+
+type MockRedeemRewardUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRedeemRewardUseCase) EXPECT() *MockRedeemRewardUseCase_Expecter {
+	return &MockRedeemRewardUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockRedeemRewardUseCase) Execute(command *commands.RedeemRewardCommand) (*entities.LedgerEntry, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.LedgerEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.RedeemRewardCommand) (*entities.LedgerEntry, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.RedeemRewardCommand) *entities.LedgerEntry); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LedgerEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.RedeemRewardCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedeemRewardUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRedeemRewardUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.RedeemRewardCommand
+func (_e *MockRedeemRewardUseCase_Expecter) Execute(command interface{}) *MockRedeemRewardUseCase_Execute_Call {
+	return &MockRedeemRewardUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockRedeemRewardUseCase_Execute_Call) Run(run func(command *commands.RedeemRewardCommand)) *MockRedeemRewardUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.RedeemRewardCommand))
+	})
+	return _c
+}
+
+func (_c *MockRedeemRewardUseCase_Execute_Call) Return(_a0 *entities.LedgerEntry, _a1 error) *MockRedeemRewardUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedeemRewardUseCase_Execute_Call) RunAndReturn(run func(*commands.RedeemRewardCommand) (*entities.LedgerEntry, error)) *MockRedeemRewardUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRedeemRewardUseCase creates a new instance of MockRedeemRewardUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRedeemRewardUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRedeemRewardUseCase {
+	mock := &MockRedeemRewardUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+)
+
+// MockOrderHistoryController is an autogenerated mock type for the OrderHistoryController type
+type MockOrderHistoryController struct {
+	mock.Mock
+}
+
+type MockOrderHistoryController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderHistoryController) EXPECT() *MockOrderHistoryController_Expecter {
+	return &MockOrderHistoryController_Expecter{mock: &_m.Mock}
+}
+
+// ListByCustomer provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockOrderHistoryController) ListByCustomer(customerID string, limit int, cursor string) (*dto.OrderHistoryResponseDto, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 *dto.OrderHistoryResponseDto
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*dto.OrderHistoryResponseDto, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *dto.OrderHistoryResponseDto); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderHistoryResponseDto)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrderHistoryController_ListByCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCustomer'
+type MockOrderHistoryController_ListByCustomer_Call struct {
+	*mock.Call
+}
+
+// ListByCustomer is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockOrderHistoryController_Expecter) ListByCustomer(customerID interface{}, limit interface{}, cursor interface{}) *MockOrderHistoryController_ListByCustomer_Call {
+	return &MockOrderHistoryController_ListByCustomer_Call{Call: _e.mock.On("ListByCustomer", customerID, limit, cursor)}
+}
+
+func (_c *MockOrderHistoryController_ListByCustomer_Call) Run(run func(customerID string, limit int, cursor string)) *MockOrderHistoryController_ListByCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrderHistoryController_ListByCustomer_Call) Return(_a0 *dto.OrderHistoryResponseDto, _a1 error) *MockOrderHistoryController_ListByCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrderHistoryController_ListByCustomer_Call) RunAndReturn(run func(string, int, string) (*dto.OrderHistoryResponseDto, error)) *MockOrderHistoryController_ListByCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrderHistoryController creates a new instance of MockOrderHistoryController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderHistoryController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderHistoryController {
+	mock := &MockOrderHistoryController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
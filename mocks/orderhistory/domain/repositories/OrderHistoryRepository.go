@@ -0,0 +1,141 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+)
+
+// MockOrderHistoryRepository is an autogenerated mock type for the OrderHistoryRepository type
+type MockOrderHistoryRepository struct {
+	mock.Mock
+}
+
+type MockOrderHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderHistoryRepository) EXPECT() *MockOrderHistoryRepository_Expecter {
+	return &MockOrderHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: order
+func (_m *MockOrderHistoryRepository) Add(order *entities.OrderSummary) error {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*entities.OrderSummary) error); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOrderHistoryRepository_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type MockOrderHistoryRepository_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - order *entities.OrderSummary
+func (_e *MockOrderHistoryRepository_Expecter) Add(order interface{}) *MockOrderHistoryRepository_Add_Call {
+	return &MockOrderHistoryRepository_Add_Call{Call: _e.mock.On("Add", order)}
+}
+
+func (_c *MockOrderHistoryRepository_Add_Call) Run(run func(order *entities.OrderSummary)) *MockOrderHistoryRepository_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.OrderSummary))
+	})
+	return _c
+}
+
+func (_c *MockOrderHistoryRepository_Add_Call) Return(_a0 error) *MockOrderHistoryRepository_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrderHistoryRepository_Add_Call) RunAndReturn(run func(*entities.OrderSummary) error) *MockOrderHistoryRepository_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByCustomer provides a mock function with given fields: customerID, limit, cursor
+func (_m *MockOrderHistoryRepository) ListByCustomer(customerID string, limit int, cursor string) (*entities.OrderPage, error) {
+	ret := _m.Called(customerID, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCustomer")
+	}
+
+	var r0 *entities.OrderPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, string) (*entities.OrderPage, error)); ok {
+		return rf(customerID, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, string) *entities.OrderPage); ok {
+		r0 = rf(customerID, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, string) error); ok {
+		r1 = rf(customerID, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOrderHistoryRepository_ListByCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCustomer'
+type MockOrderHistoryRepository_ListByCustomer_Call struct {
+	*mock.Call
+}
+
+// ListByCustomer is a helper method to define mock.On call
+//   - customerID string
+//   - limit int
+//   - cursor string
+func (_e *MockOrderHistoryRepository_Expecter) ListByCustomer(customerID interface{}, limit interface{}, cursor interface{}) *MockOrderHistoryRepository_ListByCustomer_Call {
+	return &MockOrderHistoryRepository_ListByCustomer_Call{Call: _e.mock.On("ListByCustomer", customerID, limit, cursor)}
+}
+
+func (_c *MockOrderHistoryRepository_ListByCustomer_Call) Run(run func(customerID string, limit int, cursor string)) *MockOrderHistoryRepository_ListByCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockOrderHistoryRepository_ListByCustomer_Call) Return(_a0 *entities.OrderPage, _a1 error) *MockOrderHistoryRepository_ListByCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOrderHistoryRepository_ListByCustomer_Call) RunAndReturn(run func(string, int, string) (*entities.OrderPage, error)) *MockOrderHistoryRepository_ListByCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrderHistoryRepository creates a new instance of MockOrderHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderHistoryRepository {
+	mock := &MockOrderHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
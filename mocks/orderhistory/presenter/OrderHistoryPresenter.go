@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	dto "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/infrastructure/api/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOrderHistoryPresenter is an autogenerated mock type for the OrderHistoryPresenter type
+type MockOrderHistoryPresenter struct {
+	mock.Mock
+}
+
+type MockOrderHistoryPresenter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOrderHistoryPresenter) EXPECT() *MockOrderHistoryPresenter_Expecter {
+	return &MockOrderHistoryPresenter_Expecter{mock: &_m.Mock}
+}
+
+// Present provides a mock function with given fields: page
+func (_m *MockOrderHistoryPresenter) Present(page *entities.OrderPage) *dto.OrderHistoryResponseDto {
+	ret := _m.Called(page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Present")
+	}
+
+	var r0 *dto.OrderHistoryResponseDto
+	if rf, ok := ret.Get(0).(func(*entities.OrderPage) *dto.OrderHistoryResponseDto); ok {
+		r0 = rf(page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.OrderHistoryResponseDto)
+		}
+	}
+
+	return r0
+}
+
+// MockOrderHistoryPresenter_Present_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Present'
+type MockOrderHistoryPresenter_Present_Call struct {
+	*mock.Call
+}
+
+// Present is a helper method to define mock.On call
+//   - page *entities.OrderPage
+func (_e *MockOrderHistoryPresenter_Expecter) Present(page interface{}) *MockOrderHistoryPresenter_Present_Call {
+	return &MockOrderHistoryPresenter_Present_Call{Call: _e.mock.On("Present", page)}
+}
+
+func (_c *MockOrderHistoryPresenter_Present_Call) Run(run func(page *entities.OrderPage)) *MockOrderHistoryPresenter_Present_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.OrderPage))
+	})
+	return _c
+}
+
+func (_c *MockOrderHistoryPresenter_Present_Call) Return(_a0 *dto.OrderHistoryResponseDto) *MockOrderHistoryPresenter_Present_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOrderHistoryPresenter_Present_Call) RunAndReturn(run func(*entities.OrderPage) *dto.OrderHistoryResponseDto) *MockOrderHistoryPresenter_Present_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOrderHistoryPresenter creates a new instance of MockOrderHistoryPresenter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOrderHistoryPresenter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOrderHistoryPresenter {
+	mock := &MockOrderHistoryPresenter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
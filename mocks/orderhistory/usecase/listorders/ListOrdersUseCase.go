@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	entities "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/domain/entities"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockListOrdersUseCase is an autogenerated mock type for the ListOrdersUseCase type
+type MockListOrdersUseCase struct {
+	mock.Mock
+}
+
+type MockListOrdersUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockListOrdersUseCase) EXPECT() *MockListOrdersUseCase_Expecter {
+	return &MockListOrdersUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockListOrdersUseCase) Execute(command *commands.ListCustomerOrdersCommand) (*entities.OrderPage, error) {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *entities.OrderPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*commands.ListCustomerOrdersCommand) (*entities.OrderPage, error)); ok {
+		return rf(command)
+	}
+	if rf, ok := ret.Get(0).(func(*commands.ListCustomerOrdersCommand) *entities.OrderPage); ok {
+		r0 = rf(command)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OrderPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*commands.ListCustomerOrdersCommand) error); ok {
+		r1 = rf(command)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockListOrdersUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockListOrdersUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.ListCustomerOrdersCommand
+func (_e *MockListOrdersUseCase_Expecter) Execute(command interface{}) *MockListOrdersUseCase_Execute_Call {
+	return &MockListOrdersUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockListOrdersUseCase_Execute_Call) Run(run func(command *commands.ListCustomerOrdersCommand)) *MockListOrdersUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.ListCustomerOrdersCommand))
+	})
+	return _c
+}
+
+func (_c *MockListOrdersUseCase_Execute_Call) Return(_a0 *entities.OrderPage, _a1 error) *MockListOrdersUseCase_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockListOrdersUseCase_Execute_Call) RunAndReturn(run func(*commands.ListCustomerOrdersCommand) (*entities.OrderPage, error)) *MockListOrdersUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockListOrdersUseCase creates a new instance of MockListOrdersUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockListOrdersUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockListOrdersUseCase {
+	mock := &MockListOrdersUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
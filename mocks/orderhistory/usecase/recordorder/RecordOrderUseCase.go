@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	commands "github.com/viniciuscluna/tc-fiap-customer/internal/orderhistory/usecase/commands"
+)
+
+// MockRecordOrderUseCase is an autogenerated mock type for the RecordOrderUseCase type
+type MockRecordOrderUseCase struct {
+	mock.Mock
+}
+
+type MockRecordOrderUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRecordOrderUseCase) EXPECT() *MockRecordOrderUseCase_Expecter {
+	return &MockRecordOrderUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: command
+func (_m *MockRecordOrderUseCase) Execute(command *commands.RecordOrderCommand) error {
+	ret := _m.Called(command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*commands.RecordOrderCommand) error); ok {
+		r0 = rf(command)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRecordOrderUseCase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockRecordOrderUseCase_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - command *commands.RecordOrderCommand
+func (_e *MockRecordOrderUseCase_Expecter) Execute(command interface{}) *MockRecordOrderUseCase_Execute_Call {
+	return &MockRecordOrderUseCase_Execute_Call{Call: _e.mock.On("Execute", command)}
+}
+
+func (_c *MockRecordOrderUseCase_Execute_Call) Run(run func(command *commands.RecordOrderCommand)) *MockRecordOrderUseCase_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*commands.RecordOrderCommand))
+	})
+	return _c
+}
+
+func (_c *MockRecordOrderUseCase_Execute_Call) Return(_a0 error) *MockRecordOrderUseCase_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRecordOrderUseCase_Execute_Call) RunAndReturn(run func(*commands.RecordOrderCommand) error) *MockRecordOrderUseCase_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRecordOrderUseCase creates a new instance of MockRecordOrderUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRecordOrderUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRecordOrderUseCase {
+	mock := &MockRecordOrderUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTokenIssuer is an autogenerated mock type for the TokenIssuer type
+type MockTokenIssuer struct {
+	mock.Mock
+}
+
+type MockTokenIssuer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTokenIssuer) EXPECT() *MockTokenIssuer_Expecter {
+	return &MockTokenIssuer_Expecter{mock: &_m.Mock}
+}
+
+// Issue provides a mock function with given fields: subject, claims
+func (_m *MockTokenIssuer) Issue(subject string, claims map[string]interface{}) (string, time.Time, error) {
+	ret := _m.Called(subject, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issue")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) (string, time.Time, error)); ok {
+		return rf(subject, claims)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) string); ok {
+		r0 = rf(subject, claims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) time.Time); ok {
+		r1 = rf(subject, claims)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, map[string]interface{}) error); ok {
+		r2 = rf(subject, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockTokenIssuer_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type MockTokenIssuer_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - subject string
+//   - claims map[string]interface{}
+func (_e *MockTokenIssuer_Expecter) Issue(subject interface{}, claims interface{}) *MockTokenIssuer_Issue_Call {
+	return &MockTokenIssuer_Issue_Call{Call: _e.mock.On("Issue", subject, claims)}
+}
+
+func (_c *MockTokenIssuer_Issue_Call) Run(run func(subject string, claims map[string]interface{})) *MockTokenIssuer_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockTokenIssuer_Issue_Call) Return(token string, expiresAt time.Time, err error) *MockTokenIssuer_Issue_Call {
+	_c.Call.Return(token, expiresAt, err)
+	return _c
+}
+
+func (_c *MockTokenIssuer_Issue_Call) RunAndReturn(run func(string, map[string]interface{}) (string, time.Time, error)) *MockTokenIssuer_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTokenIssuer creates a new instance of MockTokenIssuer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTokenIssuer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTokenIssuer {
+	mock := &MockTokenIssuer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
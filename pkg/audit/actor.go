@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+const (
+	// AnonymousActorID identifies requests that reached a use case without credentials.
+	AnonymousActorID = "anonymous"
+	// SystemActorID identifies invocations not triggered by a request, such as jobs and consumers.
+	SystemActorID = "system"
+)
+
+// Actor is who invoked a use case, recorded in the audit log.
+type Actor struct {
+	ID        string
+	Roles     []string
+	RequestID string
+}
+
+// System is the actor of invocations not triggered by a request.
+func System() Actor {
+	return Actor{ID: SystemActorID}
+}
+
+// ActorFromRequest takes the actor from the authenticated principal and the request ID set by
+// the request ID middleware.
+func ActorFromRequest(r *http.Request) Actor {
+	actor := Actor{ID: AnonymousActorID, RequestID: middleware.GetReqID(r.Context())}
+
+	principal, ok := auth.PrincipalFromContext(r.Context())
+	if !ok {
+		return actor
+	}
+	if principal.Subject != "" {
+		actor.ID = principal.Subject
+	}
+	for _, role := range principal.Roles {
+		actor.Roles = append(actor.Roles, string(role))
+	}
+	return actor
+}
@@ -0,0 +1,42 @@
+package audit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/audit"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+func TestActorFromRequest_ShouldUsePrincipalAndRequestID(t *testing.T) {
+	var actor audit.Actor
+	handler := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = audit.ActorFromRequest(r)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set(middleware.RequestIDHeader, "request-1")
+	principal := &auth.Principal{Subject: "staff-1", Roles: []auth.Role{auth.RoleStaff, auth.RoleAdmin}}
+	request = request.WithContext(auth.ContextWithPrincipal(request.Context(), principal))
+
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.Equal(t, "staff-1", actor.ID)
+	assert.Equal(t, []string{"staff", "admin"}, actor.Roles)
+	assert.Equal(t, "request-1", actor.RequestID)
+}
+
+func TestActorFromRequest_WithoutPrincipal_ShouldBeAnonymous(t *testing.T) {
+	actor := audit.ActorFromRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, audit.AnonymousActorID, actor.ID)
+	assert.Empty(t, actor.Roles)
+	assert.Empty(t, actor.RequestID)
+}
+
+func TestSystem_ShouldIdentifyTheSystemActor(t *testing.T) {
+	assert.Equal(t, audit.Actor{ID: audit.SystemActorID}, audit.System())
+}
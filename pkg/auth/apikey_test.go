@@ -0,0 +1,46 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+func TestGenerateAPIKey_ShouldEmbedIDAndVerifyAgainstHash(t *testing.T) {
+	// GIVEN a new key ID
+	id, err := auth.NewAPIKeyID()
+	assert.NoError(t, err)
+
+	// WHEN generating a key for it
+	key, err := auth.GenerateAPIKey(id)
+	assert.NoError(t, err)
+
+	// THEN the key should carry the prefix and ID
+	assert.True(t, strings.HasPrefix(key, auth.APIKeyPrefix+"_"+id+"_"))
+	parsedID, ok := auth.ParseAPIKeyID(key)
+	assert.True(t, ok)
+	assert.Equal(t, id, parsedID)
+	// AND only the same key should match its hash
+	hash := auth.HashAPIKey(key)
+	assert.NotContains(t, hash, key)
+	assert.True(t, auth.VerifyAPIKeyHash(key, hash))
+	assert.False(t, auth.VerifyAPIKeyHash(key+"x", hash))
+}
+
+func TestParseAPIKeyID_WithMalformedKey_ShouldFail(t *testing.T) {
+	for _, key := range []string{"", "tcfc", "tcfc_abc", "other_abc_secret", "tcfc__secret"} {
+		// WHEN parsing a malformed key
+		_, ok := auth.ParseAPIKeyID(key)
+
+		// THEN it should be rejected
+		assert.False(t, ok, key)
+	}
+}
+
+func TestIsAPIKeyScope(t *testing.T) {
+	assert.True(t, auth.IsAPIKeyScope(auth.ScopeCustomersRead))
+	assert.True(t, auth.IsAPIKeyScope(auth.ScopeCustomersWrite))
+	assert.False(t, auth.IsAPIKeyScope("admin"))
+}
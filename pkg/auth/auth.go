@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// Role is a coarse-grained permission granted to an authenticated caller.
+type Role string
+
+const (
+	RoleKiosk   Role = "kiosk"
+	RoleStaff   Role = "staff"
+	RoleAdmin   Role = "admin"
+	RoleService Role = "service"
+	// RoleCustomer is granted to session tokens issued to identified customers.
+	RoleCustomer Role = "customer"
+	// RoleGuest is granted to session tokens issued to anonymous guest customers.
+	RoleGuest Role = "guest"
+)
+
+// Scopes granted to service callers that are not bound to a user role.
+const (
+	ScopeCustomersRead  = "customers:read"
+	ScopeCustomersWrite = "customers:write"
+	// ScopeCustomersExport allows dumping the whole customer base, meant for the analytics pipeline.
+	ScopeCustomersExport = "customers:export"
+)
+
+var (
+	ErrMissingCredentials = errors.New("missing credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Principal is the authenticated identity attached to a request.
+type Principal struct {
+	Subject string
+	Roles   []Role
+	Scopes  []string
+	Claims  map[string]any
+}
+
+func (p *Principal) HasRole(role Role) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// Authenticator resolves the principal behind an HTTP request.
+// It returns ErrMissingCredentials when the request carries no credentials it understands.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	_ Authenticator = ChainAuthenticator(nil)
+)
+
+// ChainAuthenticator tries each authenticator in order and returns the first principal found.
+// It reports ErrMissingCredentials only when none of them recognised any credentials.
+type ChainAuthenticator []Authenticator
+
+func Chain(authenticators ...Authenticator) ChainAuthenticator {
+	return ChainAuthenticator(authenticators)
+}
+
+func (c ChainAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	var failures []error
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(r)
+		if err == nil {
+			return principal, nil
+		}
+		if !errors.Is(err, ErrMissingCredentials) {
+			failures = append(failures, err)
+		}
+	}
+	if len(failures) == 0 {
+		return nil, ErrMissingCredentials
+	}
+	return nil, errors.Join(failures...)
+}
@@ -0,0 +1,52 @@
+package auth_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+// Feature: Authenticator Chain
+
+func TestChain_WithOneMatchingAuthenticator_ShouldReturnItsPrincipal(t *testing.T) {
+	// GIVEN a chain where only the second authenticator accepts the credentials
+	expected := &auth.Principal{Subject: "guest-1"}
+	chain := auth.Chain(
+		stubAuthenticator{err: errors.Join(auth.ErrInvalidCredentials, errors.New("unknown key"))},
+		stubAuthenticator{principal: expected},
+	)
+
+	// WHEN the request is authenticated
+	principal, err := chain.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// THEN the matching principal should be returned
+	assert.NoError(t, err)
+	assert.Same(t, expected, principal)
+}
+
+func TestChain_WithoutCredentials_ShouldReturnMissingCredentials(t *testing.T) {
+	// GIVEN a chain where no authenticator finds credentials
+	chain := auth.Chain(stubAuthenticator{err: auth.ErrMissingCredentials}, stubAuthenticator{err: auth.ErrMissingCredentials})
+
+	// WHEN the request is authenticated
+	_, err := chain.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// THEN missing credentials should be reported
+	assert.ErrorIs(t, err, auth.ErrMissingCredentials)
+}
+
+func TestChain_WithRejectedCredentials_ShouldReturnInvalidCredentials(t *testing.T) {
+	// GIVEN a chain where every authenticator rejects or ignores the credentials
+	chain := auth.Chain(stubAuthenticator{err: auth.ErrMissingCredentials}, stubAuthenticator{err: auth.ErrInvalidCredentials})
+
+	// WHEN the request is authenticated
+	_, err := chain.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+
+	// THEN the credentials should be reported as invalid
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	assert.NotErrorIs(t, err, auth.ErrMissingCredentials)
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	_ TokenIssuer = (*RSATokenIssuer)(nil)
+)
+
+const (
+	DefaultTokenIssuer     = "tc-fiap-customer"
+	DefaultSessionTokenTTL = 15 * time.Minute
+)
+
+// TokenIssuer signs short-lived tokens for identities managed by this service.
+type TokenIssuer interface {
+	Issue(subject string, claims map[string]any) (token string, expiresAt time.Time, err error)
+}
+
+// RSATokenIssuer signs RS256 tokens and publishes its public key as a JWKS,
+// so other services can verify them offline.
+type RSATokenIssuer struct {
+	key    *rsa.PrivateKey
+	keyID  string
+	issuer string
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+func NewRSATokenIssuer(key *rsa.PrivateKey, keyID string, issuer string, ttl time.Duration) *RSATokenIssuer {
+	if keyID == "" {
+		keyID = thumbprint(&key.PublicKey)
+	}
+	return &RSATokenIssuer{key: key, keyID: keyID, issuer: issuer, ttl: ttl, now: time.Now}
+}
+
+// NewRSATokenIssuerFromEnv loads the signing key from AUTH_SIGNING_KEY_FILE (PEM, PKCS#1 or PKCS#8).
+// Without a key file an ephemeral key is generated, which is only suitable for local development
+// because tokens stop verifying after a restart and differ between replicas.
+func NewRSATokenIssuerFromEnv() (*RSATokenIssuer, error) {
+	issuer := os.Getenv("AUTH_TOKEN_ISSUER")
+	if issuer == "" {
+		issuer = DefaultTokenIssuer
+	}
+
+	ttl := DefaultSessionTokenTTL
+	if value := os.Getenv("AUTH_SESSION_TOKEN_TTL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_SESSION_TOKEN_TTL: %w", err)
+		}
+		ttl = parsed
+	}
+
+	var key *rsa.PrivateKey
+	if path := os.Getenv("AUTH_SIGNING_KEY_FILE"); path != "" {
+		loaded, err := LoadRSAPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+		key = loaded
+	} else {
+		log.Println("Warning: AUTH_SIGNING_KEY_FILE not set, generating an ephemeral signing key")
+		generated, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate signing key: %w", err)
+		}
+		key = generated
+	}
+
+	return NewRSATokenIssuer(key, os.Getenv("AUTH_SIGNING_KEY_ID"), issuer, ttl), nil
+}
+
+// LoadRSAPrivateKey reads a PEM encoded RSA private key.
+func LoadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode signing key: no PEM block found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an RSA key")
+	}
+	return key, nil
+}
+
+func (i *RSATokenIssuer) Issue(subject string, claims map[string]any) (string, time.Time, error) {
+	now := i.now()
+	expiresAt := now.Add(i.ttl)
+
+	mapClaims := jwt.MapClaims{}
+	for name, value := range claims {
+		mapClaims[name] = value
+	}
+	mapClaims["iss"] = i.issuer
+	mapClaims["sub"] = subject
+	mapClaims["iat"] = now.Unix()
+	mapClaims["exp"] = expiresAt.Unix()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, mapClaims)
+	token.Header["kid"] = i.keyID
+
+	signed, err := token.SignedString(i.key)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign token: %w", err)
+	}
+	return signed, expiresAt, nil
+}
+
+func (i *RSATokenIssuer) JWKS() JSONWebKeySet {
+	return JSONWebKeySet{Keys: []JSONWebKey{NewJSONWebKey(i.keyID, &i.key.PublicKey)}}
+}
+
+// Authenticator verifies the tokens signed by this issuer, so the service itself
+// can accept the customer and guest sessions it hands out.
+func (i *RSATokenIssuer) Authenticator() (*JWTAuthenticator, error) {
+	keySet, err := NewKeySet(StaticKeySource{Set: i.JWKS()}, DefaultJWKSRefreshDelay)
+	if err != nil {
+		return nil, err
+	}
+	return NewJWTAuthenticator(JWTConfig{KeySet: keySet, Issuer: i.issuer})
+}
+
+// JWKSHandler serves the issuer public key at /.well-known/jwks.json.
+func (i *RSATokenIssuer) JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(i.JWKS())
+}
+
+// thumbprint derives a stable key ID from the public key.
+func thumbprint(key *rsa.PublicKey) string {
+	sum := sha256.Sum256(x509.MarshalPKCS1PublicKey(key))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
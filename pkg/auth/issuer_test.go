@@ -0,0 +1,105 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+// Feature: Session Token Issuer
+
+func TestRSATokenIssuer_IssuedToken_ShouldVerifyAgainstPublishedJWKS(t *testing.T) {
+	// GIVEN an issuer with a locally generated key
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := auth.NewRSATokenIssuer(key, "", "tc-fiap-customer", 15*time.Minute)
+
+	// AND a verifier that only knows the published JWKS
+	keySet, err := auth.NewKeySet(auth.StaticKeySource{Set: issuer.JWKS()}, time.Minute)
+	require.NoError(t, err)
+	verifier, err := auth.NewJWTAuthenticator(auth.JWTConfig{KeySet: keySet, Issuer: "tc-fiap-customer"})
+	require.NoError(t, err)
+
+	// WHEN a session token is issued
+	token, expiresAt, err := issuer.Issue("customer-1", map[string]any{"roles": []string{"customer"}})
+	require.NoError(t, err)
+
+	// THEN it should verify offline and carry the customer identity
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	principal, err := verifier.Authenticate(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "customer-1", principal.Subject)
+	assert.True(t, principal.HasRole(auth.RoleCustomer))
+	assert.WithinDuration(t, time.Now().Add(15*time.Minute), expiresAt, time.Minute)
+}
+
+func TestRSATokenIssuer_JWKSHandler_ShouldServePublicKey(t *testing.T) {
+	// GIVEN an issuer
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := auth.NewRSATokenIssuer(key, "key-1", "tc-fiap-customer", time.Minute)
+
+	// WHEN the JWKS endpoint is requested
+	w := httptest.NewRecorder()
+	issuer.JWKSHandler(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	// THEN the public key should be published
+	var set auth.JSONWebKeySet
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&set))
+	require.Len(t, set.Keys, 1)
+	assert.Equal(t, "key-1", set.Keys[0].Kid)
+	publicKey, err := set.Keys[0].RSAPublicKey()
+	assert.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(publicKey))
+}
+
+func TestLoadRSAPrivateKey_WithPKCS8File_ShouldLoadKey(t *testing.T) {
+	// GIVEN a PKCS#8 PEM file
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	// WHEN the key is loaded
+	loaded, err := auth.LoadRSAPrivateKey(path)
+
+	// THEN it should match the original key
+	assert.NoError(t, err)
+	assert.True(t, key.Equal(loaded))
+}
+
+func TestRSATokenIssuer_Authenticator_ShouldAcceptOwnTokens(t *testing.T) {
+	// GIVEN an issuer and its own verifier
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	issuer := auth.NewRSATokenIssuer(key, "", "tc-fiap-customer", time.Minute)
+	verifier, err := issuer.Authenticator()
+	require.NoError(t, err)
+
+	// WHEN a guest token is issued and verified
+	token, _, err := issuer.Issue("guest-1", map[string]any{"roles": []string{"guest"}})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	principal, err := verifier.Authenticate(req)
+
+	// THEN the guest principal should be returned
+	assert.NoError(t, err)
+	assert.Equal(t, "guest-1", principal.Subject)
+	assert.True(t, principal.HasRole(auth.RoleGuest))
+}
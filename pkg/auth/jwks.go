@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+// JSONWebKey is the subset of RFC 7517 needed to verify RS256 signatures.
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewJSONWebKey encodes an RSA public key as a JWK.
+func NewJSONWebKey(kid string, key *rsa.PublicKey) JSONWebKey {
+	return JSONWebKey{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func (k JSONWebKey) RSAPublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" {
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// KeySource loads a JSON Web Key Set from somewhere.
+type KeySource interface {
+	Load() (*JSONWebKeySet, error)
+}
+
+// FileKeySource reads a JWKS document from disk.
+type FileKeySource struct {
+	Path string
+}
+
+func (s FileKeySource) Load() (*JSONWebKeySet, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	var set JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+	return &set, nil
+}
+
+// StaticKeySource serves a fixed JWKS document.
+type StaticKeySource struct {
+	Set JSONWebKeySet
+}
+
+func (s StaticKeySource) Load() (*JSONWebKeySet, error) {
+	return &s.Set, nil
+}
+
+// URLKeySource fetches a JWKS document over HTTP.
+type URLKeySource struct {
+	URL    string
+	Client rest.HTTPClient
+}
+
+func (s URLKeySource) Load() (*JSONWebKeySet, error) {
+	req, err := http.NewRequest(http.MethodGet, s.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+	var set JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+	return &set, nil
+}
+
+// KeySet caches the RSA keys of a KeySource and reloads them when an unknown
+// key ID shows up, at most once per refresh interval.
+type KeySet struct {
+	source          KeySource
+	refreshInterval time.Duration
+
+	mu         sync.Mutex
+	keys       map[string]*rsa.PublicKey
+	lastLoaded time.Time
+}
+
+func NewKeySet(source KeySource, refreshInterval time.Duration) (*KeySet, error) {
+	ks := &KeySet{source: source, refreshInterval: refreshInterval}
+	if err := ks.reload(); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Key returns the key for kid. An empty kid is accepted when the set holds a single key.
+func (ks *KeySet) Key(kid string) (*rsa.PublicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+	if time.Since(ks.lastLoaded) >= ks.refreshInterval {
+		if err := ks.reload(); err != nil {
+			return nil, err
+		}
+		if key, ok := ks.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (ks *KeySet) lookup(kid string) (*rsa.PublicKey, bool) {
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+func (ks *KeySet) reload() error {
+	set, err := ks.source.Load()
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.RSAPublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	ks.keys = keys
+	ks.lastLoaded = time.Now()
+	return nil
+}
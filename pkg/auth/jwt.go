@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	_ Authenticator = (*JWTAuthenticator)(nil)
+)
+
+const (
+	DefaultRolesClaim       = "roles"
+	DefaultJWKSRefreshDelay = time.Minute
+)
+
+// JWTConfig configures how bearer tokens are verified and mapped to principals.
+type JWTConfig struct {
+	// HMACSecret enables HS256 tokens when set.
+	HMACSecret []byte
+	// KeySet enables RS256 tokens when set.
+	KeySet *KeySet
+	// Issuer and Audience are enforced when non-empty.
+	Issuer   string
+	Audience string
+	// RolesClaim is the claim holding the caller roles, as a string or a list of strings.
+	RolesClaim string
+	// RoleMapping translates identity provider role names to service roles.
+	// Values that are already service roles are always accepted.
+	RoleMapping map[string]Role
+}
+
+// JWTAuthenticator validates HS256/RS256 bearer tokens.
+type JWTAuthenticator struct {
+	config JWTConfig
+	parser *jwt.Parser
+}
+
+func NewJWTAuthenticator(config JWTConfig) (*JWTAuthenticator, error) {
+	var methods []string
+	if len(config.HMACSecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if config.KeySet != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("no JWT verification keys configured")
+	}
+	if config.RolesClaim == "" {
+		config.RolesClaim = DefaultRolesClaim
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	return &JWTAuthenticator{config: config, parser: jwt.NewParser(options...)}, nil
+}
+
+// NewJWTAuthenticatorFromEnv builds a JWTAuthenticator from AUTH_JWT_* environment variables.
+func NewJWTAuthenticatorFromEnv() (*JWTAuthenticator, error) {
+	config := JWTConfig{
+		HMACSecret:  []byte(os.Getenv("AUTH_JWT_HS256_SECRET")),
+		Issuer:      os.Getenv("AUTH_JWT_ISSUER"),
+		Audience:    os.Getenv("AUTH_JWT_AUDIENCE"),
+		RolesClaim:  os.Getenv("AUTH_JWT_ROLES_CLAIM"),
+		RoleMapping: parseRoleMapping(os.Getenv("AUTH_JWT_ROLE_MAPPING")),
+	}
+
+	var source KeySource
+	if path := os.Getenv("AUTH_JWT_JWKS_FILE"); path != "" {
+		source = FileKeySource{Path: path}
+	} else if url := os.Getenv("AUTH_JWT_JWKS_URL"); url != "" {
+		source = URLKeySource{URL: url, Client: &http.Client{Timeout: 10 * time.Second}}
+	}
+	if source != nil {
+		keySet, err := NewKeySet(source, DefaultJWKSRefreshDelay)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWKS: %w", err)
+		}
+		config.KeySet = keySet
+	}
+
+	return NewJWTAuthenticator(config)
+}
+
+// parseRoleMapping parses "external:role,other:role" pairs.
+func parseRoleMapping(value string) map[string]Role {
+	mapping := map[string]Role{}
+	for _, pair := range strings.Split(value, ",") {
+		external, role, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if ok && external != "" && role != "" {
+			mapping[external] = Role(role)
+		}
+	}
+	return mapping
+}
+
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	header := r.Header.Get("Authorization")
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, ErrMissingCredentials
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.keyFunc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	subject, _ := claims.GetSubject()
+	return &Principal{
+		Subject: subject,
+		Roles:   a.roles(claims),
+		Scopes:  scopes(claims),
+		Claims:  claims,
+	}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return a.config.HMACSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		return a.config.KeySet.Key(kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %q", token.Method.Alg())
+	}
+}
+
+func (a *JWTAuthenticator) roles(claims jwt.MapClaims) []Role {
+	var roles []Role
+	for _, value := range stringList(claims[a.config.RolesClaim]) {
+		if role, ok := a.config.RoleMapping[value]; ok {
+			roles = append(roles, role)
+			continue
+		}
+		switch role := Role(value); role {
+		case RoleKiosk, RoleStaff, RoleAdmin, RoleService, RoleCustomer, RoleGuest:
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// scopes reads the space separated OAuth "scope" claim.
+func scopes(claims jwt.MapClaims) []string {
+	value, _ := claims["scope"].(string)
+	return strings.Fields(value)
+}
+
+func stringList(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
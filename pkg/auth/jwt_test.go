@@ -0,0 +1,203 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+const testSecret = "test-secret"
+
+type JWTAuthenticatorTestSuite struct {
+	suite.Suite
+	rsaKey        *rsa.PrivateKey
+	authenticator *auth.JWTAuthenticator
+}
+
+func (suite *JWTAuthenticatorTestSuite) SetupTest() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	suite.rsaKey = key
+
+	jwksPath := filepath.Join(suite.T().TempDir(), "jwks.json")
+	data, err := json.Marshal(auth.JSONWebKeySet{Keys: []auth.JSONWebKey{auth.NewJSONWebKey("key-1", &key.PublicKey)}})
+	suite.Require().NoError(err)
+	suite.Require().NoError(os.WriteFile(jwksPath, data, 0o600))
+
+	keySet, err := auth.NewKeySet(auth.FileKeySource{Path: jwksPath}, time.Minute)
+	suite.Require().NoError(err)
+
+	suite.authenticator, err = auth.NewJWTAuthenticator(auth.JWTConfig{
+		HMACSecret:  []byte(testSecret),
+		KeySet:      keySet,
+		Issuer:      "tc-fiap",
+		RoleMapping: map[string]auth.Role{"backoffice": auth.RoleStaff},
+	})
+	suite.Require().NoError(err)
+}
+
+func TestJWTAuthenticatorTestSuite(t *testing.T) {
+	suite.Run(t, new(JWTAuthenticatorTestSuite))
+}
+
+func (suite *JWTAuthenticatorTestSuite) claims(roles ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   "tc-fiap",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": roles,
+		"scope": "customers:read customers:write",
+	}
+}
+
+func (suite *JWTAuthenticatorTestSuite) request(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+func (suite *JWTAuthenticatorTestSuite) hs256(claims jwt.MapClaims, secret string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	suite.Require().NoError(err)
+	return token
+}
+
+func (suite *JWTAuthenticatorTestSuite) rs256(claims jwt.MapClaims, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(suite.rsaKey)
+	suite.Require().NoError(err)
+	return signed
+}
+
+// Feature: JWT Authentication
+// Scenario: Verify bearer tokens and map claims to roles
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithValidHS256Token_ShouldReturnPrincipal() {
+	// GIVEN a token signed with the shared secret
+	token := suite.hs256(suite.claims("kiosk"), testSecret)
+
+	// WHEN the request is authenticated
+	principal, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the principal should carry the subject, roles and scopes
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "user-1", principal.Subject)
+	assert.Equal(suite.T(), []auth.Role{auth.RoleKiosk}, principal.Roles)
+	assert.True(suite.T(), principal.HasScope(auth.ScopeCustomersRead))
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithValidRS256Token_ShouldReturnPrincipal() {
+	// GIVEN a token signed with a key published in the JWKS
+	token := suite.rs256(suite.claims("admin"), "key-1")
+
+	// WHEN the request is authenticated
+	principal, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the principal should be admin
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), principal.HasRole(auth.RoleAdmin))
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithMappedRole_ShouldTranslateRole() {
+	// GIVEN a token carrying an identity provider role name and an unknown role
+	token := suite.hs256(suite.claims("backoffice", "superuser"), testSecret)
+
+	// WHEN the request is authenticated
+	principal, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN only the mapped role should be granted
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []auth.Role{auth.RoleStaff}, principal.Roles)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithoutAuthorizationHeader_ShouldReturnMissingCredentials() {
+	// GIVEN a request without a bearer token
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(suite.request(""))
+
+	// THEN missing credentials should be reported
+	assert.ErrorIs(suite.T(), err, auth.ErrMissingCredentials)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithWrongSecret_ShouldReturnInvalidCredentials() {
+	// GIVEN a token signed with another secret
+	token := suite.hs256(suite.claims("kiosk"), "other-secret")
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the token should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithExpiredToken_ShouldReturnInvalidCredentials() {
+	// GIVEN an expired token
+	claims := suite.claims("kiosk")
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	token := suite.hs256(claims, testSecret)
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the token should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithWrongIssuer_ShouldReturnInvalidCredentials() {
+	// GIVEN a token issued by someone else
+	claims := suite.claims("kiosk")
+	claims["iss"] = "somebody-else"
+	token := suite.hs256(claims, testSecret)
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the token should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithUnknownKeyID_ShouldReturnInvalidCredentials() {
+	// GIVEN a token referencing a key that is not in the JWKS
+	token := suite.rs256(suite.claims("kiosk"), "key-2")
+
+	// WHEN the request is authenticated
+	_, err := suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the token should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func (suite *JWTAuthenticatorTestSuite) Test_Authentication_WithUnsignedToken_ShouldReturnInvalidCredentials() {
+	// GIVEN a token using the "none" algorithm
+	token, err := jwt.NewWithClaims(jwt.SigningMethodNone, suite.claims("admin")).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	suite.Require().NoError(err)
+
+	// WHEN the request is authenticated
+	_, err = suite.authenticator.Authenticate(suite.request(token))
+
+	// THEN the token should be rejected
+	assert.ErrorIs(suite.T(), err, auth.ErrInvalidCredentials)
+}
+
+func TestNewJWTAuthenticator_WithoutKeys_ShouldFail(t *testing.T) {
+	// GIVEN a configuration without any verification key
+	// WHEN the authenticator is created
+	_, err := auth.NewJWTAuthenticator(auth.JWTConfig{})
+
+	// THEN creation should fail
+	assert.Error(t, err)
+}
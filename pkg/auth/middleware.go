@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Rule describes who may call a route. A principal is allowed when it holds
+// any of the listed roles or any of the listed scopes.
+type Rule struct {
+	Roles  []Role
+	Scopes []string
+}
+
+func (rule Rule) Allows(principal *Principal) bool {
+	for _, role := range rule.Roles {
+		if principal.HasRole(role) {
+			return true
+		}
+	}
+	for _, scope := range rule.Scopes {
+		if principal.HasScope(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// Middleware authenticates every request carrying credentials and stores the
+// principal in the request context. Requests without credentials pass through
+// untouched so public routes keep working; protected routes reject them in Authorize.
+func Middleware(authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, err := authenticator.Authenticate(r)
+			if errors.Is(err, ErrMissingCredentials) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				unauthorized(w)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// Authorize rejects requests whose principal does not satisfy the rule.
+func Authorize(rule Rule) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				unauthorized(w)
+				return
+			}
+			if !rule.Allows(principal) {
+				http.Error(w, `{"error":"Forbidden"}`, http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func unauthorized(w http.ResponseWriter) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="tc-fiap-customer"`)
+	http.Error(w, `{"error":"Unauthorized"}`, http.StatusUnauthorized)
+}
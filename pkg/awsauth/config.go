@@ -0,0 +1,93 @@
+package awsauth
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+// DefaultRefresh is how often the mounted credential files are read again. The kubelet takes
+// about a minute to update a mounted Secret.
+const DefaultRefresh = time.Minute
+
+// resolveTimeout bounds the startup lookup of the credentials, which may wait on the instance
+// metadata service when no other provider has any.
+const resolveTimeout = 5 * time.Second
+
+// ProviderFromEnv returns a FileProvider reading the directory AWS_CREDENTIALS_DIR every
+// AWS_CREDENTIALS_REFRESH, or nil when the directory is not set, leaving the credentials to the
+// default chain of the SDK: environment variables, shared files, web identity token files (IRSA)
+// and container or instance roles.
+func ProviderFromEnv() (*FileProvider, error) {
+	dir := os.Getenv("AWS_CREDENTIALS_DIR")
+	if dir == "" {
+		return nil, nil
+	}
+
+	refresh := DefaultRefresh
+	if value := os.Getenv("AWS_CREDENTIALS_REFRESH"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid AWS_CREDENTIALS_REFRESH: %q", value)
+		}
+		refresh = parsed
+	}
+
+	return NewFileProvider(dir, refresh), nil
+}
+
+// LoadConfig resolves the region and the credentials of client, the same way for every client: the
+// files mounted in AWS_CREDENTIALS_DIR, read again as they rotate, or else the default chain of the
+// SDK. A local emulator at endpoint accepts any credentials, so it gets dummy ones when
+// AWS_ACCESS_KEY_ID is not set. The provider chosen is logged.
+func LoadConfig(ctx context.Context, client string, endpoint string, optFns ...func(*config.LoadOptions) error) (aws.Config, error) {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = "us-east-1" // Default region
+	}
+	options := append([]func(*config.LoadOptions) error{config.WithRegion(region)}, optFns...)
+
+	fileProvider, err := ProviderFromEnv()
+	if err != nil {
+		return aws.Config{}, err
+	}
+	switch {
+	case fileProvider != nil:
+		options = append(options, config.WithCredentialsProvider(fileProvider))
+	case endpoint != "" && os.Getenv("AWS_ACCESS_KEY_ID") == "":
+		options = append(options, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("dummy", "dummy", "")))
+	}
+
+	awsConfig, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	LogSource(ctx, client, awsConfig.Credentials)
+	return awsConfig, nil
+}
+
+// LogSource resolves the credentials once and logs which provider supplied them, e.g.
+// EnvConfigCredentials, WebIdentityCredentials or EC2RoleProvider, never the credentials
+// themselves. A failure is only logged, as the calls report it again until credentials show up.
+func LogSource(ctx context.Context, client string, provider aws.CredentialsProvider) {
+	if provider == nil {
+		log.Printf("Warning: %s has no AWS credentials provider\n", client)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+
+	credentials, err := provider.Retrieve(ctx)
+	if err != nil {
+		log.Printf("Warning: %s could not resolve AWS credentials: %v\n", client, err)
+		return
+	}
+	log.Printf("%s using AWS credentials from %s\n", client, credentials.Source)
+}
@@ -0,0 +1,129 @@
+package awsauth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderFromEnv_WithoutDirectory_ShouldLeaveTheDefaultChain(t *testing.T) {
+	// GIVEN no credentials directory
+	t.Setenv("AWS_CREDENTIALS_DIR", "")
+
+	// WHEN reading the configuration
+	provider, err := ProviderFromEnv()
+
+	// THEN no provider should replace the default chain
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+}
+
+func TestProviderFromEnv_WithDirectory_ShouldReadItEveryRefresh(t *testing.T) {
+	// GIVEN a credentials directory read every 30 seconds
+	t.Setenv("AWS_CREDENTIALS_DIR", "/var/run/secrets/aws")
+	t.Setenv("AWS_CREDENTIALS_REFRESH", "30s")
+
+	// WHEN reading the configuration
+	provider, err := ProviderFromEnv()
+
+	// THEN a file provider should read the directory
+	require.NoError(t, err)
+	assert.Equal(t, "/var/run/secrets/aws", provider.dir)
+	assert.Equal(t, 30*time.Second, provider.refresh)
+}
+
+func TestProviderFromEnv_WithInvalidRefresh_ShouldFail(t *testing.T) {
+	// GIVEN a refresh interval that is not a duration
+	t.Setenv("AWS_CREDENTIALS_DIR", "/var/run/secrets/aws")
+	t.Setenv("AWS_CREDENTIALS_REFRESH", "soon")
+
+	// WHEN reading the configuration
+	_, err := ProviderFromEnv()
+
+	// THEN it should fail
+	assert.EqualError(t, err, `invalid AWS_CREDENTIALS_REFRESH: "soon"`)
+}
+
+func TestLoadConfig_WithCredentialsDirectory_ShouldPreferTheMountedFiles(t *testing.T) {
+	// GIVEN mounted credentials as well as credentials in the environment
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIAFILE", "AWS_SECRET_ACCESS_KEY": "secret"})
+	t.Setenv("AWS_CREDENTIALS_DIR", dir)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIAENV")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "sa-east-1")
+	output := captureLog(t)
+
+	// WHEN loading the configuration of a client
+	awsConfig, err := LoadConfig(context.Background(), "SNS", "")
+
+	// THEN the mounted files should supply the credentials, and the source be logged
+	require.NoError(t, err)
+	assert.Equal(t, "sa-east-1", awsConfig.Region)
+	credentials, err := awsConfig.Credentials.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAFILE", credentials.AccessKeyID)
+	assert.Contains(t, output.String(), "SNS using AWS credentials from "+FileProviderName)
+}
+
+func TestLoadConfig_WithLocalEndpointWithoutCredentials_ShouldUseDummyOnes(t *testing.T) {
+	// GIVEN a local emulator and no credentials
+	t.Setenv("AWS_CREDENTIALS_DIR", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	captureLog(t)
+
+	// WHEN loading the configuration of a client
+	awsConfig, err := LoadConfig(context.Background(), "SQS", "http://localhost:9324")
+
+	// THEN dummy credentials should be used
+	require.NoError(t, err)
+	credentials, err := awsConfig.Credentials.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "dummy", credentials.AccessKeyID)
+}
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var output bytes.Buffer
+	previous := log.Writer()
+	log.SetOutput(&output)
+	t.Cleanup(func() { log.SetOutput(previous) })
+	return &output
+}
+
+func TestLogSource_ShouldLogTheProviderWithoutTheCredentials(t *testing.T) {
+	// GIVEN a provider of static credentials
+	output := captureLog(t)
+	provider := credentials.NewStaticCredentialsProvider("AKIAEXAMPLE", "secret", "token")
+
+	// WHEN logging where the credentials come from
+	LogSource(context.Background(), "DynamoDB", provider)
+
+	// THEN only the provider should be logged
+	assert.Contains(t, output.String(), "DynamoDB using AWS credentials from StaticCredentials")
+	assert.NotContains(t, output.String(), "AKIAEXAMPLE")
+	assert.NotContains(t, output.String(), "secret")
+	assert.NotContains(t, output.String(), "token")
+}
+
+func TestLogSource_WithoutCredentials_ShouldWarn(t *testing.T) {
+	// GIVEN a provider that finds no credentials
+	output := captureLog(t)
+	provider := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{}, errors.New("no credentials found")
+	})
+
+	// WHEN logging where the credentials come from
+	LogSource(context.Background(), "DynamoDB", provider)
+
+	// THEN a warning should be logged
+	assert.Contains(t, output.String(), "Warning: DynamoDB could not resolve AWS credentials: no credentials found")
+}
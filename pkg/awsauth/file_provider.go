@@ -0,0 +1,77 @@
+package awsauth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// FileProviderName is the source of the credentials read by a FileProvider.
+const FileProviderName = "MountedFileCredentials"
+
+// File names of the credentials, the keys of the Secret mounted as a volume.
+const (
+	accessKeyIDFile     = "AWS_ACCESS_KEY_ID"
+	secretAccessKeyFile = "AWS_SECRET_ACCESS_KEY"
+	sessionTokenFile    = "AWS_SESSION_TOKEN"
+)
+
+// FileProvider reads the credentials from the files AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and,
+// when present, AWS_SESSION_TOKEN of a directory, as a Kubernetes Secret mounted as a volume lays
+// them out. The credentials it returns expire after refresh, so the cache of the SDK reads the
+// files again and picks rotated credentials up without a restart.
+type FileProvider struct {
+	dir     string
+	refresh time.Duration
+	now     func() time.Time
+}
+
+func NewFileProvider(dir string, refresh time.Duration) *FileProvider {
+	return &FileProvider{dir: dir, refresh: refresh, now: time.Now}
+}
+
+func (p *FileProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	accessKeyID, err := p.read(accessKeyIDFile, true)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	secretAccessKey, err := p.read(secretAccessKeyFile, true)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+	sessionToken, err := p.read(sessionTokenFile, false)
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		SessionToken:    sessionToken,
+		Source:          FileProviderName + ": " + p.dir,
+		CanExpire:       true,
+		Expires:         p.now().Add(p.refresh),
+	}, nil
+}
+
+// read ignores the surrounding spaces and line breaks editors and shells leave in the files.
+func (p *FileProvider) read(name string, required bool) (string, error) {
+	content, err := os.ReadFile(filepath.Join(p.dir, name))
+	if os.IsNotExist(err) && !required {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read AWS credentials: %w", err)
+	}
+
+	value := strings.TrimSpace(string(content))
+	if value == "" && required {
+		return "", fmt.Errorf("failed to read AWS credentials: %s is empty", filepath.Join(p.dir, name))
+	}
+	return value, nil
+}
@@ -0,0 +1,93 @@
+package awsauth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredentials(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+}
+
+func TestFileProvider_Retrieve_ShouldReadMountedFiles(t *testing.T) {
+	// GIVEN a mounted Secret whose files end with line breaks
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{
+		"AWS_ACCESS_KEY_ID":     "AKIAEXAMPLE\n",
+		"AWS_SECRET_ACCESS_KEY": "secret\n",
+		"AWS_SESSION_TOKEN":     "token\n",
+	})
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	provider := NewFileProvider(dir, time.Minute)
+	provider.now = func() time.Time { return now }
+
+	// WHEN retrieving the credentials
+	credentials, err := provider.Retrieve(context.Background())
+
+	// THEN they should be read without the line breaks and expire after the refresh interval
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAEXAMPLE", credentials.AccessKeyID)
+	assert.Equal(t, "secret", credentials.SecretAccessKey)
+	assert.Equal(t, "token", credentials.SessionToken)
+	assert.Equal(t, FileProviderName+": "+dir, credentials.Source)
+	assert.True(t, credentials.CanExpire)
+	assert.Equal(t, now.Add(time.Minute), credentials.Expires)
+}
+
+func TestFileProvider_Retrieve_WithoutSessionToken_ShouldReturnLongTermCredentials(t *testing.T) {
+	// GIVEN a mounted Secret without a session token
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIAEXAMPLE", "AWS_SECRET_ACCESS_KEY": "secret"})
+
+	// WHEN retrieving the credentials
+	credentials, err := NewFileProvider(dir, time.Minute).Retrieve(context.Background())
+
+	// THEN they should have no session token
+	require.NoError(t, err)
+	assert.Empty(t, credentials.SessionToken)
+}
+
+func TestFileProvider_Retrieve_AfterRotation_ShouldReadNewCredentials(t *testing.T) {
+	// GIVEN credentials already read once
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIAOLD", "AWS_SECRET_ACCESS_KEY": "old"})
+	provider := NewFileProvider(dir, time.Minute)
+	_, err := provider.Retrieve(context.Background())
+	require.NoError(t, err)
+
+	// WHEN the Secret is rotated and the credentials are retrieved again
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIANEW", "AWS_SECRET_ACCESS_KEY": "new"})
+	credentials, err := provider.Retrieve(context.Background())
+
+	// THEN the new credentials should be returned
+	require.NoError(t, err)
+	assert.Equal(t, "AKIANEW", credentials.AccessKeyID)
+	assert.Equal(t, "new", credentials.SecretAccessKey)
+}
+
+func TestFileProvider_Retrieve_WithMissingOrEmptySecretKey_ShouldFail(t *testing.T) {
+	// GIVEN a directory with an access key but no secret key
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIAEXAMPLE"})
+	provider := NewFileProvider(dir, time.Minute)
+
+	// WHEN retrieving the credentials
+	_, err := provider.Retrieve(context.Background())
+
+	// THEN it should fail
+	assert.ErrorContains(t, err, "AWS_SECRET_ACCESS_KEY")
+
+	// AND an empty secret key should fail too
+	writeCredentials(t, dir, map[string]string{"AWS_SECRET_ACCESS_KEY": "\n"})
+	_, err = provider.Retrieve(context.Background())
+	assert.ErrorContains(t, err, "is empty")
+}
@@ -0,0 +1,70 @@
+package awsauth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// NewV1Session creates a session of the SDK v1 for client with the region and credentials
+// LoadConfig resolves, pointed to endpoint when set, e.g. a local emulator.
+func NewV1Session(client string, endpoint string) (*session.Session, error) {
+	awsConfig, err := LoadConfig(context.Background(), client, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionConfig := &awsv1.Config{
+		Region:      awsv1.String(awsConfig.Region),
+		Credentials: V1Credentials(awsConfig.Credentials),
+	}
+	if endpoint != "" {
+		sessionConfig.Endpoint = awsv1.String(endpoint)
+	}
+
+	sess, err := session.NewSession(sessionConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+	return sess, nil
+}
+
+// V1Credentials hands the credentials of provider to the clients still on the SDK v1, which get
+// them again once they expire, as the v2 clients do.
+func V1Credentials(provider aws.CredentialsProvider) *credentialsv1.Credentials {
+	return credentialsv1.NewCredentials(&v1Provider{provider: provider, now: time.Now})
+}
+
+type v1Provider struct {
+	provider aws.CredentialsProvider
+	now      func() time.Time
+
+	canExpire bool
+	expires   time.Time
+}
+
+// Retrieve and IsExpired are serialized by credentialsv1.Credentials.
+func (p *v1Provider) Retrieve() (credentialsv1.Value, error) {
+	credentials, err := p.provider.Retrieve(context.Background())
+	if err != nil {
+		return credentialsv1.Value{}, err
+	}
+
+	p.canExpire = credentials.CanExpire
+	p.expires = credentials.Expires
+	return credentialsv1.Value{
+		AccessKeyID:     credentials.AccessKeyID,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+		ProviderName:    credentials.Source,
+	}, nil
+}
+
+func (p *v1Provider) IsExpired() bool {
+	return p.canExpire && !p.now().Before(p.expires)
+}
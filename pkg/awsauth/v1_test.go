@@ -0,0 +1,81 @@
+package awsauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestV1Credentials_ShouldRetrieveAgainOnceExpired(t *testing.T) {
+	// GIVEN a provider returning new credentials every minute
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	provider := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		calls++
+		keys := []string{"AKIAOLD", "AKIANEW"}
+		return aws.Credentials{
+			AccessKeyID:     keys[min(calls, len(keys))-1],
+			SecretAccessKey: "secret",
+			Source:          FileProviderName,
+			CanExpire:       true,
+			Expires:         now.Add(time.Minute),
+		}, nil
+	})
+	adapter := &v1Provider{provider: provider, now: func() time.Time { return now }}
+
+	// WHEN retrieving the credentials through the SDK v1
+	value, err := adapter.Retrieve()
+
+	// THEN they should carry the source of the provider
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAOLD", value.AccessKeyID)
+	assert.Equal(t, FileProviderName, value.ProviderName)
+	assert.False(t, adapter.IsExpired())
+
+	// AND they should be retrieved again once expired
+	now = now.Add(time.Minute)
+	assert.True(t, adapter.IsExpired())
+	value, err = adapter.Retrieve()
+	require.NoError(t, err)
+	assert.Equal(t, "AKIANEW", value.AccessKeyID)
+}
+
+func TestV1Credentials_ShouldHandTheCredentialsToTheSDK(t *testing.T) {
+	// GIVEN a provider of credentials
+	provider := aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return aws.Credentials{AccessKeyID: "AKIAEXAMPLE", SecretAccessKey: "secret", SessionToken: "token"}, nil
+	})
+
+	// WHEN the SDK v1 gets the credentials
+	value, err := V1Credentials(provider).Get()
+
+	// THEN they should be the ones of the provider
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAEXAMPLE", value.AccessKeyID)
+	assert.Equal(t, "secret", value.SecretAccessKey)
+	assert.Equal(t, "token", value.SessionToken)
+}
+
+func TestNewV1Session_ShouldUseTheCredentialsOfLoadConfig(t *testing.T) {
+	// GIVEN mounted credentials and a local endpoint
+	dir := t.TempDir()
+	writeCredentials(t, dir, map[string]string{"AWS_ACCESS_KEY_ID": "AKIAFILE", "AWS_SECRET_ACCESS_KEY": "secret"})
+	t.Setenv("AWS_CREDENTIALS_DIR", dir)
+	t.Setenv("AWS_REGION", "sa-east-1")
+	captureLog(t)
+
+	// WHEN creating the session of a client of the SDK v1
+	sess, err := NewV1Session("KMS", "http://localhost:4566")
+
+	// THEN it should carry the region, the endpoint and the mounted credentials
+	require.NoError(t, err)
+	assert.Equal(t, "sa-east-1", *sess.Config.Region)
+	assert.Equal(t, "http://localhost:4566", *sess.Config.Endpoint)
+	value, err := sess.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "AKIAFILE", value.AccessKeyID)
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config sizes a read-through cache. Found values are kept for TTL and misses for NegativeTTL,
+// which is short so a value created elsewhere shows up soon. A zero Size disables the cache.
+type Config struct {
+	Size        int
+	TTL         time.Duration
+	NegativeTTL time.Duration
+	// RedisURL, when set, adds a cache shared by every replica behind the in-process one, whose
+	// commands give up after RedisTimeout. A write keeps what was read before it out of the shared
+	// cache for RedisTombstoneTTL, which must outlast the slowest read.
+	RedisURL          string
+	RedisTimeout      time.Duration
+	RedisTombstoneTTL time.Duration
+}
+
+// Enabled reports whether the cache is configured.
+func (c Config) Enabled() bool {
+	return c.Size > 0 && c.TTL > 0
+}
+
+// Shared reports whether a shared cache is configured.
+func (c Config) Shared() bool {
+	return c.RedisURL != ""
+}
+
+// ConfigFromEnv reads <prefix>_SIZE, <prefix>_TTL, <prefix>_NEGATIVE_TTL, <prefix>_REDIS_URL,
+// <prefix>_REDIS_TIMEOUT and <prefix>_REDIS_TOMBSTONE_TTL, falling back to defaultConfig. Setting
+// <prefix>_SIZE to 0 disables the cache and <prefix>_NEGATIVE_TTL to 0 disables negative caching.
+func ConfigFromEnv(prefix string, defaultConfig Config) (Config, error) {
+	config := defaultConfig
+
+	if value := os.Getenv(prefix + "_SIZE"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 0 {
+			return Config{}, fmt.Errorf("invalid %s_SIZE: %q", prefix, value)
+		}
+		config.Size = size
+	}
+	if value := os.Getenv(prefix + "_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			return Config{}, fmt.Errorf("invalid %s_TTL: %q", prefix, value)
+		}
+		config.TTL = ttl
+	}
+	if value := os.Getenv(prefix + "_NEGATIVE_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl < 0 {
+			return Config{}, fmt.Errorf("invalid %s_NEGATIVE_TTL: %q", prefix, value)
+		}
+		config.NegativeTTL = ttl
+	}
+	if value := os.Getenv(prefix + "_REDIS_URL"); value != "" {
+		config.RedisURL = value
+	}
+	if value := os.Getenv(prefix + "_REDIS_TIMEOUT"); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return Config{}, fmt.Errorf("invalid %s_REDIS_TIMEOUT: %q", prefix, value)
+		}
+		config.RedisTimeout = timeout
+	}
+	if value := os.Getenv(prefix + "_REDIS_TOMBSTONE_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			return Config{}, fmt.Errorf("invalid %s_REDIS_TOMBSTONE_TTL: %q", prefix, value)
+		}
+		config.RedisTombstoneTTL = ttl
+	}
+
+	return config, nil
+}
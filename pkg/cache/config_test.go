@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var defaultConfig = Config{Size: 100, TTL: time.Minute, NegativeTTL: 5 * time.Second}
+
+func TestConfigFromEnv_WithoutVariables_ShouldReturnDefaults(t *testing.T) {
+	// WHEN no variable is set
+	config, err := ConfigFromEnv("TEST_CACHE", defaultConfig)
+
+	// THEN the defaults should be used
+	assert.NoError(t, err)
+	assert.Equal(t, defaultConfig, config)
+	assert.True(t, config.Enabled())
+	assert.False(t, config.Shared())
+}
+
+func TestConfigFromEnv_ShouldOverrideDefaults(t *testing.T) {
+	// GIVEN every variable set
+	t.Setenv("TEST_CACHE_SIZE", "10")
+	t.Setenv("TEST_CACHE_TTL", "2m")
+	t.Setenv("TEST_CACHE_NEGATIVE_TTL", "0s")
+	t.Setenv("TEST_CACHE_REDIS_URL", "redis://localhost:6379/0")
+	t.Setenv("TEST_CACHE_REDIS_TIMEOUT", "50ms")
+	t.Setenv("TEST_CACHE_REDIS_TOMBSTONE_TTL", "5s")
+
+	// WHEN reading the config
+	config, err := ConfigFromEnv("TEST_CACHE", defaultConfig)
+
+	// THEN the variables should win, negative caching being disabled
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Size: 10, TTL: 2 * time.Minute, RedisURL: "redis://localhost:6379/0", RedisTimeout: 50 * time.Millisecond, RedisTombstoneTTL: 5 * time.Second}, config)
+	assert.True(t, config.Shared())
+}
+
+func TestConfigFromEnv_WithZeroSize_ShouldDisableCache(t *testing.T) {
+	// GIVEN a zero size
+	t.Setenv("TEST_CACHE_SIZE", "0")
+
+	// WHEN reading the config
+	config, err := ConfigFromEnv("TEST_CACHE", defaultConfig)
+
+	// THEN the cache should be disabled
+	assert.NoError(t, err)
+	assert.False(t, config.Enabled())
+}
+
+func TestConfigFromEnv_WithInvalidValue_ShouldFail(t *testing.T) {
+	for name, value := range map[string]string{
+		"TEST_CACHE_SIZE":                "-1",
+		"TEST_CACHE_TTL":                 "0s",
+		"TEST_CACHE_NEGATIVE_TTL":        "soon",
+		"TEST_CACHE_REDIS_TIMEOUT":       "-1s",
+		"TEST_CACHE_REDIS_TOMBSTONE_TTL": "0s",
+	} {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN an invalid value
+			t.Setenv(name, value)
+
+			// WHEN reading the config
+			_, err := ConfigFromEnv("TEST_CACHE", defaultConfig)
+
+			// THEN it should fail naming the variable
+			assert.ErrorContains(t, err, name)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package cache
+
+import "sync"
+
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+	// dups counts the callers waiting for this call.
+	dups int
+}
+
+// Group coalesces concurrent loads of the same key: while a load is in flight, callers asking
+// for the same key wait for it and share its result instead of loading again.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// Do runs load for key unless a load of key is already in flight, in which case it waits for
+// that one. shared reports whether the result came from another caller's load.
+func (g *Group[K, V]) Do(key K, load func() (V, error)) (value V, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	if inFlight, ok := g.calls[key]; ok {
+		inFlight.dups++
+		g.mu.Unlock()
+		<-inFlight.done
+		return inFlight.value, inFlight.err, true
+	}
+	c := &call[V]{done: make(chan struct{})}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.value, c.err = load()
+	return c.value, c.err, false
+}
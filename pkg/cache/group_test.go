@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup_Do_ShouldShareConcurrentLoads(t *testing.T) {
+	// GIVEN a load that blocks until every caller is waiting
+	var group Group[string, int]
+	var loads atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	results := make([]int, 5)
+	shared := make([]bool, 5)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _, shared[0] = group.Do("key", func() (int, error) {
+			loads.Add(1)
+			close(started)
+			<-release
+			return 42, nil
+		})
+	}()
+	<-started
+
+	// WHEN other callers ask for the same key while it loads
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _, shared[i] = group.Do("key", func() (int, error) {
+				loads.Add(1)
+				return 0, nil
+			})
+		}()
+	}
+	waitForWaiters(&group, "key", len(results)-1)
+	close(release)
+	wg.Wait()
+
+	// THEN a single load should answer all of them
+	assert.Equal(t, int32(1), loads.Load())
+	assert.Equal(t, []int{42, 42, 42, 42, 42}, results)
+	assert.False(t, shared[0])
+	for _, s := range shared[1:] {
+		assert.True(t, s)
+	}
+}
+
+func TestGroup_Do_ShouldLoadAgainOnceDone(t *testing.T) {
+	// GIVEN a load that failed
+	var group Group[string, int]
+	_, err, _ := group.Do("key", func() (int, error) { return 0, errors.New("boom") })
+
+	// WHEN loading the key again
+	value, nextErr, shared := group.Do("key", func() (int, error) { return 7, nil })
+
+	// THEN the failure should not be remembered
+	assert.Error(t, err)
+	assert.NoError(t, nextErr)
+	assert.Equal(t, 7, value)
+	assert.False(t, shared)
+}
+
+// waitForWaiters blocks until n callers wait for the load of key in flight.
+func waitForWaiters(group *Group[string, int], key string, n int) {
+	for {
+		group.mu.Lock()
+		dups := group.calls[key].dups
+		group.mu.Unlock()
+		if dups == n {
+			return
+		}
+		runtime.Gosched()
+	}
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// LRU keeps at most capacity entries in process memory, evicting the least recently used one
+// to make room. Entries also expire after the TTL they were set with.
+type LRU[K comparable, V any] struct {
+	mu        sync.Mutex
+	capacity  int
+	items     map[K]*list.Element
+	order     *list.List
+	now       func() time.Time
+	evictions int64
+}
+
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return newLRU[K, V](capacity, time.Now)
+}
+
+func newLRU[K comparable, V any](capacity int, now func() time.Time) *LRU[K, V] {
+	return &LRU[K, V]{capacity: capacity, items: make(map[K]*list.Element), order: list.New(), now: now}
+}
+
+// Get returns the value of key unless it is missing or expired.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+	element, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+	item := element.Value.(*entry[K, V])
+	if !c.now().Before(item.expiresAt) {
+		c.remove(element)
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return item.value, true
+}
+
+// Set stores value under key for ttl, replacing any previous value.
+func (c *LRU[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if element, ok := c.items[key]; ok {
+		item := element.Value.(*entry[K, V])
+		item.value = value
+		item.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+		c.evictions++
+	}
+}
+
+// Delete removes key, reporting whether it was there.
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[key]
+	if ok {
+		c.remove(element)
+	}
+	return ok
+}
+
+// Len counts the entries, expired ones not yet removed included.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Evictions counts the entries evicted to make room since the cache was created.
+func (c *LRU[K, V]) Evictions() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.evictions
+}
+
+func (c *LRU[K, V]) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.items, element.Value.(*entry[K, V]).key)
+}
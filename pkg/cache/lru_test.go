@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestLRU_Get_ShouldReturnValueUntilItExpires(t *testing.T) {
+	// GIVEN a value set for a minute
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	lru := newLRU[string, int](2, clock.Now)
+	lru.Set("a", 1, time.Minute)
+
+	// WHEN reading it before and after the minute
+	before, foundBefore := lru.Get("a")
+	clock.Advance(time.Minute)
+	_, foundAfter := lru.Get("a")
+
+	// THEN it should only be found before expiring, and be removed once expired
+	assert.True(t, foundBefore)
+	assert.Equal(t, 1, before)
+	assert.False(t, foundAfter)
+	assert.Equal(t, 0, lru.Len())
+}
+
+func TestLRU_Set_ShouldEvictLeastRecentlyUsed(t *testing.T) {
+	// GIVEN a full cache whose oldest entry was read last
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	lru := newLRU[string, int](2, clock.Now)
+	lru.Set("a", 1, time.Minute)
+	lru.Set("b", 2, time.Minute)
+	lru.Get("a")
+
+	// WHEN another entry is set
+	lru.Set("c", 3, time.Minute)
+
+	// THEN the entry not used for the longest should be evicted
+	_, foundA := lru.Get("a")
+	_, foundB := lru.Get("b")
+	_, foundC := lru.Get("c")
+	assert.True(t, foundA)
+	assert.False(t, foundB)
+	assert.True(t, foundC)
+	assert.Equal(t, int64(1), lru.Evictions())
+}
+
+func TestLRU_Set_ShouldReplaceValueAndTTL(t *testing.T) {
+	// GIVEN a value about to expire
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	lru := newLRU[string, int](2, clock.Now)
+	lru.Set("a", 1, time.Second)
+
+	// WHEN it is set again for longer
+	lru.Set("a", 2, time.Minute)
+	clock.Advance(time.Second)
+
+	// THEN the new value should still be there, without evicting anything
+	value, found := lru.Get("a")
+	assert.True(t, found)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, int64(0), lru.Evictions())
+}
+
+func TestLRU_Delete_ShouldReportWhetherKeyWasThere(t *testing.T) {
+	// GIVEN a single entry
+	lru := NewLRU[string, int](2)
+	lru.Set("a", 1, time.Minute)
+
+	// WHEN deleting it twice
+	first := lru.Delete("a")
+	second := lru.Delete("a")
+
+	// THEN only the first delete should find it
+	assert.True(t, first)
+	assert.False(t, second)
+	_, found := lru.Get("a")
+	assert.False(t, found)
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	_ SharedStore = (*RedisStore)(nil)
+)
+
+// SharedStore keeps cache entries outside the process, where every replica sees them, and
+// carries the messages replicas use to tell each other which entries they changed.
+type SharedStore interface {
+	// Get reports whether key is there and returns its value.
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	// SetIfAbsent sets key only when it is not there, and reports whether it did.
+	SetIfAbsent(key string, value []byte, ttl time.Duration) (bool, error)
+	Delete(keys ...string) error
+	Publish(channel string, message string) error
+	// Subscribe calls handle with every message published on channel until stop is called.
+	// Messages published while the connection is down are lost.
+	Subscribe(channel string, handle func(message string)) (stop func(), err error)
+}
+
+// RedisStore is a SharedStore on any server speaking the Redis protocol. Every command gives up
+// after the timeout, as a cache that answers slower than the database is worse than none.
+type RedisStore struct {
+	client  *redis.Client
+	timeout time.Duration
+}
+
+// NewRedisStore connects to url, e.g. redis://:password@host:6379/0 or rediss:// for TLS. The
+// server is pinged but an unreachable one is only logged, since the client reconnects on its own.
+func NewRedisStore(url string, timeout time.Duration) (*RedisStore, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	options.ReadTimeout = timeout
+	options.WriteTimeout = timeout
+	store := &RedisStore{client: redis.NewClient(options), timeout: timeout}
+
+	ctx, cancel := store.context()
+	defer cancel()
+	if err := store.client.Ping(ctx).Err(); err != nil {
+		log.Printf("Warning: redis at %s is unreachable: %v\n", options.Addr, err)
+	}
+	return store, nil
+}
+
+func (s *RedisStore) Get(key string) ([]byte, bool, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	value, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *RedisStore) Set(key string, value []byte, ttl time.Duration) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (s *RedisStore) SetIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.SetNX(ctx, key, value, ttl).Result()
+}
+
+func (s *RedisStore) Delete(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.Del(ctx, keys...).Err()
+}
+
+func (s *RedisStore) Publish(channel string, message string) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.Publish(ctx, channel, message).Err()
+}
+
+func (s *RedisStore) Subscribe(channel string, handle func(message string)) (func(), error) {
+	ctx, cancel := s.context()
+	defer cancel()
+
+	subscription := s.client.Subscribe(context.Background(), channel)
+	// Waiting for the confirmation makes sure no message published after Subscribe returns is
+	// missed. Without it the subscription keeps trying to reconnect in the background.
+	if _, err := subscription.Receive(ctx); err != nil {
+		log.Printf("Warning: failed to subscribe to %s, retrying in the background: %v\n", channel, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for message := range subscription.Channel() {
+			handle(message.Payload)
+		}
+	}()
+	return func() {
+		subscription.Close()
+		<-done
+	}, nil
+}
+
+// Close releases the connections to the server.
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+func (s *RedisStore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
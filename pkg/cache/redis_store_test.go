@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedisStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	store, err := NewRedisStore("redis://"+server.Addr(), time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close() })
+	return store, server
+}
+
+func TestRedisStore_Get_ShouldReturnValueUntilItExpires(t *testing.T) {
+	// GIVEN a value set for a minute
+	store, server := newTestRedisStore(t)
+	require.NoError(t, store.Set("key", []byte("value"), time.Minute))
+
+	// WHEN reading it before and after the minute
+	value, foundBefore, errBefore := store.Get("key")
+	server.FastForward(time.Minute)
+	_, foundAfter, errAfter := store.Get("key")
+
+	// THEN it should only be found before expiring
+	assert.NoError(t, errBefore)
+	assert.True(t, foundBefore)
+	assert.Equal(t, []byte("value"), value)
+	assert.NoError(t, errAfter)
+	assert.False(t, foundAfter)
+}
+
+func TestRedisStore_SetIfAbsent_ShouldKeepExistingValue(t *testing.T) {
+	// GIVEN a value already set
+	store, server := newTestRedisStore(t)
+	require.NoError(t, store.Set("key", []byte("first"), time.Minute))
+
+	// WHEN setting it only if absent, and a key that is not there
+	replaced, err := store.SetIfAbsent("key", []byte("second"), time.Minute)
+	added, addErr := store.SetIfAbsent("other", []byte("value"), time.Minute)
+
+	// THEN only the missing key should be set, with its TTL
+	assert.NoError(t, err)
+	assert.False(t, replaced)
+	value, _ := server.Get("key")
+	assert.Equal(t, "first", value)
+	assert.NoError(t, addErr)
+	assert.True(t, added)
+	assert.Equal(t, time.Minute, server.TTL("other"))
+}
+
+func TestRedisStore_Delete_ShouldRemoveEveryKey(t *testing.T) {
+	// GIVEN two values
+	store, server := newTestRedisStore(t)
+	require.NoError(t, store.Set("a", []byte("1"), time.Minute))
+	require.NoError(t, store.Set("b", []byte("2"), time.Minute))
+
+	// WHEN deleting both, and nothing
+	err := store.Delete("a", "b")
+	noKeysErr := store.Delete()
+
+	// THEN both should be gone
+	assert.NoError(t, err)
+	assert.NoError(t, noKeysErr)
+	assert.False(t, server.Exists("a"))
+	assert.False(t, server.Exists("b"))
+}
+
+func TestRedisStore_Get_WithServerDown_ShouldFail(t *testing.T) {
+	// GIVEN a server that went away
+	store, server := newTestRedisStore(t)
+	server.Close()
+
+	// WHEN reading a value
+	_, found, err := store.Get("key")
+
+	// THEN the failure should be reported
+	assert.Error(t, err)
+	assert.False(t, found)
+}
+
+func TestRedisStore_Subscribe_ShouldReceivePublishedMessages(t *testing.T) {
+	// GIVEN a subscriber
+	store, _ := newTestRedisStore(t)
+	var mu sync.Mutex
+	var received []string
+	stop, err := store.Subscribe("channel", func(message string) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, message)
+	})
+	require.NoError(t, err)
+
+	// WHEN messages are published
+	require.NoError(t, store.Publish("channel", "first"))
+	require.NoError(t, store.Publish("other", "ignored"))
+	require.NoError(t, store.Publish("channel", "second"))
+
+	// THEN the subscriber should receive the ones of its channel, in order
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 2
+	}, time.Second, 10*time.Millisecond)
+	stop()
+	assert.Equal(t, []string{"first", "second"}, received)
+}
+
+func TestNewRedisStore_WithInvalidURL_ShouldFail(t *testing.T) {
+	// WHEN connecting to something that is not a redis URL
+	_, err := NewRedisStore("http://localhost:6379", time.Second)
+
+	// THEN it should fail
+	assert.ErrorContains(t, err, "invalid redis url")
+}
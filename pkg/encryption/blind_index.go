@@ -0,0 +1,28 @@
+package encryption
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// BlindIndex computes deterministic keyed hashes of sensitive values, so they can be looked up
+// by exact match without being stored in plaintext. Without the index key the hashes cannot be
+// reversed by hashing every possible CPF.
+type BlindIndex struct {
+	key []byte
+}
+
+func NewBlindIndex(provider KeyProvider) *BlindIndex {
+	return &BlindIndex{key: provider.IndexKey()}
+}
+
+// Compute returns the index of value within domain, e.g. "cpf". Equal values in different domains
+// get unrelated indexes. Callers normalize values first, since the index only matches exact values.
+func (b *BlindIndex) Compute(domain string, value string) string {
+	mac := hmac.New(sha256.New, b.key)
+	mac.Write([]byte(domain))
+	mac.Write([]byte{0})
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
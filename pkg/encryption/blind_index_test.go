@@ -0,0 +1,26 @@
+package encryption_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+func TestBlindIndex_ShouldBeDeterministicPerDomainAndKey(t *testing.T) {
+	index := encryption.NewBlindIndex(newLocalProvider(t, "key-1"))
+
+	value := index.Compute("customer.cpf", "12345678901")
+
+	assert.Equal(t, value, index.Compute("customer.cpf", "12345678901"))
+	assert.NotEqual(t, value, index.Compute("customer.email", "12345678901"))
+	assert.NotEqual(t, value, index.Compute("customer.cpf", "12345678902"))
+	assert.NotContains(t, value, "12345678901")
+}
+
+func TestBlindIndex_ShouldNotChangeWithMasterKeyRotation(t *testing.T) {
+	before := encryption.NewBlindIndex(newLocalProvider(t, "key-1")).Compute("customer.cpf", "12345678901")
+	after := encryption.NewBlindIndex(newLocalProvider(t, "key-2")).Compute("customer.cpf", "12345678901")
+
+	assert.Equal(t, before, after)
+}
@@ -0,0 +1,73 @@
+package encryption
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/awsauth"
+)
+
+// Key providers selected by PII_KEY_PROVIDER.
+const (
+	// ProviderLocal reads the keys from PII_KEY_FILE.
+	ProviderLocal = "local"
+	// ProviderKMS uses the KMS key PII_KMS_KEY_ID.
+	ProviderKMS = "kms"
+	// ProviderDevelopment uses built-in keys, which are public and only fit local development.
+	ProviderDevelopment = "development"
+)
+
+// DevelopmentKeyID identifies the built-in keys of the development provider.
+const DevelopmentKeyID = "development"
+
+// NewKeyProviderFromEnv reads PII_KEY_PROVIDER (default local). The local provider requires
+// PII_KEY_FILE, so a missing key file fails the startup instead of silently encrypting with public
+// keys; those are only used when the development provider is chosen explicitly. The kms provider
+// needs PII_KMS_KEY_ID and the base64 encrypted index key in PII_KMS_INDEX_KEY; KMS_ENDPOINT
+// points it at a local emulator.
+func NewKeyProviderFromEnv() (KeyProvider, error) {
+	switch provider := os.Getenv("PII_KEY_PROVIDER"); provider {
+	case "", ProviderLocal:
+		path := os.Getenv("PII_KEY_FILE")
+		if path == "" {
+			return nil, fmt.Errorf("PII_KEY_FILE is required by the local key provider; set PII_KEY_PROVIDER=%s to use the built-in development keys", ProviderDevelopment)
+		}
+		return LoadLocalKeyProvider(path)
+	case ProviderDevelopment:
+		log.Println("Warning: encrypting personal data with the built-in development keys, which must never protect real data")
+		return developmentKeyProvider()
+	case ProviderKMS:
+		return newKMSKeyProviderFromEnv()
+	default:
+		return nil, fmt.Errorf("unknown PII_KEY_PROVIDER: %q", provider)
+	}
+}
+
+func newKMSKeyProviderFromEnv() (*KMSKeyProvider, error) {
+	keyID := os.Getenv("PII_KMS_KEY_ID")
+	if keyID == "" {
+		return nil, errors.New("PII_KMS_KEY_ID is required by the kms key provider")
+	}
+	encryptedIndexKey, err := base64.StdEncoding.DecodeString(os.Getenv("PII_KMS_INDEX_KEY"))
+	if err != nil || len(encryptedIndexKey) == 0 {
+		return nil, errors.New("PII_KMS_INDEX_KEY must hold the base64 encrypted index key")
+	}
+	sess, err := awsauth.NewV1Session("KMS", os.Getenv("KMS_ENDPOINT"))
+	if err != nil {
+		return nil, err
+	}
+	return NewKMSKeyProvider(kms.New(sess), keyID, encryptedIndexKey)
+}
+
+// developmentKeyProvider derives fixed keys, so data written in development survives restarts.
+// They are public and must never protect real data.
+func developmentKeyProvider() (*LocalKeyProvider, error) {
+	masterKey := sha256.Sum256([]byte("tc-fiap-customer development master key"))
+	indexKey := sha256.Sum256([]byte("tc-fiap-customer development index key"))
+	return NewLocalKeyProvider(DevelopmentKeyID, map[string][]byte{DevelopmentKeyID: masterKey[:]}, indexKey[:])
+}
@@ -0,0 +1,71 @@
+package encryption_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+func TestNewKeyProviderFromEnv_WithoutKeyFile_ShouldFail(t *testing.T) {
+	// GIVEN no key variables
+	t.Setenv("PII_KEY_PROVIDER", "")
+	t.Setenv("PII_KEY_FILE", "")
+
+	// WHEN building the provider
+	_, err := encryption.NewKeyProviderFromEnv()
+
+	// THEN it should fail instead of falling back to the public development keys
+	assert.ErrorContains(t, err, "PII_KEY_FILE is required")
+}
+
+func TestNewKeyProviderFromEnv_WithDevelopmentProvider_ShouldUseDevelopmentKeys(t *testing.T) {
+	// GIVEN the development keys requested explicitly
+	t.Setenv("PII_KEY_PROVIDER", encryption.ProviderDevelopment)
+
+	// WHEN building the provider twice
+	first, err := encryption.NewKeyProviderFromEnv()
+	assert.NoError(t, err)
+	second, _ := encryption.NewKeyProviderFromEnv()
+
+	// THEN the same development keys should be used, so data survives restarts
+	dataKey, err := first.GenerateDataKey()
+	assert.NoError(t, err)
+	assert.Equal(t, encryption.DevelopmentKeyID, dataKey.KeyID)
+	plaintext, err := second.DecryptDataKey(dataKey.KeyID, dataKey.Encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, dataKey.Plaintext, plaintext)
+}
+
+func TestNewKeyProviderFromEnv_WithKMSWithoutKeyID_ShouldFail(t *testing.T) {
+	t.Setenv("PII_KEY_PROVIDER", encryption.ProviderKMS)
+
+	_, err := encryption.NewKeyProviderFromEnv()
+
+	assert.Error(t, err)
+}
+
+func TestNewKeyProviderFromEnv_WithKMSWithoutIndexKey_ShouldFail(t *testing.T) {
+	t.Setenv("PII_KEY_PROVIDER", encryption.ProviderKMS)
+	t.Setenv("PII_KMS_KEY_ID", "alias/customer-pii")
+
+	_, err := encryption.NewKeyProviderFromEnv()
+
+	assert.Error(t, err)
+}
+
+func TestNewKeyProviderFromEnv_WithUnknownProvider_ShouldFail(t *testing.T) {
+	t.Setenv("PII_KEY_PROVIDER", "vault")
+
+	_, err := encryption.NewKeyProviderFromEnv()
+
+	assert.Error(t, err)
+}
+
+func TestNewKeyProviderFromEnv_WithMissingKeyFile_ShouldFail(t *testing.T) {
+	t.Setenv("PII_KEY_FILE", "/does/not/exist.json")
+
+	_, err := encryption.NewKeyProviderFromEnv()
+
+	assert.Error(t, err)
+}
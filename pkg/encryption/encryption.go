@@ -0,0 +1,35 @@
+// Package encryption protects personal data at rest with envelope encryption: every item is
+// encrypted with its own data key, stored next to it encrypted under a master key, and exact-match
+// lookups use blind indexes (keyed HMACs) instead of the plaintext.
+package encryption
+
+import "errors"
+
+// KeySize is the size in bytes of master, data and index keys (AES-256 and HMAC-SHA256).
+const KeySize = 32
+
+var (
+	ErrUnknownKey = errors.New("unknown master key")
+	ErrInvalidKey = errors.New("keys must be 32 bytes long")
+)
+
+// DataKey is a fresh key for encrypting one item, in plaintext for immediate use and encrypted
+// under the master key KeyID for storage.
+type DataKey struct {
+	KeyID     string
+	Plaintext []byte
+	Encrypted []byte
+}
+
+// KeyProvider holds the master keys. Its methods mirror the data key operations of AWS KMS, so
+// a KMS compatible service can back it in production.
+type KeyProvider interface {
+	// GenerateDataKey returns a new data key encrypted under the current master key.
+	GenerateDataKey() (*DataKey, error)
+	// DecryptDataKey returns the plaintext of a data key encrypted under the master key keyID,
+	// which may be an older key than the current one.
+	DecryptDataKey(keyID string, encrypted []byte) ([]byte, error)
+	// IndexKey returns the HMAC key of the blind indexes. Unlike master keys it cannot rotate
+	// without recomputing every stored index.
+	IndexKey() []byte
+}
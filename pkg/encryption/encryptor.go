@@ -0,0 +1,110 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMalformedCiphertext = errors.New("malformed ciphertext")
+)
+
+var randRead = rand.Read
+
+// Encryptor hands out the ciphers used to encrypt and decrypt the fields of one item.
+type Encryptor struct {
+	provider KeyProvider
+}
+
+func NewEncryptor(provider KeyProvider) *Encryptor {
+	return &Encryptor{provider: provider}
+}
+
+// NewItemCipher generates the data key of a new version of an item. KeyID and EncryptedKey must be
+// stored with the item so it can be decrypted later.
+func (e *Encryptor) NewItemCipher() (*ItemCipher, error) {
+	dataKey, err := e.provider.GenerateDataKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return &ItemCipher{KeyID: dataKey.KeyID, EncryptedKey: dataKey.Encrypted, aead: aead}, nil
+}
+
+// OpenItemCipher decrypts the data key stored with an item.
+func (e *Encryptor) OpenItemCipher(keyID string, encryptedKey []byte) (*ItemCipher, error) {
+	plaintext, err := e.provider.DecryptDataKey(keyID, encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+	aead, err := newAEAD(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	return &ItemCipher{KeyID: keyID, EncryptedKey: encryptedKey, aead: aead}, nil
+}
+
+// ItemCipher encrypts the fields of one item with AES-256-GCM under the item data key.
+type ItemCipher struct {
+	KeyID        string
+	EncryptedKey []byte
+	aead         cipher.AEAD
+}
+
+// Encrypt returns the nonce followed by the sealed value. The associated data, e.g. the item ID
+// and field name, is authenticated but not stored: a ciphertext copied to another item or field
+// no longer decrypts. Empty values stay empty.
+func (c *ItemCipher) Encrypt(plaintext string, associatedData string) ([]byte, error) {
+	if plaintext == "" {
+		return nil, nil
+	}
+	return seal(c.aead, []byte(plaintext), []byte(associatedData))
+}
+
+func (c *ItemCipher) Decrypt(ciphertext []byte, associatedData string) (string, error) {
+	if len(ciphertext) == 0 {
+		return "", nil
+	}
+	plaintext, err := open(c.aead, ciphertext, []byte(associatedData))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := randRead(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte, associatedData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrMalformedCiphertext
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, associatedData)
+	if err != nil {
+		return nil, ErrMalformedCiphertext
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,74 @@
+package encryption_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+func TestEncryptor_ShouldDecryptWithStoredDataKey(t *testing.T) {
+	// GIVEN a field encrypted under a new data key
+	encryptor := encryption.NewEncryptor(newLocalProvider(t, "key-1"))
+	itemCipher, err := encryptor.NewItemCipher()
+	require.NoError(t, err)
+	ciphertext, err := itemCipher.Encrypt("Jane Doe", "customer/1/name")
+	require.NoError(t, err)
+
+	// WHEN opening the stored data key and decrypting
+	opened, err := encryptor.OpenItemCipher(itemCipher.KeyID, itemCipher.EncryptedKey)
+	require.NoError(t, err)
+	plaintext, err := opened.Decrypt(ciphertext, "customer/1/name")
+
+	// THEN the original value should be returned
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", plaintext)
+	assert.NotContains(t, string(ciphertext), "Jane Doe")
+}
+
+func TestEncryptor_WithOtherAssociatedData_ShouldFailToDecrypt(t *testing.T) {
+	// GIVEN a field encrypted for customer 1
+	itemCipher, err := encryption.NewEncryptor(newLocalProvider(t, "key-1")).NewItemCipher()
+	require.NoError(t, err)
+	ciphertext, err := itemCipher.Encrypt("Jane Doe", "customer/1/name")
+	require.NoError(t, err)
+
+	// WHEN decrypting it as the field of customer 2
+	_, err = itemCipher.Decrypt(ciphertext, "customer/2/name")
+
+	// THEN decryption should fail
+	assert.ErrorIs(t, err, encryption.ErrMalformedCiphertext)
+}
+
+func TestEncryptor_ShouldUseFreshNonces(t *testing.T) {
+	itemCipher, err := encryption.NewEncryptor(newLocalProvider(t, "key-1")).NewItemCipher()
+	require.NoError(t, err)
+
+	first, _ := itemCipher.Encrypt("Jane Doe", "customer/1/name")
+	second, _ := itemCipher.Encrypt("Jane Doe", "customer/1/name")
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestEncryptor_WithEmptyValue_ShouldStayEmpty(t *testing.T) {
+	itemCipher, err := encryption.NewEncryptor(newLocalProvider(t, "key-1")).NewItemCipher()
+	require.NoError(t, err)
+
+	ciphertext, err := itemCipher.Encrypt("", "customer/1/email")
+	assert.NoError(t, err)
+	assert.Nil(t, ciphertext)
+
+	plaintext, err := itemCipher.Decrypt(nil, "customer/1/email")
+	assert.NoError(t, err)
+	assert.Empty(t, plaintext)
+}
+
+func TestEncryptor_WithTruncatedCiphertext_ShouldReturnError(t *testing.T) {
+	itemCipher, err := encryption.NewEncryptor(newLocalProvider(t, "key-1")).NewItemCipher()
+	require.NoError(t, err)
+
+	_, err = itemCipher.Decrypt([]byte("short"), "customer/1/name")
+
+	assert.ErrorIs(t, err, encryption.ErrMalformedCiphertext)
+}
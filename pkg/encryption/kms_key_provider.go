@@ -0,0 +1,70 @@
+package encryption
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+var (
+	_ KeyProvider = (*KMSKeyProvider)(nil)
+)
+
+// KMSKeyProvider keeps the master key in AWS KMS or a compatible service such as LocalStack.
+// Master keys never leave the service; only data keys are generated and decrypted through it.
+type KMSKeyProvider struct {
+	client   kmsiface.KMSAPI
+	keyID    string
+	indexKey []byte
+}
+
+// NewKMSKeyProvider decrypts the index key once, since it is needed on every lookup. The index
+// key is itself a data key of the master key, e.g. the CiphertextBlob of
+// "aws kms generate-data-key --key-spec AES_256".
+func NewKMSKeyProvider(client kmsiface.KMSAPI, keyID string, encryptedIndexKey []byte) (*KMSKeyProvider, error) {
+	output, err := client.Decrypt(&kms.DecryptInput{
+		KeyId:          aws.String(keyID),
+		CiphertextBlob: encryptedIndexKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt index key: %w", err)
+	}
+	if len(output.Plaintext) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	return &KMSKeyProvider{client: client, keyID: keyID, indexKey: output.Plaintext}, nil
+}
+
+// GenerateDataKey returns the ARN of the master key as the key ID, so items keep pointing at the
+// right key after the alias in keyID is moved to a new one.
+func (p *KMSKeyProvider) GenerateDataKey() (*DataKey, error) {
+	output, err := p.client.GenerateDataKey(&kms.GenerateDataKeyInput{
+		KeyId:   aws.String(p.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{
+		KeyID:     aws.StringValue(output.KeyId),
+		Plaintext: output.Plaintext,
+		Encrypted: output.CiphertextBlob,
+	}, nil
+}
+
+func (p *KMSKeyProvider) DecryptDataKey(keyID string, encrypted []byte) ([]byte, error) {
+	output, err := p.client.Decrypt(&kms.DecryptInput{
+		KeyId:          aws.String(keyID),
+		CiphertextBlob: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Plaintext, nil
+}
+
+func (p *KMSKeyProvider) IndexKey() []byte {
+	return p.indexKey
+}
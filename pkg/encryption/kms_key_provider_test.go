@@ -0,0 +1,93 @@
+package encryption_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+type MockKMSClient struct {
+	mock.Mock
+	kmsiface.KMSAPI
+}
+
+func (m *MockKMSClient) GenerateDataKey(input *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*kms.GenerateDataKeyOutput)
+	return output, args.Error(1)
+}
+
+func (m *MockKMSClient) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*kms.DecryptOutput)
+	return output, args.Error(1)
+}
+
+func newKMSProvider(t *testing.T, client *MockKMSClient) *encryption.KMSKeyProvider {
+	client.On("Decrypt", mock.MatchedBy(func(input *kms.DecryptInput) bool {
+		return string(input.CiphertextBlob) == "encrypted-index-key"
+	})).Return(&kms.DecryptOutput{Plaintext: indexKey}, nil).Once()
+	provider, err := encryption.NewKMSKeyProvider(client, "alias/customer-pii", []byte("encrypted-index-key"))
+	require.NoError(t, err)
+	return provider
+}
+
+func TestKMSKeyProvider_ShouldDecryptIndexKeyOnce(t *testing.T) {
+	client := &MockKMSClient{}
+
+	provider := newKMSProvider(t, client)
+
+	assert.Equal(t, indexKey, provider.IndexKey())
+	assert.Equal(t, indexKey, provider.IndexKey())
+	client.AssertExpectations(t)
+}
+
+func TestKMSKeyProvider_ShouldStoreKeyARNOfGeneratedDataKeys(t *testing.T) {
+	// GIVEN a master key addressed by alias
+	client := &MockKMSClient{}
+	provider := newKMSProvider(t, client)
+	client.On("GenerateDataKey", mock.MatchedBy(func(input *kms.GenerateDataKeyInput) bool {
+		return aws.StringValue(input.KeyId) == "alias/customer-pii" && aws.StringValue(input.KeySpec) == kms.DataKeySpecAes256
+	})).Return(&kms.GenerateDataKeyOutput{
+		KeyId:          aws.String("arn:aws:kms:us-east-1:000000000000:key/1"),
+		Plaintext:      masterKey,
+		CiphertextBlob: []byte("encrypted-data-key"),
+	}, nil).Once()
+
+	// WHEN generating a data key
+	dataKey, err := provider.GenerateDataKey()
+
+	// THEN the ARN of the key behind the alias should be kept
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:kms:us-east-1:000000000000:key/1", dataKey.KeyID)
+	assert.Equal(t, []byte("encrypted-data-key"), dataKey.Encrypted)
+}
+
+func TestKMSKeyProvider_ShouldDecryptDataKeysWithStoredKeyID(t *testing.T) {
+	client := &MockKMSClient{}
+	provider := newKMSProvider(t, client)
+	client.On("Decrypt", mock.MatchedBy(func(input *kms.DecryptInput) bool {
+		return aws.StringValue(input.KeyId) == "arn:aws:kms:us-east-1:000000000000:key/1"
+	})).Return(&kms.DecryptOutput{Plaintext: masterKey}, nil).Once()
+
+	plaintext, err := provider.DecryptDataKey("arn:aws:kms:us-east-1:000000000000:key/1", []byte("encrypted-data-key"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, masterKey, plaintext)
+}
+
+func TestNewKMSKeyProvider_WithUndecryptableIndexKey_ShouldReturnError(t *testing.T) {
+	client := &MockKMSClient{}
+	client.On("Decrypt", mock.Anything).Return(nil, errors.New("AccessDeniedException")).Once()
+
+	_, err := encryption.NewKMSKeyProvider(client, "alias/customer-pii", []byte("encrypted-index-key"))
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+var (
+	_ KeyProvider = (*LocalKeyProvider)(nil)
+)
+
+// KeyFile is the JSON document read by LoadLocalKeyProvider. Keys are base64 encoded. Rotating
+// means adding a master key and pointing current_key_id to it; old keys must stay in the file
+// for as long as items encrypted under them exist.
+type KeyFile struct {
+	CurrentKeyID string            `json:"current_key_id"`
+	MasterKeys   map[string]string `json:"master_keys"`
+	IndexKey     string            `json:"index_key"`
+}
+
+// LocalKeyProvider keeps the master keys in memory and wraps data keys with AES-256-GCM. It is
+// meant for development and tests; production uses a KMS.
+type LocalKeyProvider struct {
+	currentKeyID string
+	masterKeys   map[string]cipher.AEAD
+	indexKey     []byte
+}
+
+func NewLocalKeyProvider(currentKeyID string, masterKeys map[string][]byte, indexKey []byte) (*LocalKeyProvider, error) {
+	if len(indexKey) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	if _, found := masterKeys[currentKeyID]; !found {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, currentKeyID)
+	}
+
+	provider := &LocalKeyProvider{
+		currentKeyID: currentKeyID,
+		masterKeys:   make(map[string]cipher.AEAD, len(masterKeys)),
+		indexKey:     indexKey,
+	}
+	for keyID, key := range masterKeys {
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("master key %q: %w", keyID, err)
+		}
+		provider.masterKeys[keyID] = aead
+	}
+	return provider, nil
+}
+
+// LoadLocalKeyProvider reads the keys from a KeyFile.
+func LoadLocalKeyProvider(path string) (*LocalKeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	var file KeyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+	return newLocalKeyProviderFromFile(&file)
+}
+
+func newLocalKeyProviderFromFile(file *KeyFile) (*LocalKeyProvider, error) {
+	masterKeys := make(map[string][]byte, len(file.MasterKeys))
+	for keyID, encoded := range file.MasterKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("master key %q is not base64: %w", keyID, err)
+		}
+		masterKeys[keyID] = key
+	}
+	indexKey, err := base64.StdEncoding.DecodeString(file.IndexKey)
+	if err != nil {
+		return nil, fmt.Errorf("index key is not base64: %w", err)
+	}
+	return NewLocalKeyProvider(file.CurrentKeyID, masterKeys, indexKey)
+}
+
+// GenerateDataKey wraps the data key with the master key, using the key ID as associated data.
+func (p *LocalKeyProvider) GenerateDataKey() (*DataKey, error) {
+	plaintext := make([]byte, KeySize)
+	if _, err := randRead(plaintext); err != nil {
+		return nil, err
+	}
+	encrypted, err := seal(p.masterKeys[p.currentKeyID], plaintext, []byte(p.currentKeyID))
+	if err != nil {
+		return nil, err
+	}
+	return &DataKey{KeyID: p.currentKeyID, Plaintext: plaintext, Encrypted: encrypted}, nil
+}
+
+func (p *LocalKeyProvider) DecryptDataKey(keyID string, encrypted []byte) ([]byte, error) {
+	masterKey, found := p.masterKeys[keyID]
+	if !found {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+	return open(masterKey, encrypted, []byte(keyID))
+}
+
+func (p *LocalKeyProvider) IndexKey() []byte {
+	return p.indexKey
+}
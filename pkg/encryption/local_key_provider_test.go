@@ -0,0 +1,111 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/encryption"
+)
+
+var (
+	masterKey = []byte(strings.Repeat("m", encryption.KeySize))
+	otherKey  = []byte(strings.Repeat("o", encryption.KeySize))
+	indexKey  = []byte(strings.Repeat("i", encryption.KeySize))
+)
+
+func newLocalProvider(t *testing.T, currentKeyID string) *encryption.LocalKeyProvider {
+	provider, err := encryption.NewLocalKeyProvider(currentKeyID, map[string][]byte{"key-1": masterKey, "key-2": otherKey}, indexKey)
+	require.NoError(t, err)
+	return provider
+}
+
+func TestLocalKeyProvider_ShouldDecryptDataKeysOfEveryMasterKey(t *testing.T) {
+	// GIVEN a data key generated before the master key was rotated
+	dataKey, err := newLocalProvider(t, "key-1").GenerateDataKey()
+	require.NoError(t, err)
+
+	// WHEN decrypting it once key-2 is current
+	plaintext, err := newLocalProvider(t, "key-2").DecryptDataKey(dataKey.KeyID, dataKey.Encrypted)
+
+	// THEN the original data key should be returned
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", dataKey.KeyID)
+	assert.Equal(t, dataKey.Plaintext, plaintext)
+	assert.Len(t, plaintext, encryption.KeySize)
+}
+
+func TestLocalKeyProvider_WithUnknownKeyID_ShouldReturnError(t *testing.T) {
+	// GIVEN a data key of a master key missing from the provider
+	dataKey, err := newLocalProvider(t, "key-1").GenerateDataKey()
+	require.NoError(t, err)
+
+	// WHEN decrypting it
+	_, err = newLocalProvider(t, "key-1").DecryptDataKey("key-3", dataKey.Encrypted)
+
+	// THEN the key should be reported unknown
+	assert.ErrorIs(t, err, encryption.ErrUnknownKey)
+}
+
+func TestLocalKeyProvider_WithDataKeyClaimingAnotherMasterKey_ShouldReturnError(t *testing.T) {
+	// GIVEN a data key of key-1 stored as if it belonged to key-2
+	dataKey, err := newLocalProvider(t, "key-1").GenerateDataKey()
+	require.NoError(t, err)
+
+	// WHEN decrypting it with key-2
+	_, err = newLocalProvider(t, "key-1").DecryptDataKey("key-2", dataKey.Encrypted)
+
+	// THEN decryption should fail
+	assert.ErrorIs(t, err, encryption.ErrMalformedCiphertext)
+}
+
+func TestNewLocalKeyProvider_WithInvalidKeys_ShouldReturnError(t *testing.T) {
+	_, err := encryption.NewLocalKeyProvider("key-1", map[string][]byte{"key-1": []byte("short")}, indexKey)
+	assert.ErrorIs(t, err, encryption.ErrInvalidKey)
+
+	_, err = encryption.NewLocalKeyProvider("key-1", map[string][]byte{"key-1": masterKey}, []byte("short"))
+	assert.ErrorIs(t, err, encryption.ErrInvalidKey)
+
+	_, err = encryption.NewLocalKeyProvider("key-2", map[string][]byte{"key-1": masterKey}, indexKey)
+	assert.ErrorIs(t, err, encryption.ErrUnknownKey)
+}
+
+func TestLoadLocalKeyProvider_ShouldReadKeyFile(t *testing.T) {
+	// GIVEN a key file
+	path := filepath.Join(t.TempDir(), "keys.json")
+	document := `{"current_key_id":"key-1","master_keys":{"key-1":"` + base64.StdEncoding.EncodeToString(masterKey) +
+		`"},"index_key":"` + base64.StdEncoding.EncodeToString(indexKey) + `"}`
+	require.NoError(t, os.WriteFile(path, []byte(document), 0o600))
+
+	// WHEN loading it
+	provider, err := encryption.LoadLocalKeyProvider(path)
+
+	// THEN its keys should be used
+	require.NoError(t, err)
+	assert.Equal(t, indexKey, provider.IndexKey())
+	dataKey, err := provider.GenerateDataKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", dataKey.KeyID)
+}
+
+func TestLoadLocalKeyProvider_WithInvalidFile_ShouldReturnError(t *testing.T) {
+	documents := map[string]string{
+		"not json":          `{`,
+		"bad master key":    `{"current_key_id":"key-1","master_keys":{"key-1":"%%%"},"index_key":""}`,
+		"missing index key": `{"current_key_id":"key-1","master_keys":{"key-1":"` + base64.StdEncoding.EncodeToString(masterKey) + `"}}`,
+	}
+	for name, document := range documents {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys.json")
+			require.NoError(t, os.WriteFile(path, []byte(document), 0o600))
+
+			_, err := encryption.LoadLocalKeyProvider(path)
+
+			assert.Error(t, err)
+		})
+	}
+}
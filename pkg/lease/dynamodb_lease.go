@@ -0,0 +1,77 @@
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+var (
+	_ Lease = (*DynamoDBLease)(nil)
+)
+
+// Lease lets a single replica at a time run a periodic task.
+type Lease interface {
+	// Acquire takes the lease for ttl when it is free, expired or already held by holder, and
+	// tells whether holder got it.
+	Acquire(ctx context.Context, holder string, ttl time.Duration) (bool, error)
+}
+
+// DynamoDBAPI is the part of the DynamoDB API the lease uses.
+type DynamoDBAPI interface {
+	UpdateItem(ctx context.Context, input *dynamodb.UpdateItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error)
+}
+
+// DynamoDBLease keeps the holder and the expiry of the lease in a single item, taken with a
+// conditional update so two replicas never hold it at the same time.
+type DynamoDBLease struct {
+	db        DynamoDBAPI
+	tableName string
+	key       map[string]types.AttributeValue
+	now       func() time.Time
+}
+
+// NewDynamoDBLease keeps the lease in the item of tableName identified by key.
+func NewDynamoDBLease(db DynamoDBAPI, tableName string, key map[string]types.AttributeValue) *DynamoDBLease {
+	return &DynamoDBLease{db: db, tableName: tableName, key: key, now: time.Now}
+}
+
+func (l *DynamoDBLease) Acquire(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	now := l.now()
+	expiresAt := expression.Name("lease_expires_at")
+	heldBy := expression.Name("lease_holder")
+
+	free := expression.AttributeNotExists(expiresAt).
+		Or(expiresAt.LessThanEqual(expression.Value(now.UnixMilli()))).
+		Or(heldBy.Equal(expression.Value(holder)))
+	update := expression.Set(heldBy, expression.Value(holder)).
+		Set(expiresAt, expression.Value(now.Add(ttl).UnixMilli()))
+
+	expr, err := expression.NewBuilder().WithCondition(free).WithUpdate(update).Build()
+	if err != nil {
+		return false, fmt.Errorf("failed to build lease update: %w", err)
+	}
+
+	_, err = l.db.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(l.tableName),
+		Key:                       l.key,
+		ConditionExpression:       expr.Condition(),
+		UpdateExpression:          expr.Update(),
+		ExpressionAttributeNames:  expr.Names(),
+		ExpressionAttributeValues: expr.Values(),
+	})
+	var conditionFailed *types.ConditionalCheckFailedException
+	switch {
+	case errors.As(err, &conditionFailed):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+	return true, nil
+}
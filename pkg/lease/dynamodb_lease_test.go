@@ -0,0 +1,73 @@
+package lease
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDynamoDB struct {
+	err   error
+	input *dynamodb.UpdateItemInput
+}
+
+func (f *fakeDynamoDB) UpdateItem(ctx context.Context, input *dynamodb.UpdateItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
+	f.input = input
+	return &dynamodb.UpdateItemOutput{}, f.err
+}
+
+var key = map[string]types.AttributeValue{"id": &types.AttributeValueMemberS{Value: "LEASE#job"}}
+
+func TestDynamoDBLease_Acquire_ShouldTakeTheLeaseWhenFreeExpiredOrHeld(t *testing.T) {
+	// GIVEN a lease kept in DynamoDB
+	db := &fakeDynamoDB{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	lease := NewDynamoDBLease(db, "jobs", key)
+	lease.now = func() time.Time { return now }
+
+	// WHEN acquiring it for an hour
+	acquired, err := lease.Acquire(context.Background(), "pod-1", time.Hour)
+
+	// THEN the holder and the expiry should be written under the condition that nobody else holds it
+	require.NoError(t, err)
+	assert.True(t, acquired)
+	assert.Equal(t, "jobs", aws.ToString(db.input.TableName))
+	assert.Equal(t, key, db.input.Key)
+	assert.Equal(t, "((attribute_not_exists (#0)) OR (#0 <= :0)) OR (#1 = :1)", aws.ToString(db.input.ConditionExpression))
+	assert.Equal(t, map[string]string{"#0": "lease_expires_at", "#1": "lease_holder"}, db.input.ExpressionAttributeNames)
+	assert.Equal(t, &types.AttributeValueMemberN{Value: "1767268800000"}, db.input.ExpressionAttributeValues[":0"])
+	assert.Equal(t, &types.AttributeValueMemberS{Value: "pod-1"}, db.input.ExpressionAttributeValues[":1"])
+	assert.Equal(t, "SET #1 = :2, #0 = :3\n", aws.ToString(db.input.UpdateExpression))
+	assert.Equal(t, &types.AttributeValueMemberN{Value: "1767272400000"}, db.input.ExpressionAttributeValues[":3"])
+}
+
+func TestDynamoDBLease_Acquire_WhenHeldByAnotherReplica_ShouldNotTakeIt(t *testing.T) {
+	// GIVEN a lease held by another replica
+	db := &fakeDynamoDB{err: &types.ConditionalCheckFailedException{Message: aws.String("The conditional request failed")}}
+
+	// WHEN acquiring it
+	acquired, err := NewDynamoDBLease(db, "jobs", key).Acquire(context.Background(), "pod-2", time.Hour)
+
+	// THEN it should not be taken, without failing
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+}
+
+func TestDynamoDBLease_Acquire_WhenDynamoDBFails_ShouldFail(t *testing.T) {
+	// GIVEN DynamoDB failing
+	db := &fakeDynamoDB{err: errors.New("throttled")}
+
+	// WHEN acquiring the lease
+	acquired, err := NewDynamoDBLease(db, "jobs", key).Acquire(context.Background(), "pod-1", time.Hour)
+
+	// THEN it should fail
+	assert.EqualError(t, err, "failed to acquire lease: throttled")
+	assert.False(t, acquired)
+}
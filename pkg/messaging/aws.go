@@ -0,0 +1,29 @@
+package messaging
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/awsauth"
+)
+
+// NewSNSClient creates an SNS client. SNS_ENDPOINT points it at a local emulator such as LocalStack.
+func NewSNSClient() (snsiface.SNSAPI, error) {
+	sess, err := awsauth.NewV1Session("SNS", os.Getenv("SNS_ENDPOINT"))
+	if err != nil {
+		return nil, err
+	}
+	return sns.New(sess), nil
+}
+
+// NewSQSClient creates an SQS client. SQS_ENDPOINT points it at a local emulator such as LocalStack or ElasticMQ.
+func NewSQSClient() (sqsiface.SQSAPI, error) {
+	sess, err := awsauth.NewV1Session("SQS", os.Getenv("SQS_ENDPOINT"))
+	if err != nil {
+		return nil, err
+	}
+	return sqs.New(sess), nil
+}
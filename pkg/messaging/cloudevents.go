@@ -0,0 +1,91 @@
+package messaging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the content type of a structured mode CloudEvent.
+	CloudEventsContentType = "application/cloudevents+json"
+)
+
+var (
+	ErrInvalidEnvelope = errors.New("invalid cloudevents envelope")
+)
+
+// CloudEvent is the JSON structured mode envelope of the CloudEvents 1.0 specification.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            *time.Time      `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+}
+
+// NewCloudEvent wraps a message, using source when the message does not carry one.
+func NewCloudEvent(source string, message Message) CloudEvent {
+	event := CloudEvent{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          message.ID,
+		Source:      message.Source,
+		Type:        message.Type,
+		Subject:     message.Subject,
+	}
+	if event.Source == "" {
+		event.Source = source
+	}
+	if !message.Time.IsZero() {
+		occurredAt := message.Time.UTC()
+		event.Time = &occurredAt
+	}
+	if len(message.Data) > 0 {
+		event.DataContentType = "application/json"
+		event.Data = json.RawMessage(message.Data)
+	}
+	return event
+}
+
+func (e CloudEvent) Message() Message {
+	message := Message{
+		ID:      e.ID,
+		Type:    e.Type,
+		Source:  e.Source,
+		Subject: e.Subject,
+		Data:    []byte(e.Data),
+	}
+	if e.Time != nil {
+		message.Time = *e.Time
+	}
+	return message
+}
+
+// EncodeCloudEvent serializes a message as a structured mode CloudEvent.
+func EncodeCloudEvent(source string, message Message) ([]byte, error) {
+	body, err := json.Marshal(NewCloudEvent(source, message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode cloudevent: %w", err)
+	}
+	return body, nil
+}
+
+// DecodeCloudEvent parses a structured mode CloudEvent and checks its required attributes.
+func DecodeCloudEvent(body []byte) (Message, error) {
+	var event CloudEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return Message{}, fmt.Errorf("%w: %v", ErrInvalidEnvelope, err)
+	}
+	if event.SpecVersion != CloudEventsSpecVersion {
+		return Message{}, fmt.Errorf("%w: unsupported specversion %q", ErrInvalidEnvelope, event.SpecVersion)
+	}
+	if event.ID == "" || event.Source == "" || event.Type == "" {
+		return Message{}, fmt.Errorf("%w: id, source and type are required", ErrInvalidEnvelope)
+	}
+	return event.Message(), nil
+}
@@ -0,0 +1,63 @@
+package messaging_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+func TestEncodeCloudEvent_ShouldProduceStructuredEnvelope(t *testing.T) {
+	// GIVEN a message without a source
+	occurredAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	message := messaging.Message{ID: "evt-1", Type: "customer.registered", Subject: "customer-1", Time: occurredAt, Data: []byte(`{"customer_id":"customer-1"}`)}
+
+	// WHEN encoding it
+	body, err := messaging.EncodeCloudEvent(messaging.DefaultSource, message)
+
+	// THEN the required CloudEvents 1.0 attributes should be present
+	assert.NoError(t, err)
+	var envelope map[string]any
+	assert.NoError(t, json.Unmarshal(body, &envelope))
+	assert.Equal(t, "1.0", envelope["specversion"])
+	assert.Equal(t, "evt-1", envelope["id"])
+	assert.Equal(t, messaging.DefaultSource, envelope["source"])
+	assert.Equal(t, "customer.registered", envelope["type"])
+	assert.Equal(t, "customer-1", envelope["subject"])
+	assert.Equal(t, "2025-01-01T12:00:00Z", envelope["time"])
+	assert.Equal(t, "application/json", envelope["datacontenttype"])
+	// AND the payload should be embedded as JSON, not as a string
+	assert.Equal(t, map[string]any{"customer_id": "customer-1"}, envelope["data"])
+}
+
+func TestDecodeCloudEvent_ShouldRoundTrip(t *testing.T) {
+	// GIVEN an encoded message from another service
+	message := messaging.Message{ID: "evt-1", Type: "order.created", Source: "/tc-fiap-order", Subject: "order-1", Time: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), Data: []byte(`{"total":10}`)}
+	body, _ := messaging.EncodeCloudEvent(messaging.DefaultSource, message)
+
+	// WHEN decoding it
+	decoded, err := messaging.DecodeCloudEvent(body)
+
+	// THEN the original message should come back, keeping its own source
+	assert.NoError(t, err)
+	assert.Equal(t, message, decoded)
+}
+
+func TestDecodeCloudEvent_WithInvalidEnvelope_ShouldFail(t *testing.T) {
+	cases := map[string]string{
+		"not json":          `hello`,
+		"wrong specversion": `{"specversion":"0.3","id":"1","source":"/x","type":"t"}`,
+		"missing type":      `{"specversion":"1.0","id":"1","source":"/x"}`,
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN decoding an invalid envelope
+			_, err := messaging.DecodeCloudEvent([]byte(body))
+
+			// THEN it should be rejected
+			assert.ErrorIs(t, err, messaging.ErrInvalidEnvelope)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package messaging
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+)
+
+// Messaging drivers selected by MESSAGING_DRIVER.
+const (
+	// DriverLog writes published messages to the log; consumers stay idle.
+	DriverLog = "log"
+	// DriverMemory routes messages through an in-process MemoryBroker.
+	DriverMemory = "memory"
+	// DriverAWS publishes to SNS and consumes from SQS.
+	DriverAWS = "aws"
+)
+
+// Config selects and configures the messaging adapters.
+type Config struct {
+	Driver string
+	// Source is the CloudEvents source of published events.
+	Source string
+	// TopicARN is the SNS topic receiving the events of this service.
+	TopicARN string
+	// MaxReceives is how many times a message is attempted before it is dead-lettered.
+	MaxReceives int
+}
+
+// QueueConfig identifies the queue a consumer reads from.
+type QueueConfig struct {
+	// Name identifies the queue on the memory broker.
+	Name               string
+	URL                string
+	DeadLetterQueueURL string
+}
+
+// ConfigFromEnv reads MESSAGING_DRIVER, MESSAGING_SOURCE, MESSAGING_TOPIC_ARN and
+// MESSAGING_MAX_RECEIVES. The driver is required, so a missing one fails the startup instead of
+// the outbox relay writing every event to the log and dropping it; the log driver is only used
+// when chosen explicitly.
+func ConfigFromEnv() (Config, error) {
+	config := Config{
+		Driver:      os.Getenv("MESSAGING_DRIVER"),
+		Source:      os.Getenv("MESSAGING_SOURCE"),
+		TopicARN:    os.Getenv("MESSAGING_TOPIC_ARN"),
+		MaxReceives: DefaultMaxReceives,
+	}
+	if config.Driver == "" {
+		return Config{}, fmt.Errorf("MESSAGING_DRIVER is required; set MESSAGING_DRIVER=%s to write the events to the log in local development", DriverLog)
+	}
+	if config.Source == "" {
+		config.Source = DefaultSource
+	}
+	if value := os.Getenv("MESSAGING_MAX_RECEIVES"); value != "" {
+		maxReceives, err := strconv.Atoi(value)
+		if err != nil || maxReceives <= 0 {
+			return Config{}, fmt.Errorf("invalid MESSAGING_MAX_RECEIVES: %q", value)
+		}
+		config.MaxReceives = maxReceives
+	}
+
+	switch config.Driver {
+	case DriverLog, DriverMemory:
+	case DriverAWS:
+		if config.TopicARN == "" {
+			return Config{}, errors.New("MESSAGING_TOPIC_ARN is required by the aws messaging driver")
+		}
+	default:
+		return Config{}, fmt.Errorf("unknown MESSAGING_DRIVER %q", config.Driver)
+	}
+	return config, nil
+}
+
+// QueueConfigFromEnv reads <prefix>_QUEUE_URL and <prefix>_DLQ_URL. The URL is
+// required by the aws driver only.
+func QueueConfigFromEnv(config Config, name string, prefix string) (QueueConfig, error) {
+	queue := QueueConfig{
+		Name:               name,
+		URL:                os.Getenv(prefix + "_QUEUE_URL"),
+		DeadLetterQueueURL: os.Getenv(prefix + "_DLQ_URL"),
+	}
+	if config.Driver == DriverAWS && queue.URL == "" {
+		return QueueConfig{}, fmt.Errorf("%s_QUEUE_URL is required by the aws messaging driver", prefix)
+	}
+	return queue, nil
+}
+
+// NewPublisher builds the publisher of the configured driver. The broker backs the memory driver.
+func NewPublisher(config Config, broker *MemoryBroker) (Publisher, error) {
+	switch config.Driver {
+	case DriverMemory:
+		return broker, nil
+	case DriverAWS:
+		client, err := NewSNSClient()
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Publishing events to SNS topic %s", config.TopicARN)
+		return NewSNSPublisher(client, config.TopicARN, config.Source), nil
+	default:
+		return NewLogPublisher(), nil
+	}
+}
+
+// NewConsumer builds a consumer of the configured driver for the given queue.
+func NewConsumer(config Config, broker *MemoryBroker, queue QueueConfig) (Consumer, error) {
+	if config.Driver != DriverAWS {
+		return broker.Consumer(queue.Name), nil
+	}
+	client, err := NewSQSClient()
+	if err != nil {
+		return nil, err
+	}
+	return NewSQSConsumer(client, SQSConsumerConfig{
+		QueueURL:           queue.URL,
+		DeadLetterQueueURL: queue.DeadLetterQueueURL,
+		MaxReceives:        config.MaxReceives,
+	}), nil
+}
+
+// NewMemoryBrokerFromConfig builds the broker backing the memory driver.
+func NewMemoryBrokerFromConfig(config Config) *MemoryBroker {
+	return NewMemoryBroker(config.MaxReceives)
+}
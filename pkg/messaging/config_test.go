@@ -0,0 +1,68 @@
+package messaging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+func TestConfigFromEnv_WithoutDriver_ShouldFail(t *testing.T) {
+	// GIVEN no messaging variables
+	t.Setenv("MESSAGING_DRIVER", "")
+
+	// WHEN reading the config
+	_, err := messaging.ConfigFromEnv()
+
+	// THEN the startup should fail instead of dropping the events in the log
+	assert.ErrorContains(t, err, "MESSAGING_DRIVER is required")
+}
+
+func TestConfigFromEnv_WithLogDriver_ShouldUseDefaults(t *testing.T) {
+	// GIVEN the log driver chosen explicitly
+	t.Setenv("MESSAGING_DRIVER", messaging.DriverLog)
+
+	// WHEN reading the config
+	config, err := messaging.ConfigFromEnv()
+
+	// THEN the log driver and defaults should be used
+	assert.NoError(t, err)
+	assert.Equal(t, messaging.DriverLog, config.Driver)
+	assert.Equal(t, messaging.DefaultSource, config.Source)
+	assert.Equal(t, messaging.DefaultMaxReceives, config.MaxReceives)
+}
+
+func TestConfigFromEnv_WithAWSDriverWithoutTopic_ShouldFail(t *testing.T) {
+	// GIVEN the aws driver without a topic
+	t.Setenv("MESSAGING_DRIVER", messaging.DriverAWS)
+
+	// WHEN reading the config
+	_, err := messaging.ConfigFromEnv()
+
+	// THEN the missing topic should be reported
+	assert.Error(t, err)
+}
+
+func TestConfigFromEnv_WithUnknownDriver_ShouldFail(t *testing.T) {
+	// GIVEN an unknown driver
+	t.Setenv("MESSAGING_DRIVER", "kafka")
+
+	// WHEN reading the config
+	_, err := messaging.ConfigFromEnv()
+
+	// THEN it should be rejected
+	assert.Error(t, err)
+}
+
+func TestNewPublisher_WithMemoryDriver_ShouldUseBroker(t *testing.T) {
+	// GIVEN the memory driver
+	config := messaging.Config{Driver: messaging.DriverMemory, MaxReceives: 3}
+	broker := messaging.NewMemoryBrokerFromConfig(config)
+
+	// WHEN building the publisher
+	publisher, err := messaging.NewPublisher(config, broker)
+
+	// THEN the broker itself should publish
+	assert.NoError(t, err)
+	assert.Same(t, broker, publisher)
+}
@@ -0,0 +1,23 @@
+package messaging
+
+import (
+	"context"
+	"log"
+)
+
+var (
+	_ Publisher = (*LogPublisher)(nil)
+)
+
+// LogPublisher writes messages to the log, which drops them, so it only fits local development.
+type LogPublisher struct {
+}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, message Message) error {
+	log.Printf("Published %s %s for %s: %s", message.Type, message.ID, message.Subject, message.Data)
+	return nil
+}
@@ -0,0 +1,157 @@
+package messaging
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	_ Publisher = (*MemoryBroker)(nil)
+	_ Consumer  = (*memoryConsumer)(nil)
+)
+
+// DeadLetter is a message that was given up on, with the reason of the last failure.
+type DeadLetter struct {
+	// Body is the raw envelope as it was received.
+	Body     []byte
+	Reason   string
+	Attempts int
+}
+
+// MemoryBroker is an in-process stand-in for SNS fanning out to SQS queues. Every
+// published message is copied to each queue, as a topic subscription would, and
+// messages that fail maxAttempts times are moved to the queue's dead letters.
+// It is meant for tests and local runs; nothing survives a restart.
+type MemoryBroker struct {
+	maxAttempts int
+
+	mu     sync.Mutex
+	queues map[string]*memoryQueue
+}
+
+type memoryQueue struct {
+	pending []memoryDelivery
+	dead    []DeadLetter
+	notify  chan struct{}
+}
+
+type memoryDelivery struct {
+	body     []byte
+	attempts int
+}
+
+func NewMemoryBroker(maxAttempts int) *MemoryBroker {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxReceives
+	}
+	return &MemoryBroker{maxAttempts: maxAttempts, queues: map[string]*memoryQueue{}}
+}
+
+// Publish encodes the message as a CloudEvent and enqueues it on every queue.
+// Messages published while no queue exists are dropped, like on a topic without subscribers.
+func (b *MemoryBroker) Publish(ctx context.Context, message Message) error {
+	body, err := EncodeCloudEvent(DefaultSource, message)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, queue := range b.queues {
+		queue.pending = append(queue.pending, memoryDelivery{body: body})
+		queue.signal()
+	}
+	return nil
+}
+
+// Consumer subscribes a queue to the broker and returns a consumer reading from it.
+func (b *MemoryBroker) Consumer(queue string) Consumer {
+	b.queue(queue)
+	return &memoryConsumer{broker: b, queue: queue}
+}
+
+// Pending returns the number of messages waiting in a queue.
+func (b *MemoryBroker) Pending(queue string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.queue(queue).pending)
+}
+
+// DeadLetters returns the messages a queue gave up on.
+func (b *MemoryBroker) DeadLetters(queue string) []DeadLetter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]DeadLetter(nil), b.queue(queue).dead...)
+}
+
+// queue must be called with mu held.
+func (b *MemoryBroker) queue(name string) *memoryQueue {
+	queue, ok := b.queues[name]
+	if !ok {
+		queue = &memoryQueue{notify: make(chan struct{}, 1)}
+		b.queues[name] = queue
+	}
+	return queue
+}
+
+func (q *memoryQueue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+type memoryConsumer struct {
+	broker *MemoryBroker
+	queue  string
+}
+
+func (c *memoryConsumer) Consume(ctx context.Context, handler Handler) error {
+	for {
+		delivery, notify, ok := c.next()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-notify:
+				continue
+			}
+		}
+		c.process(ctx, delivery, handler)
+	}
+}
+
+func (c *memoryConsumer) next() (memoryDelivery, chan struct{}, bool) {
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	queue := c.broker.queue(c.queue)
+	if len(queue.pending) == 0 {
+		return memoryDelivery{}, queue.notify, false
+	}
+	delivery := queue.pending[0]
+	queue.pending = queue.pending[1:]
+	return delivery, queue.notify, true
+}
+
+func (c *memoryConsumer) process(ctx context.Context, delivery memoryDelivery, handler Handler) {
+	delivery.attempts++
+	message, err := DecodeCloudEvent(delivery.body)
+	if err == nil {
+		err = handler(ctx, message)
+	} else {
+		err = Permanent(err)
+	}
+	if err == nil {
+		return
+	}
+
+	c.broker.mu.Lock()
+	defer c.broker.mu.Unlock()
+	queue := c.broker.queue(c.queue)
+	if IsPermanent(err) || delivery.attempts >= c.broker.maxAttempts {
+		queue.dead = append(queue.dead, DeadLetter{Body: delivery.body, Reason: err.Error(), Attempts: delivery.attempts})
+		return
+	}
+	queue.pending = append(queue.pending, delivery)
+	queue.signal()
+}
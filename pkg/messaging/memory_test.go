@@ -0,0 +1,100 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+// consume runs the consumer in the background until the returned stop function is called.
+func consume(consumer messaging.Consumer, handler messaging.Handler) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var done sync.WaitGroup
+	done.Add(1)
+	go func() {
+		defer done.Done()
+		consumer.Consume(ctx, handler)
+	}()
+	return func() {
+		cancel()
+		done.Wait()
+	}
+}
+
+func TestMemoryBroker_ShouldFanOutToEveryQueue(t *testing.T) {
+	// GIVEN two queues subscribed to the broker
+	broker := messaging.NewMemoryBroker(3)
+	received := make(chan string, 2)
+	handler := func(queue string) messaging.Handler {
+		return func(ctx context.Context, message messaging.Message) error {
+			received <- queue + ":" + message.ID
+			return nil
+		}
+	}
+	stopOrders := consume(broker.Consumer("orders"), handler("orders"))
+	defer stopOrders()
+	stopLoyalty := consume(broker.Consumer("loyalty"), handler("loyalty"))
+	defer stopLoyalty()
+
+	// WHEN a message is published
+	err := broker.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "customer.registered"})
+
+	// THEN each queue should receive its own copy
+	assert.NoError(t, err)
+	var got []string
+	for range 2 {
+		select {
+		case value := <-received:
+			got = append(got, value)
+		case <-time.After(time.Second):
+			t.Fatal("message not delivered")
+		}
+	}
+	assert.ElementsMatch(t, []string{"orders:evt-1", "loyalty:evt-1"}, got)
+}
+
+func TestMemoryBroker_WithFailingHandler_ShouldDeadLetterAfterMaxAttempts(t *testing.T) {
+	// GIVEN a handler that always fails
+	broker := messaging.NewMemoryBroker(3)
+	consumer := broker.Consumer("orders")
+	var mu sync.Mutex
+	attempts := 0
+	stop := consume(consumer, func(ctx context.Context, message messaging.Message) error {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		return errors.New("database unavailable")
+	})
+	defer stop()
+
+	// WHEN a message is published
+	broker.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "order.created"})
+
+	// THEN it should be dead-lettered after three attempts
+	assert.Eventually(t, func() bool { return len(broker.DeadLetters("orders")) == 1 }, time.Second, 5*time.Millisecond)
+	deadLetter := broker.DeadLetters("orders")[0]
+	assert.Equal(t, 3, deadLetter.Attempts)
+	assert.Equal(t, "database unavailable", deadLetter.Reason)
+	assert.Equal(t, 0, broker.Pending("orders"))
+}
+
+func TestMemoryBroker_WithPermanentError_ShouldDeadLetterImmediately(t *testing.T) {
+	// GIVEN a handler rejecting the message as unprocessable
+	broker := messaging.NewMemoryBroker(5)
+	stop := consume(broker.Consumer("orders"), func(ctx context.Context, message messaging.Message) error {
+		return messaging.Permanent(errors.New("unknown order status"))
+	})
+	defer stop()
+
+	// WHEN a message is published
+	broker.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "order.created"})
+
+	// THEN it should not be retried
+	assert.Eventually(t, func() bool { return len(broker.DeadLetters("orders")) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 1, broker.DeadLetters("orders")[0].Attempts)
+}
@@ -0,0 +1,63 @@
+package messaging
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultSource is the CloudEvents source of the events published by this service.
+const DefaultSource = "/tc-fiap-customer"
+
+// Message is an integration event exchanged with other services.
+type Message struct {
+	// ID identifies the event; consumers use it to discard redeliveries.
+	ID string
+	// Type is the event type, e.g. customer.registered.
+	Type string
+	// Source identifies the producer. Publishers fill in their own source when empty.
+	Source string
+	// Subject is the ID of the entity the event is about.
+	Subject string
+	Time    time.Time
+	// Data is the JSON encoded event payload.
+	Data []byte
+}
+
+// Publisher delivers messages to a broker. Publish must only return nil once
+// the broker has accepted the message.
+type Publisher interface {
+	Publish(ctx context.Context, message Message) error
+}
+
+// Handler processes a received message. Returning an error leaves the message to be
+// redelivered, unless the error is Permanent.
+type Handler func(ctx context.Context, message Message) error
+
+// Consumer receives messages from a queue. Delivery is at least once, so handlers
+// must be idempotent. Messages that keep failing are moved to a dead-letter queue.
+type Consumer interface {
+	// Consume passes messages to handler until ctx is cancelled.
+	Consume(ctx context.Context, handler Handler) error
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks a handler error as not worth retrying, so the message is
+// dead-lettered right away instead of after the maximum number of receives.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
@@ -0,0 +1,58 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+)
+
+var (
+	_ Publisher = (*SNSPublisher)(nil)
+)
+
+// TypeAttribute is the SNS/SQS message attribute carrying the event type, so
+// subscriptions can filter on it without parsing the body.
+const TypeAttribute = "type"
+
+// SNSPublisher publishes messages as CloudEvents to an SNS topic.
+type SNSPublisher struct {
+	client   snsiface.SNSAPI
+	topicARN string
+	source   string
+}
+
+func NewSNSPublisher(client snsiface.SNSAPI, topicARN string, source string) *SNSPublisher {
+	if source == "" {
+		source = DefaultSource
+	}
+	return &SNSPublisher{client: client, topicARN: topicARN, source: source}
+}
+
+func (p *SNSPublisher) Publish(ctx context.Context, message Message) error {
+	body, err := EncodeCloudEvent(p.source, message)
+	if err != nil {
+		return err
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(p.topicARN),
+		Message:  aws.String(string(body)),
+		MessageAttributes: map[string]*sns.MessageAttributeValue{
+			TypeAttribute: {DataType: aws.String("String"), StringValue: aws.String(message.Type)},
+		},
+	}
+	// FIFO topics keep the events of one entity in order and drop republished duplicates.
+	if strings.HasSuffix(p.topicARN, ".fifo") {
+		input.MessageGroupId = aws.String(message.Subject)
+		input.MessageDeduplicationId = aws.String(message.ID)
+	}
+
+	if _, err := p.client.PublishWithContext(ctx, input); err != nil {
+		return fmt.Errorf("failed to publish %s to SNS: %w", message.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package messaging_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+type MockSNSClient struct {
+	mock.Mock
+	snsiface.SNSAPI
+}
+
+func (m *MockSNSClient) PublishWithContext(ctx aws.Context, input *sns.PublishInput, opts ...request.Option) (*sns.PublishOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*sns.PublishOutput)
+	return output, args.Error(1)
+}
+
+func TestSNSPublisher_ShouldPublishCloudEventWithTypeAttribute(t *testing.T) {
+	// GIVEN a standard topic
+	client := &MockSNSClient{}
+	publisher := messaging.NewSNSPublisher(client, "arn:aws:sns:us-east-1:000000000000:customer-events", "")
+
+	client.On("PublishWithContext", mock.MatchedBy(func(input *sns.PublishInput) bool {
+		decoded, err := messaging.DecodeCloudEvent([]byte(aws.StringValue(input.Message)))
+		return err == nil && decoded.ID == "evt-1" && decoded.Source == messaging.DefaultSource &&
+			aws.StringValue(input.MessageAttributes[messaging.TypeAttribute].StringValue) == "customer.registered" &&
+			input.MessageGroupId == nil
+	})).Return(&sns.PublishOutput{}, nil).Once()
+
+	// WHEN publishing a message
+	err := publisher.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "customer.registered", Subject: "customer-1"})
+
+	// THEN the topic should receive a CloudEvent
+	assert.NoError(t, err)
+	client.AssertExpectations(t)
+}
+
+func TestSNSPublisher_WithFIFOTopic_ShouldGroupBySubjectAndDeduplicateByID(t *testing.T) {
+	// GIVEN a FIFO topic
+	client := &MockSNSClient{}
+	publisher := messaging.NewSNSPublisher(client, "arn:aws:sns:us-east-1:000000000000:customer-events.fifo", messaging.DefaultSource)
+
+	client.On("PublishWithContext", mock.MatchedBy(func(input *sns.PublishInput) bool {
+		return aws.StringValue(input.MessageGroupId) == "customer-1" && aws.StringValue(input.MessageDeduplicationId) == "evt-1"
+	})).Return(&sns.PublishOutput{}, nil).Once()
+
+	// WHEN publishing a message
+	err := publisher.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "customer.updated", Subject: "customer-1"})
+
+	// THEN ordering and deduplication keys should be set
+	assert.NoError(t, err)
+	client.AssertExpectations(t)
+}
+
+func TestSNSPublisher_WithBrokerError_ShouldFail(t *testing.T) {
+	// GIVEN SNS is unavailable
+	client := &MockSNSClient{}
+	publisher := messaging.NewSNSPublisher(client, "arn:aws:sns:us-east-1:000000000000:customer-events", "")
+	client.On("PublishWithContext", mock.Anything).Return(nil, errors.New("throttled")).Once()
+
+	// WHEN publishing a message
+	err := publisher.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "customer.updated"})
+
+	// THEN the error should be returned so the outbox retries
+	assert.Error(t, err)
+}
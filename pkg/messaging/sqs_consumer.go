@@ -0,0 +1,172 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+)
+
+var (
+	_ Consumer = (*SQSConsumer)(nil)
+)
+
+const (
+	DefaultMaxReceives = 5
+	// ReasonAttribute is the message attribute holding why a message was dead-lettered.
+	ReasonAttribute = "dead_letter_reason"
+)
+
+// SQSConsumerConfig configures an SQS consumer. Zero values fall back to the defaults.
+type SQSConsumerConfig struct {
+	QueueURL string
+	// DeadLetterQueueURL receives messages that failed MaxReceives times or could not be parsed.
+	// Without it failed messages are left to the redrive policy of the queue.
+	DeadLetterQueueURL string
+	MaxReceives        int
+	// MaxMessages is the batch size of a receive, at most 10.
+	MaxMessages int64
+	// WaitTime enables long polling, at most 20 seconds.
+	WaitTime time.Duration
+	// RetryDelay is how long to wait after a failed receive.
+	RetryDelay time.Duration
+}
+
+// SQSConsumer long-polls an SQS queue. Messages are deleted after the handler succeeds;
+// a failed message becomes visible again after the queue visibility timeout.
+type SQSConsumer struct {
+	client sqsiface.SQSAPI
+	config SQSConsumerConfig
+}
+
+func NewSQSConsumer(client sqsiface.SQSAPI, config SQSConsumerConfig) *SQSConsumer {
+	if config.MaxReceives <= 0 {
+		config.MaxReceives = DefaultMaxReceives
+	}
+	if config.MaxMessages <= 0 || config.MaxMessages > 10 {
+		config.MaxMessages = 10
+	}
+	if config.WaitTime <= 0 || config.WaitTime > 20*time.Second {
+		config.WaitTime = 20 * time.Second
+	}
+	if config.RetryDelay <= 0 {
+		config.RetryDelay = time.Second
+	}
+	return &SQSConsumer{client: client, config: config}
+}
+
+func (c *SQSConsumer) Consume(ctx context.Context, handler Handler) error {
+	for ctx.Err() == nil {
+		if _, err := c.Poll(ctx, handler); err != nil && ctx.Err() == nil {
+			log.Printf("Warning: failed to receive from %s: %v", c.config.QueueURL, err)
+			select {
+			case <-ctx.Done():
+			case <-time.After(c.config.RetryDelay):
+			}
+		}
+	}
+	return nil
+}
+
+// Poll receives one batch and processes it, returning the number of messages received.
+func (c *SQSConsumer) Poll(ctx context.Context, handler Handler) (int, error) {
+	output, err := c.client.ReceiveMessageWithContext(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(c.config.QueueURL),
+		MaxNumberOfMessages:   aws.Int64(c.config.MaxMessages),
+		WaitTimeSeconds:       aws.Int64(int64(c.config.WaitTime / time.Second)),
+		AttributeNames:        []*string{aws.String(sqs.MessageSystemAttributeNameApproximateReceiveCount)},
+		MessageAttributeNames: []*string{aws.String("All")},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, received := range output.Messages {
+		c.process(ctx, received, handler)
+	}
+	return len(output.Messages), nil
+}
+
+func (c *SQSConsumer) process(ctx context.Context, received *sqs.Message, handler Handler) {
+	message, err := DecodeCloudEvent(unwrapSNSNotification([]byte(aws.StringValue(received.Body))))
+	if err == nil {
+		err = handler(ctx, message)
+	} else {
+		err = Permanent(err)
+	}
+
+	if err == nil {
+		c.delete(ctx, received)
+		return
+	}
+
+	receives := receiveCount(received)
+	if !IsPermanent(err) && receives < c.config.MaxReceives {
+		log.Printf("Warning: failed to process %s (receive %d of %d): %v", aws.StringValue(received.MessageId), receives, c.config.MaxReceives, err)
+		return
+	}
+	if c.config.DeadLetterQueueURL == "" {
+		log.Printf("Warning: giving up on %s, leaving it to the queue redrive policy: %v", aws.StringValue(received.MessageId), err)
+		return
+	}
+	if dlqErr := c.deadLetter(ctx, received, err); dlqErr != nil {
+		log.Printf("Warning: failed to dead-letter %s: %v", aws.StringValue(received.MessageId), dlqErr)
+		return
+	}
+	c.delete(ctx, received)
+}
+
+func (c *SQSConsumer) deadLetter(ctx context.Context, received *sqs.Message, reason error) error {
+	_, err := c.client.SendMessageWithContext(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(c.config.DeadLetterQueueURL),
+		MessageBody: received.Body,
+		MessageAttributes: map[string]*sqs.MessageAttributeValue{
+			ReasonAttribute: {DataType: aws.String("String"), StringValue: aws.String(reason.Error())},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send to dead-letter queue: %w", err)
+	}
+	return nil
+}
+
+func (c *SQSConsumer) delete(ctx context.Context, received *sqs.Message) {
+	_, err := c.client.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(c.config.QueueURL),
+		ReceiptHandle: received.ReceiptHandle,
+	})
+	if err != nil {
+		// The message will be received again; handlers are idempotent.
+		log.Printf("Warning: failed to delete %s: %v", aws.StringValue(received.MessageId), err)
+	}
+}
+
+func receiveCount(received *sqs.Message) int {
+	count, err := strconv.Atoi(aws.StringValue(received.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount]))
+	if err != nil {
+		return 1
+	}
+	return count
+}
+
+// snsNotification is the wrapper SNS puts around messages delivered to SQS
+// when raw message delivery is disabled.
+type snsNotification struct {
+	Type     string `json:"Type"`
+	Message  string `json:"Message"`
+	TopicArn string `json:"TopicArn"`
+}
+
+func unwrapSNSNotification(body []byte) []byte {
+	var notification snsNotification
+	if err := json.Unmarshal(body, &notification); err == nil && notification.Type == "Notification" && notification.TopicArn != "" {
+		return []byte(notification.Message)
+	}
+	return body
+}
@@ -0,0 +1,169 @@
+package messaging_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+const (
+	queueURL = "http://localhost:4566/000000000000/customer-orders"
+	dlqURL   = "http://localhost:4566/000000000000/customer-orders-dlq"
+)
+
+type MockSQSClient struct {
+	mock.Mock
+	sqsiface.SQSAPI
+}
+
+func (m *MockSQSClient) ReceiveMessageWithContext(ctx aws.Context, input *sqs.ReceiveMessageInput, opts ...request.Option) (*sqs.ReceiveMessageOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*sqs.ReceiveMessageOutput)
+	return output, args.Error(1)
+}
+
+func (m *MockSQSClient) DeleteMessageWithContext(ctx aws.Context, input *sqs.DeleteMessageInput, opts ...request.Option) (*sqs.DeleteMessageOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*sqs.DeleteMessageOutput)
+	return output, args.Error(1)
+}
+
+func (m *MockSQSClient) SendMessageWithContext(ctx aws.Context, input *sqs.SendMessageInput, opts ...request.Option) (*sqs.SendMessageOutput, error) {
+	args := m.Called(input)
+	output, _ := args.Get(0).(*sqs.SendMessageOutput)
+	return output, args.Error(1)
+}
+
+func receivedMessage(t *testing.T, body string, receives string) *sqs.Message {
+	t.Helper()
+	return &sqs.Message{
+		MessageId:     aws.String("sqs-1"),
+		ReceiptHandle: aws.String("receipt-1"),
+		Body:          aws.String(body),
+		Attributes:    map[string]*string{sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String(receives)},
+	}
+}
+
+func orderEvent(t *testing.T) string {
+	t.Helper()
+	body, err := messaging.EncodeCloudEvent("/tc-fiap-order", messaging.Message{ID: "evt-1", Type: "order.created", Subject: "order-1", Data: []byte(`{}`)})
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func newSQSConsumer(client *MockSQSClient) *messaging.SQSConsumer {
+	return messaging.NewSQSConsumer(client, messaging.SQSConsumerConfig{QueueURL: queueURL, DeadLetterQueueURL: dlqURL, MaxReceives: 3})
+}
+
+func TestSQSConsumer_Poll_ShouldHandleAndDeleteMessage(t *testing.T) {
+	// GIVEN a queue holding an order event
+	client := &MockSQSClient{}
+	client.On("ReceiveMessageWithContext", mock.MatchedBy(func(input *sqs.ReceiveMessageInput) bool {
+		return aws.StringValue(input.QueueUrl) == queueURL && aws.Int64Value(input.WaitTimeSeconds) == 20
+	})).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{receivedMessage(t, orderEvent(t), "1")}}, nil).Once()
+	client.On("DeleteMessageWithContext", mock.MatchedBy(func(input *sqs.DeleteMessageInput) bool {
+		return aws.StringValue(input.ReceiptHandle) == "receipt-1"
+	})).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+
+	// WHEN polling
+	var handled messaging.Message
+	count, err := newSQSConsumer(client).Poll(context.Background(), func(ctx context.Context, message messaging.Message) error {
+		handled = message
+		return nil
+	})
+
+	// THEN the decoded message should be handled and deleted
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "order.created", handled.Type)
+	assert.Equal(t, "/tc-fiap-order", handled.Source)
+	client.AssertExpectations(t)
+}
+
+func TestSQSConsumer_Poll_ShouldUnwrapSNSNotifications(t *testing.T) {
+	// GIVEN a message delivered by an SNS subscription without raw delivery
+	notification, _ := json.Marshal(map[string]string{
+		"Type":     "Notification",
+		"TopicArn": "arn:aws:sns:us-east-1:000000000000:order-events",
+		"Message":  orderEvent(t),
+	})
+	client := &MockSQSClient{}
+	client.On("ReceiveMessageWithContext", mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{receivedMessage(t, string(notification), "1")}}, nil).Once()
+	client.On("DeleteMessageWithContext", mock.Anything).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+
+	// WHEN polling
+	var handled messaging.Message
+	_, err := newSQSConsumer(client).Poll(context.Background(), func(ctx context.Context, message messaging.Message) error {
+		handled = message
+		return nil
+	})
+
+	// THEN the CloudEvent inside the notification should be handled
+	assert.NoError(t, err)
+	assert.Equal(t, "evt-1", handled.ID)
+}
+
+func TestSQSConsumer_Poll_WithFailureBelowMaxReceives_ShouldLeaveMessageForRedelivery(t *testing.T) {
+	// GIVEN a handler failing on the first receive
+	client := &MockSQSClient{}
+	client.On("ReceiveMessageWithContext", mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{receivedMessage(t, orderEvent(t), "1")}}, nil).Once()
+
+	// WHEN polling
+	_, err := newSQSConsumer(client).Poll(context.Background(), func(ctx context.Context, message messaging.Message) error {
+		return errors.New("database unavailable")
+	})
+
+	// THEN the message should be neither deleted nor dead-lettered
+	assert.NoError(t, err)
+	client.AssertNotCalled(t, "DeleteMessageWithContext", mock.Anything)
+	client.AssertNotCalled(t, "SendMessageWithContext", mock.Anything)
+}
+
+func TestSQSConsumer_Poll_WithFailureAtMaxReceives_ShouldDeadLetter(t *testing.T) {
+	// GIVEN a message received for the third time
+	client := &MockSQSClient{}
+	client.On("ReceiveMessageWithContext", mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{receivedMessage(t, orderEvent(t), "3")}}, nil).Once()
+	client.On("SendMessageWithContext", mock.MatchedBy(func(input *sqs.SendMessageInput) bool {
+		return aws.StringValue(input.QueueUrl) == dlqURL &&
+			aws.StringValue(input.MessageAttributes[messaging.ReasonAttribute].StringValue) == "database unavailable"
+	})).Return(&sqs.SendMessageOutput{}, nil).Once()
+	client.On("DeleteMessageWithContext", mock.Anything).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+
+	// WHEN the handler fails again
+	_, err := newSQSConsumer(client).Poll(context.Background(), func(ctx context.Context, message messaging.Message) error {
+		return errors.New("database unavailable")
+	})
+
+	// THEN it should be moved to the dead-letter queue
+	assert.NoError(t, err)
+	client.AssertExpectations(t)
+}
+
+func TestSQSConsumer_Poll_WithMalformedBody_ShouldDeadLetterWithoutHandling(t *testing.T) {
+	// GIVEN a message that is not a CloudEvent
+	client := &MockSQSClient{}
+	client.On("ReceiveMessageWithContext", mock.Anything).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{receivedMessage(t, "hello", "1")}}, nil).Once()
+	client.On("SendMessageWithContext", mock.Anything).Return(&sqs.SendMessageOutput{}, nil).Once()
+	client.On("DeleteMessageWithContext", mock.Anything).Return(&sqs.DeleteMessageOutput{}, nil).Once()
+
+	// WHEN polling
+	handled := false
+	_, err := newSQSConsumer(client).Poll(context.Background(), func(ctx context.Context, message messaging.Message) error {
+		handled = true
+		return nil
+	})
+
+	// THEN the poison message should go straight to the dead-letter queue
+	assert.NoError(t, err)
+	assert.False(t, handled)
+	client.AssertExpectations(t)
+}
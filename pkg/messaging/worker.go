@@ -0,0 +1,42 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Worker runs a consumer in the background, for use in lifecycle hooks.
+type Worker struct {
+	consumer Consumer
+	handler  Handler
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+func NewWorker(consumer Consumer, handler Handler) *Worker {
+	return &Worker{consumer: consumer, handler: handler}
+}
+
+// Start consumes in the background until Stop is called.
+func (w *Worker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done.Add(1)
+	go func() {
+		defer w.done.Done()
+		if err := w.consumer.Consume(ctx, w.handler); err != nil {
+			log.Printf("Warning: consumer stopped: %v", err)
+		}
+	}()
+}
+
+// Stop cancels the consumer and waits for the message being handled to finish.
+func (w *Worker) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.done.Wait()
+}
@@ -0,0 +1,34 @@
+package messaging_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+func TestWorker_ShouldConsumeUntilStopped(t *testing.T) {
+	// GIVEN a worker on a memory queue
+	broker := messaging.NewMemoryBroker(1)
+	received := make(chan string, 1)
+	worker := messaging.NewWorker(broker.Consumer("orders"), func(ctx context.Context, message messaging.Message) error {
+		received <- message.ID
+		return nil
+	})
+
+	// WHEN it is started and a message is published
+	worker.Start()
+	broker.Publish(context.Background(), messaging.Message{ID: "evt-1", Type: "order.completed"})
+
+	// THEN the message should be handled
+	select {
+	case id := <-received:
+		assert.Equal(t, "evt-1", id)
+	case <-time.After(time.Second):
+		t.Fatal("message not handled")
+	}
+	// AND Stop should return once the consumer exits
+	worker.Stop()
+}
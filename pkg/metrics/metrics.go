@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"expvar"
+	"sync"
+)
+
+var (
+	mu        sync.Mutex
+	snapshots = map[string]func() any{}
+)
+
+// Publish exposes what snapshot returns as the expvar variable name, served with the rest of
+// expvar by Handler. expvar cannot unpublish a variable, so publishing a name again, as every
+// initialization of the application does, replaces the snapshot served under it.
+func Publish(name string, snapshot func() any) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := snapshots[name]; !ok {
+		expvar.Publish(name, expvar.Func(func() any { return read(name) }))
+	}
+	snapshots[name] = snapshot
+}
+
+func read(name string) any {
+	mu.Lock()
+	snapshot := snapshots[name]
+	mu.Unlock()
+	return snapshot()
+}
+
+// Handler serves every published variable as JSON, along with the memory statistics and command
+// line expvar publishes by itself.
+var Handler = expvar.Handler
@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish_ShouldReplaceSnapshotOfSameName(t *testing.T) {
+	// GIVEN a published snapshot
+	Publish("test_metrics", func() any { return map[string]int{"hits": 1} })
+
+	// WHEN the same name is published again
+	Publish("test_metrics", func() any { return map[string]int{"hits": 2} })
+
+	// THEN expvar should serve the latest snapshot
+	assert.JSONEq(t, `{"hits":2}`, expvar.Get("test_metrics").String())
+}
@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// RelayConfigFromEnv overrides the default relay settings with OUTBOX_POLL_INTERVAL,
+// OUTBOX_BATCH_SIZE, OUTBOX_MAX_ATTEMPTS, OUTBOX_BASE_BACKOFF and OUTBOX_MAX_BACKOFF.
+func RelayConfigFromEnv() (RelayConfig, error) {
+	config := DefaultRelayConfig
+
+	durations := map[string]*time.Duration{
+		"OUTBOX_POLL_INTERVAL": &config.PollInterval,
+		"OUTBOX_BASE_BACKOFF":  &config.BaseBackoff,
+		"OUTBOX_MAX_BACKOFF":   &config.MaxBackoff,
+	}
+	for name, target := range durations {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed <= 0 {
+				return RelayConfig{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	counts := map[string]*int{
+		"OUTBOX_BATCH_SIZE":   &config.BatchSize,
+		"OUTBOX_MAX_ATTEMPTS": &config.MaxAttempts,
+	}
+	for name, target := range counts {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 {
+				return RelayConfig{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	return config, nil
+}
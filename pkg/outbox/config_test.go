@@ -0,0 +1,45 @@
+package outbox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
+)
+
+func TestRelayConfigFromEnv_WithoutVariables_ShouldReturnDefaults(t *testing.T) {
+	// GIVEN no outbox variables
+	// WHEN reading the relay config
+	config, err := outbox.RelayConfigFromEnv()
+
+	// THEN the defaults should be used
+	assert.NoError(t, err)
+	assert.Equal(t, outbox.DefaultRelayConfig, config)
+}
+
+func TestRelayConfigFromEnv_ShouldOverrideDefaults(t *testing.T) {
+	// GIVEN a faster poll interval and a smaller batch
+	t.Setenv("OUTBOX_POLL_INTERVAL", "250ms")
+	t.Setenv("OUTBOX_BATCH_SIZE", "5")
+
+	// WHEN reading the relay config
+	config, err := outbox.RelayConfigFromEnv()
+
+	// THEN only those settings should change
+	assert.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, config.PollInterval)
+	assert.Equal(t, 5, config.BatchSize)
+	assert.Equal(t, outbox.DefaultRelayConfig.MaxAttempts, config.MaxAttempts)
+}
+
+func TestRelayConfigFromEnv_WithInvalidValue_ShouldFail(t *testing.T) {
+	// GIVEN a malformed attempt count
+	t.Setenv("OUTBOX_MAX_ATTEMPTS", "many")
+
+	// WHEN reading the relay config
+	_, err := outbox.RelayConfigFromEnv()
+
+	// THEN an error should be returned
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+var (
+	_ Store = (*DynamoDBStore)(nil)
+)
+
+// PendingIndexName is the index over outbox messages keyed by status and sorted by
+// next attempt, so the relay reads due messages oldest first and dead messages can be listed apart.
+const PendingIndexName = "pending-index"
+
+// DynamoDBAPI is the part of the DynamoDB API the store uses.
+type DynamoDBAPI interface {
+	Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error)
+	PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error)
+	DeleteItem(ctx context.Context, input *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
+}
+
+type DynamoDBStore struct {
+	db        DynamoDBAPI
+	tableName string
+}
+
+func NewDynamoDBStore(db DynamoDBAPI, tableName string) *DynamoDBStore {
+	return &DynamoDBStore{db: db, tableName: tableName}
+}
+
+// TransactPut returns the write that adds message to the outbox table, to be
+// included in the transaction that changes the aggregate.
+func TransactPut(tableName string, message Message) (types.TransactWriteItem, error) {
+	av, err := attributevalue.MarshalMap(message)
+	if err != nil {
+		return types.TransactWriteItem{}, fmt.Errorf("failed to marshal outbox message: %w", err)
+	}
+	return types.TransactWriteItem{
+		Put: &types.Put{
+			TableName:           aws.String(tableName),
+			Item:                av,
+			ConditionExpression: aws.String("attribute_not_exists(id)"),
+		},
+	}, nil
+}
+
+func (s *DynamoDBStore) Pending(ctx context.Context, now time.Time, limit int) ([]Message, error) {
+	result, err := s.db.Query(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(s.tableName),
+		IndexName:              aws.String(PendingIndexName),
+		KeyConditionExpression: aws.String("#status = :pending AND next_attempt_at <= :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#status": "status",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":pending": &types.AttributeValueMemberS{Value: StatusPending},
+			":now":     &types.AttributeValueMemberN{Value: strconv.FormatInt(now.UnixMilli(), 10)},
+		},
+		Limit: aws.Int32(int32(limit)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox: %w", err)
+	}
+
+	messages := make([]Message, 0, len(result.Items))
+	if err := attributevalue.UnmarshalListOfMaps(result.Items, &messages); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal outbox messages: %w", err)
+	}
+	return messages, nil
+}
+
+func (s *DynamoDBStore) Delivered(ctx context.Context, message Message) error {
+	_, err := s.db.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(s.tableName),
+		Key: map[string]types.AttributeValue{
+			"id": &types.AttributeValueMemberS{Value: message.ID},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete outbox message: %w", err)
+	}
+	return nil
+}
+
+func (s *DynamoDBStore) Failed(ctx context.Context, message Message) error {
+	av, err := attributevalue.MarshalMap(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox message: %w", err)
+	}
+	_, err = s.db.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(s.tableName),
+		Item:                av,
+		ConditionExpression: aws.String("attribute_exists(id)"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update outbox message: %w", err)
+	}
+	return nil
+}
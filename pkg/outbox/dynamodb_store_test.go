@@ -0,0 +1,119 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
+)
+
+type MockDynamoDBClient struct {
+	mock.Mock
+}
+
+func (m *MockDynamoDBClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*dynamodb.QueryOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) DeleteItem(ctx context.Context, input *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*dynamodb.DeleteItemOutput), args.Error(1)
+}
+
+func (m *MockDynamoDBClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	args := m.Called(input)
+	return args.Get(0).(*dynamodb.PutItemOutput), args.Error(1)
+}
+
+func stringValue(av types.AttributeValue) string {
+	if s, ok := av.(*types.AttributeValueMemberS); ok {
+		return s.Value
+	}
+	return ""
+}
+
+func numberValue(av types.AttributeValue) string {
+	if n, ok := av.(*types.AttributeValueMemberN); ok {
+		return n.Value
+	}
+	return ""
+}
+
+func TestTransactPut_ShouldWritePendingMessageOnce(t *testing.T) {
+	// GIVEN a new message
+	message := newMessage(t)
+
+	// WHEN building the transaction write
+	item, err := outbox.TransactPut("outbox", message)
+
+	// THEN the message should be put as pending without overwriting
+	assert.NoError(t, err)
+	assert.Equal(t, "outbox", aws.ToString(item.Put.TableName))
+	assert.Equal(t, "attribute_not_exists(id)", aws.ToString(item.Put.ConditionExpression))
+	assert.Equal(t, outbox.StatusPending, stringValue(item.Put.Item["status"]))
+	assert.Equal(t, message.ID, stringValue(item.Put.Item["id"]))
+}
+
+func TestDynamoDBStore_Pending_ShouldQueryDueMessages(t *testing.T) {
+	// GIVEN a pending message in the outbox table
+	message := newMessage(t)
+	item, _ := attributevalue.MarshalMap(message)
+	db := new(MockDynamoDBClient)
+	db.On("Query", mock.MatchedBy(func(input *dynamodb.QueryInput) bool {
+		return aws.ToString(input.IndexName) == outbox.PendingIndexName &&
+			stringValue(input.ExpressionAttributeValues[":pending"]) == outbox.StatusPending &&
+			aws.ToInt32(input.Limit) == 10
+	})).Return(&dynamodb.QueryOutput{Items: []map[string]types.AttributeValue{item}}, nil)
+	store := outbox.NewDynamoDBStore(db, "outbox")
+
+	// WHEN reading pending messages
+	messages, err := store.Pending(context.Background(), time.Now(), 10)
+
+	// THEN the message should be returned
+	assert.NoError(t, err)
+	assert.Len(t, messages, 1)
+	assert.Equal(t, message.ID, messages[0].ID)
+	assert.Equal(t, message.Payload, messages[0].Payload)
+}
+
+func TestDynamoDBStore_Delivered_ShouldDeleteMessage(t *testing.T) {
+	// GIVEN a published message
+	message := newMessage(t)
+	db := new(MockDynamoDBClient)
+	db.On("DeleteItem", mock.MatchedBy(func(input *dynamodb.DeleteItemInput) bool {
+		return stringValue(input.Key["id"]) == message.ID
+	})).Return(&dynamodb.DeleteItemOutput{}, nil)
+
+	// WHEN marking it delivered
+	err := outbox.NewDynamoDBStore(db, "outbox").Delivered(context.Background(), message)
+
+	// THEN it should be deleted
+	assert.NoError(t, err)
+	db.AssertExpectations(t)
+}
+
+func TestDynamoDBStore_Failed_ShouldUpdateExistingMessage(t *testing.T) {
+	// GIVEN a failed attempt
+	message := newMessage(t)
+	message.Attempts = 3
+	db := new(MockDynamoDBClient)
+	db.On("PutItem", mock.MatchedBy(func(input *dynamodb.PutItemInput) bool {
+		return aws.ToString(input.ConditionExpression) == "attribute_exists(id)" &&
+			numberValue(input.Item["attempts"]) == "3"
+	})).Return(&dynamodb.PutItemOutput{}, nil)
+
+	// WHEN recording the failure
+	err := outbox.NewDynamoDBStore(db, "outbox").Failed(context.Background(), message)
+
+	// THEN the message should be rewritten only if it still exists
+	assert.NoError(t, err)
+	db.AssertExpectations(t)
+}
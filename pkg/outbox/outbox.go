@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+// Message is an event waiting in the outbox to be published.
+type Message struct {
+	ID          string    `dynamodbav:"id"`
+	Type        string    `dynamodbav:"type"`
+	AggregateID string    `dynamodbav:"aggregate_id"`
+	Payload     string    `dynamodbav:"payload"`
+	OccurredAt  time.Time `dynamodbav:"occurred_at"`
+	Attempts    int       `dynamodbav:"attempts"`
+	// NextAttemptAt is the earliest time, in Unix milliseconds, the relay may publish the message.
+	NextAttemptAt int64  `dynamodbav:"next_attempt_at"`
+	LastError     string `dynamodbav:"last_error,omitempty"`
+	// Status is pending until the message is delivered or given up on.
+	Status string `dynamodbav:"status,omitempty"`
+}
+
+const (
+	StatusPending = "pending"
+	StatusDead    = "dead"
+)
+
+// NewMessage encodes payload as JSON in a pending message.
+func NewMessage(eventType string, aggregateID string, payload any, occurredAt time.Time) (Message, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+	id, err := newMessageID()
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		ID:            id,
+		Type:          eventType,
+		AggregateID:   aggregateID,
+		Payload:       string(data),
+		OccurredAt:    occurredAt,
+		NextAttemptAt: occurredAt.UnixMilli(),
+		Status:        StatusPending,
+	}, nil
+}
+
+// Integration converts the outbox message into the message handed to publishers.
+func (m Message) Integration() messaging.Message {
+	return messaging.Message{
+		ID:      m.ID,
+		Type:    m.Type,
+		Subject: m.AggregateID,
+		Time:    m.OccurredAt,
+		Data:    []byte(m.Payload),
+	}
+}
+
+// Store reads and settles outbox messages. Messages are written by the repositories
+// of the aggregates, in the same transaction as the change they describe.
+type Store interface {
+	// Pending returns up to limit pending messages due at now, oldest first.
+	Pending(ctx context.Context, now time.Time, limit int) ([]Message, error)
+	// Delivered removes a published message from the outbox.
+	Delivered(ctx context.Context, message Message) error
+	// Failed records a failed attempt, rescheduling or burying the message.
+	Failed(ctx context.Context, message Message) error
+}
+
+func newMessageID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate message id: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
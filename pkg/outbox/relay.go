@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+)
+
+// RelayConfig tunes the relay. Zero values fall back to the defaults.
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxAttempts is the number of failed publishes after which a message is marked dead.
+	MaxAttempts int
+	// BaseBackoff doubles on every failed attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+var DefaultRelayConfig = RelayConfig{
+	PollInterval: time.Second,
+	BatchSize:    25,
+	MaxAttempts:  10,
+	BaseBackoff:  time.Second,
+	MaxBackoff:   5 * time.Minute,
+}
+
+// Relay publishes pending outbox messages. A message is removed only after the
+// publisher accepted it, so delivery is at least once: a crash between both steps,
+// or two replicas picking the same message, publishes it again with the same ID.
+type Relay struct {
+	store     Store
+	publisher messaging.Publisher
+	config    RelayConfig
+	now       func() time.Time
+
+	cancel context.CancelFunc
+	done   sync.WaitGroup
+}
+
+func NewRelay(store Store, publisher messaging.Publisher, config RelayConfig) *Relay {
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultRelayConfig.PollInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultRelayConfig.BatchSize
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultRelayConfig.MaxAttempts
+	}
+	if config.BaseBackoff <= 0 {
+		config.BaseBackoff = DefaultRelayConfig.BaseBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultRelayConfig.MaxBackoff
+	}
+	return &Relay{store: store, publisher: publisher, config: config, now: time.Now}
+}
+
+// Start polls the outbox in the background until Stop is called.
+func (r *Relay) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		ticker := time.NewTicker(r.config.PollInterval)
+		defer ticker.Stop()
+		for {
+			// Keep draining while full batches come back, then wait for the next tick.
+			for {
+				published, err := r.RunOnce(ctx)
+				if err != nil {
+					log.Printf("Warning: outbox relay failed: %v", err)
+				}
+				if err != nil || published < r.config.BatchSize || ctx.Err() != nil {
+					break
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop ends the polling loop and waits for the batch in flight.
+func (r *Relay) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	r.done.Wait()
+}
+
+// RunOnce publishes one batch of due messages and returns how many were attempted.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	messages, err := r.store.Pending(ctx, r.now(), r.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		r.deliver(ctx, message)
+	}
+	return len(messages), nil
+}
+
+func (r *Relay) deliver(ctx context.Context, message Message) {
+	publishErr := r.publisher.Publish(ctx, message.Integration())
+	if publishErr == nil {
+		if err := r.store.Delivered(ctx, message); err != nil {
+			log.Printf("Warning: outbox message %s published but not removed, it will be published again: %v", message.ID, err)
+		}
+		return
+	}
+
+	message.Attempts++
+	message.LastError = publishErr.Error()
+	if message.Attempts >= r.config.MaxAttempts {
+		message.Status = StatusDead
+		log.Printf("Error: giving up on outbox message %s (%s) after %d attempts: %v", message.ID, message.Type, message.Attempts, publishErr)
+	} else {
+		message.NextAttemptAt = r.now().Add(r.backoff(message.Attempts)).UnixMilli()
+	}
+	if err := r.store.Failed(ctx, message); err != nil {
+		log.Printf("Warning: failed to reschedule outbox message %s: %v", message.ID, err)
+	}
+}
+
+// backoff returns an exponential delay for the given attempt, jittered between half and the full value.
+func (r *Relay) backoff(attempt int) time.Duration {
+	delay := r.config.BaseBackoff << min(attempt-1, 30)
+	if delay <= 0 || delay > r.config.MaxBackoff {
+		delay = r.config.MaxBackoff
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
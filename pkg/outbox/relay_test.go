@@ -0,0 +1,158 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/messaging"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
+)
+
+type memoryStore struct {
+	mu        sync.Mutex
+	messages  map[string]outbox.Message
+	delivered []string
+}
+
+func newMemoryStore(messages ...outbox.Message) *memoryStore {
+	store := &memoryStore{messages: map[string]outbox.Message{}}
+	for _, message := range messages {
+		store.messages[message.ID] = message
+	}
+	return store
+}
+
+func (s *memoryStore) Pending(ctx context.Context, now time.Time, limit int) ([]outbox.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var pending []outbox.Message
+	for _, message := range s.messages {
+		if message.Status == outbox.StatusPending && message.NextAttemptAt <= now.UnixMilli() && len(pending) < limit {
+			pending = append(pending, message)
+		}
+	}
+	return pending, nil
+}
+
+func (s *memoryStore) Delivered(ctx context.Context, message outbox.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.messages, message.ID)
+	s.delivered = append(s.delivered, message.ID)
+	return nil
+}
+
+func (s *memoryStore) Failed(ctx context.Context, message outbox.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[message.ID] = message
+	return nil
+}
+
+func (s *memoryStore) get(id string) outbox.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages[id]
+}
+
+type recordingPublisher struct {
+	mu        sync.Mutex
+	err       error
+	published []messaging.Message
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, message messaging.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, message)
+	return nil
+}
+
+func (p *recordingPublisher) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.published)
+}
+
+func newMessage(t *testing.T) outbox.Message {
+	message, err := outbox.NewMessage("customer.registered", "customer-1", map[string]string{"customer_id": "customer-1"}, time.Now().Add(-time.Second))
+	assert.NoError(t, err)
+	return message
+}
+
+func TestRelay_RunOnce_ShouldPublishAndRemoveMessage(t *testing.T) {
+	// GIVEN a pending message
+	message := newMessage(t)
+	store := newMemoryStore(message)
+	publisher := &recordingPublisher{}
+	relay := outbox.NewRelay(store, publisher, outbox.RelayConfig{})
+
+	// WHEN the relay runs
+	count, err := relay.RunOnce(context.Background())
+
+	// THEN the message should be published with its ID, type and payload
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, message.ID, publisher.published[0].ID)
+	assert.Equal(t, "customer.registered", publisher.published[0].Type)
+	assert.Equal(t, "customer-1", publisher.published[0].Subject)
+	assert.JSONEq(t, `{"customer_id":"customer-1"}`, string(publisher.published[0].Data))
+	// AND removed from the outbox
+	assert.Equal(t, []string{message.ID}, store.delivered)
+}
+
+func TestRelay_RunOnce_WithPublishFailure_ShouldRescheduleWithBackoff(t *testing.T) {
+	// GIVEN a broker that is down
+	message := newMessage(t)
+	store := newMemoryStore(message)
+	relay := outbox.NewRelay(store, &recordingPublisher{err: errors.New("broker down")}, outbox.RelayConfig{BaseBackoff: time.Minute})
+
+	// WHEN the relay runs
+	_, err := relay.RunOnce(context.Background())
+
+	// THEN the message should stay pending with a later attempt
+	assert.NoError(t, err)
+	stored := store.get(message.ID)
+	assert.Equal(t, outbox.StatusPending, stored.Status)
+	assert.Equal(t, 1, stored.Attempts)
+	assert.Equal(t, "broker down", stored.LastError)
+	assert.Greater(t, stored.NextAttemptAt, time.Now().Add(29*time.Second).UnixMilli())
+	// AND it should not be retried before that
+	count, _ := relay.RunOnce(context.Background())
+	assert.Equal(t, 0, count)
+}
+
+func TestRelay_RunOnce_AfterMaxAttempts_ShouldMarkMessageDead(t *testing.T) {
+	// GIVEN a message that already failed once
+	message := newMessage(t)
+	message.Attempts = 1
+	store := newMemoryStore(message)
+	relay := outbox.NewRelay(store, &recordingPublisher{err: errors.New("broker down")}, outbox.RelayConfig{MaxAttempts: 2})
+
+	// WHEN it fails again
+	relay.RunOnce(context.Background())
+
+	// THEN it should be given up on
+	assert.Equal(t, outbox.StatusDead, store.get(message.ID).Status)
+}
+
+func TestRelay_Start_ShouldPublishInBackgroundUntilStopped(t *testing.T) {
+	// GIVEN a pending message and a running relay
+	store := newMemoryStore(newMessage(t))
+	publisher := &recordingPublisher{}
+	relay := outbox.NewRelay(store, publisher, outbox.RelayConfig{PollInterval: 10 * time.Millisecond})
+
+	// WHEN the relay is started
+	relay.Start()
+	defer relay.Stop()
+
+	// THEN the message should be published
+	assert.Eventually(t, func() bool { return publisher.count() == 1 }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+)
+
+// Policy configures a Limiter.
+type Policy struct {
+	// Name namespaces the buckets of this policy in the store.
+	Name string
+	// Limit applies to every request.
+	Limit Limit
+	// NotFoundLimit is charged for every 404 response and, once exhausted, blocks the
+	// client until it refills. A tight limit here makes enumerating identifiers slow
+	// without affecting clients that look up existing records. Its token is taken before
+	// the request is served and refunded for any other response, so concurrent requests
+	// cannot all pass while a single token is left.
+	NotFoundLimit Limit
+	// KeyFunc identifies the client. Defaults to ClientKey.
+	KeyFunc func(r *http.Request) string
+}
+
+// Limiter is an HTTP middleware enforcing a Policy.
+type Limiter struct {
+	store  Store
+	policy Policy
+}
+
+func NewLimiter(store Store, policy Policy) *Limiter {
+	if policy.KeyFunc == nil {
+		policy.KeyFunc = ClientKey
+	}
+	return &Limiter{store: store, policy: policy}
+}
+
+// Handler wraps next with the limiter. A nil Limiter lets every request through.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client := l.policy.KeyFunc(r)
+		notFoundKey := l.policy.Name + ":notfound:" + client
+
+		// charged tells whether a not-found token was taken and must be refunded unless the
+		// response is a 404.
+		charged := false
+		if l.policy.NotFoundLimit.Enabled() {
+			result, err := l.store.Take(notFoundKey, l.policy.NotFoundLimit)
+			if err != nil {
+				log.Printf("Warning: rate limit store failed: %v", err)
+			} else if !result.Allowed {
+				tooManyRequests(w, result)
+				return
+			} else {
+				charged = true
+			}
+		}
+
+		if l.policy.Limit.Enabled() {
+			result, err := l.store.Take(l.policy.Name+":"+client, l.policy.Limit)
+			if err != nil {
+				log.Printf("Warning: rate limit store failed: %v", err)
+			} else {
+				writeHeaders(w, result)
+				if !result.Allowed {
+					l.refund(notFoundKey, charged)
+					tooManyRequests(w, result)
+					return
+				}
+			}
+		}
+
+		if !charged {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		l.refund(notFoundKey, recorder.status != http.StatusNotFound)
+	})
+}
+
+// refund gives the not-found token back when it was charged for a request that did not
+// answer 404.
+func (l *Limiter) refund(notFoundKey string, refund bool) {
+	if !refund {
+		return
+	}
+	if err := l.store.Refund(notFoundKey, l.policy.NotFoundLimit); err != nil {
+		log.Printf("Warning: rate limit store failed: %v", err)
+	}
+}
+
+// ClientKey identifies the caller by the authenticated subject (token subject or
+// API key) and falls back to the client IP for anonymous requests.
+func ClientKey(r *http.Request) string {
+	if principal, ok := auth.PrincipalFromContext(r.Context()); ok && principal.Subject != "" {
+		return "sub:" + principal.Subject
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// writeHeaders sets the RateLimit-* fields of the IETF httpapi rate limit headers draft.
+func writeHeaders(w http.ResponseWriter, result Result) {
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+}
+
+func tooManyRequests(w http.ResponseWriter, result Result) {
+	writeHeaders(w, result)
+	w.Header().Set("Retry-After", strconv.Itoa(max(1, ceilSeconds(result.RetryAfter))))
+	http.Error(w, `{"error":"Too many requests"}`, http.StatusTooManyRequests)
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
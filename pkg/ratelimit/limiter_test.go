@@ -0,0 +1,171 @@
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/auth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/ratelimit"
+)
+
+func serve(handler http.Handler, principal *auth.Principal, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/v1/customer?cpf=12345678901", nil)
+	req.RemoteAddr = remoteAddr
+	if principal != nil {
+		req = req.WithContext(auth.ContextWithPrincipal(req.Context(), principal))
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func status(code int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(code)
+	})
+}
+
+func TestLimiter_ShouldRejectOverLimitWithHeaders(t *testing.T) {
+	// GIVEN a limit of 2 requests per minute
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:  "lookup",
+		Limit: ratelimit.Limit{Requests: 2, Period: time.Minute},
+	})
+	handler := limiter.Handler(status(http.StatusOK))
+
+	// WHEN the same client calls three times
+	first := serve(handler, nil, "10.0.0.1:1234")
+	serve(handler, nil, "10.0.0.1:1234")
+	third := serve(handler, nil, "10.0.0.1:5678")
+
+	// THEN the allowed responses should carry the RateLimit headers
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Remaining"))
+	// AND the third call should be rejected with Retry-After
+	assert.Equal(t, http.StatusTooManyRequests, third.Code)
+	assert.Equal(t, "0", third.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", third.Header().Get("Retry-After"))
+}
+
+func TestLimiter_ShouldKeySubjectsApartFromIP(t *testing.T) {
+	// GIVEN a single request per minute
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:  "lookup",
+		Limit: ratelimit.Limit{Requests: 1, Period: time.Minute},
+	})
+	handler := limiter.Handler(status(http.StatusOK))
+
+	// WHEN two API keys call from the same address
+	first := serve(handler, &auth.Principal{Subject: "apikey:a"}, "10.0.0.1:1234")
+	second := serve(handler, &auth.Principal{Subject: "apikey:b"}, "10.0.0.1:1234")
+
+	// THEN each should have its own bucket
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusOK, second.Code)
+}
+
+func TestLimiter_ShouldBlockAfterTooManyNotFound(t *testing.T) {
+	// GIVEN a generous request limit and a strict not-found limit
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:          "lookup",
+		Limit:         ratelimit.Limit{Requests: 100, Period: time.Minute},
+		NotFoundLimit: ratelimit.Limit{Requests: 2, Period: time.Minute},
+	})
+	principal := &auth.Principal{Subject: "kiosk-1"}
+	notFound := limiter.Handler(status(http.StatusNotFound))
+	found := limiter.Handler(status(http.StatusOK))
+
+	// WHEN the client keeps probing unknown CPFs
+	assert.Equal(t, http.StatusNotFound, serve(notFound, principal, "10.0.0.1:1").Code)
+	assert.Equal(t, http.StatusNotFound, serve(notFound, principal, "10.0.0.1:1").Code)
+
+	// THEN further lookups should be rejected, even for existing CPFs
+	blocked := serve(found, principal, "10.0.0.1:1")
+	assert.Equal(t, http.StatusTooManyRequests, blocked.Code)
+	assert.NotEmpty(t, blocked.Header().Get("Retry-After"))
+	// AND other clients should not be affected
+	assert.Equal(t, http.StatusOK, serve(found, &auth.Principal{Subject: "kiosk-2"}, "10.0.0.1:1").Code)
+}
+
+func TestLimiter_ShouldNotLetConcurrentProbesShareTheLastNotFoundToken(t *testing.T) {
+	// GIVEN a single not-found token and a lookup still being served
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:          "lookup",
+		Limit:         ratelimit.Limit{Requests: 100, Period: time.Minute},
+		NotFoundLimit: ratelimit.Limit{Requests: 1, Period: time.Minute},
+	})
+	principal := &auth.Principal{Subject: "kiosk-1"}
+	started, release := make(chan struct{}), make(chan struct{})
+	slow := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	done := make(chan int)
+	go func() { done <- serve(slow, principal, "10.0.0.1:1").Code }()
+	<-started
+
+	// WHEN another probe arrives meanwhile
+	concurrent := serve(limiter.Handler(status(http.StatusNotFound)), principal, "10.0.0.1:1")
+	close(release)
+
+	// THEN only the first should be served
+	assert.Equal(t, http.StatusTooManyRequests, concurrent.Code)
+	assert.Equal(t, http.StatusNotFound, <-done)
+}
+
+func TestLimiter_ShouldRefundTheNotFoundTokenOfFoundLookups(t *testing.T) {
+	// GIVEN a single not-found token
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Policy{
+		Name:          "lookup",
+		Limit:         ratelimit.Limit{Requests: 100, Period: time.Minute},
+		NotFoundLimit: ratelimit.Limit{Requests: 1, Period: time.Minute},
+	})
+	principal := &auth.Principal{Subject: "kiosk-1"}
+	found := limiter.Handler(status(http.StatusOK))
+
+	// WHEN the client looks up existing CPFs several times
+	for range 3 {
+		assert.Equal(t, http.StatusOK, serve(found, principal, "10.0.0.1:1").Code)
+	}
+
+	// THEN its not-found token should still be there
+	assert.Equal(t, http.StatusNotFound, serve(limiter.Handler(status(http.StatusNotFound)), principal, "10.0.0.1:1").Code)
+}
+
+func TestLimiter_Nil_ShouldNotLimit(t *testing.T) {
+	// GIVEN no limiter
+	var limiter *ratelimit.Limiter
+
+	// WHEN serving a request
+	w := serve(limiter.Handler(status(http.StatusOK)), nil, "10.0.0.1:1")
+
+	// THEN it should pass through
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("RateLimit-Limit"))
+}
+
+func TestLimitFromEnv(t *testing.T) {
+	// GIVEN an overridden request count
+	t.Setenv("TEST_LIMIT_REQUESTS", "5")
+	t.Setenv("TEST_LIMIT_PERIOD", "10s")
+
+	// WHEN reading the limit
+	limit, err := ratelimit.LimitFromEnv("TEST_LIMIT", ratelimit.Limit{Requests: 60, Period: time.Minute, Burst: 20})
+
+	// THEN the environment should win over the defaults
+	assert.NoError(t, err)
+	assert.Equal(t, ratelimit.Limit{Requests: 5, Period: 10 * time.Second, Burst: 20}, limit)
+}
+
+func TestLimitFromEnv_WithInvalidValue_ShouldFail(t *testing.T) {
+	t.Setenv("TEST_LIMIT_PERIOD", "soon")
+
+	_, err := ratelimit.LimitFromEnv("TEST_LIMIT", ratelimit.Limit{Requests: 60, Period: time.Minute})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+var (
+	_ Store = (*MemoryStore)(nil)
+)
+
+const defaultSweepInterval = time.Minute
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	capacity float64
+	rate     float64
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed*b.rate)
+	}
+	b.updated = now
+}
+
+// MemoryStore keeps buckets in process memory. Limits are per replica, so the
+// effective limit of a deployment is multiplied by its replica count.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return newMemoryStore(time.Now)
+}
+
+func newMemoryStore(now func() time.Time) *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*bucket), now: now, lastSweep: now()}
+}
+
+func (s *MemoryStore) Take(key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.bucket(key, limit)
+	result := Result{Limit: limit.capacity()}
+	if b.tokens >= 1 {
+		result.Allowed = true
+		b.tokens--
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / b.rate)
+	}
+	result.Remaining = int(math.Floor(b.tokens))
+	result.ResetAfter = seconds((b.capacity - b.tokens) / b.rate)
+	return result, nil
+}
+
+func (s *MemoryStore) Refund(key string, limit Limit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b := s.bucket(key, limit)
+	b.tokens = math.Min(b.capacity, b.tokens+1)
+	return nil
+}
+
+// bucket returns the refilled bucket of key, creating it full. It must be called with mu held.
+func (s *MemoryStore) bucket(key string, limit Limit) *bucket {
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.capacity()), updated: now}
+		s.buckets[key] = b
+	}
+	b.capacity = float64(limit.capacity())
+	b.rate = limit.rate()
+	b.refill(now)
+	return b
+}
+
+// sweep drops buckets that have refilled completely, since they hold no state
+// a fresh bucket would not. It runs at most once per sweep interval.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < defaultSweepInterval {
+		return
+	}
+	for key, b := range s.buckets {
+		b.refill(now)
+		if b.tokens >= b.capacity {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
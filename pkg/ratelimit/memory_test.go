@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestMemoryStore_Take_ShouldAllowBurstThenRefill(t *testing.T) {
+	// GIVEN a bucket of 2 tokens refilled every second
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	store := newMemoryStore(clock.Now)
+	limit := Limit{Requests: 2, Period: 2 * time.Second}
+
+	// WHEN the burst is consumed
+	first, _ := store.Take("client", limit)
+	second, _ := store.Take("client", limit)
+	third, _ := store.Take("client", limit)
+
+	// THEN only the burst should be allowed
+	assert.True(t, first.Allowed)
+	assert.Equal(t, 1, first.Remaining)
+	assert.True(t, second.Allowed)
+	assert.False(t, third.Allowed)
+	assert.Equal(t, time.Second, third.RetryAfter)
+	assert.Equal(t, 2*time.Second, third.ResetAfter)
+
+	// AND a token should be available once it refills
+	clock.Advance(time.Second)
+	fourth, _ := store.Take("client", limit)
+	assert.True(t, fourth.Allowed)
+}
+
+func TestMemoryStore_Take_ShouldKeepClientsApart(t *testing.T) {
+	// GIVEN a single token bucket
+	store := newMemoryStore((&fakeClock{now: time.Unix(0, 0)}).Now)
+	limit := Limit{Requests: 1, Period: time.Minute}
+
+	// WHEN two clients take a token
+	a, _ := store.Take("a", limit)
+	b, _ := store.Take("b", limit)
+
+	// THEN both should be allowed
+	assert.True(t, a.Allowed)
+	assert.True(t, b.Allowed)
+}
+
+func TestMemoryStore_Refund_ShouldGiveBackTheTokenUpToTheCapacity(t *testing.T) {
+	// GIVEN a single token bucket whose token was taken
+	store := newMemoryStore((&fakeClock{now: time.Unix(0, 0)}).Now)
+	limit := Limit{Requests: 1, Period: time.Minute}
+	store.Take("client", limit)
+
+	// WHEN refunding it twice
+	store.Refund("client", limit)
+	store.Refund("client", limit)
+
+	// THEN a single token should be available again
+	take, _ := store.Take("client", limit)
+	assert.True(t, take.Allowed)
+	take, _ = store.Take("client", limit)
+	assert.False(t, take.Allowed)
+}
+
+func TestMemoryStore_Sweep_ShouldDropRefilledBuckets(t *testing.T) {
+	// GIVEN a used bucket
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	store := newMemoryStore(clock.Now)
+	limit := Limit{Requests: 10, Period: time.Second}
+	store.Take("idle", limit)
+
+	// WHEN the bucket has refilled and a sweep runs
+	clock.Advance(2 * time.Minute)
+	store.Take("active", limit)
+
+	// THEN the idle bucket should be forgotten
+	assert.NotContains(t, store.buckets, "idle")
+	assert.Contains(t, store.buckets, "active")
+}
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Limit describes a token bucket: Requests tokens are refilled every Period and
+// at most Burst tokens are kept. Burst defaults to Requests.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// Enabled reports whether the limit is configured. A zero Limit disables limiting.
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+func (l Limit) capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// rate returns the refill rate in tokens per second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Result is the state of a bucket after a Take.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter is the time until the bucket is full again.
+	ResetAfter time.Duration
+	// RetryAfter is the time until the next token is available when the request was not allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps the token buckets. Implementations must apply Take and Refund atomically so
+// limits hold across concurrent requests and, for shared stores, across replicas.
+type Store interface {
+	// Take consumes a token from the bucket identified by key.
+	Take(key string, limit Limit) (Result, error)
+	// Refund gives back a token taken from the bucket identified by key, up to its capacity.
+	Refund(key string, limit Limit) error
+}
+
+// LimitFromEnv reads <prefix>_REQUESTS, <prefix>_PERIOD and <prefix>_BURST, falling back to defaultLimit.
+// Setting <prefix>_REQUESTS to 0 disables the limit.
+func LimitFromEnv(prefix string, defaultLimit Limit) (Limit, error) {
+	limit := defaultLimit
+
+	if value := os.Getenv(prefix + "_REQUESTS"); value != "" {
+		requests, err := strconv.Atoi(value)
+		if err != nil || requests < 0 {
+			return Limit{}, fmt.Errorf("invalid %s_REQUESTS: %q", prefix, value)
+		}
+		limit.Requests = requests
+	}
+	if value := os.Getenv(prefix + "_PERIOD"); value != "" {
+		period, err := time.ParseDuration(value)
+		if err != nil || period <= 0 {
+			return Limit{}, fmt.Errorf("invalid %s_PERIOD: %q", prefix, value)
+		}
+		limit.Period = period
+	}
+	if value := os.Getenv(prefix + "_BURST"); value != "" {
+		burst, err := strconv.Atoi(value)
+		if err != nil || burst < 0 {
+			return Limit{}, fmt.Errorf("invalid %s_BURST: %q", prefix, value)
+		}
+		limit.Burst = burst
+	}
+
+	if limit.Requests > 0 && limit.Period <= 0 {
+		return Limit{}, errors.New("rate limit period must be positive")
+	}
+	return limit, nil
+}
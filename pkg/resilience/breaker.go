@@ -0,0 +1,129 @@
+package resilience
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker.
+type State string
+
+const (
+	// StateClosed lets every operation through.
+	StateClosed State = "closed"
+	// StateOpen fails every operation fast until the breaker has been open for long enough.
+	StateOpen State = "open"
+	// StateHalfOpen lets a single operation through, whose outcome closes or opens the breaker again.
+	StateHalfOpen State = "half_open"
+)
+
+// Breaker counts consecutive failed operations and opens after too many of them, so callers stop
+// piling up on a dependency that is down and it gets time to recover.
+type Breaker struct {
+	name      string
+	threshold int
+	openFor   time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	// transitions counts the changes of state, by "from_to" name, e.g. "closed_to_open".
+	transitions map[string]int64
+}
+
+func NewBreaker(name string, threshold int, openFor time.Duration) *Breaker {
+	return newBreaker(name, threshold, openFor, time.Now)
+}
+
+func newBreaker(name string, threshold int, openFor time.Duration, now func() time.Time) *Breaker {
+	return &Breaker{name: name, threshold: threshold, openFor: openFor, now: now, state: StateClosed, transitions: make(map[string]int64)}
+}
+
+// Allow reports whether an operation may go through and, when it may not, how long until the
+// breaker lets one through again. Every allowed operation must be followed by Record.
+func (b *Breaker) Allow() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		elapsed := b.now().Sub(b.openedAt)
+		if elapsed < b.openFor {
+			return false, b.openFor - elapsed
+		}
+		b.transition(StateHalfOpen)
+		b.probing = true
+		return true, 0
+	case StateHalfOpen:
+		// A probe is already in flight; the others wait for its outcome.
+		if b.probing {
+			return false, b.openFor
+		}
+		b.probing = true
+		return true, 0
+	}
+	return true, 0
+}
+
+// Record takes the outcome of an allowed operation. Only failures that say the dependency is
+// unhealthy count; an operation rejected for its own input proves the dependency answers.
+func (b *Breaker) Record(healthy bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == StateHalfOpen {
+		b.probing = false
+		if healthy {
+			b.failures = 0
+			b.transition(StateClosed)
+		} else {
+			b.open()
+		}
+		return
+	}
+
+	if healthy {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == StateClosed && b.failures >= b.threshold {
+		b.open()
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Transitions returns how many times the breaker changed from one state to another.
+func (b *Breaker) Transitions() map[string]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	transitions := make(map[string]int64, len(b.transitions))
+	for name, count := range b.transitions {
+		transitions[name] = count
+	}
+	return transitions
+}
+
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.transition(StateOpen)
+}
+
+func (b *Breaker) transition(to State) {
+	if b.state == to {
+		return
+	}
+	log.Printf("Warning: %s circuit breaker changed from %s to %s\n", b.name, b.state, to)
+	b.transitions[string(b.state)+"_to_"+string(to)]++
+	b.state = to
+}
@@ -0,0 +1,95 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestBreaker_ShouldOpenAfterConsecutiveFailures(t *testing.T) {
+	// GIVEN a breaker opening after 3 failures for 10 seconds
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newBreaker("dynamodb", 3, 10*time.Second, clock.Now)
+
+	// WHEN two failures are followed by a success and then three failures
+	for _, healthy := range []bool{false, false, true, false, false, false} {
+		allowed, _ := breaker.Allow()
+		assert.True(t, allowed)
+		breaker.Record(healthy)
+	}
+
+	// THEN only the three consecutive failures should open it
+	clock.Advance(4 * time.Second)
+	allowed, retryAfter := breaker.Allow()
+	assert.False(t, allowed)
+	assert.Equal(t, 6*time.Second, retryAfter)
+	assert.Equal(t, StateOpen, breaker.State())
+	assert.Equal(t, map[string]int64{"closed_to_open": 1}, breaker.Transitions())
+}
+
+func TestBreaker_ShouldLetSingleProbeThroughOnceOpenForLongEnough(t *testing.T) {
+	// GIVEN an open breaker
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newBreaker("dynamodb", 1, 10*time.Second, clock.Now)
+	breaker.Allow()
+	breaker.Record(false)
+
+	// WHEN it has been open for long enough
+	clock.Advance(10 * time.Second)
+	probe, _ := breaker.Allow()
+	other, _ := breaker.Allow()
+
+	// THEN a single operation should probe the dependency
+	assert.True(t, probe)
+	assert.False(t, other)
+	assert.Equal(t, StateHalfOpen, breaker.State())
+}
+
+func TestBreaker_ShouldCloseWhenProbeSucceeds(t *testing.T) {
+	// GIVEN a half open breaker
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newBreaker("dynamodb", 1, 10*time.Second, clock.Now)
+	breaker.Allow()
+	breaker.Record(false)
+	clock.Advance(10 * time.Second)
+	breaker.Allow()
+
+	// WHEN the probe succeeds
+	breaker.Record(true)
+
+	// THEN every operation should go through again
+	allowed, _ := breaker.Allow()
+	assert.True(t, allowed)
+	assert.Equal(t, StateClosed, breaker.State())
+	assert.Equal(t, map[string]int64{"closed_to_open": 1, "open_to_half_open": 1, "half_open_to_closed": 1}, breaker.Transitions())
+}
+
+func TestBreaker_ShouldOpenAgainWhenProbeFails(t *testing.T) {
+	// GIVEN a half open breaker
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	breaker := newBreaker("dynamodb", 5, 10*time.Second, clock.Now)
+	for range 5 {
+		breaker.Allow()
+		breaker.Record(false)
+	}
+	clock.Advance(10 * time.Second)
+	breaker.Allow()
+
+	// WHEN the probe fails
+	breaker.Record(false)
+
+	// THEN the breaker should stay open for another period, without waiting for the threshold
+	allowed, retryAfter := breaker.Allow()
+	assert.False(t, allowed)
+	assert.Equal(t, 10*time.Second, retryAfter)
+	assert.Equal(t, int64(1), breaker.Transitions()["half_open_to_open"])
+}
@@ -0,0 +1,114 @@
+package resilience
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Config decides how long each attempt of an operation may take, how failed attempts are retried
+// and when the circuit breaker opens.
+type Config struct {
+	// Timeout bounds each attempt, unless Timeouts has one for the operation.
+	Timeout  time.Duration
+	Timeouts map[string]time.Duration
+	// MaxAttempts counts the first attempt, so 1 disables retries.
+	MaxAttempts int
+	// Retries wait a random time up to BaseBackoff doubled for every attempt made, capped at MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// The breaker opens after FailureThreshold consecutive failed operations and, after OpenFor,
+	// lets a single operation through to probe whether the dependency recovered.
+	FailureThreshold int
+	OpenFor          time.Duration
+}
+
+// DefaultConfig gives every attempt 2 seconds, makes three of them, and opens the breaker after 5
+// operations in a row failed, for 10 seconds.
+var DefaultConfig = Config{
+	Timeout:          2 * time.Second,
+	MaxAttempts:      3,
+	BaseBackoff:      50 * time.Millisecond,
+	MaxBackoff:       time.Second,
+	FailureThreshold: 5,
+	OpenFor:          10 * time.Second,
+}
+
+// TimeoutOf returns the timeout of each attempt of operation.
+func (c Config) TimeoutOf(operation string) time.Duration {
+	if timeout, ok := c.Timeouts[operation]; ok {
+		return timeout
+	}
+	return c.Timeout
+}
+
+// ConfigFromEnv overrides defaultConfig with <prefix>_TIMEOUT, <prefix>_MAX_ATTEMPTS,
+// <prefix>_BASE_BACKOFF, <prefix>_MAX_BACKOFF, <prefix>_BREAKER_THRESHOLD and
+// <prefix>_BREAKER_OPEN_FOR. The timeout of an operation is overridden by <prefix>_TIMEOUT_ followed
+// by its name in upper snake case, e.g. DYNAMODB_TIMEOUT_GET_ITEM for GetItem, for the operations
+// listed.
+func ConfigFromEnv(prefix string, defaultConfig Config, operations ...string) (Config, error) {
+	config := defaultConfig
+	config.Timeouts = make(map[string]time.Duration, len(defaultConfig.Timeouts))
+	for operation, timeout := range defaultConfig.Timeouts {
+		config.Timeouts[operation] = timeout
+	}
+
+	durations := map[string]*time.Duration{
+		prefix + "_TIMEOUT":          &config.Timeout,
+		prefix + "_BASE_BACKOFF":     &config.BaseBackoff,
+		prefix + "_MAX_BACKOFF":      &config.MaxBackoff,
+		prefix + "_BREAKER_OPEN_FOR": &config.OpenFor,
+	}
+	for name, target := range durations {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed <= 0 {
+				return Config{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	counts := map[string]*int{
+		prefix + "_MAX_ATTEMPTS":      &config.MaxAttempts,
+		prefix + "_BREAKER_THRESHOLD": &config.FailureThreshold,
+	}
+	for name, target := range counts {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 {
+				return Config{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+			*target = parsed
+		}
+	}
+
+	for _, operation := range operations {
+		name := prefix + "_TIMEOUT_" + upperSnake(operation)
+		if value := os.Getenv(name); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil || parsed <= 0 {
+				return Config{}, fmt.Errorf("invalid %s: %q", name, value)
+			}
+			config.Timeouts[operation] = parsed
+		}
+	}
+
+	return config, nil
+}
+
+// upperSnake turns GetItem into GET_ITEM.
+func upperSnake(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			builder.WriteByte('_')
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+	return builder.String()
+}
@@ -0,0 +1,57 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFromEnv_WithoutVariables_ShouldReturnDefaults(t *testing.T) {
+	// WHEN reading the configuration without variables
+	config, err := ConfigFromEnv("TEST_RESILIENCE", DefaultConfig, "GetItem", "Scan")
+
+	// THEN the defaults should be kept
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultConfig.Timeout, config.Timeout)
+	assert.Empty(t, config.Timeouts)
+	assert.Equal(t, DefaultConfig.MaxAttempts, config.MaxAttempts)
+	assert.Equal(t, DefaultConfig.OpenFor, config.OpenFor)
+}
+
+func TestConfigFromEnv_ShouldOverrideDefaultsAndOperationTimeouts(t *testing.T) {
+	// GIVEN variables for the retries, the breaker and the timeout of GetItem
+	t.Setenv("TEST_RESILIENCE_TIMEOUT", "1s")
+	t.Setenv("TEST_RESILIENCE_TIMEOUT_GET_ITEM", "300ms")
+	t.Setenv("TEST_RESILIENCE_MAX_ATTEMPTS", "5")
+	t.Setenv("TEST_RESILIENCE_BREAKER_THRESHOLD", "10")
+	t.Setenv("TEST_RESILIENCE_BREAKER_OPEN_FOR", "30s")
+
+	// WHEN reading the configuration
+	defaults := DefaultConfig
+	defaults.Timeouts = map[string]time.Duration{"Scan": 10 * time.Second}
+	config, err := ConfigFromEnv("TEST_RESILIENCE", defaults, "GetItem", "Scan")
+
+	// THEN they should replace the defaults, leaving the other operations alone
+	assert.NoError(t, err)
+	assert.Equal(t, 300*time.Millisecond, config.TimeoutOf("GetItem"))
+	assert.Equal(t, time.Second, config.TimeoutOf("Query"))
+	assert.Equal(t, 10*time.Second, config.TimeoutOf("Scan"))
+	assert.Equal(t, 5, config.MaxAttempts)
+	assert.Equal(t, 10, config.FailureThreshold)
+	assert.Equal(t, 30*time.Second, config.OpenFor)
+	// AND the defaults should not be changed
+	assert.Equal(t, 10*time.Second, defaults.TimeoutOf("Scan"))
+	assert.NotContains(t, defaults.Timeouts, "GetItem")
+}
+
+func TestConfigFromEnv_WithInvalidValue_ShouldFail(t *testing.T) {
+	// GIVEN an operation timeout that is not a duration
+	t.Setenv("TEST_RESILIENCE_TIMEOUT_SCAN", "ten")
+
+	// WHEN reading the configuration
+	_, err := ConfigFromEnv("TEST_RESILIENCE", DefaultConfig, "Scan")
+
+	// THEN it should name the variable
+	assert.EqualError(t, err, `invalid TEST_RESILIENCE_TIMEOUT_SCAN: "ten"`)
+}
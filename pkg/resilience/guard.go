@@ -0,0 +1,167 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+// ErrUnavailable is matched by the errors returned while the breaker fails operations fast.
+var ErrUnavailable = errors.New("dependency unavailable")
+
+// UnavailableError is returned instead of calling a dependency the breaker considers unhealthy.
+type UnavailableError struct {
+	Name       string
+	RetryAfter time.Duration
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s unavailable, retry after %s", e.Name, e.RetryAfter)
+}
+
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+// Classifier tells whether a failed attempt may succeed when retried, as throttling and transient
+// failures do. Only those count against the breaker.
+type Classifier func(err error) bool
+
+// Guard runs the operations on a dependency with a timeout for each attempt, retries the ones
+// that fail transiently after a jittered exponential backoff, and fails fast while the breaker is
+// open.
+type Guard struct {
+	name      string
+	config    Config
+	retryable Classifier
+	breaker   *Breaker
+	sleep     func(ctx context.Context, d time.Duration) error
+	jitter    func(n int64) int64
+
+	operations atomic.Int64
+	retries    atomic.Int64
+	timeouts   atomic.Int64
+	failures   atomic.Int64
+	rejected   atomic.Int64
+}
+
+func NewGuard(name string, config Config, retryable Classifier) *Guard {
+	return &Guard{
+		name:      name,
+		config:    config,
+		retryable: retryable,
+		breaker:   NewBreaker(name, config.FailureThreshold, config.OpenFor),
+		sleep:     sleep,
+		jitter:    rand.Int64N,
+	}
+}
+
+// Do runs call until it succeeds, fails with an error that is not retryable or runs out of
+// attempts. Each attempt gets a context bounded by the timeout of operation; an attempt that runs
+// out of time is retried like a transient failure, while ctx ending stops the retries.
+func (g *Guard) Do(ctx context.Context, operation string, call func(ctx context.Context) error) error {
+	allowed, retryAfter := g.breaker.Allow()
+	if !allowed {
+		g.rejected.Add(1)
+		return &UnavailableError{Name: g.name, RetryAfter: retryAfter}
+	}
+	g.operations.Add(1)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = g.attempt(ctx, operation, call)
+		if err == nil || !g.isRetryable(ctx, err) || attempt >= g.config.MaxAttempts {
+			break
+		}
+		g.retries.Add(1)
+		if sleepErr := g.sleep(ctx, g.backoff(attempt)); sleepErr != nil {
+			break
+		}
+	}
+
+	healthy := err == nil || !g.isRetryable(ctx, err)
+	if !healthy {
+		g.failures.Add(1)
+	}
+	g.breaker.Record(healthy)
+	return err
+}
+
+func (g *Guard) attempt(ctx context.Context, operation string, call func(ctx context.Context) error) error {
+	attemptCtx, cancel := context.WithTimeout(ctx, g.config.TimeoutOf(operation))
+	defer cancel()
+
+	err := call(attemptCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		g.timeouts.Add(1)
+		return &timeoutError{operation: operation, err: err}
+	}
+	return err
+}
+
+// isRetryable leaves the failures caused by the caller giving up out, as they say nothing about
+// the dependency.
+func (g *Guard) isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var timeout *timeoutError
+	return errors.As(err, &timeout) || g.retryable(err)
+}
+
+// backoff waits a random time up to BaseBackoff doubled for every attempt made, capped at MaxBackoff.
+func (g *Guard) backoff(attempt int) time.Duration {
+	ceiling := g.config.MaxBackoff
+	if shift := attempt - 1; shift < 32 {
+		if exponential := g.config.BaseBackoff << shift; exponential > 0 && exponential < ceiling {
+			ceiling = exponential
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(g.jitter(int64(ceiling)))
+}
+
+// Metrics counts the operations run, the retries, the attempts that ran out of time, the
+// operations that failed as the dependency was unhealthy and the ones rejected by the open
+// breaker, along with its state and every change of state it went through.
+func (g *Guard) Metrics() map[string]any {
+	return map[string]any{
+		"operations":  g.operations.Load(),
+		"retries":     g.retries.Load(),
+		"timeouts":    g.timeouts.Load(),
+		"failures":    g.failures.Load(),
+		"rejected":    g.rejected.Load(),
+		"state":       g.breaker.State(),
+		"transitions": g.breaker.Transitions(),
+	}
+}
+
+// timeoutError marks an attempt that ran out of the time given to the operation.
+type timeoutError struct {
+	operation string
+	err       error
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("%s timed out: %v", e.operation, e.err)
+}
+
+func (e *timeoutError) Unwrap() error {
+	return e.err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,142 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	errThrottled = errors.New("throttled")
+	errInvalid   = errors.New("invalid input")
+)
+
+func newTestGuard(config Config) (*Guard, *[]time.Duration) {
+	guard := NewGuard("dynamodb", config, func(err error) bool { return errors.Is(err, errThrottled) })
+	slept := &[]time.Duration{}
+	guard.sleep = func(ctx context.Context, d time.Duration) error {
+		*slept = append(*slept, d)
+		return ctx.Err()
+	}
+	// Waiting the whole ceiling makes the backoff predictable.
+	guard.jitter = func(n int64) int64 { return n }
+	return guard, slept
+}
+
+var testConfig = Config{
+	Timeout:          time.Second,
+	MaxAttempts:      4,
+	BaseBackoff:      100 * time.Millisecond,
+	MaxBackoff:       250 * time.Millisecond,
+	FailureThreshold: 2,
+	OpenFor:          10 * time.Second,
+}
+
+func TestGuard_Do_ShouldRetryThrottlingWithCappedExponentialBackoff(t *testing.T) {
+	// GIVEN an operation throttled three times before succeeding
+	guard, slept := newTestGuard(testConfig)
+	calls := 0
+
+	// WHEN running it
+	err := guard.Do(context.Background(), "GetItem", func(ctx context.Context) error {
+		calls++
+		if calls < 4 {
+			return errThrottled
+		}
+		return nil
+	})
+
+	// THEN it should succeed after backing off exponentially up to the cap
+	assert.NoError(t, err)
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 250 * time.Millisecond}, *slept)
+	assert.Equal(t, int64(3), guard.Metrics()["retries"])
+}
+
+func TestGuard_Do_ShouldNotRetryErrorsThatAreNotRetryable(t *testing.T) {
+	// GIVEN an operation rejected for its input
+	guard, _ := newTestGuard(testConfig)
+	calls := 0
+
+	// WHEN running it
+	err := guard.Do(context.Background(), "PutItem", func(ctx context.Context) error {
+		calls++
+		return errInvalid
+	})
+
+	// THEN it should fail at once without counting against the breaker
+	assert.ErrorIs(t, err, errInvalid)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, int64(0), guard.Metrics()["failures"])
+}
+
+func TestGuard_Do_ShouldRetryAttemptsThatRunOutOfTime(t *testing.T) {
+	// GIVEN scans given 10ms and a first attempt that hangs
+	config := testConfig
+	config.Timeouts = map[string]time.Duration{"Scan": 10 * time.Millisecond}
+	guard, _ := newTestGuard(config)
+	calls := 0
+
+	// WHEN running a scan
+	err := guard.Do(context.Background(), "Scan", func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+
+	// THEN the second attempt should get its own time and succeed
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, int64(1), guard.Metrics()["timeouts"])
+}
+
+func TestGuard_Do_ShouldStopRetryingWhenCallerGivesUp(t *testing.T) {
+	// GIVEN a caller that gives up after the first attempt
+	guard, _ := newTestGuard(testConfig)
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+
+	// WHEN the operation is throttled
+	err := guard.Do(ctx, "Query", func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errThrottled
+	})
+
+	// THEN it should not be retried nor count against the breaker
+	assert.ErrorIs(t, err, errThrottled)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, int64(0), guard.Metrics()["failures"])
+}
+
+func TestGuard_Do_ShouldFailFastWhileDependencyIsUnhealthy(t *testing.T) {
+	// GIVEN two operations that ran out of attempts
+	guard, _ := newTestGuard(testConfig)
+	for range 2 {
+		guard.Do(context.Background(), "GetItem", func(ctx context.Context) error { return errThrottled })
+	}
+
+	// WHEN running another one
+	called := false
+	err := guard.Do(context.Background(), "GetItem", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+
+	// THEN it should be rejected without calling the dependency, telling when to retry
+	var unavailable *UnavailableError
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.ErrorAs(t, err, &unavailable)
+	assert.False(t, called)
+	assert.Greater(t, unavailable.RetryAfter, 9*time.Second)
+	metrics := guard.Metrics()
+	assert.Equal(t, int64(2), metrics["failures"])
+	assert.Equal(t, int64(1), metrics["rejected"])
+	assert.Equal(t, StateOpen, metrics["state"])
+}
@@ -0,0 +1,23 @@
+package rest
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/resilience"
+)
+
+// WriteError answers a request that failed for a reason the client cannot fix. While a dependency
+// is unavailable it answers 503 with Retry-After, so clients back off instead of retrying at once,
+// and 500 otherwise.
+func WriteError(w http.ResponseWriter, err error) {
+	var unavailable *resilience.UnavailableError
+	if errors.As(err, &unavailable) {
+		w.Header().Set("Retry-After", strconv.Itoa(max(1, int(math.Ceil(unavailable.RetryAfter.Seconds())))))
+		http.Error(w, `{"error":"Service temporarily unavailable"}`, http.StatusServiceUnavailable)
+		return
+	}
+	http.Error(w, `{"error":"Error processing request"}`, http.StatusInternalServerError)
+}
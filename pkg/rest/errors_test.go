@@ -0,0 +1,38 @@
+package rest_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/resilience"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/rest"
+)
+
+func TestWriteError_WithUnavailableDependency_ShouldAnswerServiceUnavailable(t *testing.T) {
+	// GIVEN an error wrapping an unavailable dependency
+	err := fmt.Errorf("failed to get customer: %w", &resilience.UnavailableError{Name: "dynamodb", RetryAfter: 2500 * time.Millisecond})
+
+	// WHEN writing it
+	w := httptest.NewRecorder()
+	rest.WriteError(w, err)
+
+	// THEN the client should be told when to retry
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "3", w.Header().Get("Retry-After"))
+}
+
+func TestWriteError_WithOtherError_ShouldAnswerInternalServerError(t *testing.T) {
+	// WHEN writing an unexpected error
+	w := httptest.NewRecorder()
+	rest.WriteError(w, errors.New("boom"))
+
+	// THEN it should be a 500 without Retry-After
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"error":"Error processing request"}`, w.Body.String())
+}
@@ -0,0 +1,18 @@
+package dynamodb
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// String builds a string attribute value.
+func String(value string) types.AttributeValue {
+	return &types.AttributeValueMemberS{Value: value}
+}
+
+// StringValue returns the string held by av, or "" when av is missing or holds no string.
+func StringValue(av types.AttributeValue) string {
+	if s, ok := av.(*types.AttributeValueMemberS); ok {
+		return s.Value
+	}
+	return ""
+}
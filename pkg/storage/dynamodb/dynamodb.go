@@ -1,116 +1,463 @@
 package dynamodb
 
 import (
+	"context"
 	"log"
 	"os"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/awsauth"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/metrics"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/outbox"
 )
 
 // Table names constants
 const (
 	DefaultCustomerTableName = "tc-fiap-production-customer"
+	DefaultAPIKeyTableName   = "tc-fiap-production-customer-api-keys"
+	DefaultOutboxTableName   = "tc-fiap-production-customer-outbox"
+	// DefaultOrderHistoryTableName holds the order history projection built from order events.
+	DefaultOrderHistoryTableName = "tc-fiap-production-customer-order-history"
+	// DefaultLoyaltyTableName holds the loyalty points ledger and balances.
+	DefaultLoyaltyTableName = "tc-fiap-production-customer-loyalty"
+	// DefaultConsentTableName holds the current consents and the consent history of the customers.
+	DefaultConsentTableName = "tc-fiap-production-customer-consent"
+	// DefaultAuditTableName holds the append-only audit log of the customer reads and writes.
+	DefaultAuditTableName = "tc-fiap-production-customer-audit"
+	// DefaultCustomerHistoryTableName holds a snapshot of every revision of each customer.
+	DefaultCustomerHistoryTableName = "tc-fiap-production-customer-history"
+	// CustomerIDIndexName is the global secondary index used to look customers up by ID.
+	CustomerIDIndexName = "id-index"
+	// CustomerEmailIndexName is the global secondary index of the email blind indexes.
+	CustomerEmailIndexName = "email-index"
+	// ConsentOptedInIndexName is the sparse global secondary index of the customers consenting to each purpose.
+	ConsentOptedInIndexName = "opted-in-index"
+	// AuditCustomerIndexName is the global secondary index of the audit entries by customer, sorted chronologically.
+	AuditCustomerIndexName = "customer-index"
+	// AuditActorIndexName is the global secondary index of the audit entries by actor, sorted chronologically.
+	AuditActorIndexName = "actor-index"
 )
 
-var CustomerTableName = getTableName()
+var (
+	CustomerTableName        = getTableName("DYNAMODB_TABLE_NAME", DefaultCustomerTableName)
+	APIKeyTableName          = getTableName("DYNAMODB_API_KEY_TABLE_NAME", DefaultAPIKeyTableName)
+	OutboxTableName          = getTableName("DYNAMODB_OUTBOX_TABLE_NAME", DefaultOutboxTableName)
+	OrderHistoryTableName    = getTableName("DYNAMODB_ORDER_HISTORY_TABLE_NAME", DefaultOrderHistoryTableName)
+	LoyaltyTableName         = getTableName("DYNAMODB_LOYALTY_TABLE_NAME", DefaultLoyaltyTableName)
+	ConsentTableName         = getTableName("DYNAMODB_CONSENT_TABLE_NAME", DefaultConsentTableName)
+	AuditTableName           = getTableName("DYNAMODB_AUDIT_TABLE_NAME", DefaultAuditTableName)
+	CustomerHistoryTableName = getTableName("DYNAMODB_CUSTOMER_HISTORY_TABLE_NAME", DefaultCustomerHistoryTableName)
+)
 
-func getTableName() string {
-	tableName := os.Getenv("DYNAMODB_TABLE_NAME")
+func getTableName(env string, defaultName string) string {
+	tableName := os.Getenv(env)
 	if tableName == "" {
-		return DefaultCustomerTableName
+		return defaultName
 	}
 	return tableName
 }
 
-// NewDynamoDBClient creates and returns a new DynamoDB client
-func NewDynamoDBClient() dynamodbiface.DynamoDBAPI {
-	// Get AWS configuration from environment variables
-	region := os.Getenv("AWS_REGION")
-	if region == "" {
-		region = "us-east-1" // Default region
-	}
-
-	endpoint := os.Getenv("DYNAMODB_ENDPOINT")
-	awsAccessKey := os.Getenv("AWS_ACCESS_KEY_ID")
-	awsSecretKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	awsSessionToken := os.Getenv("AWS_SESSION_TOKEN")
+// Client is the part of the DynamoDB API the repositories use. *dynamodb.Client and
+// ResilientClient implement it.
+type Client interface {
+	GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error)
+	PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error)
+	UpdateItem(ctx context.Context, input *dynamodb.UpdateItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error)
+	DeleteItem(ctx context.Context, input *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
+	Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error)
+	Scan(ctx context.Context, input *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
+	BatchGetItem(ctx context.Context, input *dynamodb.BatchGetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchGetItemOutput, error)
+	BatchWriteItem(ctx context.Context, input *dynamodb.BatchWriteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchWriteItemOutput, error)
+	TransactGetItems(ctx context.Context, input *dynamodb.TransactGetItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactGetItemsOutput, error)
+	TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error)
+}
 
-	// Configure AWS session
-	awsConfig := &aws.Config{
-		Region: aws.String(region),
+// NewDynamoDBClient creates and returns a new DynamoDB client, whose calls go through a
+// ResilientClient configured by ResilienceConfigFromEnv. The tables are left to the Provisioner.
+func NewDynamoDBClient(awsConfig aws.Config) (Client, error) {
+	resilienceConfig, err := ResilienceConfigFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
-	// If running locally or with custom endpoint (e.g., DynamoDB Local)
-	if endpoint != "" {
-		awsConfig.Endpoint = aws.String(endpoint)
+	// The guard retries the calls of the repositories, so the SDK sends each attempt only once
+	svc := newClient(awsConfig, func(o *dynamodb.Options) {
+		o.Retryer = aws.NopRetryer{}
+	})
+	guard := NewGuard(resilienceConfig)
+	metrics.Publish("dynamodb", func() any { return guard.Metrics() })
 
-		// For local development, use dummy credentials
-		if awsAccessKey == "" || awsSecretKey == "" {
-			awsConfig.Credentials = credentials.NewStaticCredentials("dummy", "dummy", "")
-		}
-	}
+	log.Println("DynamoDB client initialized successfully")
 
-	// If credentials are provided, use them
-	if awsAccessKey != "" && awsSecretKey != "" {
-		awsConfig.Credentials = credentials.NewStaticCredentials(awsAccessKey, awsSecretKey, awsSessionToken)
-	}
+	return NewResilientClient(svc, guard), nil
+}
 
-	// Create AWS session
-	sess, err := session.NewSession(awsConfig)
+// Migrate creates the tables that do not exist yet and waits until every table is active,
+// whatever DYNAMODB_PROVISIONING says, failing when any of them could not be provisioned.
+func Migrate() error {
+	provisioningConfig, err := ProvisioningConfigFromEnv()
 	if err != nil {
-		log.Fatalf("Failed to create AWS session: %v", err)
+		return err
 	}
+	provisioningConfig.Mode = ProvisioningCreateIfMissing
 
-	// Create DynamoDB client
-	svc := dynamodb.New(sess)
+	awsConfig, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+	return NewProvisioner(newClient(awsConfig), provisioningConfig).Provision(context.Background())
+}
 
-	log.Println("DynamoDB client initialized successfully")
+// LoadConfig resolves the region and the credentials as awsauth.LoadConfig does for every client.
+// The provisioning calls are retried by the standard retryer of the SDK, tuned by AWS_RETRY_MODE
+// and AWS_MAX_ATTEMPTS, while the calls of the repositories are retried by the guard instead.
+func LoadConfig() (aws.Config, error) {
+	return awsauth.LoadConfig(context.Background(), "DynamoDB", os.Getenv("DYNAMODB_ENDPOINT"))
+}
 
-	// Ensure table exists
-	ensureTableExists(svc)
+// newClient points the client to DYNAMODB_ENDPOINT when set, e.g. DynamoDB Local.
+func newClient(awsConfig aws.Config, optFns ...func(*dynamodb.Options)) *dynamodb.Client {
+	endpoint := os.Getenv("DYNAMODB_ENDPOINT")
+	return dynamodb.NewFromConfig(awsConfig, append([]func(*dynamodb.Options){func(o *dynamodb.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	}}, optFns...)...)
+}
 
-	return svc
+// tableInputs describes every table of the service.
+func tableInputs() []*dynamodb.CreateTableInput {
+	return []*dynamodb.CreateTableInput{
+		customerTableInput(),
+		apiKeyTableInput(),
+		outboxTableInput(),
+		orderHistoryTableInput(),
+		loyaltyTableInput(),
+		consentTableInput(),
+		auditTableInput(),
+		customerHistoryTableInput(),
+	}
 }
 
-// ensureTableExists creates the Customer table if it doesn't exist
-func ensureTableExists(svc *dynamodb.DynamoDB) {
-	// Check if table exists
-	_, err := svc.DescribeTable(&dynamodb.DescribeTableInput{
+// customerTableInput describes the Customer table, keyed by the CPF blind index with indexes
+// by ID and by email blind index
+func customerTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
 		TableName: aws.String(CustomerTableName),
-	})
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("cpf"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("email_index"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("cpf"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(CustomerIDIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("id"),
+						KeyType:       types.KeyTypeHash,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+			{
+				IndexName: aws.String(CustomerEmailIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("email_index"),
+						KeyType:       types.KeyTypeHash,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
 
-	if err == nil {
-		log.Printf("Table %s already exists\n", CustomerTableName)
-		return
+// apiKeyTableInput describes the API key table, keyed by key ID
+func apiKeyTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(APIKeyTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
 	}
+}
 
-	// Create table
-	input := &dynamodb.CreateTableInput{
-		TableName: aws.String(CustomerTableName),
-		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+// outboxTableInput describes the outbox table, keyed by message ID with an index of due messages
+func outboxTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(OutboxTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
 			{
-				AttributeName: aws.String("CPF"),
-				AttributeType: aws.String("N"),
+				AttributeName: aws.String("status"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("next_attempt_at"),
+				AttributeType: types.ScalarAttributeTypeN,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
 			},
 		},
-		KeySchema: []*dynamodb.KeySchemaElement{
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
 			{
-				AttributeName: aws.String("CPF"),
-				KeyType:       aws.String("HASH"),
+				IndexName: aws.String(outbox.PendingIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("status"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("next_attempt_at"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
 			},
 		},
-		BillingMode: aws.String("PAY_PER_REQUEST"),
+		BillingMode: types.BillingModePayPerRequest,
 	}
+}
 
-	_, err = svc.CreateTable(input)
-	if err != nil {
-		log.Printf("Warning: Failed to create table %s: %v\n", CustomerTableName, err)
-		return
+// orderHistoryTableInput describes the order history table, keyed by customer ID and sorted by completion time
+func orderHistoryTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(OrderHistoryTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("customer_id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("customer_id"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// customerHistoryTableInput describes the customer history table, keyed by customer ID with the revisions sorted chronologically
+func customerHistoryTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(CustomerHistoryTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("customer_id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("customer_id"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// loyaltyTableInput describes the loyalty table, keyed by customer ID with the balance, ledger entries and references as sort keys
+func loyaltyTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(LoyaltyTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("customer_id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("customer_id"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	}
+}
+
+// consentTableInput describes the consent table, keyed by customer ID with the current consents and the history as sort keys
+func consentTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(ConsentTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("customer_id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("opted_in_purpose"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("customer_id"),
+				KeyType:       types.KeyTypeHash,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				KeyType:       types.KeyTypeRange,
+			},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(ConsentOptedInIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("opted_in_purpose"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("customer_id"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
 	}
+}
 
-	log.Printf("Table %s created successfully\n", CustomerTableName)
+// auditTableInput describes the audit table, keyed by entry ID with one index by customer and one by actor
+func auditTableInput() *dynamodb.CreateTableInput {
+	return &dynamodb.CreateTableInput{
+		TableName: aws.String(AuditTableName),
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("customer_id"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("actor"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+			{
+				AttributeName: aws.String("sk"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String(AuditCustomerIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("customer_id"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("sk"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+			{
+				IndexName: aws.String(AuditActorIndexName),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("actor"),
+						KeyType:       types.KeyTypeHash,
+					},
+					{
+						AttributeName: aws.String("sk"),
+						KeyType:       types.KeyTypeRange,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+	}
 }
@@ -0,0 +1,115 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// legacyCustomerKey is the hash key of the first customer table, the CPF as a number.
+const legacyCustomerKey = "CPF"
+
+// cpfLength is the number of digits of a CPF, whose leading zeros a number drops.
+const cpfLength = 11
+
+// CopyReport tells how the copy of the legacy customers went.
+type CopyReport struct {
+	Copied int
+	// Skipped are the customers already in the customer table, copied by an earlier run or
+	// registered since.
+	Skipped int
+}
+
+// CopyLegacyCustomers copies the customers of sourceTable, the first customer table keyed by the
+// numeric CPF, whose key schema DynamoDB cannot change in place, to CustomerTableName. They are
+// keyed by the plain CPF, as the customers written before the encryption, so the reindex command
+// then encrypts them and moves them to the blind index of the CPF. The source table is only read.
+func CopyLegacyCustomers(sourceTable string) (*CopyReport, error) {
+	awsConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+	return copyLegacyCustomers(context.Background(), newClient(awsConfig), sourceTable, CustomerTableName)
+}
+
+func copyLegacyCustomers(ctx context.Context, db Client, sourceTable string, targetTable string) (*CopyReport, error) {
+	input := &dynamodb.ScanInput{TableName: aws.String(sourceTable)}
+	report := &CopyReport{}
+	for {
+		result, err := db.Scan(ctx, input)
+		if err != nil {
+			return report, fmt.Errorf("failed to scan table %s: %w", sourceTable, err)
+		}
+
+		for _, item := range result.Items {
+			copied, err := copyLegacyCustomer(ctx, db, item, targetTable)
+			if err != nil {
+				return report, err
+			}
+			if copied {
+				report.Copied++
+			} else {
+				report.Skipped++
+			}
+		}
+
+		if len(result.LastEvaluatedKey) == 0 {
+			log.Printf("Copied %d customers from %s to %s, skipped %d already there\n", report.Copied, sourceTable, targetTable, report.Skipped)
+			return report, nil
+		}
+		input.ExclusiveStartKey = result.LastEvaluatedKey
+	}
+}
+
+// copyLegacyCustomer never overwrites a customer of the target table.
+func copyLegacyCustomer(ctx context.Context, db Client, item map[string]types.AttributeValue, targetTable string) (bool, error) {
+	cpf, err := legacyCPF(item[legacyCustomerKey])
+	if err != nil {
+		return false, err
+	}
+
+	copied := make(map[string]types.AttributeValue, len(item))
+	for name, value := range item {
+		if name != legacyCustomerKey {
+			copied[name] = value
+		}
+	}
+	copied["cpf"] = String(cpf)
+
+	_, err = db.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName:           aws.String(targetTable),
+		Item:                copied,
+		ConditionExpression: aws.String("attribute_not_exists(cpf)"),
+	})
+	var conditionFailed *types.ConditionalCheckFailedException
+	switch {
+	case errors.As(err, &conditionFailed):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("failed to copy customer: %w", err)
+	}
+	return true, nil
+}
+
+// legacyCPF restores the leading zeros of a CPF stored as a number.
+func legacyCPF(value types.AttributeValue) (string, error) {
+	var cpf string
+	switch v := value.(type) {
+	case *types.AttributeValueMemberN:
+		cpf = v.Value
+	case *types.AttributeValueMemberS:
+		cpf = v.Value
+	default:
+		return "", fmt.Errorf("legacy customer without a %s key", legacyCustomerKey)
+	}
+	if len(cpf) < cpfLength {
+		cpf = strings.Repeat("0", cpfLength-len(cpf)) + cpf
+	}
+	return cpf, nil
+}
@@ -0,0 +1,77 @@
+package dynamodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeLegacyTables scans the pages of the legacy table and keeps the items put in the new one.
+type fakeLegacyTables struct {
+	Client
+	pages []*dynamodb.ScanOutput
+	items map[string]map[string]types.AttributeValue
+}
+
+func (f *fakeLegacyTables) Scan(ctx context.Context, input *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	page := f.pages[0]
+	f.pages = f.pages[1:]
+	return page, nil
+}
+
+func (f *fakeLegacyTables) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	cpf := StringValue(input.Item["cpf"])
+	if _, found := f.items[cpf]; found {
+		return nil, &types.ConditionalCheckFailedException{Message: aws.String("The conditional request failed")}
+	}
+	f.items[cpf] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func TestCopyLegacyCustomers_ShouldKeyThemByThePlainCPFWithoutOverwriting(t *testing.T) {
+	// GIVEN a legacy table with a CPF that lost its leading zero and a customer already copied
+	db := &fakeLegacyTables{
+		pages: []*dynamodb.ScanOutput{
+			{
+				Items: []map[string]types.AttributeValue{
+					{"CPF": &types.AttributeValueMemberN{Value: "1234567890"}, "id": String("customer-1"), "name": String("Maria")},
+				},
+				LastEvaluatedKey: map[string]types.AttributeValue{"CPF": &types.AttributeValueMemberN{Value: "1234567890"}},
+			},
+			{
+				Items: []map[string]types.AttributeValue{
+					{"CPF": &types.AttributeValueMemberN{Value: "98765432100"}, "id": String("customer-2")},
+				},
+			},
+		},
+		items: map[string]map[string]types.AttributeValue{"98765432100": {"cpf": String("98765432100")}},
+	}
+
+	// WHEN copying the customers
+	report, err := copyLegacyCustomers(context.Background(), db, "Customer", "Customers")
+
+	// THEN the new customer should be keyed by the CPF with its leading zero, and the other left alone
+	require.NoError(t, err)
+	assert.Equal(t, &CopyReport{Copied: 1, Skipped: 1}, report)
+	assert.Equal(t, map[string]types.AttributeValue{
+		"cpf":  String("01234567890"),
+		"id":   String("customer-1"),
+		"name": String("Maria"),
+	}, db.items["01234567890"])
+}
+
+func TestCopyLegacyCustomers_WithItemWithoutKey_ShouldFail(t *testing.T) {
+	// GIVEN a legacy item without the CPF key
+	db := &fakeLegacyTables{pages: []*dynamodb.ScanOutput{{Items: []map[string]types.AttributeValue{{"id": String("customer-1")}}}}}
+
+	// WHEN copying the customers
+	_, err := copyLegacyCustomers(context.Background(), db, "Customer", "Customers")
+
+	// THEN it should fail
+	assert.EqualError(t, err, "legacy customer without a CPF key")
+}
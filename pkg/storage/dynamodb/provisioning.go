@@ -0,0 +1,216 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// ProvisioningMode tells what the service does about its tables on startup.
+type ProvisioningMode string
+
+const (
+	// ProvisioningOff leaves the tables alone, e.g. when they are managed by terraform and
+	// checked elsewhere.
+	ProvisioningOff ProvisioningMode = "off"
+	// ProvisioningCreateIfMissing creates the tables that do not exist yet and waits until every
+	// table is active, e.g. against DynamoDB Local.
+	ProvisioningCreateIfMissing ProvisioningMode = "create-if-missing"
+	// ProvisioningVerifyOnly fails when a table or one of its indexes is missing, and waits for
+	// the tables that are not active yet.
+	ProvisioningVerifyOnly ProvisioningMode = "verify-only"
+)
+
+// ProvisioningConfig tunes the provisioning of the tables.
+type ProvisioningConfig struct {
+	Mode ProvisioningMode
+	// Timeout bounds the wait for the tables to become active.
+	Timeout time.Duration
+	// PollInterval is how often the status of a table is checked while waiting.
+	PollInterval time.Duration
+}
+
+// DefaultProvisioningConfig only verifies the tables, as the production ones are managed by
+// terraform.
+var DefaultProvisioningConfig = ProvisioningConfig{
+	Mode:         ProvisioningVerifyOnly,
+	Timeout:      2 * time.Minute,
+	PollInterval: 2 * time.Second,
+}
+
+// ProvisioningConfigFromEnv reads DYNAMODB_PROVISIONING, DYNAMODB_PROVISIONING_TIMEOUT and
+// DYNAMODB_PROVISIONING_POLL_INTERVAL over DefaultProvisioningConfig.
+func ProvisioningConfigFromEnv() (ProvisioningConfig, error) {
+	config := DefaultProvisioningConfig
+
+	if value := os.Getenv("DYNAMODB_PROVISIONING"); value != "" {
+		switch mode := ProvisioningMode(value); mode {
+		case ProvisioningOff, ProvisioningCreateIfMissing, ProvisioningVerifyOnly:
+			config.Mode = mode
+		default:
+			return ProvisioningConfig{}, fmt.Errorf("invalid DYNAMODB_PROVISIONING: %q", value)
+		}
+	}
+
+	for name, target := range map[string]*time.Duration{
+		"DYNAMODB_PROVISIONING_TIMEOUT":       &config.Timeout,
+		"DYNAMODB_PROVISIONING_POLL_INTERVAL": &config.PollInterval,
+	} {
+		value := os.Getenv(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			return ProvisioningConfig{}, fmt.Errorf("invalid %s: %q", name, value)
+		}
+		*target = parsed
+	}
+
+	return config, nil
+}
+
+// TableAPI is the part of the DynamoDB API the Provisioner uses.
+type TableAPI interface {
+	DescribeTable(ctx context.Context, input *dynamodb.DescribeTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error)
+	CreateTable(ctx context.Context, input *dynamodb.CreateTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.CreateTableOutput, error)
+}
+
+// Provisioner makes sure every table of the service exists and is active before it serves.
+type Provisioner struct {
+	client TableAPI
+	config ProvisioningConfig
+	tables []*dynamodb.CreateTableInput
+}
+
+func NewProvisioner(client TableAPI, config ProvisioningConfig) *Provisioner {
+	return &Provisioner{client: client, config: config, tables: tableInputs()}
+}
+
+// NewProvisionerFromEnv builds the Provisioner configured by ProvisioningConfigFromEnv. Its calls
+// are retried by the standard retryer of the SDK rather than by the guard of the repositories.
+func NewProvisionerFromEnv(awsConfig aws.Config) (*Provisioner, error) {
+	config, err := ProvisioningConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewProvisioner(newClient(awsConfig), config), nil
+}
+
+// Provision checks every table, creating the missing ones in create-if-missing mode, then waits
+// until the new and the not yet active tables are active. It returns the errors of all the
+// tables joined, so a single run reports everything that is wrong.
+func (p *Provisioner) Provision(ctx context.Context) error {
+	if p.config.Mode == ProvisioningOff {
+		log.Println("DynamoDB table provisioning is off")
+		return nil
+	}
+
+	var errs []error
+	var pending []*dynamodb.CreateTableInput
+	for _, input := range p.tables {
+		wait, err := p.provisionTable(ctx, input)
+		if err != nil {
+			errs = append(errs, err)
+		} else if wait {
+			pending = append(pending, input)
+		}
+	}
+
+	deadline := time.Now().Add(p.config.Timeout)
+	for _, input := range pending {
+		errs = append(errs, p.waitUntilActive(ctx, input, time.Until(deadline)))
+	}
+	return errors.Join(errs...)
+}
+
+// provisionTable tells whether the table has to be waited for.
+func (p *Provisioner) provisionTable(ctx context.Context, input *dynamodb.CreateTableInput) (bool, error) {
+	tableName := aws.ToString(input.TableName)
+
+	output, err := p.client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: input.TableName,
+	})
+	var notFound *types.ResourceNotFoundException
+	switch {
+	case errors.As(err, &notFound):
+		if p.config.Mode != ProvisioningCreateIfMissing {
+			return false, fmt.Errorf("table %s does not exist", tableName)
+		}
+		return true, p.createTable(ctx, input)
+	case err != nil:
+		return false, fmt.Errorf("failed to describe table %s: %w", tableName, err)
+	}
+
+	if err := verifyIndexes(input, output.Table); err != nil {
+		return false, err
+	}
+	if status := output.Table.TableStatus; status != types.TableStatusActive {
+		log.Printf("Table %s is %s, waiting for it to become active\n", tableName, status)
+		return true, nil
+	}
+
+	log.Printf("Table %s is active\n", tableName)
+	return false, nil
+}
+
+func (p *Provisioner) createTable(ctx context.Context, input *dynamodb.CreateTableInput) error {
+	tableName := aws.ToString(input.TableName)
+
+	_, err := p.client.CreateTable(ctx, input)
+	var inUse *types.ResourceInUseException
+	switch {
+	// Another replica starting at the same time created it first
+	case errors.As(err, &inUse):
+		log.Printf("Table %s is being created by another replica, waiting for it to become active\n", tableName)
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to create table %s: %w", tableName, err)
+	}
+
+	log.Printf("Table %s created, waiting for it to become active\n", tableName)
+	return nil
+}
+
+func (p *Provisioner) waitUntilActive(ctx context.Context, input *dynamodb.CreateTableInput, timeout time.Duration) error {
+	tableName := aws.ToString(input.TableName)
+	if timeout <= 0 {
+		return fmt.Errorf("table %s did not become active within %s", tableName, p.config.Timeout)
+	}
+
+	waiter := dynamodb.NewTableExistsWaiter(p.client, func(o *dynamodb.TableExistsWaiterOptions) {
+		o.MinDelay = p.config.PollInterval
+		o.MaxDelay = p.config.PollInterval
+	})
+	err := waiter.Wait(ctx, &dynamodb.DescribeTableInput{TableName: input.TableName}, timeout)
+	if err != nil {
+		return fmt.Errorf("table %s did not become active within %s: %w", tableName, p.config.Timeout, err)
+	}
+
+	log.Printf("Table %s is active\n", tableName)
+	return nil
+}
+
+// verifyIndexes fails when the table lacks one of the global secondary indexes the repositories
+// query, which the provisioning does not add to existing tables.
+func verifyIndexes(input *dynamodb.CreateTableInput, table *types.TableDescription) error {
+	existing := make(map[string]bool, len(table.GlobalSecondaryIndexes))
+	for _, index := range table.GlobalSecondaryIndexes {
+		existing[aws.ToString(index.IndexName)] = true
+	}
+
+	var errs []error
+	for _, index := range input.GlobalSecondaryIndexes {
+		if indexName := aws.ToString(index.IndexName); !existing[indexName] {
+			errs = append(errs, fmt.Errorf("table %s has no index %s", aws.ToString(input.TableName), indexName))
+		}
+	}
+	return errors.Join(errs...)
+}
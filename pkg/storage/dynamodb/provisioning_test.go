@@ -0,0 +1,239 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// missing is the status of a table that does not exist yet.
+const missing types.TableStatus = ""
+
+// fakeTables describes each table by the statuses it reports one call after the other, keeping
+// the last one, and reports the tables without statuses as missing.
+type fakeTables struct {
+	statuses  map[string][]types.TableStatus
+	indexes   map[string][]string
+	createErr error
+	created   []string
+}
+
+func newFakeTables() *fakeTables {
+	return &fakeTables{statuses: map[string][]types.TableStatus{}, indexes: map[string][]string{}}
+}
+
+// withAllTables makes every table of the service active with its indexes.
+func (f *fakeTables) withAllTables() *fakeTables {
+	for _, input := range tableInputs() {
+		tableName := aws.ToString(input.TableName)
+		f.statuses[tableName] = []types.TableStatus{types.TableStatusActive}
+		for _, index := range input.GlobalSecondaryIndexes {
+			f.indexes[tableName] = append(f.indexes[tableName], aws.ToString(index.IndexName))
+		}
+	}
+	return f
+}
+
+func (f *fakeTables) DescribeTable(ctx context.Context, input *dynamodb.DescribeTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
+	tableName := aws.ToString(input.TableName)
+	statuses := f.statuses[tableName]
+	if len(statuses) > 1 {
+		f.statuses[tableName] = statuses[1:]
+	}
+	if len(statuses) == 0 || statuses[0] == missing {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("Requested resource not found")}
+	}
+
+	table := &types.TableDescription{TableName: input.TableName, TableStatus: statuses[0]}
+	for _, indexName := range f.indexes[tableName] {
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, types.GlobalSecondaryIndexDescription{IndexName: aws.String(indexName)})
+	}
+	return &dynamodb.DescribeTableOutput{Table: table}, nil
+}
+
+func (f *fakeTables) CreateTable(ctx context.Context, input *dynamodb.CreateTableInput, optFns ...func(*dynamodb.Options)) (*dynamodb.CreateTableOutput, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	tableName := aws.ToString(input.TableName)
+	f.created = append(f.created, tableName)
+	f.statuses[tableName] = []types.TableStatus{types.TableStatusCreating, types.TableStatusActive}
+	for _, index := range input.GlobalSecondaryIndexes {
+		f.indexes[tableName] = append(f.indexes[tableName], aws.ToString(index.IndexName))
+	}
+	return &dynamodb.CreateTableOutput{}, nil
+}
+
+func provisioningConfig(mode ProvisioningMode) ProvisioningConfig {
+	return ProvisioningConfig{Mode: mode, Timeout: time.Second, PollInterval: time.Millisecond}
+}
+
+func TestProvisioningConfigFromEnv_ShouldDefaultToVerifyOnly(t *testing.T) {
+	// GIVEN no provisioning variables
+	t.Setenv("DYNAMODB_PROVISIONING", "")
+	t.Setenv("DYNAMODB_PROVISIONING_TIMEOUT", "")
+	t.Setenv("DYNAMODB_PROVISIONING_POLL_INTERVAL", "")
+
+	// WHEN reading the configuration
+	config, err := ProvisioningConfigFromEnv()
+
+	// THEN the tables should only be verified
+	require.NoError(t, err)
+	assert.Equal(t, DefaultProvisioningConfig, config)
+}
+
+func TestProvisioningConfigFromEnv_ShouldReadTheVariables(t *testing.T) {
+	// GIVEN every provisioning variable
+	t.Setenv("DYNAMODB_PROVISIONING", "create-if-missing")
+	t.Setenv("DYNAMODB_PROVISIONING_TIMEOUT", "30s")
+	t.Setenv("DYNAMODB_PROVISIONING_POLL_INTERVAL", "500ms")
+
+	// WHEN reading the configuration
+	config, err := ProvisioningConfigFromEnv()
+
+	// THEN they should be used
+	require.NoError(t, err)
+	assert.Equal(t, ProvisioningConfig{Mode: ProvisioningCreateIfMissing, Timeout: 30 * time.Second, PollInterval: 500 * time.Millisecond}, config)
+}
+
+func TestProvisioningConfigFromEnv_WithInvalidValues_ShouldFail(t *testing.T) {
+	// GIVEN an unknown mode
+	t.Setenv("DYNAMODB_PROVISIONING", "create")
+
+	// WHEN reading the configuration
+	_, err := ProvisioningConfigFromEnv()
+
+	// THEN it should fail
+	assert.EqualError(t, err, `invalid DYNAMODB_PROVISIONING: "create"`)
+
+	// AND a timeout that is not a duration should fail too
+	t.Setenv("DYNAMODB_PROVISIONING", "off")
+	t.Setenv("DYNAMODB_PROVISIONING_TIMEOUT", "0s")
+	_, err = ProvisioningConfigFromEnv()
+	assert.EqualError(t, err, `invalid DYNAMODB_PROVISIONING_TIMEOUT: "0s"`)
+}
+
+func TestProvision_Off_ShouldNotCallDynamoDB(t *testing.T) {
+	// GIVEN no tables at all
+	tables := newFakeTables()
+
+	// WHEN provisioning with the provisioning off
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningOff)).Provision(context.Background())
+
+	// THEN nothing should be checked nor created
+	assert.NoError(t, err)
+	assert.Empty(t, tables.created)
+}
+
+func TestProvision_CreateIfMissing_ShouldCreateTheMissingTablesAndWaitForThem(t *testing.T) {
+	// GIVEN every table but the audit one
+	tables := newFakeTables().withAllTables()
+	delete(tables.statuses, AuditTableName)
+	delete(tables.indexes, AuditTableName)
+
+	// WHEN provisioning
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningCreateIfMissing)).Provision(context.Background())
+
+	// THEN only the audit table should be created, and waited for until active
+	require.NoError(t, err)
+	assert.Equal(t, []string{AuditTableName}, tables.created)
+	assert.Equal(t, []types.TableStatus{types.TableStatusActive}, tables.statuses[AuditTableName])
+}
+
+func TestProvision_CreateIfMissing_WhenCreatedByAnotherReplica_ShouldWaitForIt(t *testing.T) {
+	// GIVEN a missing table another replica creates at the same time
+	tables := newFakeTables().withAllTables()
+	tables.statuses[OutboxTableName] = []types.TableStatus{missing, types.TableStatusCreating, types.TableStatusActive}
+	tables.createErr = &types.ResourceInUseException{Message: aws.String("Table already exists")}
+
+	// WHEN provisioning
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningCreateIfMissing)).Provision(context.Background())
+
+	// THEN the table should be waited for until active instead of failing
+	require.NoError(t, err)
+	assert.Equal(t, []types.TableStatus{types.TableStatusActive}, tables.statuses[OutboxTableName])
+}
+
+func TestProvision_CreateIfMissing_WhenCreationFails_ShouldFail(t *testing.T) {
+	// GIVEN a missing table that cannot be created
+	tables := newFakeTables().withAllTables()
+	delete(tables.statuses, LoyaltyTableName)
+	tables.createErr = errors.New("access denied")
+
+	// WHEN provisioning
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningCreateIfMissing)).Provision(context.Background())
+
+	// THEN the failure should be returned instead of only logged
+	assert.EqualError(t, err, "failed to create table "+LoyaltyTableName+": access denied")
+}
+
+func TestProvision_VerifyOnly_ShouldReportEveryMissingTableAndIndex(t *testing.T) {
+	// GIVEN a missing table and a table without one of its indexes
+	tables := newFakeTables().withAllTables()
+	delete(tables.statuses, ConsentTableName)
+	tables.indexes[CustomerTableName] = []string{CustomerIDIndexName}
+
+	// WHEN verifying the tables
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningVerifyOnly)).Provision(context.Background())
+
+	// THEN both should be reported and nothing should be created
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "table "+CustomerTableName+" has no index "+CustomerEmailIndexName)
+	assert.ErrorContains(t, err, "table "+ConsentTableName+" does not exist")
+	assert.Empty(t, tables.created)
+}
+
+func TestProvision_VerifyOnly_ShouldWaitForTheTablesBeingCreated(t *testing.T) {
+	// GIVEN a table still being created
+	tables := newFakeTables().withAllTables()
+	tables.statuses[CustomerTableName] = []types.TableStatus{types.TableStatusCreating, types.TableStatusCreating, types.TableStatusActive}
+
+	// WHEN verifying the tables
+	err := NewProvisioner(tables, provisioningConfig(ProvisioningVerifyOnly)).Provision(context.Background())
+
+	// THEN it should be waited for until active
+	require.NoError(t, err)
+	assert.Equal(t, []types.TableStatus{types.TableStatusActive}, tables.statuses[CustomerTableName])
+}
+
+func TestProvision_WhenATableNeverBecomesActive_ShouldFailAfterTheTimeout(t *testing.T) {
+	// GIVEN a table stuck being created
+	tables := newFakeTables().withAllTables()
+	tables.statuses[APIKeyTableName] = []types.TableStatus{types.TableStatusCreating}
+	config := provisioningConfig(ProvisioningVerifyOnly)
+	config.Timeout = 20 * time.Millisecond
+
+	// WHEN verifying the tables
+	err := NewProvisioner(tables, config).Provision(context.Background())
+
+	// THEN it should fail once the timeout is over
+	assert.ErrorContains(t, err, "table "+APIKeyTableName+" did not become active within 20ms")
+}
+
+func TestProvision_WhenDescribeFails_ShouldFail(t *testing.T) {
+	// GIVEN DynamoDB denying the calls
+	provisioner := NewProvisioner(failingTables{}, provisioningConfig(ProvisioningCreateIfMissing))
+
+	// WHEN provisioning
+	err := provisioner.Provision(context.Background())
+
+	// THEN the failure should be returned without trying to create the tables
+	assert.ErrorContains(t, err, "failed to describe table "+CustomerTableName+": access denied")
+}
+
+type failingTables struct{}
+
+func (failingTables) DescribeTable(context.Context, *dynamodb.DescribeTableInput, ...func(*dynamodb.Options)) (*dynamodb.DescribeTableOutput, error) {
+	return nil, errors.New("access denied")
+}
+
+func (failingTables) CreateTable(context.Context, *dynamodb.CreateTableInput, ...func(*dynamodb.Options)) (*dynamodb.CreateTableOutput, error) {
+	panic("CreateTable should not be called")
+}
@@ -0,0 +1,190 @@
+package dynamodb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/resilience"
+)
+
+var (
+	_ Client = (*dynamodb.Client)(nil)
+	_ Client = (*ResilientClient)(nil)
+)
+
+// Operations lists the calls ResilientClient guards, whose timeouts can be set one by one.
+var Operations = []string{
+	"GetItem", "PutItem", "UpdateItem", "DeleteItem", "Query", "Scan",
+	"BatchGetItem", "BatchWriteItem", "TransactGetItems", "TransactWriteItems",
+}
+
+// ResilienceConfigFromEnv reads the DYNAMODB_ resilience variables, giving a page of a scan 10
+// seconds instead of the 2 seconds of the other operations by default.
+func ResilienceConfigFromEnv() (resilience.Config, error) {
+	defaults := resilience.DefaultConfig
+	defaults.Timeouts = map[string]time.Duration{"Scan": 10 * time.Second}
+	return resilience.ConfigFromEnv("DYNAMODB", defaults, Operations...)
+}
+
+// ResilientClient runs every call through a resilience.Guard, so each attempt gets the timeout of
+// its operation, throttling and transient failures are retried with jittered exponential
+// backoff, and calls fail fast with resilience.ErrUnavailable while DynamoDB is unhealthy.
+//
+// The client must not retry on its own, or every attempt of the guard would be retried again.
+type ResilientClient struct {
+	client Client
+	guard  *resilience.Guard
+}
+
+func NewResilientClient(client Client, guard *resilience.Guard) *ResilientClient {
+	return &ResilientClient{client: client, guard: guard}
+}
+
+// NewGuard builds the guard of the DynamoDB calls from config.
+func NewGuard(config resilience.Config) *resilience.Guard {
+	return resilience.NewGuard("dynamodb", config, IsRetryable)
+}
+
+// errUnprocessed fails the attempts of a batch that leave part of it unprocessed, so the guard
+// retries the rest.
+var errUnprocessed = errors.New("batch left unprocessed")
+
+var (
+	retryables = retry.IsErrorRetryables(retry.DefaultRetryables)
+	throttles  = retry.IsErrorThrottles(retry.DefaultThrottles)
+)
+
+// IsRetryable tells throttling, server errors, failed connections and batches left partly
+// unprocessed apart from the errors caused by the request itself, as failed conditions and
+// validation errors.
+func IsRetryable(err error) bool {
+	var internalServerError *types.InternalServerError
+	var transactionConflict *types.TransactionConflictException
+	if errors.As(err, &internalServerError) || errors.As(err, &transactionConflict) || errors.Is(err, errUnprocessed) {
+		return true
+	}
+	return throttles.IsErrorThrottle(err) == aws.TrueTernary || retryables.IsErrorRetryable(err) == aws.TrueTernary
+}
+
+func guarded[I, O any](c *ResilientClient, ctx context.Context, operation string, input I,
+	call func(context.Context, I, ...func(*dynamodb.Options)) (O, error), optFns []func(*dynamodb.Options)) (O, error) {
+	var output O
+	err := c.guard.Do(ctx, operation, func(ctx context.Context) error {
+		var err error
+		output, err = call(ctx, input, optFns...)
+		return err
+	})
+	return output, err
+}
+
+func (c *ResilientClient) GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
+	return guarded(c, ctx, "GetItem", input, c.client.GetItem, optFns)
+}
+
+func (c *ResilientClient) PutItem(ctx context.Context, input *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	return guarded(c, ctx, "PutItem", input, c.client.PutItem, optFns)
+}
+
+func (c *ResilientClient) UpdateItem(ctx context.Context, input *dynamodb.UpdateItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.UpdateItemOutput, error) {
+	return guarded(c, ctx, "UpdateItem", input, c.client.UpdateItem, optFns)
+}
+
+func (c *ResilientClient) DeleteItem(ctx context.Context, input *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error) {
+	return guarded(c, ctx, "DeleteItem", input, c.client.DeleteItem, optFns)
+}
+
+func (c *ResilientClient) Query(ctx context.Context, input *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	return guarded(c, ctx, "Query", input, c.client.Query, optFns)
+}
+
+func (c *ResilientClient) Scan(ctx context.Context, input *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	return guarded(c, ctx, "Scan", input, c.client.Scan, optFns)
+}
+
+// BatchGetItem resends the keys DynamoDB leaves unprocessed, as it does when throttled, like the
+// attempts that fail. The output gathers the items of every attempt, along with the keys still
+// unprocessed after the last one.
+func (c *ResilientClient) BatchGetItem(ctx context.Context, input *dynamodb.BatchGetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchGetItemOutput, error) {
+	output := &dynamodb.BatchGetItemOutput{Responses: make(map[string][]map[string]types.AttributeValue)}
+	pending := input
+	err := c.guard.Do(ctx, "BatchGetItem", func(ctx context.Context) error {
+		result, err := c.client.BatchGetItem(ctx, pending, optFns...)
+		if err != nil {
+			return err
+		}
+		for tableName, items := range result.Responses {
+			output.Responses[tableName] = append(output.Responses[tableName], items...)
+		}
+		output.UnprocessedKeys = result.UnprocessedKeys
+		if len(result.UnprocessedKeys) == 0 {
+			return nil
+		}
+		next := *pending
+		next.RequestItems = result.UnprocessedKeys
+		pending = &next
+		return errUnprocessed
+	})
+	if errors.Is(err, errUnprocessed) {
+		return output, nil
+	}
+	return output, err
+}
+
+// BatchWriteItem resends the requests DynamoDB leaves unprocessed like BatchGetItem does, returning
+// the ones still unprocessed after the last attempt.
+func (c *ResilientClient) BatchWriteItem(ctx context.Context, input *dynamodb.BatchWriteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchWriteItemOutput, error) {
+	output := &dynamodb.BatchWriteItemOutput{}
+	pending := input
+	err := c.guard.Do(ctx, "BatchWriteItem", func(ctx context.Context) error {
+		result, err := c.client.BatchWriteItem(ctx, pending, optFns...)
+		if err != nil {
+			return err
+		}
+		output.UnprocessedItems = result.UnprocessedItems
+		if len(result.UnprocessedItems) == 0 {
+			return nil
+		}
+		next := *pending
+		next.RequestItems = result.UnprocessedItems
+		pending = &next
+		return errUnprocessed
+	})
+	if errors.Is(err, errUnprocessed) {
+		return output, nil
+	}
+	return output, err
+}
+
+func (c *ResilientClient) TransactGetItems(ctx context.Context, input *dynamodb.TransactGetItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactGetItemsOutput, error) {
+	return guarded(c, ctx, "TransactGetItems", input, c.client.TransactGetItems, optFns)
+}
+
+// TransactWriteItems sends every attempt with the same client request token, so an attempt
+// retried after the one before timed out is not applied twice, as its conditions would then fail.
+func (c *ResilientClient) TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	if input.ClientRequestToken == nil {
+		token, err := clientRequestToken()
+		if err != nil {
+			return nil, err
+		}
+		withToken := *input
+		withToken.ClientRequestToken = aws.String(token)
+		input = &withToken
+	}
+	return guarded(c, ctx, "TransactWriteItems", input, c.client.TransactWriteItems, optFns)
+}
+
+func clientRequestToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,186 @@
+package dynamodb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/viniciuscluna/tc-fiap-customer/pkg/resilience"
+)
+
+// fakeDynamoDB answers each call with the next of its errors, then succeeds.
+type fakeDynamoDB struct {
+	Client
+	errs    []error
+	tokens  []string
+	calls   int
+	batches [][]types.WriteRequest
+}
+
+func (f *fakeDynamoDB) next() error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeDynamoDB) GetItem(ctx context.Context, input *dynamodb.GetItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.GetItemOutput, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &dynamodb.GetItemOutput{Item: map[string]types.AttributeValue{"cpf": String("x")}}, nil
+}
+
+func (f *fakeDynamoDB) TransactWriteItems(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.TransactWriteItemsOutput, error) {
+	f.tokens = append(f.tokens, aws.ToString(input.ClientRequestToken))
+	return &dynamodb.TransactWriteItemsOutput{}, f.next()
+}
+
+// BatchWriteItem processes a single request of each call, leaving the others unprocessed.
+func (f *fakeDynamoDB) BatchWriteItem(ctx context.Context, input *dynamodb.BatchWriteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.BatchWriteItemOutput, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	requests := input.RequestItems["table"]
+	f.batches = append(f.batches, requests)
+	output := &dynamodb.BatchWriteItemOutput{}
+	if len(requests) > 1 {
+		output.UnprocessedItems = map[string][]types.WriteRequest{"table": requests[1:]}
+	}
+	return output, nil
+}
+
+func newTestClient(fake *fakeDynamoDB) *ResilientClient {
+	config := resilience.DefaultConfig
+	config.BaseBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	config.FailureThreshold = 1
+	return NewResilientClient(fake, NewGuard(config))
+}
+
+func serverError(status int) error {
+	return &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: status}},
+		Err:      errors.New("server error"),
+	}
+}
+
+func TestResilientClient_GetItem_ShouldRetryThrottling(t *testing.T) {
+	// GIVEN a read throttled once
+	fake := &fakeDynamoDB{errs: []error{&types.ProvisionedThroughputExceededException{}}}
+	client := newTestClient(fake)
+
+	// WHEN reading the item
+	output, err := client.GetItem(context.Background(), &dynamodb.GetItemInput{})
+
+	// THEN it should be read on the second attempt
+	assert.NoError(t, err)
+	assert.Equal(t, "x", StringValue(output.Item["cpf"]))
+	assert.Equal(t, 2, fake.calls)
+}
+
+func TestResilientClient_GetItem_ShouldFailFastOnceDynamoDBIsUnhealthy(t *testing.T) {
+	// GIVEN a read that failed with server errors on every attempt
+	fake := &fakeDynamoDB{errs: []error{serverError(500), serverError(503), serverError(500)}}
+	client := newTestClient(fake)
+	_, err := client.GetItem(context.Background(), &dynamodb.GetItemInput{})
+	assert.Error(t, err)
+
+	// WHEN reading again
+	_, err = client.GetItem(context.Background(), &dynamodb.GetItemInput{})
+
+	// THEN the call should not reach DynamoDB
+	assert.ErrorIs(t, err, resilience.ErrUnavailable)
+	assert.Equal(t, 3, fake.calls)
+}
+
+func TestResilientClient_TransactWriteItems_ShouldRetryWithSameToken(t *testing.T) {
+	// GIVEN a transaction conflicting once
+	fake := &fakeDynamoDB{errs: []error{&types.TransactionConflictException{}}}
+	client := newTestClient(fake)
+	input := &dynamodb.TransactWriteItemsInput{}
+
+	// WHEN writing
+	_, err := client.TransactWriteItems(context.Background(), input)
+
+	// THEN both attempts should carry the same token, without changing the input
+	assert.NoError(t, err)
+	assert.Len(t, fake.tokens, 2)
+	assert.NotEmpty(t, fake.tokens[0])
+	assert.Equal(t, fake.tokens[0], fake.tokens[1])
+	assert.Nil(t, input.ClientRequestToken)
+}
+
+func TestResilientClient_BatchWriteItem_ShouldResendUnprocessedItems(t *testing.T) {
+	// GIVEN a batch DynamoDB processes one request at a time
+	fake := &fakeDynamoDB{}
+	client := newTestClient(fake)
+	requests := []types.WriteRequest{
+		{DeleteRequest: &types.DeleteRequest{Key: map[string]types.AttributeValue{"id": String("1")}}},
+		{DeleteRequest: &types.DeleteRequest{Key: map[string]types.AttributeValue{"id": String("2")}}},
+		{DeleteRequest: &types.DeleteRequest{Key: map[string]types.AttributeValue{"id": String("3")}}},
+	}
+
+	// WHEN writing the batch
+	output, err := client.BatchWriteItem(context.Background(), &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]types.WriteRequest{"table": requests},
+	})
+
+	// THEN each attempt should resend only what was left unprocessed
+	assert.NoError(t, err)
+	assert.Empty(t, output.UnprocessedItems)
+	assert.Equal(t, [][]types.WriteRequest{requests, requests[1:], requests[2:]}, fake.batches)
+}
+
+func TestResilientClient_BatchWriteItem_ShouldReturnItemsLeftAfterLastAttempt(t *testing.T) {
+	// GIVEN a batch that needs more attempts than allowed
+	fake := &fakeDynamoDB{}
+	client := newTestClient(fake)
+	requests := make([]types.WriteRequest, 5)
+	for i := range requests {
+		requests[i] = types.WriteRequest{DeleteRequest: &types.DeleteRequest{}}
+	}
+
+	// WHEN writing the batch
+	output, err := client.BatchWriteItem(context.Background(), &dynamodb.BatchWriteItemInput{
+		RequestItems: map[string][]types.WriteRequest{"table": requests},
+	})
+
+	// THEN the requests still unprocessed should be returned to the caller
+	assert.NoError(t, err)
+	assert.Len(t, output.UnprocessedItems["table"], 2)
+	assert.Equal(t, 3, fake.calls)
+}
+
+func TestIsRetryable_ShouldTellTransientFailuresApart(t *testing.T) {
+	retryable := []error{
+		&types.ProvisionedThroughputExceededException{},
+		&types.RequestLimitExceeded{},
+		&types.InternalServerError{},
+		serverError(http.StatusServiceUnavailable),
+		errUnprocessed,
+	}
+	for _, err := range retryable {
+		assert.True(t, IsRetryable(err), err.Error())
+	}
+
+	notRetryable := []error{
+		&types.ConditionalCheckFailedException{},
+		&types.ResourceNotFoundException{},
+		context.Canceled,
+		errors.New("failed to marshal customer"),
+	}
+	for _, err := range notRetryable {
+		assert.False(t, IsRetryable(err), err.Error())
+	}
+}
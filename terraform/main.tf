@@ -16,7 +16,11 @@ provider "aws" {
   # Não configurar access_key/secret_key aqui
 }
 
-# DynamoDB Table - Customer
+# DynamoDB Table - Customer (legada)
+# Tabela original, com o CPF numérico como chave. O DynamoDB não altera a chave de uma tabela
+# existente, então ela é mantida como está até que os clientes sejam copiados para a tabela
+# customers com `main migrate -copy-customers-from <tabela>` (veja o README); só então pode ser
+# removida, retirando o prevent_destroy.
 resource "aws_dynamodb_table" "customer" {
   name           = var.table_name
   billing_mode   = "PAY_PER_REQUEST"  # On-demand pricing (melhor para Academy)
@@ -37,6 +41,10 @@ resource "aws_dynamodb_table" "customer" {
     enabled = true
   }
 
+  lifecycle {
+    prevent_destroy = true
+  }
+
   tags = {
     Name        = "Customer Table"
     Environment = var.environment
@@ -45,13 +53,487 @@ resource "aws_dynamodb_table" "customer" {
   }
 }
 
+# DynamoDB Table - Customers
+resource "aws_dynamodb_table" "customers" {
+  name           = var.customer_table_name
+  billing_mode   = "PAY_PER_REQUEST"
+  hash_key       = "cpf"
+
+  # Mesmo esquema usado pela aplicação (pkg/storage/dynamodb)
+  attribute {
+    name = "cpf"
+    type = "S"
+  }
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "email_index"
+    type = "S"
+  }
+
+  # Consulta de clientes pelo ID (clientes convidados não possuem CPF)
+  global_secondary_index {
+    name            = "id-index"
+    hash_key        = "id"
+    projection_type = "ALL"
+  }
+
+  # Consulta de clientes pelo índice cego (HMAC) do email, que é armazenado criptografado
+  global_secondary_index {
+    name            = "email-index"
+    hash_key        = "email_index"
+    projection_type = "ALL"
+  }
+
+  # Optional: Enable point-in-time recovery (pode não estar disponível no Academy)
+  # point_in_time_recovery {
+  #   enabled = true
+  # }
+
+  # Optional: Server-side encryption (geralmente disponível)
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customers Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Chave mestra da criptografia envelope dos dados pessoais (CPF, nome e email).
+# A aplicação gera uma chave de dados por item e guarda o ID da chave mestra junto do item.
+resource "aws_kms_key" "pii" {
+  description             = "Chave mestra dos dados pessoais de clientes"
+  deletion_window_in_days = 30
+  enable_key_rotation     = true
+
+  tags = {
+    Name        = "Customer PII Key"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+resource "aws_kms_alias" "pii" {
+  name          = "alias/${var.pii_key_alias}"
+  target_key_id = aws_kms_key.pii.key_id
+}
+
+# DynamoDB Table - API keys de serviços internos (somente o hash da chave é armazenado)
+resource "aws_dynamodb_table" "api_keys" {
+  name         = var.api_key_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer API Keys Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+resource "aws_dynamodb_table" "outbox" {
+  name         = var.outbox_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "status"
+    type = "S"
+  }
+
+  attribute {
+    name = "next_attempt_at"
+    type = "N"
+  }
+
+  # Mensagens pendentes ordenadas pela próxima tentativa, consultadas pelo relay
+  global_secondary_index {
+    name            = "pending-index"
+    hash_key        = "status"
+    range_key       = "next_attempt_at"
+    projection_type = "ALL"
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer Outbox Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+resource "aws_dynamodb_table" "order_history" {
+  name         = var.order_history_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "customer_id"
+  range_key    = "sk"
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer Order History Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Fila dos eventos de pedido consumidos para o histórico, com DLQ
+resource "aws_sqs_queue" "order_events_dlq" {
+  name                      = "${var.order_events_queue_name}-dlq"
+  message_retention_seconds = 1209600
+}
+
+resource "aws_sqs_queue" "order_events" {
+  name                       = var.order_events_queue_name
+  visibility_timeout_seconds = 60
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.order_events_dlq.arn
+    maxReceiveCount     = 5
+  })
+}
+
+# Assinatura no tópico do serviço de pedidos, quando informado
+resource "aws_sns_topic_subscription" "order_events" {
+  count                = var.order_events_topic_arn == "" ? 0 : 1
+  topic_arn            = var.order_events_topic_arn
+  protocol             = "sqs"
+  endpoint             = aws_sqs_queue.order_events.arn
+  raw_message_delivery = true
+  filter_policy        = jsonencode({ type = ["order.completed"] })
+}
+
+resource "aws_sqs_queue_policy" "order_events" {
+  count     = var.order_events_topic_arn == "" ? 0 : 1
+  queue_url = aws_sqs_queue.order_events.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "sns.amazonaws.com" }
+      Action    = "sqs:SendMessage"
+      Resource  = aws_sqs_queue.order_events.arn
+      Condition = { ArnEquals = { "aws:SourceArn" = var.order_events_topic_arn } }
+    }]
+  })
+}
+
+resource "aws_dynamodb_table" "loyalty" {
+  name         = var.loyalty_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "customer_id"
+  range_key    = "sk"
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer Loyalty Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Fila dos eventos de pagamento que geram pontos de fidelidade, com DLQ
+resource "aws_sqs_queue" "payment_events_dlq" {
+  name                      = "${var.payment_events_queue_name}-dlq"
+  message_retention_seconds = 1209600
+}
+
+resource "aws_sqs_queue" "payment_events" {
+  name                       = var.payment_events_queue_name
+  visibility_timeout_seconds = 60
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.payment_events_dlq.arn
+    maxReceiveCount     = 5
+  })
+}
+
+# Assinatura no tópico do serviço de pagamentos, quando informado
+resource "aws_sns_topic_subscription" "payment_events" {
+  count                = var.payment_events_topic_arn == "" ? 0 : 1
+  topic_arn            = var.payment_events_topic_arn
+  protocol             = "sqs"
+  endpoint             = aws_sqs_queue.payment_events.arn
+  raw_message_delivery = true
+  filter_policy        = jsonencode({ type = ["payment.confirmed"] })
+}
+
+resource "aws_sqs_queue_policy" "payment_events" {
+  count     = var.payment_events_topic_arn == "" ? 0 : 1
+  queue_url = aws_sqs_queue.payment_events.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "sns.amazonaws.com" }
+      Action    = "sqs:SendMessage"
+      Resource  = aws_sqs_queue.payment_events.arn
+      Condition = { ArnEquals = { "aws:SourceArn" = var.payment_events_topic_arn } }
+    }]
+  })
+}
+
+# Consentimentos LGPD: consentimento atual por finalidade e histórico somente de inclusão.
+# O índice esparso opted-in-index contém apenas os consentimentos concedidos.
+resource "aws_dynamodb_table" "consent" {
+  name         = var.consent_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "customer_id"
+  range_key    = "sk"
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  attribute {
+    name = "opted_in_purpose"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "opted-in-index"
+    hash_key        = "opted_in_purpose"
+    range_key       = "customer_id"
+    projection_type = "ALL"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer Consent Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Trilha de auditoria LGPD: registros somente de inclusão de quem leu ou alterou
+# dados de clientes, consultáveis por cliente (customer-index) ou por ator (actor-index).
+resource "aws_dynamodb_table" "audit" {
+  name         = var.audit_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "actor"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "customer-index"
+    hash_key        = "customer_id"
+    range_key       = "sk"
+    projection_type = "ALL"
+  }
+
+  global_secondary_index {
+    name            = "actor-index"
+    hash_key        = "actor"
+    range_key       = "sk"
+    projection_type = "ALL"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer Audit Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Histórico de clientes: uma versão completa (criptografada) por escrita, ordenada por data em sk.
+resource "aws_dynamodb_table" "customer_history" {
+  name         = var.customer_history_table_name
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "customer_id"
+  range_key    = "sk"
+
+  attribute {
+    name = "customer_id"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+
+  server_side_encryption {
+    enabled = true
+  }
+
+  tags = {
+    Name        = "Customer History Table"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
+# Tópico dos eventos de domínio publicados pelo relay do outbox
+resource "aws_sns_topic" "customer_events" {
+  name = var.events_topic_name
+
+  tags = {
+    Name        = "Customer Events Topic"
+    Environment = var.environment
+    Project     = "tc-fiap-customer"
+    ManagedBy   = "Terraform"
+  }
+}
+
 # Output útil para o pipeline
 output "dynamodb_table_name" {
-  description = "Nome da tabela DynamoDB"
-  value       = aws_dynamodb_table.customer.name
+  description = "Nome da tabela DynamoDB de clientes (DYNAMODB_TABLE_NAME)"
+  value       = aws_dynamodb_table.customers.name
 }
 
 output "dynamodb_table_arn" {
-  description = "ARN da tabela DynamoDB"
-  value       = aws_dynamodb_table.customer.arn
+  description = "ARN da tabela DynamoDB de clientes"
+  value       = aws_dynamodb_table.customers.arn
+}
+
+output "legacy_customer_table_name" {
+  description = "Nome da tabela legada de clientes, origem de migrate -copy-customers-from"
+  value       = aws_dynamodb_table.customer.name
+}
+
+output "pii_kms_key_alias" {
+  description = "Alias da chave KMS dos dados pessoais (PII_KMS_KEY_ID)"
+  value       = aws_kms_alias.pii.name
+}
+
+output "api_key_table_name" {
+  description = "Nome da tabela DynamoDB de API keys"
+  value       = aws_dynamodb_table.api_keys.name
+}
+
+output "outbox_table_name" {
+  description = "Nome da tabela DynamoDB de outbox"
+  value       = aws_dynamodb_table.outbox.name
+}
+
+output "events_topic_arn" {
+  description = "ARN do tópico SNS de eventos de cliente"
+  value       = aws_sns_topic.customer_events.arn
+}
+
+output "order_events_queue_url" {
+  description = "URL da fila SQS de eventos de pedido"
+  value       = aws_sqs_queue.order_events.id
+}
+
+output "order_events_dlq_url" {
+  description = "URL da DLQ de eventos de pedido"
+  value       = aws_sqs_queue.order_events_dlq.id
+}
+
+output "payment_events_queue_url" {
+  description = "URL da fila SQS de eventos de pagamento"
+  value       = aws_sqs_queue.payment_events.id
+}
+
+output "payment_events_dlq_url" {
+  description = "URL da DLQ de eventos de pagamento"
+  value       = aws_sqs_queue.payment_events_dlq.id
 }
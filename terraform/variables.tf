@@ -10,6 +10,90 @@ variable "table_name" {
   default     = "Customer"
 }
 
+variable "customer_table_name" {
+  description = "Nome da tabela DynamoDB de clientes, chaveada pelo índice cego do CPF"
+  type        = string
+  default     = "Customers"
+}
+
+variable "api_key_table_name" {
+  description = "Nome da tabela DynamoDB de API keys"
+  type        = string
+  default     = "CustomerApiKeys"
+}
+
+variable "outbox_table_name" {
+  description = "Nome da tabela DynamoDB de outbox dos eventos de domínio"
+  type        = string
+  default     = "CustomerOutbox"
+}
+
+variable "events_topic_name" {
+  description = "Nome do tópico SNS dos eventos de cliente"
+  type        = string
+  default     = "customer-events"
+}
+
+variable "order_history_table_name" {
+  description = "Nome da tabela DynamoDB do histórico de pedidos"
+  type        = string
+  default     = "CustomerOrderHistory"
+}
+
+variable "order_events_queue_name" {
+  description = "Nome da fila SQS dos eventos de pedido"
+  type        = string
+  default     = "customer-order-events"
+}
+
+variable "order_events_topic_arn" {
+  description = "ARN do tópico SNS do serviço de pedidos (vazio para não assinar)"
+  type        = string
+  default     = ""
+}
+
+variable "loyalty_table_name" {
+  description = "Nome da tabela DynamoDB do extrato de pontos de fidelidade"
+  type        = string
+  default     = "CustomerLoyalty"
+}
+
+variable "consent_table_name" {
+  description = "Nome da tabela DynamoDB de consentimentos LGPD"
+  type        = string
+  default     = "CustomerConsent"
+}
+
+variable "audit_table_name" {
+  description = "Nome da tabela DynamoDB da trilha de auditoria de clientes"
+  type        = string
+  default     = "CustomerAudit"
+}
+
+variable "customer_history_table_name" {
+  description = "Nome da tabela DynamoDB do histórico de versões dos clientes"
+  type        = string
+  default     = "CustomerHistory"
+}
+
+variable "pii_key_alias" {
+  description = "Alias (sem o prefixo alias/) da chave KMS que protege CPF, nome e email"
+  type        = string
+  default     = "tc-fiap-customer-pii"
+}
+
+variable "payment_events_queue_name" {
+  description = "Nome da fila SQS dos eventos de pagamento"
+  type        = string
+  default     = "customer-payment-events"
+}
+
+variable "payment_events_topic_arn" {
+  description = "ARN do tópico SNS do serviço de pagamentos (vazio para não assinar)"
+  type        = string
+  default     = ""
+}
+
 variable "environment" {
   description = "Environment name (staging, production, etc)"
   type        = string